@@ -29,17 +29,18 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVMo\xdc6\x10\xbd\xef\xaf\x18\xa4\xd7J\x9b\xa0=\x14\xba%n\v\x04M\x02cm\xf8>\x92fw\x19S$K\x0e\xd7\xdd~\xfc\xf7bHɫ\x95do\xec\x02\xd5M\xc3\xe1㛯G\x16E\xb1B\xa7\xee\xc8\aeM\x05\xe8\x14\xfd\xc1d\xe4/\x94\xf7?\x85R\xd9\xf5\xe1\xdd\xea^\x99\xb6\x82\xab\x18\xd8v\x1b\n6\xfa\x86~\xa6\xad2\x8a\x955\xab\x8e\x18[d\xacV\x00h\x8ce\x14s\x90_\x80\xc6\x1a\xf6Vk\xf2ŎLy\x1fk\xaa\xa3\xd2-\xf9\x04>\x1c}x[\xbe\xfb\xb1|\xbb\x020\xd8Q\x0556\xf7\xd1yr6(\xb6^Q(\x0f\xa4\xc9\xdbR\xd9Up\xd4\b\xfa\xce\xdb\xe8*8-\xe4\xdd\xfdə\xf5\x87\x04\xb4\x19\x80\x8eiI\xab\xc0\xbf-.\x7fR\x81\x93\x8b\xd3ѣ^\"\x92\x96\x832\xbb\xa8\xd1\xcf\x1c\xe4\x80\xd0XG\x15|\x11.\x0e\x1bjW\x00}\xa4\x89[\x01ض)w\xa8\xaf\xbd2L\xfe\xca\xea\xd8\r9+\xe0k\xb0\xe6\x1ay_A9d\xb7l<\xa5\xc4ު\x8e\x02c\xe7\x92\uf430\xf7;\xea\xff\xf9(\x87\xb7\xc84\a\x93̕'\xae\xb7GGg(\xa7D\xc0h-#\x06\xf6\xca\xecV'\xe7û\x9c\x8afO\x1dV\xbd\xafud\xde_\x7f\xbc\xfb\xe1\xe6\xcc\f\xe0\xbcu\xe4Y\r\xe5\xc9ߨ\xfdFV\x80\x96B\xe3\x95\xe3\xd4\x1c\x7f\x17gk\x00r@\xde\x05\xad\xf4!\x05\xe0=\r9\xa6\xb6\xe7\x04v\v\xbcW\x01<9O\x81L\xeeL1\xa3\x01[\x7f\xa5\x86\xcb\t\xf4\ry\x81\x81\xb0\xb7Q\xb7Ҿ\a\xf2\f\x9e\x1a\xbb3\xea\xcfG\xec\x00lӡ\x1a\x99\x02C\xaa\xa2A\r\aԑ\xbe\a4\xed\x04\xb9\xc3#x\x923!\x9a\x11^\xda\x10\xa6<>[O\xa0\xcc\xd6V\xb0gv\xa1Z\xafw\x8a\x87\xa1ll\xd7E\xa3\xf8\xb8N\xf3\xa5\xea\xc8ևuK\a\xd2\xeb\xa0v\x05\xfaf\xaf\x98\x1a\x8e\x9e\xd6\xe8T\x91\x021i0ˮ\xfd\xce\xf7c\x1cΎ\x9d\x15:\x7fi\x92^P\x1e\x19-P\x01\xb0\x87\xca!\x9e\xaa &I\xdd旛[\x18\x98\xe4J墜\\gy\x19\xea#\xd9TfK>\xef\xdbz\xdb%L2\xad\xb3\xcap\xfai\xb4\"\xc3\x10b\xdd)\x966\xf8=R`)\xdd\x14\xf6*\t\x17\xd4\x04\xd1\xc9\xe8\xb4S\x87\x8f\x06\xae\xb0#}\x85\x81\xfe\xe7ZIUB!E\xf8\xa6j\x8d\xe5x\xea\x9c\xd3;Z\x18\xa4\xf4\x89\xd2N\xe5\xf1\xc6Q#\x95\x95\xe4\xcaV\xb5UM\x9e\xa9\xad\xf5\x803\xff\xf3L-K\x80|YDo\xd8z\xdc\xd1'\x9b1\xa7N\x97\xdaN\xbe\x0fK@\x03c\x91\xad\xac\t\xb4\xec\xb8\x00\xc8{\xe4\x91\x180*\xf3\xa8)\x8bA>S\x99T\x1d\x14\xa50h\x1a\xfa5\xf5\xa3i\x8e\x17\x02\xfd\xbc\xb0EB\xda\xdb\a\xb0[&3\x06\xed\xb9.DR\x13\xf8h^D\xf6\xfc\xa6\xb8@ss\xe6\fʴ\xd2\x1b\xbd4\xcb!C\xea\xa5\xd8dZ\xf0\xe7\x97\xf2\xf8#\x13\xbb\xf9q\x05\xdc[\xa7p\xc1\xee)\xb0j\x16\x16\u07bcyY\xbc\x02\xf3\xb1\x95\xe1\xdb*\xf2\xaf\xe9\xc0\xcd\x04ch\xbemԺ?\xa0hl\xe7\x90U\xadiPH\x19\x1f\x95\xf7\x1c\xe7\xbc\x12\xed\xff\xd0t\ay]\xd0\xe3{\xe45aݝC\x8cG*\x1b\x12\xbf<\xc7#\x9a\xc3̄\x05Hg۞Y\xbf/H\x1a^\x10\x98\f\x83\xf24\xb9\x9b\x8ae5\x99\xf8,\xcd\xe1\xc4e\xda\r\x93\xe5IR\xbfIm\x199\x86\x97\xe8m\xda0$\xbb\x89ާ\xfb,[\xe5\x19\xf3j\xc5\xd5\x18x$,\xf2\xa8\xbc\xd0\x16\x9f\xe6;\x06b\x02\x06,\x86\xb1\x12=\xe0R\xd5\x175hk}\x87\x9c_\xad\x85\x00\xcd<L\xd4\x1akM\x15\xb0\x8f\xf3\xe5\xe7\x14\x97B\xc0ݥ\xe8>g\xaf\xfcl\xe9\xb7\x00\xd66\xf2\x13\xa9\xe7\xfd\x9c\x05\\(\xc7\x05\xa6n\x8f\xe1\x12\xcfk\xf1Yj\x88\xc9\xcd\xf6\x1c\x85\xa7\xd4\xf5\v=,X7\x84\xed\\\xa1\v\xf8byy\xe9\xc9\b\x17\xa7bf\f\xf2\xc2kGu\x0ey\x90ǖX?>`+\xf8\xeb\x9fտ\x01\x00\x00\xff\xff\xdd}\xa6m\xca\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}_s\xdb8\x92\xf8{>\x05ʿ\x87\xd9ݒ\xecI\xfd\xf6\xe1\xcao\x19'\xb9Q\xedL\xe2\x8a=\xd9g\x88lI\x18\x83\x00\x17\x00ek\xef\xee\xbb_\xa1\x01\xf0\x8f\b\x92\xa0,{\xb2{\xe1Kb\x11l\x00ݍ\xeeFw\xa3\xb1\\.\xdfВ}\x05\xa5\x99\x14ׄ\x96\f\x9e\f\b\xfb\x97\xbe|\xf8\x0f}\xc9\xe4\xd5\xfe\xed\x9b\a&\xf2krSi#\x8b/\xa0e\xa52x\x0f\x1b&\x98aR\xbc)\xc0М\x1az\xfd\x86\x10*\x844\xd4\xfe\xacퟄdR\x18%9\a\xb5܂\xb8|\xa8ְ\xae\x18\xcfA!\xf0\xd0\xf5\xfe\xc7˷\x7f\xbd\xfc\xf1\r!\x82\x16pM\xd64{\xa8J}\xb9\a\x0eJ^2\xf9F\x97\x90Y\x90[%\xab\xf2\x9a4/\xdc'\xbe;7ԟ\xf0k\xfc\x813m\xfe\xd6\xfa\xf1\x17\xa6\r\xbe(y\xa5(\xaf{\xc2\xdf4\x13ۊS\x15~}C\x88\xced\t\xd7\xe4\x93\xed\xa2\xa4\x19\xe4o\b\xf1\xa3\xc6.\x97~\xc0\xfb\xb7\x0eB\xb6\x83\x82\xba\xb1\x10\"K\x10\xefnW_\xff\xff]\xe7gBrЙb\xa5\xc1\xb9\xff\xf7\xb2\xfe\x9d\xf8Q\x12\xa6\t%_q\x8eDy\x94\x13\xb3\xa3\x86((\x15h\x10F\x13\xb3\x03\x92\xd1\xd2T\n\x88ܐ\xbfUkP\x02\f\xe8\x16\xbc\x8cWڀ\"\xdaP\x03\x84\x1aBI)\x990\x84\tbX\x01\xe4O\xefnWD\xae\x7f\x87\xcchBEN\xa8\xd62c\xd4@N\xf6\x92W\x05\xb8o\xff|YC-\x95,A\x19\x16\x90\xee\x9e\x16'\xb5~\x1d\x9b\xab},z\xdcW$\xb7,\x05nZ\x1eŐ{\x8c\xda\xf9\x99\x1d\xd3\xcd\xf4\x91\xc9\xec\xcfT\xf8\xe1_\x1e\x81\xbe\x03e\xc1\x10\xbd\x93\x15\xcf-'\xeeAY\x04fr+\xd8?kؚ\x18\x89\x9drj@[\xcc\x18P\x82r\xb2\xa7\xbc\x82\x85E\xca\x11\xe4\x82\x1e\x88\x02\xdb'\xa9D\v\x1e~\xa0\x8f\xc7\xf1\xabT@\x98\xd8\xc8k\xb23\xa6\xd4\xd7WW[f\xc2\xfa\xcadQT\x82\x99\xc3\x15.\x15\xb6\xae\x8cT\xfa*\x87=\xf0+ͶK\xaa\xb2\x1d3\x90Y2_ђ-q\"\x02\xd7\xd8e\x91\xff\xbf\xc0\x1e\xbaӭ9X\xb6\xd5F1\xb1m\xbd\xc0\xf51\x83<v\xe98ft\xa0\xdc\x14\x1b*؟,\xea\xbe|\xb8\xbbo3*Ӟ(-~\x1d\xa2\x8f\xc5&\x13\x1bP\ueecd\x92\x05\xc2\x04\x91;VE>\xe7\f\x84!\xbaZ\x17\xccX6\xf8G\x05ڮ\x01y\f\xf6\x06e\x10Y\x03\xa9\xcaܲ\xf1q\x83\x95 7\xb4\x00~C5\xbc2\xad,U\xf4\xd2\x12!\x89Zm\xc9z\xdcء\xb7\xf5\"\b\xc8\x01\xd2:\xc1rWB\xd6Yh\xf6+\xb6a\x99[N\x1b\xa9\x1a\xb9\xe3d`\x17C\xf1\xa5o\x9fL\xb3;AK\xbd\x93\xe6\x9e\x15 +s\xdcb\x8aאxw\xab#(a\x84~\xbc(\xb3*\r\xb9]\xb4\x8f\x94\x19\x1c\xf3\xcd݊|Ea\x15\xbeF\xa1Uib*%,\x97D\xfa\xfa\x024?\xdc\xcb\xdf4\x90\xbcB\xe6\xce\x14 \x1e\x16d\r\x1b\xcb\t\n\xec\xf7\xf6\x15(eq\xa3q\x00\xb2\xea\t\x1b\xfb\xdc\xef\xc0\xe2\x96V\xdc\xf8u\xc24y\xfb#)\x98\xa8L\x8f\xd5\x06\xa9\x8e\x98\xa2\x86\x16r\x0f\xea\x14$\xbe\xa7\x86\xfej?>\u009d\x05J\x10\xaaE\xde\xda\xe3q}\xc0\x971j\xbbg\xb5iAd\x9a\\\\\x10\xa9ȅ\xd3\xc0\x17\v\xf7uŸY2\xd1\xee\xe3\x91q\x1ez\x997y\x87CGP}/?jǼ'\xe1b\x00V\v5\x8f;0;P\xa4\x94\xb5\xc6\xdb0\x0eD\x1f\xb4\x81\xc2#&h\x11?\x9fHO\xb8v8\xf7 \xb4ū\x9fH\x7f\xf2\xa2✮9\\\x13\xa3*\x18\xc0\xcdZJ\x0eTL \xe7\vhòs\xa0\xc6A\x8a F\xf9\x17\x1d\f\xa0Ҥ\x0f@h\x04\xb4Ǚ\xd5Μ\xb7\x10\xdb\xc5ʛ\xe8\xa0J\x05\x99\x15\xdb\xd7^\x1d0ਂ\x84$\\\x8a-(\u05fd5U\x02\x87)\xb0\x1c\x97\x13+i\x15p\xabNȦ\xb2B\xf8\x92\xd8\xe5=\xc8\x04Lh\x034\u009d\xcf \x10<e\xbc\xca!\xbfq\x96ם5 \xf3`6\xf7\xc4f\n\xa1>\x8cB\xf4꙳\f\xad@o\xf0-\xd1p\x8d\xf1i\xa3\xa5\x0f%8\xdb\xd9\xd2\xd2\x0f\xbbQ\xbf\xa3\x02A\x83\xb1\x1f]\xfc\xe5b\x81$\xee\xf6\xda\xedC\x13\xaa\xa0FK\xb2\xe0\x84\xa24\x87~kf\xa0\x88`qT\xa0$ғ*E\x0f\x03Ԭ7\x00g\xa4\xe7\x10\xcc#\x8a\x8a\xd0\xec\x95iz\xdc\xef\xbf3U\xcfCG\x8d\xdb]ʄ\xa5\x9f\xddyvȧ\xdd\x06\u03a2MH\x13\x81Ǆ\x83\x87{\xb3\x11j\xfdA\xc8:\v\xcf\x0f1y\xcd[\x9ey\xff%1\xb5\x93\xf2a\n;?\xdb6ͮ\x88d\xe8V!k\xd8\xd1=\x93\xcaO\xbdѵ\xf0\x04Ye\xa2\xab\x9e\x1a\x92\xb3\xcd\x06\x94\x85S\xee\xa8\x06\xed\xf6\xc9\xc3\b\x19\xb6\xdfIK\x8cD_\x1eͣ!\xa4%\x13\xce|h\xe8\u05908֒\xe1\xb1\x03\xb5\xf65*\xe3\x9c\xedY^Q\x8ez\x99\x8a\xcc͇\xd6\xe3\x8aI\x99\x11\"\xf7\xc6\x1c\xe5L\xf78\x83 L\xca\x12\xa9\xb3U\x92\x02\xac\xd1[\xd8MA\xbf\xe9\xf0\xcc\xd7\xd4\xda*rh\xf6\x04\x89\xa5*\x0e\xdaw\x95\xa3\x1d\xd9ȌEC\x14\xf4D\x10N\xd7\xc0\x89\x06\x0e\x99\x91*\x8e\x91):\xbb'E\b\x0e 2\"\xf9\xba[\x8df\x02# \t\xee\xe1v,\xdb9S\xcf2\x11\xc2!\xb9\x04k\xf0\x19B˒G\xd4E\xf3\x8c\x12\xdfw2\xb6֛gb\xd5\x1fË\xad\xff\xe6I\x90\x99\xcd\x13Em\xb3\xbe\xba\x98\xad\xd9!\xbe\xa9m\x9e\x7fO\xc4\x06\xc9\x7f\x02ӎ\xac~\x82n\xa1d\x9e\x1e\xe4[\x8bU\x06\xfaҚSh\xe9,\b3\xe1ש\x95б\xb9z\u07b2\x0e\x12\xbem\xda\xccg\xfaDҤ\xac\x89\x17\"L\xddſ ]Pe\xdcy\x8d\x91L\x93_\xda_-\b\xdb\xd4H\xcf\x17dø\x01u\x84\xfd\x93D}\xa0\xcc9\x90\x91\xa2\xf5\b\xfa\xefM\xb6\xfb\xf0dM0݄\xaa\x12\xf1r\xfc\xb13d\x83\xb5\xdfU\xcf\x13p\t\xfa\xb1\x99\x82\x02\xfd\xe3\xb8cj\xff\x82\xa6ջO\xef\xe3\xfb\xab\xf6\x93\xc0y\xbd\x89L,:\xf7\xbc;\x9aQ{|ބ\x0fo\xd0\x06\xaa7@.\x16\xb2 \x94<\xc0\xc1\x99.T\x10K\x1f\x1a\x1a't\xaf\x00\x832\xc8g\x0fp@0\xf1(K\xffI\xe5\x06\xf7<\xc0!\xa5\xd9\x11\x0e혘\xf6\xd1#\x8b'\xfb\x03\"\x02\x9d\xeb\xa9l\xe0\x1e\xbf\x14\"1\x8d\xf8\x93(K\xc2\x13p\x7f\xc24\x93X\xa5\xddG;L\x89\x1c\xf0\x83v\xb4\xb4+f\xc7J\x14\xab\xe8q\x90\x9bd\x82\xba\xe7+\xe5,\xaf;rkd%\x16\xe4\x934\xf6\x9f\x0fOL\xfbH\xe6{\t\xfa\x934\xf8ˋ`\xd4\r\xfc%\xf1\xe9z\xc0\x85&\x9c\x94\xb7\bk\xc7\xe2\x9cN\xb3\xdcV\xe3\x9ei\xb2\x12v\xbb\xe2P\x92\xd8\x15\x86]]w\xae\xa3\xa2\xd2\x18F\x13R,\x9d\xdb&֓ǷT\x1dt?\xbbS\xdf\xe1\xbdU\x16\xee\x8d\v\xfer\x9aA\x1e\xe25\x18\x95\xa4\x06\xb6,K\xec\xaf\x00\xb5\x05RZ\x11\x9e\xc6\x11\x89\x82\xd5\xcff\x1e\xfb\xa4i\xef\xf0x\xc1\x9bO\x0ffi\x17\\B\xab@\xc6ɦ\x03\x11\xc7\xe1\xa6\xd33B-\x8a&\xc6$vi\x9ec\xa2\t\xe5\xb73$\xfa\fZ\xcc]\x9a\xad\xb1;\x15XP\x8cu\xfc\x97\xd5t\xc8\xcd\xffCJʔ\xbe$\xef0\xa7\x84C\xe7\x9dwZ\xb5\xc0$t\x899!\x96\x05\xf6\x94[\xddk\x05\xa8 \xc0\x9d&\x96\x9b\x9e]\xb2 \x8f;\xa9\x9dڬ\x83(\x17\x0fpp!\xbb\xc9.ۋ\xfcb%.\x9c\x0e\xef-\xd8Z\xe1K\xc1\x0f\xe4\x02\xdf]<ǔId\xb6\xc4fOˇ:-fY\xd0r\xe9\x19\xd4\xc8bDh`NO\xaa\xa1l7\x8c\xc1\b\xb0\x1fֹ*\xd6\xc8\x1d\x9bm\x12\x8b\x96RG\"\xe9\x03C\x99`\xde[\xa9\x8d\xf3Wul֨CK\x06'\x16\xa1\x1b\x97@$U\xc8\xf6\xb0Bq\xca\xf5\xda~\xeew\xa0\xc1\xc7\v\xbcc\xcc\x01\xb5;\xab\x8bf};i{\xe1\xe2\x15\xd8\t\xcd\xd0b\xc0oK%3\xd0\xd1`r\xf3$\xc8\xebHZD{\xee\xb5Ϗ\xba]\x8aˉ\x18wA\x86'\xdd䴈\x98i\xaf\x7fxj9$\xedڷ\x7fO\xf1\xd8\xdcq\x11\xcc\xd9+\nz\x9c'\x944\xc4\x1b\xf7eX\r\x1e\x903\xfeնBI\x90\xaaKk\x06\xfc\x16\x14u\xc1\xc4\n; oϮ\xd8I\x90\xa1\xb1l\x8f\xd8s\x9a)y\x13:i\xa8S\xff\xe0\x96r)\xd1U\xaf\xa0C\xbc\xbeW\x1b\xed@!M\xcb!0\xc3\xdc+e\xfe\x83&\x1b\xa6\xb4i\x0fA\x0f\xe4\x89D\xc1\xcc\xdc\xf8\x88\x0fJ\x9d\xb4\xef\xf9\xec\xbel\xb9\x9bv\xf21\xe4G9\xc4$\xce\x1c\xe3;@؆0C@d\xb2\x12\xe8@\xb1\xeb\x18\xbbp\xc8u\x12\x96\xa5.\x92\xb4\xd5o\x1f\x10U\x91\x86\x80%r\n\x13\xa3\x9e\x96v\xf3\x8f\x94\xf1\x97 \x9b\x19J#\x8b=\xa7\xad\x89\x90c\xd6Έ+\xe8\x13+\xaa\x82\xd0\xc2\xd2\b\x959+\xa0K\xf4&\xf3\xcc~\x81j\xc2H\xbbbJ\x0e\x06|\xf6X\xe2\x182)4ˡV\xae\x9e\x11\xa4 \x94l(\xe3\x95J\x94\x80\xb3\xd0;g7\xe1%\xc1\xf9\xb6\ti\x9d/\x11\x15\t\xde\xd4D[q\\\x1a\x97*\xdd\xe2\x9b2\xb3\x14̷\xb2J\xc5$\xe6\xe5\x9d\xd9\xd0\xf2\x99\x8cT\x1c\xbe[Z\xa9C\xfdni\x8d=\xdf-\xad\x89绥\xf5\xdd\xd2Ji\xf9\xdd\xd2\xfani\xb5\x9f\xff\x13\x96\xd6Ԉ܁\xba\x81\x97\x93\xa3H\b\x15\x8f\rq\x04\xbeOn\xf09\xd8\xcfʅ\\\xc5AE2\xef\aҪcB\xabQ\x1eur\xa4]5\x81\xe7\xdd\xf9\x9e\tS\xf2\x19Y\xef\xa1\xd3\xf3e\xbd\xafF!\x9e)\xeb\xdd\x0f{\xda\xc6>)\xe7= e^v\xf4\xc2'J\x14@\x83[݅\xc1c\xf3\x1a␉\xfe_91\xb6\x97\xb5uF\xfex\xf1,\xfad\x1e\x89\x92\xf4\xe2/\x17\xdf\x1e\xfaσ\xf0A\x14\xf7q\xe7\x0f\x18G\xa0\xda\x1dh;-\xab\x9b\x05\xf7m\xb2\xf1Y\xf865\x13\xbeFb\x04V\x97%\x8f\xb0\xf8\xad\xca\x02\x03\xc5\xe7\xd2k\xa4g\x1c\x15]E\xe0$\x1d\x16\xa5\xfa \xb2\x9d\x92BV\xda{%,\xacw\x99;Q\x1e@Ƙ5\xba\xc2\xffJv\xb2\x8adb\x8f\xa0o\"#oz\xf2\x9d\xe4<\x1f\x84\x06C\xf7o/\xbbo\x8c\xf4\xa9z䑙]\x04\xd0\xe3\x0e\x04F\xd8Ŷ\x9d\x80\x1f\n\x02\xf8\x93\xf1\xc7\f\x16\x01$\x15\x11\x8c;Ϋ\xcb\t\xb4\xf9\x8e|.\x9d\xefi\xb6\xdd1\xeeSIK\xe6;9\x85\xaf\x9b\xa27`\x97\u038dv\x9f\xe5\xc8\xc2\x1f\x92\x9a7?!/\xc5#6\x91|wB\xca]bn\xef\xb3\xc3\xf3)Iusv\xcc/\x96@w\xfe\xb4\xb9$\xfcL\xa7\xc8\xcd\xc1\u038b\xa7ýb\x12\xdc뤾%&\xbc\x9d/s\xfd\x1c\x1e\x80\xe1\xf4\xb5ɤ\xb5I\x0f\xc1\xf8\xf8&\xd3\xd2\xe6$\xa3Mb,\x8d\xf5_-\xdd\xecՒ\xcc^7\xb5l\x94%F_\xceI\x1e\x8b\xd7j!\x93\n\x90\xbf\x16\xb3\x9d\x8a\x06\xa9:&\xe5I{\x9e\xcfG0,ჹ\xf5JvkQq\xc3J\x8e\xc1\xcd=ˣ\x0e\x00\xb3\x83C]T\xe2w\x89\xc71}y\x94\xcf_j\xae\xbd<\xb2\xbe\xa9&\x8f\xc09\xa1\xb1u՛y\xe6\xca\x13er\tVG\xd8\xd5\xe9\xabe\xf8\x9aF\v\xc7\xeex\xe2\x145M\x11s\xfbP1\\ZeP\x98\xa7ț\x9eU\xe9lc\xfc\xed\x1f\x15\xa8\x03\xc1\xe2.\xb5\xed\xd1\x1c\x8c\xf2\vS\xdb\xcdQ\x10\x15^l\r\xf9\xb4{\x86x\xb3\x94\xc9;\xe14\xe1\xf1x\xf0\x1b+#\x9a\x8d\x86\x15|v\x0f\x11\xedc\xe0s!\xeb\xaf#\x9fM\x19\xad\xa9'\x88^v\xdb1\x7f\xe31\xa9\xe9ӭ\xb1?\xe8d\xd0)'\x82҂\xf2\x93'\x80^j\x1b2\xb5\x11I\xb6\xbd\xd2N\xf8\xcc\v\xe0\xbd\xe0\x89\x9e\x978ɓ\x88\xa9\x94\x93;\xf3\xf0\xf4\n'u^\xf5\x84\xcek\x9d\xccI>\x91\x93\x94v\x92\x1c\x99MI\x1b\x99\x0e\x9e\x8e\x9f\xb4I8a\x93\x10V\x9d\x1ai\xc2I\x9ay'h\x12p\x98\xba4^\xf1\xa4\xcc+\x9e\x90y\xed\x931\x13L2\xf1z\xde\t\x98\x93\xdd\xfaR\xe5\xa0FC#\xa9\\8\xca\x7f){\x8d\xee@\x8eb\x02\xa14\x9dmձ_Q\\\xfbj\x98X\xf7t(\xc4g9\xad\xa5\xfd;\xf1\x9a\xc6\x1c\xe9\x1aw\xbe\x18\xaa\v\xe9h(\xa9\xc2\x02\xbb\xeb\x83K\xf9\x88\xaa\xca\x0f4\xdb\x1dA\xdfQM6R\x15Ԑ\x8b:Hv\xe5\x80ۿ/.\t\xf9(뼁v\xed\x18͊\x92\x1f쎁\\\xb4?8\x8d\x03\xa2\xdc\x16z\xbb\x95\x9ce\x11[*Z?\xc85\xee\x15t\xc0\xaaFY;\xac^چqS\nͮn\x9dƍ\xe4\\>\xce܋Ӓ\xfd'\x96\x97~\x86\xb7\xe6\xdd\xed\na\x04\xf6\xc0z\xd5u\x02S=\x9b5X5\xd9\xccsh\xed\xaf6\x1d\x88\xdd\\\xc0v\x05W\xc8]\xb1ޠ\xa6\xbd\xe8̤\x95.\xb7+7\x8e\xa1^,\xcfPq \x12\xb3N̎\xa9|YRe\x0e.\x99a\xd1\x19CP\x8bcޖA\xed\xd1/@\x1cEo\xa8;\x8cQ\xbcC\xd9\r\x8c\x1e\xe3\xee\x94q\f\x9f\xf0\x9b<\xdbw\xc6q\f[\x18K\xc4T\xe4\xe7hv\xd4ټXڗ\xcf\xfdU\xee\xe1}ԛ\xd5A\xcf\xddQ\xf3H\nS\x80\xe8*\xc3\x0efr\xae\x01\xab\xc6\xf6_=#')t\xed\xeb~\x9e⸺낈\xcc/\x94A\r\x9d\xc5\xe4\x13V)?\x90ۯ\xb8g\xaaE\x9b_\xa2~\xcf\x14\\W!`\x1a\x81\xe3?\xf8\xe9\xfc\xe9[\xdaHE\xb7\xf0\x8bt\x85\xa0\xa7\xc8\xdem\xdd)\x10\ueb5e\x90c\x19\x16M\xacJ\xac/I}\x04\xacɋ\xeeU\u07b5\xa3\x9cYK\xd8\x18~\n\xdd\xef\xef\x7fq\xb32\xac\x80\xcb\xf7\x95K\t\xb02Q\x83Eq\x98\xad\x83\xb4\xb6\xff\xdd\xc9G,P\x1b\xf7+\x86\xc2\xfe\xcdd\x14`B6\xa6\xe9͚RUrIsP7Rl\xd8vbv\xbfu\x1a\x1f\xa9\xd9\f\x7f\xf4\x93\xabuT\x80\x7f\xe68\xbd\xb5y8\a\xfe\x91q\xd0nX\t\x02\xf8\xb6\xffU-\x8f\xabb\xedl\xb8\x8d}Yw0\xa0\xe3ܴ\xd05\\\x82\xb2V\x94s\"W:\xf0\xea\xf0\xc4\x1b\x8a0a`\v\xfd\r݈\x04\xdew\n\x93\a>\x9f\x12G_\xe3_\xb5\xcc\xca\xd6Jsv\xa5\xdcD\x06>\x04\xa7u\xcd\xc3#3\xbe,\xd3y\xebh\x0em\x16\x86\n\xd8c\xc5\xf6\xe9\x12\xf6\xae\xb0\xbb\xbf\xf8\xc23r\xa5\xb0\b\xa6/\xfa\x8eE#O\xaab\xbf\xaeӁ\xea\xd4\"\xfd\xce\x18(J\x13\xd3\xd2ӂ\xe4\xa71\x80\xb5\x85#\r\xe5-~\xa6\xa1A\xccF\xd5\a\x91\x8d\xa5-\xf9u<B\xcd1N\x8e!\xe0\xc6g۟\r\x015\xc0!\x04\xe8*\xcb@\xebM\xc5\xf9\xa1N\xf6\xffF\xb0\xf1\x912~>T8h\x83\x8c`\xa77\nir\xc2>\x99\x18D\x1eVz8\b3\x0f\x15\x9e\n>\xd7N\x1bZ\x9cT\x8f\xff\xa6\x0f\x06odQy+e\x8f\xd6c\xa7\xba!\x7fL,7\xe0ܗ\xb8=\xb1\xd0 '\xb0\aA\xac^s(\x0eW\n̈́\xe2\xcfO:\xdd\x104Ep\"D\xef\x9d!\xdeO\xa0\xf1~\x93\x1ft\r\x133\x11\xf1\xba\x8a>\x12\xfaf\xa3\xdb\xe7_[\xbb\x19\x96\x16\xc4i\xf6^T6g\x9au\xf5\xc2\xf3\x84\xdc\xcd\xddj\b\xdc)\"\xae\x7f\x9b\xc73\x97q\x7f\xba\xcf\x12i\xfd\xe9\xce\x12h\x11\x885\x8f\x9f\x7f\xee\xb8\xd4O+ٍ_:\x83#\v'\xb4(\xe7\xfe\x18]\x01Z\xd3m\xa8\xd5\xfdh\x8d\xf6-\bp\x8e-\x17\x06\x88\x00m\xce\\u+U\xbb%C3SQ\xdfAH\x1fm\xb5\xfaA\x13.cP\xf1\xbe\x0e\x16.\x82\n\xbb\x99\x99\x88z*\x99J\xd9\xfd|\xa8\x1bZܠ\r\x89\xd4i\xae\xee\x02ζ\xcc\xee\x12,\xe5\xb6T\xad\xe9\x16\x96\x99\xe4\x1cPZ\xf7\xc7\xf5\x92kݟl\xfb\x02TON\xedc\xbb\xad\x8fe9j\xbb\x10.u\xc9\xd4x9\x93a\n\x9a{\xd2z\x03\x92\xd8\U0006c34d\xc3B\xf4\x12\xb1\xfeH\xdbmê\xf3b\xd9{H\xfd\x1db\v\xbf\xa3\x8e\xf3cA\x7f\x97jA\n&\xec?T\xe4.\x14\x15>\x9e5\xfe\x9d\x94\x0fw\x11#\xb67\xf8\x9f\xeb\x86M\x90\x80\t7l<\x8e\xb8\x96\x95\x8f#\xd7\x06m< \x81u\xd7ϼQC\x98#\xfa\xa07\x9dA_\xe8\xcf\x1dH\x93\xaa\xc0\xf5<\x00\xeb.\\T\xc5\xf9aq\f\xf9\xe8R\xbc\x06v\xab.\xbd7\x03\x9a\xd3\xee\x03\x1d\x85XN\x14H]V\xa1-\xd0O\xd9/z4\x0f\x19\x93=\x1c\xffܴ\x1e£\x1bf\xcb\xdc\x1b\x98`\xc7\b<\xefV\x17/!\x98`\xfe[ۦ>\x19\xdfڸ\x85|\xa7A\xffV\xfcd\xf5\x92|\x82\xbe\xa3\xdf\x1d\x96\x86\x1cs\fpUE\x9a\xacĭ\x92[\x05\xba\xcftK\xf2w\xca\f\x13ۏR\xdd\xf2j\xcb\xc4\xe7\xe1\x83!c\x8do\xa92\xcc2\xad\x1bOl\xa0LP\xce\xfe\x19\x93O\xed\x97Ӏn\x067JK\x920\x8c\xa1\x17\xef\xc1ڪ\x83\xfb\xfb\xa8(,=^O\xb1;\x02M\xa6dcm\x1346E\xe8\xf6\x92|\x92\xd1\x05\xee\x13tX\x17\xa65\xad@\x9b%l6R\x19\x17\xaf].\t\xdb\x04'\x82\x95\x1d\xe89rW\n\x12\x16\v\xb4֩\x0f\x8d\x1aB\xb7\xafBm\x8a\x05\xc7\vzp\xb1\x19\x9ae\x95\xb5\x94\xae\xb4\xa1<b\xa8<K\x80\xa3\xb7\xc6.\"\xc8\x7f{V,k\xd5\x06\xd4w\xbba?\x0e\xa5Xr\xc1Yo\xdcN\x11\x04yT\xcc\x18k\x1bɑ`\xbaG\x95\xb16\x12\xe7D[T\x9f\xe4\x7f#N\x1c\xae\x86\x93DҦ|_C\x19\x12\xb3~\xd6x\x81\xde\x1aqC\xac\xfd\x8a\xf90\xbe\x95%s\xb6\xa3b;x\x8e}\xa7d\xb5\xdd\x05N\x1e0\x8aI^\x01\xba+Q\xa4\xe8p\xff\xab\xa9\x94h\x05\xd3G\x0e\a\x93\xc0\f8\\\x9a=\x90\xaa\\\xf8\xfbU\xfd\xf5\xb9W\xfe\xa6\x8a\xe5F\xc9b\xe9\xfbń\xb6\x85\x8fe+&\xad\x05bvQ\xac\x13g}\xfbb\xf0\xc8\te\t\x82P\xed{N\xa8't\xb2\xbaц*\xf3,w\xc4]\a\u0084'\x02\xbb\x8bO\xe2·\xf4]A\xa5\x1b\x7f\x8fd\rxA4\x13\xe1\x06_\x97\x1e\xe0\xf8#\x1a-\x12x\xe3\x9eT\xf1\f\xbaq\xd7BwB\xaf\xebU\xd8\u05fa\xf6\xc3ɛίG0\x8e\x0e\xc7\xe2\x05\x8bu\x93\xb0Q\xfc\x13\x8by\xbe1\xf52\xb3S\xf9\xf3\x1f~\xe8u\x9f\xb4\xa9\x89cdl\x8f\x83ۗ\xe1\xcdJ\xf7>\xc5[\x0e\xd6\xf4\xd2\x00\xdd\xedӬm\xf2\xfe\x8c~\xa3s:\x8d\xc2]\xd5\xe7\xf1\x9a\xec\xcf\xe8.z1_\xd1y\xa7\xfcH\xf1\xa6ۓV\xed\xdf\xfd\xb7\x11g\x91\a{nwQ\xcb[\x14\x06\xfe\xaa\xfe\xa2\xa8V\xea\xfd\x88r:oI\vߓ\xff\xe5\x7f\x03\x00\x00\xff\xff\xe1\xa0\x1ak\x81\x7f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccYI\x93b\xb9\x11\xbe\xf3+2b\x0es\xe9\aݶ\x0f\x0e.\x0e\x9a\xb2#:\\\xed\xaah\xca\xe5\xeb\b)\x01\rzҳ\x16h\xbc\xfcwGj\x81\xc7[\x1a\xaa\xed\x18\x8f.UOK*\xd7/3EUU\x13\xd6\xc8W\xb4N\x1a=\a\xd6H\xfc\xeaQӗ\x9b\xee\x7f\xef\xa6\xd2\xcc\x0e\x1f&{\xa9\xc5\x1c\x96\xc1yS\x7fAg\x82\xe5\xf8\x80\x1b\xa9\xa5\x97FOj\xf4L0\xcf\xe6\x13\x00\xa6\xb5\xf1\x8c\xa6\x1d}\x02p\xa3\xbd5J\xa1\xad\xb6\xa8\xa7\xfb\xb0\xc6u\x90J\xa0\x8d\xc4\xcbՇ\xf7\xd3\x0f\xbf\x9b\xbe\x9f\x00hV\xe3\x1c\u058c\xefC㼱l\x8b\xca\xf0Drz@\x85\xd6L\xa5\x99\xb8\x069ݰ\xb5&4s\xb8,$\n\xf9\xf6\xc4\xf9\xc7Hl\x95\x88=fbq]I\xe7\xff<\xbe\xe7Q:\x1f\xf75*X\xa6\xc6؊[\xdc\xceX\xff\x97\xcb\xd5\x15\xac\x9dJ+Ro\x83bv\xe4\xf8\x04\xc0q\xd3\xe0\x1c\xe2\xe9\x86q\x14\x13\x80\xac\x9aH\xad\x02&DT6S\xcfVj\x8fviT\xa8\xf5\xf9.\x81\x8e[\xd9\xf8\xa8\xcc$\vda\xa0H\x03\xce3\x1f\x1c\xb8\xc0w\xc0\x1c,\x0eL*\xb6V8\xfb\xabf\xe5\xffH\x0f\xe0gg\xf43\xf3\xbb9Lөi\xb3c\xae\xac&\x1b=\xb7f\xfc\x89\x04p\xdeJ\xbd\x1db\xe9\x919\xffʔ\x14\x91\x93\x17Y#H\a~\x87\xa0\x98\xf3\xe0i\x82\xbe\x92\x86\x80T\x84P4\x04G\xe6\xf2=\x00\x87D%\xeah\x98Sջ\xeb\x8amb\x05^;T\x12\xff4\x93\xb9o\x91-\xfe=\xe5\x16\xcf$\x9dgusEw\xb1\xc51bW\xaax\xc0\r\vʷE%+\xa9\xb6_^\x8b\xd5 \x9f\x8at\xea\xeaƇ\xab\xb9t\xeb\xda\x18\x85,QI\xbb\x0e\x1f\x92\x17\xf2\x1d\xd6l\x9e7\x9b\x06\xf5\xe2\xf9\xd3\xeboWW\xd30\xe4H\x9d\xa0 ñ\x96mvh\x11^c\xfc%\xbb\xb9,ڙ&\x80Y\xff\x8c\xdc_\x8c\xd8XӠ\xf5\xb2\x04K\x1a-,j\xcdvx\xfaWu\xb5\x06@b\xa4S \b\x940\xf9U\x8e\x1f\x14Yr0\x1b\xf0;\xe9\xc0bcѡN0E\xd3Lg\x06\xa7\x1d\xd2+\xb4D\x86b;(AXv@\xeb\xc1\"7[-\xffq\xa6\xed\xc0\x9b\xec\xcc\x1e\x9d\x87\x18\xa1\x9a)rր\xef\x80iѡ\\\xb3\x13X\xa4;!\xe8\x16\xbdx\xc0u\xf9\xf8L\xd1 \xf5\xc6\xcca\xe7}\xe3\xe6\xb3\xd9V\xfa\x82\xd0\xdc\xd4u\xd0ҟf\x11l\xe5:xc\xddL\xe0\x01\xd5\xcc\xc9m\xc5,\xdfI\x8f\xdc\a\x8b3\xd6\xc8*\n\xa2\x13\xa4\xd6\xe2\a\x9b1\xdd]]\xdb\v\xe94\"\xa4\xbe\xc1<\x04\xaf\xc9e\x12\xa9$\xe2\xc5\n4E\xaa\xfb\xf2\xc7\xd5\v\x14N\x92\xa5\x92Q.[{z)\xf6!mJ\xbdA\x9b\xcem\xac\xa9#MԢ1R\xfb\xf8\xc1\x95D\xed\xc1\x85u-=\xb9\xc1\xdf\x03:O\xa6\xeb\x92]\xc6,\x06k\x84\xd0D\x90\xe8n\xf8\xa4a\xc9jTK\xe6\xf0\x17\xb6\x15Y\xc5Ud\x84\xbb\xac\xd5\xce\xcd\xdd\xcdI\xbd\xad\x85\x92SGL;\x88\x06\xab\x06\xf9U\xdc\tt\xd2Rdx\xe61FWGA\x19*Ɠr\x19\xc3 A\x83q\x8e\xce}6\x02\xbb+\x1d\x96\x17\xe7\x8dW<6hk\xe9bz\x85\x8d\xb1\xdd\xcc\xc3\xceH\xde\x1e\x05\xf1\xba\x06\a@\x1d\xea>#\x15|A&\x9e\xb4:\x8d,\xfd\xcdJ߿hĐ4\x12\x8b\xab\x93\xe6\xcfh\xa5\x117\x84\xff\xd8\xd9~V\xc1\xce\x1ca\x13\xfd_{u\"\xecr'\xcd\xfb\xa8]\xc6\xe2\xf9SA\xf0\x14[90\xb3\xae\xa6\xb0\xc8Am6\xf0\x1e\x84tTH\xb8H\xb4\xaf,\x1dT,4\xe6\xe0mx\x93\xf8\xdc\xe8\x8d\xdc\xf6\x85n\xd7Fc\x1es\x83tGs\xcbx\x13\xa1\x16yGc\xcdA\n\xb4\x15Ň\xdcH\x9e9\t6e\x90\x8dD%z\xd84\x1aeQ\x14\x8b\x82\x82\x9a\xa9\x1b6\\\x9e7\xc6J\x9aI\x9d<\xf8B b\x8d\xadsj\xd6\x1e\xb5\xc0n\xb6\x89ܘ\bh\x0e\x05\x1c\xa5\xdf%\xa4TCq\aߌ=\x1a{<\rMwx\x7f\xd9!\xedL\x89\x17\xc1!\xb7裷\xa1\"\xf7!W\x9a\x02|\x0e.bm\x17'ʈ\x05_9\xbd\xc7S_\xd1p˸\xb9\x14\xba\xcdr/{\x95A\xa5y\x11\xc4\xe2\x06-\xea^\xb5P\xc6@\x06\xa0\xb6\xc7j\xf4\x18\x93\x800\xdc\x11\xfesl\xbc\x9b\x99\x03ڃ\xc4\xe3\xech\xec^\xeamE\xe6\xa9r\xbc\xcdb33\xfb!\xfe\x19\xb9\xef\xe5\xe9\xe1i\x0e\v!\xc0\xf8\x1dZ\xb2\xf1&\xa8▭\xaa\xea]L\xde\xef H\xf1\x87\xefQ\xa2iR\x98ݡ\xc8U\f\x95\x13U\x87\x91'\xd2\xdb*\x99\xd0X\xa0\xfcK\x9eQg\xd3'`\x1a\xf2ڡ\xb2\xb6=\b\xc5(\xdd\f\xc1\xef\x1e\xfb\xc8\xfb\x8d\x98\x04\xf8Z]\xecTլ\xa9\xd2n\xe6M-\xf9\xa4+m\xac\xbdo\x84o\xa9\xf5\xa5\x16\x92Smx\x1dv\xa5\a\x12W-\xc1\x80\x1a\xbaM\xc2\x18\xd8\f\xab)\x89\x9bS\xed\r\x8e\x9f\xda{/\x9dcB\xbe\x9c>\x1dz*\xdb\x1ch\xa4\xf4\xcal_\xcf\x11o\xb8њ\x02\xdd\x1b`g\x14\xfd\xd1u\xd3\xc7\x1b\xc1g\x1d\xf8\x1e\a\x14\xdf\x13\xe5c\xdcXt\x9c\x8e\x11/\xc1a\xc4\xf5[l\xc0\xed\x88\xe0l\x89\xf6\x1e^\x96\v\xdax\xce\xc0\f\x96\vX\a-\x14\x16\x8e\x8e;\xd4Դ\xc8\xcdi\xf8.\x1a/\x8f\xab\xa2\xd5X\xbc䶣\xe8vX\x86\x94\x1e\xe6\xb0>\r\x94\x1bw\b\xd9X\xdcȯw\b\xf9\x1c7\x16\x857\xcc\xef@j'\x05\x02\x1bP\x7f\xaa\x03G\x04=\x97\x16O\x19s\xbe\xc3<\xdf\u0086\xc4\xce[\xe0\xa1\xe8\xf8F\xfc<\xe7mg-\x94\xef\x9c<\xae\xcḇ8\x1e\x94\xe8p~\xd3\xf8S*\xde\xf8@\x16\xbeb\xe6\xb5\x7f\xe2\x1bE`yY\x19\nf*9\x8c\xb5\xe8\x1a\xa3\x05\xb5l\xf7\x95\x80\x17\x96\xffw\x85\xe0\xb0Y\xabk\x94\xeb\xac\x15+\xdc\xd5\x05\xc5W\xa47\xf7A\xe9m\xad\xdde\x98\xb5\xa3\xfe\xf4\xd2\nud\xfcE:\xa0\xc1\x8a\xa6\xd5\x16Qg\xae!\xe8X\x18ƒa:\x99\f\x1cy\xa0&\x9cR\x98\x98\x93p6\x9e\xd4\xe6H\xa7[\xe4\"\x050:%|\xea\r\x99\x16\xb9+\xa7\xa5\x01\xcaG\xa9\x14\x15\x01\x16kCڢ\xb2֢:\x01s\xe4M\x87\xdfL\xdf\xff\xffZ.Ŝ\xa7\x0e\n\xc5\x17<\xc8\xfe\xd3\xd4}\xfa~\xecQ)\xf0p\x0e\x1a\xfa\xf8\xa9t\xeb3\x9b\xb7\xfd\x04\x1b\xa9\xa8\x98la\xc7\x1d\xe5\xc1\xc0\xc3\xea\xc7\xd5\xe3\x8f.\xf6\x10\xa8\xbd\x83#Y\xd0E\x96\xa8i0\xf9\x85$8OY\xe4\xb6\x03\x14{&/\x00e\xf4\x96*\xcf\xf4\\B%^\xf2'cA\xa0\xa7l\xa5\xb7\xc0wLo)6\x86@?r\x9c\xd9o3J\xee3\xea!R\x8f\xb8\xc7]\x16}\x91C=\xc1[\xac9\xfe\x8e}\xe6?\x9b\xf6\xf2\\\xdaQ\xfc\x18\xd8\x16St\x17K2'EW\xfe\xf2\xb6}\x19\xdf\xdf`\xf7\x1fοW=\xff\xd5S\x7f\xef\x89\xffW\xa1\x9c\x9a*ݛ\xe5\xf3\xe7\xb4+=x\xe6#\xc0\xd6&\xf8\x81\xec\xdfr\xf8\xc1\xa0\x8e\xbff\xbc\x85\xc7\xf8\x1bͭ\x02\x85\xf6\x14\x8b\xf0`m|\x14-\x8fu\x11*\x86\xf2\xd2\xfd\x10\xbc\xe8\xfc\x94\xd4^\xeb\xff\xd0t\x87\\\x83y\xba7\x99rmˮY\xc9홰>?u\xcf\xe1\x9f\xff\x9e\xfc'\x00\x00\xff\xff\xf3/:\xb2\x01\x1d\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVMs\xdb6\x10\xbd\xebW\xecL\xaf%\x15O{\xe8\xf0\xd689x\xdaf4v&w\bX\x89\x88A\x00\xdd]\xc8u?\xfe{\a\x00)K\x14\xe5$\x97\xf0&`\xb1\xfb\xf0\xde\ue0da\xa6Y\xa9h?!\xb1\r\xbe\x03\x15-\xfe%\xe8\xf3/n\x1f\x7f\xe1ֆ\xf5\xe1f\xf5h\xbd\xe9\xe06\xb1\x84\xe1\x1e9$\xd2\xf8\x0ew\xd6[\xb1\xc1\xaf\x06\x14e\x94\xa8n\x05\xa0\xbc\x0f\xa2\xf22\xe7\x9f\x00:x\xa1\xe0\x1cR\xb3G\xdf>\xa6-n\x93u\x06\xa9$\x9fJ\x1f\u07b47?\xb7oV\x00^\r\u0601A\x87\x82[\xa5\x1fS$\xfc3!\v\xb7\atH\xa1\xb5a\xc5\x11uο\xa7\x90b\a/\x1b\xf5\xfcX\xbb\xe2~WR\xbd-\xa9\xeek\xaa\xb2\xeb,\xcbo\xd7\"~\xb7cTt\x89\x94[\x06T\x02\xd8\xfa}r\x8a\x16CV\x00\xacC\xc4\x0e>dXQi4+\x80\xf1\xda\x05f\x03ʘB\xa4r\x1b\xb2^\x90n\x83K\xc3D`\x03\x06Y\x93\x8dR\x88\xfa\xd8c\xb9\"\x84\x1dH\x8fPˁ\x04\xd8\xe2\x88\xc0\x94s\x00\x9f9\xf8\x8d\x92\xbe\x836\xf3\xd5\xd6\xd0\fd\f\xa8T\xbf\x9d/\xcbs\x06\xccB\xd6\xef\xafA`Q\x92x\x02Q\xea\xda\xe0\x81N\xf8=\aP\xe2\xdb\xd8+>\xaf\xfeP6\xaeU\xae1\x87\x9bʴ\xeeqP\xdd\x18\x1b\"\xfa_7w\x9f~z8[\x86s\xac\v҂eP\x13\xd2L\\e\r\x82G\b\x04C\xa0\x89Un\x8fI#\x85\x88$vj\xad\xfa\x9d\f\xcf\xc9\xea\f¿\xcd\xd9\x1e@F]O\x81\xc9S\x84\\H\x1c\x9b\x02\xcdx\xd1J\xaee \x8c\x84\x8c\xbe\xceU^V\x1e\xc2\xf63jig\xa9\x1f\x90r\x1a\xe0>$g\xf2\xf0\x1d\x90\x04\bu\xd8{\xfb\xf717\xe7{\xe7\xa2NI\xa1$\xb7\x9dW\x0e\x0e\xca%\xfc\x11\x947\xb3̃z\x06\xc2\\\x13\x92?\xc9W\x0e\xf0\x1c\xc7\x1f\x99D\xebw\xa1\x83^$r\xb7^\xef\xadL\x96\xa2\xc30$o\xe5y]\xdc\xc1n\x93\x04\xe2\xb5\xc1\x03\xba5\xdb}\xa3H\xf7VPK\"\\\xabh\x9br\x11_l\xa5\x1d\xcc\x0f4\x9a\x10\x9f\x95\xbd\xe8\x9e\xfa\x15\x17\xf8\x06y\xb2'\xd4\x1e\xa9\xa9\xea\x15_T\xc8K\x99\xba\xfb\xf7\x0f\x1faBR\x95\xaa\xa2\xbc\x84^\xf02\xe9\x93ٴ~\x87T\xcf\xed(\f%'z\x13\x83\xf5R~hg\xd1\vp\xda\x0eVx\xea\xd8,\xdd<\xedm\xb1\xdd\xec\x00)\x1a%h\xe6\x01w\x1enՀ\xeeV1~g\xad\xb2*\xdcd\x11\xbeJ\xad\xd3\xc7d\x1e\\\xe9=٘\x9e\x81+\xd2.\f\xffCD\x9d\xc5\xcd\xfc\xe6\xd3vgu\x1d\xab] x\xea\xad\xee\xa7\xe1\x9f\xd1t4\x8as\xfe\x96\x8d!\x7f/v;߹zy(\"[\xc2Y\xc36p\xe1ݯ\xf3RL\xf5\x1b\x99\xa9\x8e>r\xa3\x13Qi\xbe\xa3ϫ\xa5C_\xcb\x05\x12\x05\xbaX\x9d\x81z_\x82\xca?\x06e=\x83\xf2\xcf\xe3A\x90^\t<!\xe5\t\xd1!e\xb7B\x03&]\xf07\xd2r\xfa&E\n\x1a\xf9b\x14\x01\xacఀ\xe9\x15u\xf2\xe7\x93sj\xeb\xb0\x03\xa1\x84W\x94UD\xeay\xb6W\u07be/P\xb0\xc91K\x1a\x1c\xdf\xfb/\x8aP\xe8\xf6i\xb8\xac\xd4\xc0\a|ZX\xbd\xf3\x1b\n{B\x9e\xb7|\xde\xdcT\xf6p\xfe\x1e\xbc\xc2\xd2bS^,r\xb6Bs\xc2\"K \xb5?\xe5\x95\xd3\xf6\xe8\xf4\x1d\xfc\xf3\xdf\xea\xff\x00\x00\x00\xff\xff_։ȱ\n\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcWMs\xe36\f\xbd\xfbW`\xa6\x97vf%o\xa6=t|k\x9d=d6\xddf\xe2m\xee\x14\x05Il(\x92%Ag\xd3\xe9\x8f\uf014\xfc!ˎsY\xdd\f\x82\xe0\xc3\x03\xf0H\x17E\xb1\x10N=\xa1\x0fʚ\x15\b\xa7\xf0\x1b\xa1\xe1_\xa1|\xfe5\x94\xca.\xb77\x8bge\xea\x15\xacc \xdb?b\xb0\xd1K\xbc\xc5F\x19EʚE\x8f$jAb\xb5\x00\x10\xc6X\x12l\x0e\xfc\x13@ZC\xdej\x8d\xbehєϱ\xc2**]\xa3O\xc1ǣ\xb7\x1f˛_ʏ\v\x00#z\\Am_\x8c\xb6\xa2\xf6\xf8O\xc4@\xa1ܢFoKe\x17\xc1\xa1\xe4ح\xb7ѭ`\xbf\x90\xf7\x0e\xe7f̷C\x98\xc7\x1c&\xadh\x15\xe8\xf3\xdc\xea\xbd\x1a<\x9c\x8e^\xe8S\x10i1(\xd3F-\xfc\xc9\xf2\x02 H\xebp\x05_\x18\x86\x13\x12\xeb\x05\xc0\x90b\x82U\f\xd9mor(\xd9a/2^\x00\xeb\xd0\xfc\xf6p\xf7\xf4\xf3\xe6\xc8\fPc\x90^9JD\xfdW\xec\xec0M\x00T\x00\x01\x03\x1c \xbbC\b\u0080\xf0\xa4\x1a!\t\x1ao{\xa8\x84|\x8e\x0el\xf57J\x82@\u058b\x16?@\x88\xb2\x03\xc1Q\xb2\xc3\xc1Yڶ\xd0(\x8d\xe5\xce\xe6\xbcu\xe8I\x8d\x94\xe7\uf821\x0e\xac\x97\xb2\xe0\x8f\x13ϻ\xa0\xe6\xce\xc2\x00\xd4\xe1H\x1e\xd6\x03W`\x1b\xa0N\x05\xf0\xe8<\x064\xb9\xd7\xd8,̐M9\t\xbdA\xcfa t6\xea\x9a\x1br\x8b\x9e\xc0\xa3\xb4\xadQ\xff\xeeb\af\x8c\x0fՂ\x12\x99\x86\xd0\x1b\xa1a+t\xc4\x0f L=\x89܋W\xf0\x98\x18\x8c\xe6 ^\xda\x10\xa68\xfe\xb0\x1eA\x99Ʈ\xa0#ra\xb5\\\xb6\x8a\xc61\x93\xb6\xef\xa3Q\xf4\xbaL\x13\xa3\xaaHևe\x8d[\xd4ˠ\xdaBx\xd9)BI\xd1\xe3R8U\xa4DL\x1a\xb5\xb2\xaf\x7f\xf0\xc3`\x86\xa3c\xe9\x95\x1b2\x90W\xa6=XH\xd3\xf1\x8e\xf2\xf0\xbc\xe4\xeeʡr\x8a\xfb*\xb0\x89\xa9{\xfc\xb4\xf9\n#\x92\\\xa9\xa1\xc5v\xae'\xbc\x8c\xf5a6\x95i\xd0\xe7}\xa9M9&\x9a\xdaYe(\xfd\x90Z\xa1!\b\xb1\xea\x15\x85\xb1\u05f9tӰ\xeb$EP!DW\v\xc2z\xeapg`-z\xd4k\x11\xf0;\u05ca\xab\x12\n.\xc2U\xd5:\x14ةs\xa6\xf7`a\x94\xc73\xa5\x9dH\xc6ơ\xe4\xc22\xb7\xbcS5J\xe6\x91j\xac\a\xb1W\x90\x81\xe9c\xa2\xe6\x15 \x81\x13\xbeE\x9aZ'X\xbe&'>\xfe\xa5\x13ǂ\xf5#\x96mɚ\x13\x06 Y\x8f~\x9a\x16\xea\x12\x06\x98m\xf4Y$c\x7f3\r\xcc+\v\n\x8b\xdd!\xa6ӣ\xf9C\x13\xfb\xf9\x03\n\xf8=a\xbe\xb7\xed\xc5\xf5\xb55\xc4sq\xd1\xe9\xc9\xea\xd8\xe3\xc6\b\x17:\xfb\x86\xef\x1da\xff\xa7C\x9f\xafዮ\xe3m\xbe\xbb\xfa.8F}\xf6\xdcG\xe4\x1b\x04\xcfg:8\\\x15\xe5\nL\x83\xe7U\x89\xae7w\xef\xa1\xf0\x8c\xfb;\x8atg\x1a\xfbF\x8a{\xc7Y\xbf320~\xe9\r\xf1vO\xf3+d\xeciޒ\xefN\x84ϱBo\x900\xec\x95\xfaEQ7\x1b\x11\xe0\xa5S\xb2K\x1b\xd3@\xf0%\x10\x82\x95jNR\xaf\x80\xcf:\xa2<\xce\fe\x91\x86u\xc6\xcc\xe0O\xccg\xd4\xef\xdc\x01ŠHW)(\t\x8a\xe1\x1d\x1a\x9a\xfcG\xaae\xf4>]Q\xd9\xca/\x93\xe9\x86kEtT\x9e\xbf\x1e\xef\xdfP\xd2۽gzq\ve2\x1a\xe7\xb1\b\xaa\xe5\x17\x14\xaf\xb1\x96&\x8d;%#\x7f\xc7/\xbcc\xa2f+\x8aߜ\xca\x03\xf8\x06\xc4O;\xc7,\xf8h\xf2=?}æ\x80\x18\xf8\xb9\x05R\x98\x19\x8c\x15B\x8d\x1a\tk\xa8^\xf3\xcd\xf5\x1a\b\xfbS܍\xf5\xbd\xa0\x15\xf0\xfd_\x90\x9ai#\x13\xb5\x16\x95\xc6\x15\x90\x8f\xe7\xbal6q\u05c903\x86G9?\xb0\xcf\\c\xec\x86\xf1bg\xc0\xd9\xfb\xa5\x80/\xf82c}\xf0Vb\bx:Fg3\x99\x1d\x82\x13c\xe0GZ}\xc0\xd2\xf0\x97a\xb0\xfc\x1f\x00\x00\xff\xff\xc02\xee\xedJ\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4ZKs\x1b7\x12\xbe\xebWt)\x87$U\x1e2\xf6\xa6\xb6\xb6x\xb3\xe5͖v\x13Yeʾ\xa4r\x00\a=3\x88f\x00\x04\xc0\x90\xe2f\xf3߷\x1a\x0fr^$E:\xb1\xe7b\x13\x8f\xee\x0f\xfdFCY\x96]1->\xa2\xb1B\xc9\x050-\xf0ɡ\xa4_v\xf6\xf8\x0f;\x13j\xbe~y\xf5($_\xc0Mk\x9djޣU\xad\xc9\xf1-\x16B\n'\x94\xbcj\xd01\xce\x1c[\\\x010)\x95c4l\xe9'@\xae\xa43\xaa\xae\xd1d%\xca\xd9c\xbb\xc2U+j\x8e\xc6\x13O\xac\xd7\xdf\xcd^~?\xfb\xee\n@\xb2\x06\x17\xa0\x15_\xab\xbamp\xc5\xf2\xc7V\xdb\xd9\x1ak4j&ԕ\u0558\x13\xedҨV/`?\x11\xf6F\xbe\x01\xf3\xbd\xe2\x1f=\x997\x9e\x8c\x9f\xa9\x85u\xff\x99\x9a\xfdQX\xe7W\xe8\xba5\xac\x1e\x83\xf0\x93VȲ\xad\x99\x19M_\x01\xd8\\i\\\xc0\x1d\xc1\xd0,G~\x05\x10\x8f\xe8ae\xc08\xf7Bc\xf5\xbd\x11ҡ\xb9!\nIX\x19p\xb4\xb9\x11\xday\xa1\xdc+\x0e\x01 \x04\x84`\x1ds\xad\x05\xdb\xe6\x150\vw\xb8\x99\xdf\xca{\xa3J\x836\xc0\x03\xf8\xd5*y\xcf\\\xb5\x80YX>\xd3\x15\xb3\x18g\x83x\x97~\"\x0e\xb9-\x81\xb6\xce\bYN\xc1x\x10\r¦B\t\xae\x12\x16\xc2ia\xc3,\xc11Οr\x9a\xb1\x9f\xa7\xedֱF\xf7\x10\xdc\x18d\xfb\xad\x01\x02g\x0e\xa7\x00\xec\xe4\t\xaa\x00W!I\xde\x1b\x16\x13R\xc8\xd2\x0f\x05M\x80S\xb0B\x0f\x119\xb4z\x02\x99\xc6|\xa6\x15\x9f\xc9D\xb4\a\xebn0zJ6\xb4\xfe\xcfF\xd5\x03t\xaf\xf8\x05P\xce\xe2\x1b\x16\xf7\xb8~\xec\x0e\x9d\xb4\x8f\n\xfd\x9aļյb\x1c\r\xb1\xaf\x98\xe45\x92f\x198ä-\xd0\x1c\x80\x91\xb6=lu\x1ḟD\xaf3s\x8e0\xa2\xef,\x9d2\xacD\xf8Q\xe5>@\x91I\x1b\xecٴ\xadT[sX%.\x00\xd6)3i\xe0\x848\xec\x8at\x13ف\x9f\xf5y\x1eFߡ\x9d\xe2\xe9,'\x1f\x11JN{\xd0\xeb\x12\xa7\xbd'L\xaf_\x86p\x95WذE\\\xa94\xca\xd7\xf7\xb7\x1f\xff\xb6\xec\r\x03h\xa34\x1a'R\xf8\f_'9tF\xa1/\xea\xffe\xbd9\x00b\x10v\x01\xa7,\x816\xd8d\x18C\x1e1\x05\xf5\b\v\x06\xb5A\x8b2\xe4\r\x1af\x12\xd4\xeaW\xcc\xddl@z\x89\x86\xc8$E\xe5J\xae\xd180\x98\xabR\x8a\xff\xeeh[\xb2=bZ3\x87ց\x0f\xb5\x92հfu\x8b/\x80I>\xa0ܰ-\x18$\x9e\xd0\xca\x0e=\xbf\xc1\x0eq\xfc\xa4\f\x82\x90\x85Z@圶\x8b\xf9\xbc\x14.\xa5\xcc\\5M+\x85\xdb\xce}\xf6\x13\xab\xd6)c\xe7\x1c\xd7Xϭ(3f\xf2J8\xcc]kpδ\xc8\xfcA\xa4O\x9b\xb3\x86\x7feb\x92\xb5=\xb6#\xab\t\x9f\xcftg\xa8\x87r\x1f\b\v,\x92\nG\xdck!Ů\xf7\xff\\>@B\x124\x15\x94\xb2_:\x92K\xd2\x0fISȂb\x00\xed+\x8cj<M\x94\\+!\x9d\xff\x91\xd7\x02\xa5\x03ۮ\x1a\xe1\xc8\f~k\xd1:Rݐ\xec\x8d/+(\x96\xb5\x9a̜\x0f\x17\xdcJ\xb8a\r\xd67\xcc\xe2g\xd6\x15i\xc5f\xa4\x84gi\xab[,\r\x17\a\xf1v&R\xa9s@\xb5\x83\xfae\xa91'Œli\xa7(D\x8ct\x852\xc0\x86\xcb\xfbr\x9a\x0e\x00\xf4MF\xb9\xe1\xa2SFGߛ)B\t\xb0\xec\x04\xec\x14\x8dc\xf0\xac\xfb\xc1\xb3\xfb\xa5\x10\xbe\xdbcP++\x9c2[\"\x1c\xa2\xf7\xd0 \x0e\xea\x86>\xa98\x9e8ܝ\xe28\x05\x9b\xb6\x82\xabX\xb0n*\xde(\xb8\xb5R\x8e\xb9Ч\xe4Y\xc0\xb4\xe2'pE\x8e\f\f\x16hP昢\xe0\xb1\xcad\x02Y\xb7f\x18c<l)p$eL\"~}\x7f\x9b\xd2B\x12b\xc4>\x8a\xfc'\xe5C_!\xb0\xe6>\x8b\x9e\xe6=i\xa2\xf4\xdd\x16\x01\x84\x8f\x8dN\x01\x03-0Ԟ\xbb\xbc\x04BZ\x87\x8c\xc7A\n\a\x06\xe3܋\x10\xf3\x0e\x82\xa4o\x9f\xbfH'\xc0(\x06\v\x0e\xff^\xbe\xbb\x9b\xffK\x85s\x00\xcbs\xb4\xbe\xc8vؠt/vu?G+\fr\xaa\xe2q\xd60)\n\xb4n\x16\xa9\xa1\xb1?\xbf\xfaeZ~\x00?(\x03\xf8\xc4\x1a]\xe3\v\x10A滰\x9e\xccF\xd8p\xf0\x1dE\xd8\bWy\xa0Z\xf1x\xc0\x8d?\x82c\x8f\b*\x1e\xa1E\xa8\xc5\xe3\x84\xff\x84\xef\xdaWM{\x98\xbf\x93\xf7\xfcq\r\xdf\x047\xbe\xa6\x9f\xd7\x01\xc6.\x81w\x1dl\x0f'x\x99\x11e\x89\xfb\xf2ld,\x94p(T\x7f\v\xca\xd0Y\xa5\xea\x90\xf0\x84IO!R\"\x1f\xc1\xfb\xf9\xd5/\xd7\xf0M_\x06\aX\t\xc9\xf1\t^\x81\x88w$\xad\xf8\xb73x\xf0v\xb0\x95\x8e=\x11\xa7\xbcR\x16%(YoCi\xbcF\xb0\x8a\xeeVX\xd7Y(\x958l\xd8\x16Tq\x80OR\x11\x99&\x03͌;Z.\xa5\xef\xe1\xdd\xdbw\x8b\x80\x8cL\xa7\xf4Q\x972j!\xa8(b\x92\xc7<\xed\xed\x8e@\xb7\xc1$\x9c\x82\xbcb\xb2\xc4p2\x84\xa2\xa5<x\x91s\x8e\xeb\x94\xf4=\xcf/}\xdd\xf2\xac(\xf1\xc5r\xfe3%\xe1\v\xf4O\x90D\xf7rs\x81$\x1e\xdb\x15\x1a\x89\x0e\xbd0\xb8\xca-\xc9!G\xed\xec\\\xadѬ\x05n\xe6\x1be\x1e\x85,32\xfa,X\x97\x9d\xfb;\xf3\xfc+\xffϥ\a\xf7\xb7\xebO=}\xaf\x1b\xf0\xf9E@\xdc\xed\xfc\x12\t\xa4z\xfa\xf99\xf2\xa0\x1c\x96\xb1\xc4\x1b\xd2$\xa7\xddT\"\xaf\xd2\xed\xaa\x13\xd5\x1b\xc6C\xd8gr\xfb\x85|\x87\xe4\xdc\x1aB\xb4\xcdbs0c\x92\xd3\xff\xad\xb0\x8e\xc6/\x11l+>)\xb8|\xb8}\xfb%=\xaa\x15\x97D\x92\x03\xb7\x86\xf0=e{TY\xc3t\x16V3\xa7\x1a\x91\x0fVS\xd5|\xcbII\x85@s\xa2\xcc|\xdf[\x9c\n\xe1\x89\xfa{\xb7\xe6\xac:ױr\xa2\xb0\xec\xf6M\x8f\x95\x9fG\xe5u\xda\x14\x1eXi\x81\x19\x04\x06\r\xd3d\x11\x8f\xb8\xcdBe\xa3\x99\xa0\xb2\x84*\x8f]\x9f\b\x98\xd65\xd5\x0e\xa1Z\x99\xa0\x18\xeb\xec(\x1ef\xfd\xf9\x0e\tdR\x95\xa9/\xb6DGw\xf3/'\x9c\x0f\x03 \x7f\xae\xa0v]\xc3\\\xc9B\x94\xad\xf1w\xbe\xb1\xa4d[\xd7lU\xe3\x02\x9ciǄ\x9e!\xc8\aZr\xfc\xfc\x1f:K\x93\x85\x9fhqN\x9f\xaa\xd7\xf8\x1c\x1f\x06eی\xa1d\xf0\xa8\xb4`\x13\xe3\x06\xad\x1by/M\\_\x9f\xe3c\xc1(/\xb9\xc3\xc7爉\xdbo4\xf4xQH7\xe0n\azR\xe9g\xc4\x06\x83\xbf\xb5t\xed\xe9\xe3Φ\x1b\x14\x835t7\x1f\fi\xc5\a#\xfd08\x98\xecuɻH\xc7]\x1b\xff\xf8qF\xdf&<\xeaD\x99\x86\xe4\xe8\xd2S\x0f\x95\xf7\x97vnrE\u05fc^\a\xf9\x12\x9dߌ\xc9\xf8\x8e\xab\xe1\xd11DCq\xa0\xf3.\x14\x19O\xb5^\xba\xe4\xc2N_\xa3\x105\xe4\xfe\xbaF\xb7ɂ\x89\x1a9\xa4ǿ3\xa9\xac\xb0\xa0\x1c\x1d\x9c45<\"\xbc\xc3\x17\xa5\x87\n\xc1\xfa\xfe\xe5\xd7vG\xb3\xb5\xc8}\xfblB\b\xe3\x8c](\xd30\x17Z\xf1\x19\x91\xb8,zM\xfal\x83ֲ\xf2\x94\xd3\xfe\x14V\x85>P\xdc\x02l\xa5Z\xb7k\x04\xf52\xd2\xd76\x1a\xday\xbd\xa8\xc9\x16K\xdfƙ\xab\x92I\x17m]\xfb=\xdd\xe8\xb0\x7f\x18\xf6\xa8V8]\xd7\x1diD\x1d\x03X1{JT\xf7\xb4f\xca\xebv!\xed\xa8\xdb\xc1\x91\xf0}\x87\x9b\x89\xd1\xd1Kmw\xf2&\xb9\xcc\xc4\xdc\x0f\xde\x1b\xce:\x7fdt\x89\xbb'\x90P\xa9:y\xb8r\xac\x06\xd96+4$\x9c\xd5֡\x1d\x04\xfe\xd0D\xd8Ir\xea\xf6\xb7ߟ\x94\x1a(\xc5NIΤo\xf1\xdbP&pauͶ\xbb\xb3\xf8\x9a\x9b\xfck\xba\x15\xbc7\xf2\xe4\xe9\x1a\x0f\xd5\x10\xc7[\x98\x1e\xd3[%\x0f\xdcR\x93\x93\v\xe9\xfe\xfe\xfd\x91\xa2]H\x87\xe5 \x8d\xc4y\x12\xe7\x1b\xe2\xf2\xd7p8R\x03Yɴ\xad\x94\xbb}{\xc24\x96\xbb\x85\xc9E\xf6\xf5\xbc\x0f\x88\xfe\x95!.\x8a\xa60\x01u\x1fp\xce\xf2\xdf\xfe\x1f\x0e\\b\xc5\xcb\x1e\x85\x13\xf9*\xfe\x1d\xc3TVX\xa2f\x86b\x82\x7fú\x19\xbeȾ\x00+|\xa3\x9d\xaa\xddP\xfe\x86\x86\xd9\xd4uNI_\xc0)3~P\x84\x93\t\xa8\x7f\xa0ϙ{&\xcdi4\xe8\x91\xf3\x0e\xed\xf8|\xd3\x1diW\xbb\x97\xcd\x05\xfc\xfe\xc7\xd5\xff\x03\x00\x00\xff\xff\x8b\xcb\x17\x16\x81$\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_s\xdb6\x12\x7f\xf7\xa7\xd8q\x1f\xda΄R\x93\xeb\xdc\xdc\xe8-q\xae7\xbek\x1dO\xe4\xe4\xa5Ӈ\x15\xb1\"Q\x93\x00\n\x80\x92u\xbd~\xf7\x9b\x05H\x8a\xff$YJ\x9b\xe0\xc5\x16\xb0X\xfc\xb0\xff\xb1L\x92\xe4\n\x8d\xfcH\xd6I\xad\x16\x80Fғ'ſ\xdc\xec\xf1\x1fn&\xf5|\xf3\xf2\xeaQ*\xb1\x80\x9b\xcay]\xbe'\xa7+\x9b\xd2[ZK%\xbd\xd4\xea\xaa$\x8f\x02=.\xae\x00P)푧\x1d\xff\x04H\xb5\xf2V\x17\x05\xd9$#5{\xacV\xb4\xaad!\xc8\x06\xe6\xcdћ\xeff/\xbf\x9f}w\x05\xa0\xb0\xa4\x05\x18-6\xba\xa8J\xb2伶\xe4f\x1b*\xc8\xea\x99\xd4W\xceP\xca\xcc3\xab+\xb3\x80\xfdB\xdc\\\x1f\x1cA\xdfk\xf11\xf0y\x1f\xf9\x84\xa5B:\xff\x9f\xc9\xe5\x1f\xa5\xf3\x81\xc4\x14\x95\xc5b\x02GXuReU\x81v\xbc~\x05\xe0Rmh\x01w\f\xc5`J\xe2\n\xa0\xbeg\x80\x96\x00\n\x11$\x87Ž\x95ʓ\xbda\x16\x8d\xc4\x12\x10\xe4R+\x8d\x0f\x92i\xf9\x80^\x83ω\x8f\fRE\xa9\xa4\xca\xc2T\x84\x00^Ê\xa0F\"\x023\x80_\x9dV\xf7\xe8\xf3\x05\xccXp3\xa3\xc5L5<k\x9a(\xf3\xbb\xc1\xac\xdf\xf1=\x9c\xb7Re\x87\x90\xfdɠzx\xee\xb5x&\x92\x87\x9c\x02M\x83\xa62\x85FA\x96\x0f\xcfQ\x89\x82\x80\r\x14\xbcE\xe5\xd6d\x0f\xa0h\xb6=\xecL\x1fɇ\x86_g\xe5\x1c\xe9\x9c#\x8aH\xdb;\xfecw\xeaԹ\xf7Z\xd4\x1b\xa06jp\x1e}\xe5\xc0Ui\x0e\xe8\xe0\x8e\xb6\xf3[uoufɹ\t\x18\x81|frt}\x1c˰\xf0\xd7\xe2Xk[\xa2_\x80T\xfe\xef\xdf\x1f\xc6Vo\x9ay\xed\xb1x\xb3\xf3\xe4zH\x1f\x86\xd3\x11-;[V\xab\xff\x8b\xc0]1\xa4\xb7Z\xf5\xe5\xfaf0;\x05\xb6ô\x89\xb7\xb3\xd4R\b\xb5\x0f\xb2$\xe7\xb14=\xae\xaf\xb3>?\x81>N\xc4\xe5\xcd\xcb\x18\xcaҜJ\\Ԕڐz}\x7f\xfb\xf1o\xcb\xde4\x80\xb1ڐ\xf5\xb2\x89\xaeqt\x92Gg\x16\xfa\x92\xfd_\xd2[\x03\xe0\x03\xe2.\x10\x9cE\xc8E'\x89s$jL\xd1y\xa4\x03Kƒ#\x15\xf3\nO\xa3\x02\xbd\xfa\x95R?\x1b\xb0^\x92e6\xe0r]\x15!\"m\xc8z\xb0\x94\xeaL\xc9\xff\xb6\xbc\x1d\xfb\"\x1fZ\xa0'烬\xad\xc2\x026XT\xf4\x02P\x89\x01\xe7\x12w`\x89τJu\xf8\x85\rn\x88\xe3'\xb6\x1f\xa9\xd6z\x01\xb9\xf7\xc6-\xe6\xf3L\xfa&\xa5\xa6\xba,+%\xfdn\x1e\xb2\xa3\\U^[7\x17\xb4\xa1b\xeed\x96\xa0Ms\xe9)\xf5\x95\xa59\x1a\x99\x84\x8b\xa8\x90Vg\xa5\xf8\xca\xd6I\xd8\xf5\x8e\x1dyd\x1c!\x11\x9e\xa1\x1eΌ \x1d`\xcd*^q\xaf\x85&\xbe\xbf\xff\xe7\xf2\x01\x1a$QSQ){ґ\\\x1a\xfd\xb04\xa5Zs\x84\xe6}k\xab\xcb\xc0\x93\x940Z*\x1f~\xa4\x85$\xe5\xc1U\xabRz6\x83\xdf*r\x9eU7d{\x13\xca\x0e\x0e\xae\x95a3\x17C\x82[\x057XRq\x83\x8e>\xb3\xaeX+.a%<K[\xddbjH\x1c\xc5\xdbYh*\xa1\x03\xaa\x1dV7KC)k\x96\x85\xcb[\xe5Z\xa6ѧ\xd6\xda\x02\x8e\xe8\xfb\x92\x9a\x0e\x01<V\x98>Vf\xe9\xb5Ō~ԑ\xe7\x90\xe8\x94\xd9\xf1x3ŨA\xac:\t5\x9e\b.RBQ\x93N\xb0\xdc\xe6d\xa9\xbbǒ\xd1Nzmw\xcc8\xa6\xe2\xa1I\x1c\xd4N\x90\x83\x16'\xeeƹ$8\x90\xa55YR)5\xe1\xe6X\x994\x01\xbeS-\x8c!\x1e\xd6\a\x1c\t͓\x80_\xdf\xdf6᷑p\r}\x14aO\x8a\x87\xc7ZR!B\xb6:}\xf6\xa4!\xf0\xb8]G\x10!\x06y\r\bFR,\x83\xdb\xf8\x0fR9O(\xeaIv;K\xf5ڋ\x18[\x0e\x82\xe4\xb1\xcf\x13\xac\x12@\x8euR\xc0\xbf\x97\xef\xee\xe6\xff\xd2\xf1\x1e\x80iJ΅r\x80JR\xfeE[\x12\brҒຈf%*\xb9&\xe7g57\xb2\xee\xe7W\xbfL\xcb\x0f\xe0\am\x81\x9e\xb04\x05\xbd\x00\x19eކ\xcf\xc6j\xa4\x8b\x17o9\xc2V\xfa<\x005Z\xd4\x17܆+x|$\xd0\xf5\x15*\x82B>Ҵ\xf4\x01\xaeC\xa1\xb9\x87\xf9;\xbb\xd6\x1f\xd7\xf0Mt\x96k\xfey\x1da\xb4\x89\xb2\xeb}{8>G\x0f\xde\xca,\xa3}E;2\x16\x0e\xec\x1c\x12\xbf\x05m\xf9\xaeJwX\x04Ƭ\xa7\x18\x90H\x8c\xe0\xfd\xfc\xea\x97k\xf8\xa6/\x83\x03GI%\xe8\t^\x81TQ6F\x8bog\xf0\x10\xec`\xa7<>\xf1Ii\xae\x1d)Ъ\xd8\xc5\a\u0086\xc0\xe9\x92`KE\x91ĒD\xc0\x16w\xa0\xd7\a\xceiTĦ\x89`\xd0\xfa\xa3eI3\x1e\u07bd}\xb7\x88\xc8\xd8t\xb2\x10\xdb8s\xad%\x17\x1f\xa8D\x9d\x0f\x83\xdd1\xe8*\x9a\x84א\xe6\xa82\x8a7#XW\x9co.r\xceq=Ќ\xe7\xf9e\xa8\x0f\x9e\x15%\xbeXn}\xa6$B!\xfc\t\x92\xe8>\xf1.\x90\xc4c\xb5\"\xab\xc8S\x10\x86Щc9\xa4d\xbc\x9b\xeb\rٍ\xa4\xed|\xab\xed\xa3TY\xc2F\x9fD\xebr\xf3\xf0~\x9f\x7f\x15\xfe\\z\xf1\xf0\xd2\xff\xd4\xdb\xf7\x1a\x13\x9f_\x04|\xba\x9b_\"\x81\xa6n}~\x8e<(\x87e]I\ry\xb2\xd3ns\x99\xe6\xcd+\xa6\x13\xd5K\x141\xec\xa3\xda}!\xdfa9W\x96\x11풺I\x97\xa0\x12\xfc\xbf\x93\xce\xf3\xfc%\x82\xad\xe4'\x05\x97\x0f\xb7o\xbf\xa4GU\xf2\x92Hr\xa0:\x8f\xe3)٣JJ4I\xa4F\xafK\x99\x0e\xa8\xb96\xbd\x15\xac\xa4\xb5${\xa2\xca|\xdf#n\xaa\xe4\x89*\xb7\xa59\xab\xccu\n\x8d˵\xbf}{\x02ǲ%l0\xecuX\x17\xb7\r\xafA\a\xec<<\xc1\xb7\xee\x0eG\xae>\xa8>u\x83L[\x99\x85Tۆ\x8f\xf0\xf4QXb\xb7\xf3\xd9\x1d%\x1a#Uv\x16֦\x91\xb8$\xcf\xcf\xe5\x89\x02\xbd\xdb\x02>V\xc6\x1f\xb5\xbb\xd3.\xf5a\x00\x04\xd0\x12 ߉5\xf4H\xbb$V\x8b\x06%\x97z\\\xcd\xd5%\xf1\x8a\x00\x8d)\xb8\x1e\x8b\x15\xe0\x94\xaf7m\xd1T\xab\xb5\xcc*\x1b\x1eacI\xa9\xaa(pU\xd0\x02\xbc\xadƌ\x8e\xb8O\xb7#{B\xe3\x1f:\xa4\x8d\xbaO\xf4\x84\xa7o\xd5\xeb\x14\x8f/C\xaa*\xc7P\x12x\xd4F\xe2\xc4<\x1b\xfb\xc8\xd1y\xe1\xfa\xfa\x1c\x93\x8a\x9etB\x06u\x03s\xe2\xc1\\;b\xfd|\xe0\x19~\xa4\x06w\x9cΎ\xe7:\xa8\xa5\xdf*~\v\xf5\x11&ӽ\x81\x01\x8d\xd1\xe2j(\xb4nl\x1b,\xee#\xd3p\xa1\xef\xf4\x83\xd5^c\xbd{\x9bq[%tm\xcfi\xac\xc4Nq-\xf7\x98V}\xd3?\xe6\x87\xc1ŭ\x95T\xf3\v\xb1\xd7佤\xb1r3f\x13\x9a\xa2VԎ\"K\n\xfd\x8a\xd8\xf9ޢkN\x9e2\x82.\xbf\xb85\xd47̎Dx\xea\xf1Kt\x8d\xb2 \x01\xed\a\xbc\t6\x0f9\x81\v-\xc1\xaf]˨r$BT\x9e\x00=N\xceM\x03^\xa0\xa7\x84Y\\\x16}&}\xae$\xe70;\xe5t?E\xaa\xd8\xf1\xa9\xb7\x00\xaet\xe5ۖO\xed}\xb5(\xbev\xb5i\x9c\xd7v\xcaѝ\x82r\xcf4Sf\xd8Ɓ\xe3v\bG\xe2\xdb\x1dm'fG\x1fD\xba\x8b7\x8d\tM\xac\xfd\x10\xac\xe3,\x01\xd4\a]b\xff\rH\xc8uј\xbc\xf6\\\aT\xe5\x8a,K'|\x9ai\xc4\xd4\x16,\xf1I\xde\ns\xea-\xd5rh\xa2fdU\xb7\x1dRT\xa1/\xedb\"\x15ҙ\x02w\xedeB\x01\xcb\x16<ݽܛQ\xe3\x9a\x1c(\x0e\xa4\xd9\xe3\r\xc1\xf6\xd3\xd3ty>\xf5!\xab?\xc6_\xa5\x06\xeb\xed7\xb7\xbf\xe6\x84#e\x82\xf3h\xfd'\x05\xc8e\x8fé\xd8\x18Λ\x8e\x8c\xc7CZ\xff\x98\xcf\x19\xcd&\xa57\x9a\f\xc8E\x87w\xdda\xef\xceT\xab\xf6\xf3\xd3\x02~\xff\xe3\xea\xff\x01\x00\x00\xff\xff\xc5p\x17\xe3F\"\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc=]\x93\x1c)r\xef\xfa\x15\x19\xf2\xc3\xda\x11ӣS\xf8\x1e\x1c\xf3&\xcfJ\xd6\xf8\ue909\x19Y\xfbLWewqCA-P\xd3\xea\xb3\xfd\xdf\x1d$P\x1f\xddT\x15\xdd\xf3\xb1\xbb\xe6E1Ր@~g\x92\xa0\xd5j\xf5\x865\xfc;jÕ\xbc\x02\xd6p\xfcaQ\xba\xbf\xcc\xe5ÿ\x99K\xae\xde=\xbe\x7f\xf3\xc0ey\x05\u05ed\xb1\xaa\xbeC\xa3Z]\xe0ϸ\xe1\x92[\xae\xe4\x9b\x1a-+\x99eWo\x00\x98\x94\xca2\xf7ٸ?\x01\n%\xadVB\xa0^mQ^>\xb4k\\\xb7\\\x94\xa8\tx\x9c\xfa\xf1O\x97\xef\xff|\xf9\xa77\x00\x92\xd5x\x05\x1a\x8dU\x1a\xcd\xe5#\n\xd4꒫7\xa6\xc1\xc2\xc1\xdcj\xd56W\xd0\xff\xe0Ǆ\xf9\xfcZ\xef\xfcp\xfa\"\xb8\xb1\x7f\x19~\xfd+7\x96~iD\xab\x99\xe8'\xa3\x8f\x86\xcbm+\x98\xee>\xbf\x010\x85j\xf0\n\xbe\xb8i\x1aV`\xf9\x06 ,\x9d\xa6]\x85U?\xbe\xf7 \x8a\nk\xe6\xd7\x03\xa0\x1a\x94\x1fno\xbe\xff\xeb\xfd\xe83@\x89\xa6м\xb1\x84\x80\xffYu\xdf!.\x14\xb8\x01\x06\xdfi\xa3n5\x84x\xb0\x15\xb3\xa0\xb1\xd1hPZ\x03\xb6B`M#xAx\a\xb5\x19@\x8a\xa3\fl\xb4\xaa{hkV<\xb4\rX\x05\f,\xd3[\xb4\xf0\x97v\x8dZ\xa2E\x03\x85h\x8dE}\xd9\x01j\xb4jP[\x1e\xb1\xecۀw\x06_\xe76\xe6\x9aÅ\x1f\x05\xa5c\"\xf4[\b\xf8\xc42\xa0\x0f\xd4\x06l\xc5M\xbfո=`\x12\xd4\xfa\xefX\xd8\xcb\x03\xd0\xf7\xa8\x1d\x180\x95jE\xe9x\xef\x11\xb5CV\xa1\xb6\x92\xff\xa3\x83m\xdc\xc6ݤ\x82Y4\x16\xb8\xb4\xa8%\x13\xf0\xc8D\x8b\x17\xc0dy\x00\xb9f{\xd0\xe8\xe6\x84V\x0e\xe0\xd1\x00s\xb8\x8e\xbf\x11\xf1\xe4F]Aemc\xae\u07bd\xdbr\x1b%\xaaPu\xddJn\xf7\xefH8\xf8\xba\xb5J\x9bw%>\xa2xg\xf8v\xc5tQq\x8b\x85m5\xbec\r_\xd1F$I\xd5e]\xfeSG\xd4Ѵv\xefx\xd4X\xcd\xe5v\xf0\x03\t\xc4\t\xe4q\xa2\xe2\x19σ\xf2[\xec\xa9\xe0>9\xd4\xdd}\xbc\xff6dJn\x02Q\x06\xbc9E\x1f\x87M.7\xa8\xfd8bM\a\x13e\xd9(.-\xfdQ\b\x8e҂i\xd75\xb7\x8e\r~m\xd18~W\x87`\xafI\xeb\xc0\x1a\xa1mJf\xb1<\xecp#\xe1\x9a\xd5(\xae\x99\xc1W\xa6\x95\xa3\x8aY9\"dQk\xa8K\x0f;{\xf4\x0e~\x88\x1aq\x82\xb4A\x8b\xdc7X\x8c$\xcd\r㛨.6J\x8f\x94\x8c\x1b2\xc6QZ\xf8]\xf3Zĩ\xc5\xc3_\x96\xb8̵\x7f\xefF;~s+k%\xff\xb5ER\xa6^\xfc\xf1X_\xe9\x81j\x1f7\xc7F\x87ԝD\xb4k\xf8\xa3\x10m\x89e\xa7\u05cf6\x98\xb3\x8d\x8fGP\xc8\xe81.\x9d\x109\xeb\xe3\xf6\"\xfb_I\x813\x8d \x95M\xc0\xe3\xd2\xc3\x03.\t\x03I\x9aPG\x8bubų[\x06\x90\xad\x10l-\xf0\n\xacn\x8f\xd1\xe8\xc72\xad\xd9~\x02[\xd1\x03x\x12\xb2: A\xd5\b^\x10\xc9;\x85B\xf8\xfa㢊\x1b\xa7(\xe3.o\x95\xe0\xc5~\x01_\x1f\x93\x83\xa2\xb4\x06\xd9\r;\x845V\xec\x91+\x9d\x12\x03\xa5\xa9\xeb\xc0\x9e\xf7jZ9-\x19\x80\x1cڸ\xcc\r'\x91U)\xf5\xb0\xc4\x10\x9f]\x9f\xde:@A\x0ee\xb7\x95@\xed`\xbb\xd7\b\xf8\x03\x8b\xd6&\x96\tP\xb6d\x9a\x94\x86F\x19;M\xf7i\xd5\x05C\xe7(\xf5\xe3\f\xd3\x1c\xed,\xc9\xea\xbe\x05%\x1c\x89\xeap0R\xc8J\xa2\xdbF\xed\x88\xda\xf7ժ\xf5}'\x91\x02kf\xb0\x04%'g&vi\x05\x9a0WI\x9c\xd1롋~\xff\xe4\xf1\x80`k\x14`P`a\x95>Ff\x0eJ}\xcbQ\xac\x13\xa8Lhӱ\x04\xf4\x1b\x98\x01\t\x8e\xd3w\x15/*\xefa8\xf6$8P*4N\x9b\x90˼\x9f\xda$,\x91?L2\xa7=\xfa\xb6 V\x87\xf0R\x1a\xa5o\x19j\xb8oI\xd4\xf6\xba\xf7H\xb7\x84\xefV\xcdn\xfb\xff'b\xa319\x83ig\xe4\x1f\xc8\xfd\xcc\xe6\xe9I\xbe\xa5\b\x0f\xcd%\xdcl\x00\xeb\xc6\xee/\x80\xdb\xf8uI\x12\x98\x10\x839\xfe\xc0\xb49\x9d\xe93I\x93#\x13/D\x98n\x8a? ]\xc8d\xdc\a\x8b\x91M\x93\xbf\x0eG]\x00\xdftH//`ÅE}\x80\xfd\xb3T}\xa4\xccs #\xc7\xea\x01\xe5\tlQ}\xfc\xe1\\\x1c\xd3'\xc12\xf1r8\xd8\xfb\xc61\x82\x18\x9b\xe7\x05\xb8@\xf12\xd7XS\x1c\x0e\xdf\b\x9b\xfd\x17r\xaa?|\xf9\xf98V>l\x19\x9cw\xb4\x91\x05\xa1\xf3\xed\xc3\xc1\x8e\x86\xeb\vQA\xfc\x85|\xa0.\xa8\xf29\x97\v`\xf0\x80{\xef\xba0\t\x8e>,vΘ^#%\x7f\x88\xcf\x1epO`\xd2ٜ\xe3\x96\xcb\r\xbe=`\xc2\xf5O\xb5\x11\x0eݚBX\xec\xf1\xe4>\x10\"(\x86\xcfe\x03߂($r'閩Kb\x8b\xb8?c\x9bY\xac2\x9cc\x98\xfa$\x0e\xf8\xc9xZ:\x89\xa9x\xc8i\x1a$\x99\xc9%\xa8oߙ\xe0e7\x91\x97\x91\x1by\x01_\x94u\xffP\x80f\x88Q~Vh\xbe(K_^\x04\xa3~\xe1/\x89O?\x03\t\x9a\xf4Z\xde!l\x98\xf3\xf36\xcdq[\x87{n\xe0F\xbaxţ$s*J\xef\xfa\xe9\xfcDuk(]'\x95\\\x91\xcdL\xce\x14\xf0\xad\xf4\b\xddO\x9e4L\xf8\xcd\x19\v\xff\x8bO2\vV`\x19#K\xca~2\x8b[^d\xceW\xa3\xde\"4N\x85\xe7qD\xa6b\r\xbb9\x8d}\xf2\xacwlA\xf1\x96ˋY9\x81\xcb\xe8\x15ɸ\xd8u\"\xb39\xdduyGdE\xc9\xc5X\xc4.+K:\xc2b\xe2\xf6\x04\x8d~\x02-N\x15\xcd\xc1ڽ\t\xacY\xe3\xc4\U000bf765#n\xfe_h\x18\xd7\xe6\x12>\xd0I\x95\xc0\xd1o!\x0f6\x00\x931e\xe3\xa6r,\xf0Ȅ\xb3\xbdN\x81J@\xe1-\xb1\xda\x1c\xf9%\x17\xb0\xab\x94\xf1fs\xc3Q\xd0y\xc1\xdb\aܿ\xbdp\xd3/N9\x14\xf2\xb77\xf2\xad\xb7\xe1G\x02\xdb\x19|%\xc5\x1e\xde\xd2oo\x9f\xe2\xcad2[f\xb7\x1f\xab\x87.ö\xaaY\xb3\n\fjU=\xa34d2Y\u07b7\x11\xc7\fs\xe3}R<8\xb9s\xbb\xcdb\xd1F\x19\xfb9\x9d\xb7\x9bX\xcfm\x1c1\xf6L\x139\xaeE\x8f=\xe4\xb1:}뜸\x8dE\x1dry^\aG\xff\xff\x89\x91Q\xeaTd\xb8\xd8.\x19Ǻ\xfc\xaaC\xf0\x027\xf9\x83\x93\x9c%\x9e\xe20:\xbc\x9c\xe8m\x7f\xfc1\xc8':\xc9u\x7f\x0f7\xf2\xdc\x0em\xa1\xea\x9a\x1d\x9e*f-\xf5ڏ\x8c<\x1d\x00y\xea\xebmK\xf2\x9ck\x11{\x1e\xa2\xf3\xc3\x1d\xb7\x15\x97\xc0\xa2\xda@\x1d\x18\x8aA\xa3R9\xe4T\xab\x98\x815\xa2\xecR\xe4\xbf\aS^syC\x13\xc0\xfbg7\xfdУ\xeb%\x9d\xcd\xeb\x8e&\x1d\xe5\xbb\x0f\xded5\xaa\x84]\x85\x1aG\x8cq\x9c\xf7&OQ*;H\x19\x9c\xe0\x106\xaa\xfc\xc9\xc0\x86kc\x87K0К\\Z\x9fH>\xb7\xeeo\xbcF\xd5ڗD\xf0\xc7~\x9a\xd1Yo\xcd~\U0003ab41ժ\xf5\xc6\xdc\xf2\xba;U\r\xe8\xdd1n\xbbc#ʟX\xe5H\xd0\b\xb4\bkܤ\xcf[S\xadP\xd2\xf0\x12u\xac\x12\xf0d\xe3\xca\t\xe6\x86qѦNiR\xed\xd4\bT~\xd4\xfa\xac\x00\xf4\xab\x1f9\xc8\xfbUj7FP\xe6\xde\xe9 \v\x81o\x80[@Y8\x8c\xa3\xf6*\x99\xa6\b\xc8 \xd4\xf0\\=\x97\xa7\xc0]C\xd9\xd6y\bX\x91@r9\x9b\xf2\x1av\xffĸx\t\xb29\xce\xfb\xa4\xf4\x1d\xb2\xf2\x9c\x1c\xc9/\x83\xe1\x80Ҵ\x9a\x0e߽\xee\xd8q\x91\xb7fG9\x10\xac\x95E\x85\xa4\x84\xe4X7x\xf0\\\x1a\x8b,\x97\x17\x9cW\xd4J\xc9\xe56\x8fvىȾyT\xaf\x95\x12ȦO\x01\xfb\xe6p\xfd\n\x9a\xe8\x97~\x9a'j\xa2\x9e\b\xfeؚ\xe8\x90MQ\xa7\xb4\x80Y\xeb\xc2}\x129\x05\xba\x95C\xeb\xf2\x02\x8a\xe8\x94H:\xac\xe29Cd.y\x06mGt\xbd\x91\xdc\x0e\x9dG\a\xe2E\x9dG7A\xe7\x0e\x9c\x93\xe1\xba\x19\x01p\x02\x1a\xe3\x10Z{\xc75'8\x92k\x04V\x96X\xfaܡsEBX\xe2\v\xcf&\x8a\v\x92\xbb;\xdd\x13̢ll\xa3\xa0\x93\xf2\xa0\xfa\x11W\xad|\x90j'W\x14\x8c\x9b\x93uH\xae\xab\xf8\xcc\xd3۳\x95Ѳ~\xc9W\xd3KZh̯\xf9<\x15\xfd\xa7\x17\xd02\xd9|sR\xc2c\x8e\v\x96\xf4\x9a/\x80\x9e\xf8qq\x15s\xf3\xcf\f\x0e\x87\xc2\u05feX\xf9Iei7iP\x03\xa7pW\xa1\xadP\xc7\xd2\xe8\x15\x95\x84\x97\xb3'\x94}\xf0\xd2թ9\xa6\x8a.\xb2/\xbf<\xa8\\\xa3\xe8\xa6\x15\xe2\xc2\xf16kE2\x1c\xb6\x8aD옳\xb2\xea\xb7\xd2\x1eCN\xf5C6\x1e\x87\x95\x0e\xe3\xfa\xbe\xae\n!\x16\xf8\xa98s\xa0qj\xbfT\xd898_\x1f\x973P\xfe/.\xff7/\xfd˨T\xc8Gcn\x95d\x87\xc4\x04\xac\x04\x83\r\xd0\xd8\xd77\x84~\xa1\xd0\xf6\xf7\x85S\x8b\xf5\xd7&H̤\v\x9b\x81\xd6\x04\x9c\x83z\x0f\xb2\x06\xad\xf1\xae@g\aB\xceЍ\xffP\xf8[\b\x11L\x8a_\xbfU\x18\xc57T\xcfs\x03\x7f\x86J\xb5\x89\xaa\xba\x19\x94-TW,oxTh\x11\x0e\x14в\xc7\xf7\x97\xe3_\xac\ne\x17\x94EK\x00\xa2\xa0\xa8\xcf\xccrY\xf2G^\xb6LD\xa9\xedk\xf8=\x03\xf5|\x96\x80\xa64H.<\x03\xc6\xf1#\x86\x83\xaf\x8d?\x969Y\xc5\xcd\xfb\xa2y\xd5\x19g\xd7d\x8ck.&\xac\xe1\xa9\xc7\x17\xcfR\x85\xfa\x9b\xd4Z\x9c^a\x91\x13I,TS\x9cQC\x91Y\xac\xf5\xe4\xf3\x96\x9c*\x89Sb\xee\x17\xab\x88x\xfe:\x88,\xfc,\xd7<\x9c\x82\x9d\x17\xafoxŪ\x86שeȬ`x\xbeR\xc4\xe7\x88;\xa6\xeb\x11\x16\xab\x10\x9e\x14\x97d\xd4\x19\x9cR]\xb0\x88\xb1<\xd6\x7f\xb5\xfa\x81W\xab\x1ax\xddZ\x81Y\x96\x98\xfd\xf1\x94j\x80.v\xf9\x1bk\x1a.\xb7\xc7L\x91\xcb:\xb3l\xb3\xcc2_\x0e\x162\xe2\x99a\x88\xd1Gl\x13ᨿB\x9c\x88\xeeb*\x91K\xab.\xe1\x83\xdc\a\xb8\t8\x83\x90N*{t\xb9\xcb-kǅ\x18\xde`\"\xb0\xf3\xa0\xc2=B\xc3j\xbf\xaa)\x0f<IW\xa5G\x8e\xf2Y\xc1\xdc\xd7\x03\x18Ì\xe5kz\xe3u+,o\x04:/둗\xc9{U\xb6\xc2}\x87\xe4\xbf+\xba5\xb4\xde\x13\xa4\xafw\x9d,^\x1e\x04\x16\xcc\xc0\x0e\x85\x00\x96⎣\xed\x17\xfe\xb6n\xa1VtMΑ72I\xb8\xe3{ᥘ\xaeF\x11\xf5\xea\x04܂I\xba\xf1k\x12\x1b\x994Q9Z\xf4\xc8W\xf6\x1e?}\xfb\xb5E\xbd\a\xf5He\x05\xc1\xa3\xea\xeb\xf7\x83\xba1.\xee\x8b\n0(\xe3\xa9D\xffQx\xd1+(\xf8 \xbd}?\\\x0f\x8dq\x9a\xaf\x0f\x9f\x9c:w\x91Qr\x8e\x89\xe1Ru\xa3\x13Ö\\\xf1\xdcB\xf7\x97\r\xa6N\x0f\xa7\x16\xfd\x97|\x1f\xf37*`?\xa7p=\xef\x88f\xb1P\xfd\xa5\x82\xab\xa5\xf0*ۣ\xcc+D?\xed`\xf3\x05\v\xcf_\xa2\xe0<\x13S9\x05\xe6\xa7\xe1\xe9\x15\n\xca_\xb5\x90\xfc\xb5\nȳ\vǳ\xce\x16\xb3\x0f\x92r\x8e\xfe\x96\x8f\xb3\xe7\v\xc23\n\xc13\x8e\xa8\x96V\x9aQ\xf0}Z\xa1w\x06\x0esE\xe3\x15\v\xba_\xb1\x90\xfb\xb5\v\xb8\x17\x98d\xe1\xe7\xd3\n\xb5\xcf>\xa5\x88\xc7\xe1_T\x89\xb7Jۥ`\xe1\xf6\xb0\x7f\xe2\xb4q\x10@)Q\x82\x8c]\x13\xbb$\x97?\xb8\xfb\xe7m*}0\x18\xddۿ\xa9ҭm\xe9\x1c\xe2\xee\xa0\xfb\xd1}\xda\rj\x94\xfe)\x8a\xff\xbc\xff\xfa\xa5\x83\x9f\xf2A\x83\xa7z\xf0\x04\x82\xf7(ʀ\x9cp|\x15\n~<\xb6\xc8&?\xf3\xd9\x01k\xf8\x7f\xd0\xcbcOH\xcf|\xb8\xbd!\x18\xd1o\xa2\xa7̺J\x83\xeeTo\x8d\u0382t\xa8\x9a\x14\x8b\x9b\xcd\b\xe2\xb8*v\xf8\xd4\x0f\x96\xfeY\xa7h\xc1x\xac\x83r\x82w{\xe3\xd715\xcb'\xe7\xc4\xc9=(ϑ\x15\xd7\xe5\xaaa\xda\xee\x89m\xcc\xc5h\r\xd1b̥W&\x15\xeb\xf1SUI\xf4\xc6\x17\xaa\xe8\xbcnߌOD\x0fqw\xce:\xa6\xefh,\xde\xcex\xc6uL\x1b\xdf\x15a*\xf19Y\x84\xf1li\xab\xa0\x89n\xbf\x9f\x95\x06\xb9\xebF\xcf\xeb9\x17\xd5\xc6\xd4O\x02\x8c\x1bO\xaa\xceH֘*\xf1,\xd0\xd3t\x1d\xbd\x98e\x99m\x9f\xb2I\x0f`\xb4O^T\x03m\xb5è\xcf\xe2\xb6IZiXJ\xb7;\xdb\xeckC\xb8\xb8\x18D\xee\xafsl\x9a\xf9\xe4\xc8ُ\x8dx\xf4L\xa8\x1f\xcaF9\xd5v\x8c\xa93\x0eL\x17]\xbb\x8cB\xdcy\x7f4\xf3\xa9\x8a<\x83q\xc6\xf3\x14\x84\xaf\\\\A\xf2Պ̗)~SD\xcfh5STX\xb6\x02\xcf}\x97\xee~0~\xf9e\xba8[\xc6\xdbt\x0e\xd9\x03\x03\xed|\xe6\xf1\x1bx\x81\x12\x01\xf2\x90\x92S\x0e\x1f\x05\a\xfe\t\xac¿\x96X\x14h̦\x15\xb1\x92\xa7\xd0\xc8,\x96\xb1;7݊O\xaaEi\x1b\xa1X\x89\xfaZ\xc9\rO\x9cX\x8c\xd0\xfa_\xa3\xce\a<[\xd0\xc7V\xf7\xcf\x0f\xce>\xdd\xf6$\xcd\xd50̈́@\xf1\x89\v4?\xab\x9dt\xeb\xca\x10\xc8\xdbԸ\xc1ݥ\xa2\xd5ά\xefA\xb6\xf5\xda9\xb9h\xedt\xb0\xb8Qz\xbe\x8a\xd8\xe3\x9dK\x8b[L\x85\xca;\xcd-\xde7L\x1b\xa4\x15e\xec\xe0\x97\x83!>\xfa\xdc\b\xb6\xf5\xe5b%/\x98\xc5\xce\x00\xd3\fS˧\xf1\x86`\x89=U降$T\xb6PO][\x98\x14\xeb\xa9'0\x13\xa6:\xf9\b\xa6\xb7\xc8\x05k,]\x12!:\x12\x11m\x80A\x0f\xcb\x1e\xbc\x839\x02;\xcdi\xa1\xd47\x14\x95\x19\xcb\xeaD\x94\xb0\xacw\xae\x8f\xc1\xd0ӵ\xba\x1cԦ\r\x1f\x01\xec\x8a\xd0`\xc7LWp\x9c\xf4\xbd{\xd8\x1e\f\xb9\xea\x0e4\x96\x80\x8f(\xc1\x89\"\xe3\x02\xcb9N\xfdF\x89]\xfd\x88\xfa'\xd3\xc1\xa1j9\xc7\xe2\xf7\x96i\xdb-\xfd؏\xd9(]3{\x05%\xb3\xb8r\xa3\xcfs\xdd\xd2Oyj}\xe6\xf1\x1c\xdd\xfe\n\xe2Qī)\xce\xfa\xf9;[5\x1aö1\bݡFآtx\xefr}I\x8f)^{\vƢ+\xbft(d\x85mY\x98\xc0\xbbp\xddif|C\x97\xa2\xd8\xed\xa4\x8aN\xab\x8ap\xc1\xee\x0e\x999|\x92\xf9\b\x17\x9f\x86}C\xd2\xd6\xef؟U0_\x04I\x8f\xf2Z\xdeE\xd6)\x99\xb6\x8af>ɜTJ=d\xb9ٟ\xbb\x8e}:\x89K\xcfJt\xadp\xadZ;\xf0s\x02\xc2\x13ˤ'*\x9fپ\x10\xcc\x0f\xfe\x92\xd1T\x9a4\xcf\xd3\xfb<\x82ԅ\xb7\xca2\x11\x8d\x8c\xe3ˮC5s\xc9\xfe>>\xf0+\xc4\xfe\xe2\x10\xf2\xc1\xcb\xe1=\xec\xaa\x7fn2h\x82\xfe\x8a\xf5TZ5d\xfd\x92@\xba\x97*{\x9fd\xea]\xc0%\xfbGP?Ѣ2p\xfc\xb9\xef=\x85G\xbfL\xef0\xa3LG\x9a@\xc1\x87\xad:\xc98c\xe93^jS1\xb3\xe4\x9e\u07ba>\x9d\xdb10W\x9d\x13z7!\x95黠+\xf8\x82\xbb\xc4W\x8f,:\x8d\"\xa9Jt\xb9\x91\xb7Zm5\x9ac\xa6[ѝ?.\xb7\x9f\x94\xbe\x15\xed\x96˯\xd3\xd5\xd1s\x9do\x99\xb6\xdc1\xad_Ob\xecu\xb4q\x89ߖGO\xff\xc0%\x13\xfc\x1f)]>\xfcqi\x86\x19}\xd7\x04\xe4\x9dc\xa1\"\xe2\x97\x14`\xd0\xd0?\x99\x81\xf9\x89\xf3^\xc2\x17\x95\x14\xe3p`\xcb\xc7@\xb9\x815\x1a\xbb\xc2\xcdFi\xeb\xf3\xf7\xab\x15\xf0Mt\x90\x9c\x86\xa08ѿ\xb8\x0e<\x95x\xef\x8e¢ò\t\xa9DMV\x87BΚ\xed}F\x92\x15\x85\x8b\t\xf0\x9d\xb1,\x15\x9b<IOS\xa8\x1ad%G\x85\xdc\f\xfbw9\xbeN}\x108\x8f:\xba\xf2\xed\r\xba\x98:\b\x1b\xbd(\x01\xc6a\xef\x1ce\x02^\x8d\xddL\x87\xddy\xa6\xe6[\aeJ=\x86\xfd\x8d\x1e\x8b\x0e\a\x9e\xa1\x93#[Q1\xb9\x9d\xbc\xf4Ui\xd5n\xabțS\x0e\x11\x94-E\xce\r\xa9\x02\x13\xffs\v\xdbj98\xb4\v5\x0fSZ\xba[\ued0f\xf2\x04E\xad\xfb\xcb\x1f\xbd\xaa\x9a\xb1\xf9\xd9Y\xc2\t\x88\x8b\xb6?\x01\x91\x99\xbd,f\xaf\xa9\x84@\x9b\xcf\xdcg\x9d\xc3P\x12\t\x9d6~6$t\x10\xa7\x900\xf4%\xfa\x88\xe7w\x83\x91)\x1f\xe5Lt\xcc;1\xb4\xc5yP˛\x1e:Acw\xe74t\x98Q\xf0wV\xdam\x04\xe1\x94ȗ\xe6Nǽ\xbf߈\xf5\xb1\xf3\xb6>\x9e\x1d\xbb~?\x80qpM\xd0E\xb1\xfd41\xde\xfcg\xbeIɋ\xff\x1f\x84\xd6\x02\xff\xe5\xe8\xd7W\xbe\xee\xb7cZr\xb9=\v#\xbf\x84\xb1\x89x>\x80}Ɉ>\xae\xfc\xd9b\xfa\xa4Y:\xfaH\f^\x0e\xf0\x1cf\n_\xfe/\x00\x00\xff\xff\xe5\x9d;'\xe7k\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]s\x1c)\x92\xef\xfa\x15\x84\xeea?B\xdd\x1e\xc7\xedÅ\xde|\xb2\xe7\xae\xe3<c\x85\xa5\xf1\xf3\xd2U\xd9jFUP\x03T\xcb}{\xfb\xdf/2\x81\xfaꢋj\xb5\xe4\x9d]\xf3b\xab\x1a\x92$3\xc9\x0fH`\xb1X\\\xf0J|\x01m\x84\x92\u05ccW\x02\xbeZ\x90\xf8\x97Y>\xfe\x87Y\n\xf5f\xf7\xf6\xe2Q\xc8\xfc\x9a\xdd\xd4ƪ\xf23\x18U\xeb\f\xde\xc3FHa\x85\x92\x17%X\x9es˯/\x18\xe3R*\xcb\xf1\xb3\xc1?\x19˔\xb4Z\x15\x05\xe8\xc5\x03\xc8\xe5c\xbd\x86u-\x8a\x1c4\x01\x0f]\xef~X\xbe\xfd\xcb\xf2\x87\v\xc6$/ᚙl\vy]\x80Y\xee\xa0\x00\xad\x96B]\x98\n2\x04\xfa\xa0U]]\xb3\xf6\a\xd7\xc8w落\xf3\xed\xe9S!\x8c\xfd\x9f\xde\xe7\x8f\xc2X\xfa\xa9*j͋N\x7f\xf4\xd5\b\xf9P\x17\\\xb7\xdf/\x183\x99\xaa\xe0\x9a\xfd\x8c]U<\x83\xfc\x821\x8f?u\xbd`<ω\"\xbc\xb8\xd5BZ\xd07\xaa\xa8\xcb@\x89\x05\xcb\xc1dZT\x96F|g\xb9\xad\rS\x1bf\xb7\xd0\xed\a˯F\xc9[n\xb7\xd7li\xa8\u07b2\xdar\x13~u$r\x00\xfc'\xbbG܌\xd5B>\x8c\xf5\xf6\x8e\xddh%\x19|\xad4\x18D\x99\xe5\xc4@\xf9\xc0\x9e\xb6 \x99ULגP\xf9O\x9e=\xd6\xd5\b\"\x15d\xcb\x01\x9e\x1e\x93\xfe\xc7)\\\xee\xb7\xc0\nn,\xb3\xa2\x04\xc6}\x87\xec\x89\x1b\xc2a\xa34\xb3[a\xa6i\x82@z\xd8:t>\x0e?;\x84rn\xc1\xa3\xd3\x01\x15\x84w\x99i \xb9\xbd\x17%\x18\xcb\xcb>\xccw\x0f\x90\x00\x8cHT\xf1ڐp\xb4\xado\xbb\x9f\x1c\x80\xb5R\x05py\xd1Vڽu\xb2\x97m\xa1\xe4\u05fe\xb2\xaa@\xbe\xbb]}\xf9\xf7\xbb\xdeg֧\xe8\xff-\x9a\xef\xac\xe1\x06\x13\x86q\xf6\x85f\t\xd3~\xda2\xbb\xe5\x96i@1\x00i\xb1F\xa5a\x11H\x9d3\xa5;\xa0*\xd0B\xe5\"\v,\xa2\xc6f\xab\xea\"gk@n-\x9bڕV\x15h+\xc2<t\xa5\xa3^:_\x8f\xa1\x8f\x05G\xecZ91\x05C\x92\xe9g\x1b\xe4\x9eHn\xf2\bӎ\x878\x88\x9f\xb9dj\xfd+dv9\x00}\a\x1a\xc1\x84QdJ\xee@#E2\xf5 \xc5\xff6\xb0\rN\tK\x92j\xc1XF\xf3Y\xf2\x82\xedxQ\xc3\x15\xe32\x1f@.\xf9\x9ei\xc0>Y-;\xf0\xa8\x81\x19\xe2\xf1\x93\xd2\xc0\x84ܨk\xb6\xb5\xb62\xd7o\xde<\b\x1b\x94n\xa6ʲ\x96\xc2\xeeߐ\xfe\x14\xeb\xda*m\xde䰃\xe2\x8d\x11\x0f\v\xae\xb3\xad\xb0\x90\xd9Z\xc3\x1b^\x89\x05\rD\x92\xe2]\x96\xf9\xbf\x05~\x9b^\xb7\a3\xd3\x15R\x993\u0603\xba\xd4I\x97\x03\xe5\x86\xd8r\x01?!\xe9>\x7f\xb8\xbb\xefJ\x9e0\x9e)\x1d\x01\x8c\xf1\a\xa9)\xe4\x06\xbc.\xd8hU\x12L\x90y\xa5\x84\xb4\xf4GV\b\x90\x96\x99z]\n\x8bb\xf0[\r\xc6\"\xeb\x86`o\xc80\xa1\xd0\xd6\x15\xce\xdd|Xa%\xd9\r/\xa1\xb8\xe1\x06^\x99W\xc8\x15\xb3@&$q\xabkn\x87\x95\x1dy;?\x04\x9b\x19am\xd0\x15w\x15d\xbd\xa9\x86\xed\xc4FdnB\xa1JnT\xc9@-\xbb2>\xfb\xe9\x17\xd2}ï\x03<\x9c\x82\f\xbd\x82A\xa3d\xb7\xc4\xfc\xd66\xa2\xc89hLi&\x95=\x80y\xa8Z;\x94\xf0P&09\x10vv\xa0RS,\xe9\b\x90ֶ\x0e\xe5+\xcaj\xc2\xfbQT\xab\xb2\x84\\p\v\xc5\xfe$\xf4\xfb \xc6Ȭ\xa8\x1f\xb6vz^lzD\xcfk`\xa2Ӟ&\xe3_C\x8dCk\xfcW\xb2\xecdD\x1dM\xba\xc0j\xd9\xf2pЏ\x84\xa7C\xd20\xb6\xda0\xabQ\xe7z\xec\x9eDQ\xe0LF\x8c+\xc8{\xa8Ż\x13\x1b&l\x18͚\x13\x06\x92-\x9d\x17\xb5l}\x86\xc6\xfe#\x82\x03\xecH\xed\xbb\xfe\xd1S\xe1\x96I\xf8j\xdbZ8\xec\xc8\b6\xbc0\x83!x\x854k\x18Wl]\xdb\xd30\x80\xb2\xb2\xfb+\xd7v\xa3\x8aB=1C\xca\x16\x8d\xe0F<\xd4\xdaM\xf6?\xe6\xb0\xe1ua\xaf\x1d\xce\x7f\x8aI\xeb\xf84\xb3PVh2O\x91\xd3{\xdf\x16\a\x8c\xb3%ob\x8c\xe0&\a?Dy\xf7c\x04\x88r^l\xa5\xd5N\xe4ޜ\x1f\xa8+vTeaɌ\xb8\x93\xbc2[eQ\"Tm\xc7j\xa5\x8c\n\xcb\xcd\xddj\x00\xad3\t\x11]r\x85IN\xadbO\\Xҹ7w+\xf6\x05c\b\b\xad\x99\x9bl\xcc\xd6Z\xa2\x9d\x8b\xf4\xf7\x19x\xbe\xbfW\xbf\x18`yM&:\xb8\xb7Wl\r\x1b\xb4g\x1a\x10\x06\xfe\x04Z\xa3~7\x84\x84\xaa\x0f\\\xa6\x86=\x8e%(\x1b\xde\xe2\v\xc3\xde\xfe\xc0J!k;*uG\x15\x1bQ\x8f[^\xaa\x1d\xe8\xe7\x10\xf7=\xb7\xfc'\x042\xa0)\x02g\x04\xdd\v\f\xd1w\xbd\xa7\x1f\xd7\x11M\xec\xcajӁ*\f\xbb\xbcDmp\xe9B\xce\xcb+\a\xa1\x16\x85]\b\xd9\xed'\xa8&\xec\xe94\x828\xfa:\xa6\x9b{\xf5\xa3q\"\xff,\xfaD`\x8e\u0601J\xe5lG\xf5\xd8F\x14\xc0\xcc\xdeX(\x83\xd6j=\xffN83,\xe4+\x14\x85\ac\x90\xde~P\xe3\x04\x91uQ\xf0u\x01פ\xe4\x8f\xd0l\\ߌ\x11\xed3\x18+\xb2s\x92\xccA\x1c!\x98\xf6?\xf4(C\xa1\x03\x7f\x04\xc6#\xe0==1N)\x8a\x0e\xd1\xfbԺ\x88\"Wi\xc8Љ\xbd\xf6α\x80\x82\x1cr\xa9X\xa1\xe4\x03h\x87Fc\xacPY\x02Jh\xce\xd0\xef\xd4hb\x84d\x9b\x1a]\xd2%C5\x11\x15\x12!\x8d\x05\x1e\x91\xe630\x0f\xbefE\x9dC~S\xd4Ƃ\xbe\xcbT\x05yXe\x1aUͩL\xfcp\x14\xb2\x0f`\n\x91\x012\"s\x95\x16\xb4\xca\x13\x93\xed6\x96\xd9W\xe0\x16\x9d\x90\xd7~\bm\x902\xa9\\\fXlx\xf9\xe7\xcb+\x12\x81~\xef\xfd~\f\xe3\x1a\x1a2\xcdR\xced\xf2\xc7[\b\ve\x84\xba\x93Jj\x06߹\xd6|\x7f\x84\xeb\xcdj\xda\v\xf0=\x06{\xc0y\x19\xaa}#\xde\x0f\xfb\xffW\xe4\xfey\xf9mhՙ\v\x89|.\x84\xb1=6\x1b\xb7\x8c\x85d\x1d\x8b!=\x81\xa4\x83\x89jr\x8a\xab\xff \xc4<\xeb܉M\x96F6\xfd\x04\xf8\xa7\xa2\xe4V\xa9\xc7\x14\xea\xfd7\xd6kװXF;#l\r[\xbe\x13J\x9b\xe1:)|\x85\xac\xb6Q\xcd\xc2-\xcb\xc5f\x03\x1aa\xd1:\x7f\xb3-p\x8cX\xc7\xe3\x17\xd6QY\xd1\n\x83q\xb5LG\x96\x125bC\xa1\b5\n\xd5y8\x18[\x90\x03\x91\x8b\x9d\xc8k^\x90/\xc1e\xe6\xc6\xc7\x1b\xfcbZmB \x0e\xf0\x8fJ\xb5+Ρ\t\x83D&\xf6\x96\xbd\x94\x04t\xf2K\f\x8e\x0e\xab\xc6)\x11\xd6\x12\x8e\xf6\x8d\xcc\xd4u\x01\xc6w\x97\x93\x9f\xdc꤫\x96Yn\x91\xa1\xe0k(\x98\x81\x022\xabt\x9cB)r\xe0J\xaaҍ\x10wD\xcb\xf6ív0\x13`\x19Ÿ[\x91m\x9d\xfb\x8a\x82F\xb0X\xae\xc0в\b\xaf\xaa\"b\xba\xda2)\x1c\xbe\xb3)\xbdі\x04\r2\x84\x1b\xd3%mI\xd4\xcfm\x19%{;7\xfbT\x1f_\xe8\x1f\xc5\xf7_\x89\xe8\xc1\xea\x9c(\xec\x13\x9a\x84цA\xf2|\x88\x92\x1e).\xc0,;\xcbs\u0086\xaf)\f\xed\xf9\x8f\a{)\aD\xf9}\xf1\xee\xb4\t3\x83u\x93s\xeae\x19\xd7t\xf3O\xc272Yw\xdeb\xcd\xe2\xd9\xc7n\xcb+\xda\x16\xf0\fɯ\xd8F\x14\x16ȩ\x9aB\x94\xcd\xe0\xdc9\t\x94j\x81\x19\xed\x12\xdbl\xfb\xa1\xd9<Jh1\xa0\xd5\x10\x80s\xd0C\x94C<H\x00\xc9\x1aׂvM\x85\x86\x92vc)\x92\xec~!W\xf0\xdd\xcf\xef\xe3\xb1g\xb7$J\xea\xc1\xa0\x12&\xad+\xef\x06\x8eQ\x17W\x1f\xaa\x84_\xc8_k\x02A\xb7\v\x7f\xc58{\x84\xbds\xb1\xb8d\xc87\x1e*'\xa2\xa0\x81R\x02HS<\u009e@\x8d\xef\xf1\x8f\x979\xd2\xe2\xca#\x8cl\xfb\xc5J\x8f\xae\x88\x9f\xdfLqt\xc3\x0fD\x98\x94\xd9Ԗ\x86\xa8~\xfa\x8c\xec\xb0\xc7\xcb\f\xbd\x14J\xe0ˉ\xc3N\x16\xa7n_\xfd\xac\x98G\xd8\xff\xc18^\xe3,\xdb\n\xdau\xe2\xb4z\xa36\xb3\x18\xee\xca\x17^\x88\xbc\xe9\xccͫ\x95\xbcb?+\x8b\xff|\xf8*\fv,s\xf6^\x81\xf9YY\xfa\xf2\xa2Tv\x83x\r\x1a\xbb\x9eh\x82JgI\x90\x88\xdd\xec\x11gKQP\x1b~\b\xc3V\x12C2G\xa2\x19\xddQ\xb2\x90\xeb\xd2uVֆ\xf6Z\xa5\x92\v\xb7,6֛\xe7\x81\xd2=\x16\x9c\xa5c\xdf\xe9=\x1a#\xf7\x8bK[*x\x06yأ\xa3|\x1an\xe1Ad3\xfa,A?\x00\xab\xd0,\xa4K\xcb\fE\xedG6_\xbc\xd2=\x87P\xbc2\x1f\xc9\x16\x19+\v\x9c\xa4\x895\x03\x9b\x93\xaaGri\x8eWO\x1b%Yorw\x92\xa8\xdf\xcd(\x9dg5f\xf2딩\xdd\x19\x8b3\xbd%\xa7=\xad\xbf\xa1\x85\xa5Y\xf0wVq\xa1͒\xbd\xa3\x94\xda\x02z\xbf\xf9\x05\xc1\x0e\x98\xc4n+Z\xdd\xfe\xad\x16;^\xa0\xddG\xc5,\x19\x14\xce\vP\x9b\x03\x1f\xe9\x8a=m\x95q\xe6\xba\xd9,\xbb|\x84\xbd\xdb\xcaM궫(.W\xf2\xd2\xf9\x10\a\x13\xbeq8\x94,\xf6\xec\x92~\xbb|\xae[5C gT\xfd\xbax\xacנ%X0\x8b\x92W\v/\xc8V\x95\x13\n\x88\x12V\xe78\xf8\x18$\aG\x04\x1b7\x99\x9b\xe8\x98OQ Y\x94+e\"Y\x1a\x11\xb4\x12\x04\xfdV\x19\xeb\xd6\xffz~\xf6\xe8\x02\xa1\n\x8b\x82\x8co,hf\xac\xd2!\x17\x12\x15n\xca\x12x\xb7\xdco\xc1\x80\xdf\xff\xf1\x8b\x8d\x0e0F\x8f\x97\xadnp\xda\xfc\xd2\xedAQG<#\xaf\x85\xdaVZe`\xa2\t\tmI\xb4\t=\n\x1eҡYO\xe5.\xea\xda$)ݔ\xc5\xe0P\xe69\xd0H\xba\x13\"\x92\x0f_;Kè]\xf0\xef\x14i=\x05GF\a%ʒ\x0f\xf3p\x93ѽq\xad\xc3\x1c\xf3\xc0\\\x98\xa3\x1fj\xd29s\xac}#\xca\xffh.E)\xe4\x8a:bo_\xcc\raA\x8b\xc7\xf2\x92\xc6\xca\xe9\xce\xf1M\xe8\xac\xe5^\xf3\xc1'\xb3)\xdap\xd1\xd0c\xee\xe1^\x04y\xb5R\xd9\xce\xf2\xc9L\xe7\xb5R\xf9\x1f\f\xdb\bml\x17\rs$\xa3i\x14\xd4\t!\x9f\xfc\xa0\xf5\xc9\x11\xdf'\u05fa\xb3\x90\xb7UO>cyN\x9c\x1bH\xba\xe5;\xf0)\xa3 3UKZ\x82B=\x80\xdd̀\xe8X\xe3\xac@\xa2\xbd\xeb4\x96u\x99N\x90\x05I\x92\x90\x93\xebU\xdd&?r\x91\xb6^\xc4Nc\xab=\x96<9VN\x9fG!\xb3\xb2\x9b\xc7^\U000af8acK\xc6K\xe4!\xb9\x1d\xa2\x84&\x95ݱ\xbbɷ\xc4\x16d\xb4\xac\xc2YV\x15`\xc1\xe7K\xce\xc0#S҈\x1c\x1a\xd3\xefE@I\xc6ن\x8b\xa2\xd63\xb4\xeal\x92ύ\xa1\xbc69\x7f`\x94\x8eȂH\x94\xb8\xbe=\xc3\v\x9e\xd6\xf8\x95\x9e\xe7Ǧ8\x8c\x1a\xe6\xfb\x8b\x95\x16\xcae\xe1\x9f\xdfe\xf4\xf9\xbe\\\xee\xbf\xfb\x8c\xdf}\xc6\xef>㜎\xbe\xfb\x8c\x13\xe5\xbb\xcf\xf8\xddg<^\xbe\xfb\x8c)\xe5\xbb\xcf8\x13\x91o\xe53\xa6`\xb8\xa05\xce#\x15\x92\xb0JLA\x98B{\xa2/\x9fl\xe3\xcfH\x9c%\x87x5\x0er\xe4\xf4L\xe4\xd8C\xcc\xebh\x8dW\x93T\x8c30\xcc\x1dw|1\xc1a>é\x95\x80\xc0\xf9O\xad\xac\x8eB>\xe3\xa9\x15?\x84\xb4\b\xe3\xa43+\x81H\xf3O-\\\xf9\xe4\x9d\x12x\xd8Jqi\x17\xb11\xc6$)\x01\x8fo\x9ct~\x90\xa9\xf8\x02\xb2\xf4*'af\xc9\xd3(\xeb/\xff|\xf9\xfb`\xd1y\x99\x12e\xc3!m\x9d\x1a\x8f\xe9G\x8c\xe5\xbb)\x89\xfd\xec\xd0\xdf\xcfT8\xab짞di\x88\x1c\x81\xd7\x17\xeb\x01\x95\x7fO\xfa\xc6B\xf9\xa9\xf2\xd6\xf2\fG\xdbW#\xf0\x92\x0e\xb7s\xb3\x97\xd9V+\xa9j\xe3ׄ\x10ֻ\xcc\x1d\xf8\x0f c\xc2>\xaaA\xfe¶\xaa\x8e\x9c\x96\x98 mB\xf6j\x1aAzɬ>1\x02,߽]\xf6\x7f\xb1ʧ\xb6\xb2'a\xb7\x11`t\x11\x04\xcfs\x8c\v:\ai\xbc\x1e\bw\x14\r\x852\x02Li&E\xe1$6@\xe8\xc9+\xfbT\xb9\xd5\xc1\x93\xfd\xa6\xe95\xac\xf4\x04عi\xafM\x96\xe2\xb4\xfb\xfe\x8cd׳\x1eI\xfaf鬧%\xb1\xa6\xaeP&$\xac\xa6\xa7\xa9\xa6\xb0Օ\xf4\xe4\xd4\xe4\b95\x11u\xee\nċ&\x9d\xbeL\xaai2\xcd\xd2\xd2J\xe7R\xecURH_9q\xf4\xf5\xd2Eg$\x89\x9e\xff\xb4\xc99WV\x8e\xa7|&%z&\xad\xbeL㜔\xca97\x813\x89\xaa\xe9S\xe7US4_51\xf3\xf5\xd31'\xc5f\xb2\xc2܄\xcb\xf1\x9b\xfeB\x996\xc6ŷ\x10\xce\xe7\x92I鞛\xfc\xac\x18\xf0\xd3\x00\x16\nKp\x19_\xd1'/\xeb\u008a\xaah/%\x8b\x05\x7f[\xd87\x17\xf6\xfc\xaa蘸\xbf\xae\xea\xd3\xe7F◃\b\x83\x1b\xf6\x04E\xc1xln\x1eP!s\x97afj\x01h\xa7p\x96\xfb\x1b\x89\xfc\r\x9aWn\xbaЉx\xb2velٍ\xcb\xe3\xd7]\x1d5&\xa9z\xec\xc0Kv\xbe?}\xfb\xad\x06\xbdgt\xf9V\xe3'\xb5\a+\xfdD7\x18$\x06\xf5\xe3\xd5\xe1\xb1\xfd\x8b\x83`\xa3U\x0f\xec\x9dt\xd6y\x88\x13\xb5A\xbd\xd3\x06W\xa8T1f\x8a\xf6\x13\x01!U\x03!\xd24\xc5\x11\x9fs\xd2\xf0%B\xads\x04[I\xde\xc8<O\xf2\x1b\x9e <\xf5\xe4`zbH\xd2I\xc1\x97\b\xbd\xe6\x04_\xb3|\xc7\xf4\x93\x80\xf37\x81_\xf8\xe4\xdfK\x9d\xf8\x9bA\xbd\xd4\x13~\xf3i\xf7J'\xfa^\xfd$\xdfk\x9e\xe0\x9bur/9Uj\xd6\xee\x7fj\x9aS\xda\xc6\xfc\xf4\x89\xbcēx\x89\xdb\xf6)\xd8'\x9e\xb8\x9b\x7f\xd2.\x91\xces\xa6\xd6+\x9f\xa8{\xe5\x93t\xdf\xe2\x04]\x82 %T\x99\x7fR\xee\xd9\xdb4J\xe7\xa0'\xb7\xc2\xe6H\xed\xa4\xbc\xa6\xc6T}\xc4\x06{=\xe1jS\xac\xd5\xf3\xc5\xc9<\xf8[\xe5\xe9\x05\x81c[\xc3(\x99\x1dϤ\xb7W\u05faM}\xc7\xd4?-\xe0\xb6\xf3\fT\x1c\x151\x05P\x94\xae\x145\xd9\x1fx\xb6\x1d\xf4\xb0\xe5\x86m\x94.\xb9e\x97\xcd\x06\xea\x1b\xd7\x01\xfe}\xb9d\xecG\xd5\xe4\xaft\xef\xee2\xa2\xac\x8a=FD\xec\xb2\xdb\xe0yR\x12\x95\xce\xd0\xf3\xad*D\x16\xf1\xfdF\xefxs\r\x0e.\xbe\xa1[\xe8\xb2N\x06E,\x00\xc1\xe6\"\xdc\x00ؿ\x1f\xd8].~\xe2\x9a\x05\xaf\xc4\x7f\xd1\xfb>gX\xfdzw\xbb\"XA\x8c\xe8\xe1\xa0&i\xafa\xf9\x1a\xd0t\xb7c?\xa6OV\x9b\x1e\xd4~\xdel\xf7\xe5\x04\xc8\xdd3\x19\xc1}\xf0\xaa9S\xa8\xb5nW\x0e\x97c=\xa1|q\xb9gʿ\x83 t\xbe\xa8\xb8\xb6{\x97`s\xd5\xc3#\x98\xe7\xa9ի\xa3\xd6\xea\xf0\x19\x90n\xe9\x91=\xbc\x00B\xbb\xbb\xfb\xaa\xbf\xa1>\xa4\xe7sp:~\xd2x\xf2\x8c\xf1\v\xe0t\xdc\x13Z\x10\x15#?E\xb3\x02Ͼrh\xfcu\xf1?\xa9\x1d\xbc\x8f\xae \xf6\x9f\x02\x194\x19I\xd7\vP\xe9\xc6\xf3\b\x05\xdb\x1c=\xbao\xfayj/\x9e\x7f\x17P\xf1\xf7U?g\x91\xf0\xae\x0fj\xfcu\f\xba\xce;t\x1a\xf3\xaa\xe8ݡ=\xbb\xfdB\xf1c\xa3J\xfd\xd4\xf7\xf1cX&\f\x9b\xee\x11XB\x1e}0\xe4\\d\xb4J\xf3\a\xf8\xa8\xdcC/)b\xd2o\xd1{\x06\xc8{n!\x87\xd9O\u0098\xa2\xf7c\x1b\x02l\xcf,\x1c\xdc:\x8f؞x\xaf\xbe\xb5\xc5sd\xe4\xfe\xfe\xa3\x1b)\xbd\xaf\xf1\xde?\x95\x81\xfa\xd8\x00\xb2 P\xc0A[\xe3\x7f\xb7\xea\x89.c\x8f\xaf\xf5\x86\xd7,:\x0fj\x01\x1d\x9e\xa0\xb4֓\x86YW\x85\xe29\xe8\x1bz\xd1#aĿ\xf4\x1a\f܁\xfe\xbb \xdenF\xc6\x13z~\xc1\xcc\x11\xf4\xe8\x8a\x02\x8a\x1fE\x01\xc6!\x9eh\x1an\x0f[6\x96\xa2.\xd7\xceS\xdd\xe0\x8fM'G,\xb3\x1b*-\xf4W\xa0\xd1Ot[\x02\xb5\t\x92\x7f\x9c\x18\xacᣐ\x16\x1e`<\x14\x9e\xb0\t\xbb\u07b3 a\xf6\xa4(\xc2/\xe3-;\xcetg\x1e\x93\xf7\x1bWw1X\xdc\x18\x95\t\U000bf7c4\xf5\x97\xf0\xbd\xdc\xcd\xcf\xc7B\xa9#t\xac\r|z\x92\xa0?\a]mV2\xf6\xdcƴ\x9e\xf8\xe5\x00Z\xf4\x99\r\xab\xb0\xef\x11\x18\x03\x00L\x85\x9d\x19\xe3\x1ep\t\x1bB\xc24oR\x1d\xd2sb\xb2\xc5m¸k\xb3\x18\x7fBg\xd1<\xf5s\x91@n\xf7lM\x1f\xf0\xf8Kd\xee}\x9b\x8cW\xb6\xd6A\x0f՚\xee\xc6F எ>\xed-\xb2\xf6\x89\xaaS\x18ܾ\x11ծ\x98O\xbeb9\x02\xa7yM,\xfa4\x91\x8b=\xdd+\x93\v\x84\x7f\x1a\x8fGg\f\xe2|瞜\x9a \xc2Ƕ\xe6\u0600\x9ba\xe0\x90\xfd#V\xaf:\x12\xba*}b\f\xb7X\xa79#\xe9\xe5\x88\x1a\x86+\xd6\xefbL\x18?H\xb7`?\xc3al\xbb`\x1f$\x0e\xe2\x90\x00\xee\xb4\x1c\xe4\xb4\x19@\xdaq\xce\x10wM+:\xaa8\xa2!\xa7\xc5\xf6\xcb\x00\xc6 \x0f\x9a\xde\xeai\xaa\xb8\xb3\x8a\x86\xfdQ\x8c\xf9m\xb4Ǔ\xe1@\xfft\xf0kT\x83\x1f\xd5\xde1\xcd=\xaaF\x0e>\xd2\xfbeyGr\xbc?\xdb\xfdR\xaf\xdbk\xf0\xd9\xdf\xfe~\xf1\xff\x01\x00\x00\xff\xff\xba:\x16j\xf5x\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcV\xcfo\xeb6\f\xbe\xe7\xaf \xb0\xeb\xec\xbcb;\f\xb9\r\xdd\x0eŶ\x87\xa2y\xe8]\x91\x99\x84\xab,y$\x95.\xc3\xfe\xf8A\x92ݦ\xb6\xb2\xf6\xed0\xdd,\x89\x1f?~\xfc!7M\xb32\x03=\"\v\x05\xbf\x013\x10\xfe\xa9\xe8ӗ\xb4O?HKa}\xbaY=\x91\xef6p\x1bEC\xff\x80\x12\"[\xfc\t\xf7\xe4I)\xf8U\x8fj:\xa3f\xb3\x020\xde\a5i[\xd2'\x80\r^98\x87\xdc\x1cзOq\x87\xbbH\xaeC\xce\xe0\x93\xebӧ\xf6\xe6\xfb\xf6\xd3\n\xc0\x9b\x1e7 \xc8\xe9L\x8dFa\xfc#\xa2\xa8\xb4'tȡ\xa5\xb0\x92\x01m\xc2?p\x88\xc3\x06^\x0f\x8a\xfd\xe8\xbb\xf0\xdef\xa8m\x86z(P\xf9ԑ\xe8/\xd7n\xfcJ\xe3\xad\xc1E6\xaeN(_\x90c`\xfd\xfc\xea\xb4\x01\x11.'\xe4\x0f\xd1\x19\xae\x1a\xaf\x00Ć\x017\x90m\ac\xb1[\x01\x8c\x82d\xacf\xd4\xe2tS\xe0\xec\x11{S\x9c\x00\x84\x01\xfd\x8f\xf7w\x8f\xdfm\xdfl\x03t(\x96i\xd0,\xeb\xdf\xcd\xcb>\xd4\xc2\x04\x1200R\x02\r`\xacE\x11\xb0\x91\x19\xbdB\xa1\f\xe4\xf7\x81\xfb\x9cV0\xbb\x10\xf5\x02U\x8f\b\x8fY\xff1\xcc\xf6\xe5p\xe00 +MҔuQq\x17\xbb\xffF<\xad\x14k\xb1\x82.\x95\x1eJ\xf6<\xea\x85\xdd(\x0f\x84=\xe8\x91\x04\x18\aFA_\x8a1m\x1b\x0fa\xf7;Zmg\xd0E\x17I\x99\x8c\xaeK\x15{BV`\xb4\xe1\xe0\xe9\xaf\x17lI\x02%\xa7\xceh\xd6\xce+\xb27\x0eN\xc6E\xfc\x16\x8c\xeffȽ9\x03c\xf2\t\xd1_\xe0e\x03\x99\xf3\xf8-0f\xa97pT\x1dd\xb3^\x1fH\xa7>\xb4\xa1\xef\xa3'=\xafsK\xd1.j`YwxB\xb7\x16:4\x86\xed\x91\x14\xadFƵ\x19\xa8Ɂ\xf8܋m\xdf}\xc3c\xe7\xca\x1b\xb7zN5(\xca\xe4\x0f\x17\a\xb9u\xbe\"=\xa9\x91J1\x15\xa8\x12\xe2k\x16\xd2V\x92\xee\xe1\xe7\xed\x17\x98\x98\x94L\x95\xa4\xbc^]\xe82\xe5'\xa9I~\x8f\\\xec\xf6\x1c\xfa\x8c\x89\xbe\x1b\x02y\xcd\x1f\xd6Q.ܸ\xebIe*픺9\xecm\x9eU\xb0C\x88Cg\x14\xbb\xf9\x85;\x0f\xb7\xa6Gwk\x04\xff\xe7\\\xa5\xacH\x93\x92\xf0\xa1l]N\xe0\xf9\xe5\"\xef\xc5\xc14;\xaf\xa4\xb62%\xb6\x03ڔܤo\xb2\xa6=\xd9\xd2V\xfb\xc0`j&퇘d\x8b\xaf\xe42N\xa4\xc2f6\xa7R\x97\xbfϦ>\x96\xf2\xc9\xd1\b\xce7g\x9c\xeeӝ\xb9\x7fG{\xb4g\xeb\xb0@\x94)\x84\xefSI\v}\xec\x97>\x1b\xf8\x8cϕ\xdd{\x0eiB\xe3|\xd4\\\xad\r(\x8f\u0601\xfc\"\xdcyd\xe5V~\x18\x97#?\a4\x02\x01G\xefSK\a\xbf\x80\xac\xbc\b\x8b;\xa4\xd8W\xd8T\xf9\xdc\xf9}\xc8\x7f\x11&96Z\xda\t\xc7d\x8f~\n\xaf\n\xe0\xf5\\\x97\xb5\x9cs\x1f\x12\xb4\xac\xfc<\xff7\xe34\x97\x88\xb1\xea\xbbɬ\xaa\a\xc9cM\xf1z\x7f\x8d,\xa3sf\xe7p\x03\xcaqi]l\r\xb39ϫf*\xb5/ԣ\xa8\xe9\x87w\nh\xf1*\xa4u\xbf@I\xcd\xf3|D\x7f\xadE\xe0\xd9ȫ\xf3\n\xe4\xee|\xcd\xf4\xf6\xe5os\xd9g\xa5\x9e7\x90f}\xa3T\x11\xf2CJUSZ\xea\xbc\xfa[\xb3Pi{yw\x1a$o\xfae\xfa\xabY\xc6p\x95B\xb5\x02\x16\x9b\x19\xbe\xbb\bO4\xb09L\x01\xff\x13\x00\x00\xff\xff\xd9Ո\xaf\x10\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVM\x8f\xdb6\x10\xbd\xfbW\f\x90kd'h\x0f\x85/E\xb0\xe9!h\xd2,\xe2t\xef\xb48\xb2\xa6K\x91\xeap\xa8\x8d\x8b\xfe\xf8bHi\xd7+\xcb\xd9ݢ\xa8.\x86\xc9ᛏ7\xf3Ȫ\xaaV\xa6\xa7\x1b\xe4H\xc1o\xc1\xf4\x84\xdf\x04\xbd\xfe\x8b\xeb۟\xe2\x9a\xc2fx\xbb\xba%o\xb7p\x95\xa2\x84\xee\vƐ\xb8\xc6\xf7ؐ'\xa1\xe0W\x1d\x8a\xb1F\xccv\x05`\xbc\x0fbt9\xea_\x80:x\xe1\xe0\x1cru@\xbf\xbeM{\xdc'r\x169\x83O\xae\x877\xeb\xb7?\xae߬\x00\xbc\xe9p\vCp\xa9\xc3\xe8M\x1f\xdb .\xd4\x05s=\xa0C\x0ek\n\xab\xd8c\xad.\x0e\x1cR\xbf\x85\x87\x8d\x021\xba/\xa1\xdfd\xb4݈\xf6qD\xcb\x06\x8e\xa2\xfc\xfa\x1d\xa3\x8f\x14%\x1b\xf6.\xb1q\x17#\xcb6\xb1\r,\xbf=x\xaf`\x88\xae\xec\x90?$g\xf8\xd2\xf9\x15@\xacC\x8f[\xc8\xc7{S\xa3]\x01\x8c\xf5\xc9p\xd5T\x9a\xb7\x05\xb1n\xb13\xc5\x0f@\xe8ѿ\xbb\xfep\xf3\xc3\xee\xd12\x80\xc5X3\xf5\x92\xab\xbc\x9c\"P\x04\x03S$p\xd7\"#\xdc\xe4zB\x94\xc0\x18Ǡ\xefA\x01\xa6\xf8\xe3\xfa~\xb1\xe7\xd0#\vMɗ錄NVgq\xfd]=\xda\x03\xd0T\xca)\xb0\xdah\x18AZ\x9cʁv\xcc\x1eB\x03\xd2R\x04ƞ1\xa2/\xad\xa7\xcb\xc6C\xd8\xff\x81\xb5\xacg\xd0;d\x85Q\xae\x92\xb3ڟ\x03\xb2\x00c\x1d\x0e\x9e\xfe\xbaǎ !;uF0\n\x90\x17do\x1c\f\xc6%|\r\xc6\xdb\x19rg\x8e\xc0\xa8>!\xf9\x13\xbc| \xce\xe3\xf8\x14\x18\x81|\x13\xb6Њ\xf4q\xbb\xd9\x1cH\xa6\xa9\xabC\xd7%Or\xdc\xe4\x01\xa2}\x92\xc0qcq@\xb7\x89t\xa8\f\xd7-\t֒\x187\xa6\xa7*'\xe2˔t\xf6\x15\x8fs\x1a\x1f\xb9\x95\xa3\xb6X\x14&\x7f8\xd9\xc8S\xf2\x02zt`J\xd7\x14\xa8\x92\xe2\x03\v\xba\xa4\xa5\xfb\xf2\xcb\xee+L\x91\x14\xa6\n)\x0f\xa6gu\x99\xf8\xd1j\x92o\x90˹\x86C\x971\xd1\xdb>\x90\x97\xfc\xa7v\x84^ \xa6}G\xa2m\xf0g\xc2(J\xdd\x1c\xf6*+\x13\xec\x11Ro\x8d\xa0\x9d\x1b|\xf0pe:tW&\xe2\xff̕\xb2\x12+%\xe1Yl\x9d\xea\xedܸ\x94\xf7tPG\x99\xbc@\xed\xb2\"\xecz\xac\x1f\r\x9e\xa2PC\xa3B4\x81g\x052\x93^,\xe3=\xae\xe7\xb2P@\xb9,\x1a:\xccW\x01\x8c\xb5\xf9\xaa1\xee\xfa\xe2\xd9\xef\x14l!\xef\xab\xecI{\xb8\t\xac\x11\rd\x91\xab)\xcf1\x92\xc4c\u0084Ξu\xeaŚ\xe7T\x18\xadRl\xdcy\xa0\x8f#\xb97\xccw\xa5!_J\xfe\x00\x90;\x8f\xbbQ\xab\xbd\xa0\xb78מ\x1cM\xc8\xed\x1d\xd1\xc2\x1dI[\xe6Ɲ^v\xcfcA\xbf[<.-\xcfb\xffڢZ\x16\x19F\x88X3\x8a\xc6\x11\xd1\xe9\x94\xebЮ\x01>\xa5\x98'\xcf,\"\x82\xaa\a\xd9\xe9\xf4-\x1e\xcf\v\rO\x91;\xbe\x1b\x9e\x0e\xf9L˦O\xef\xdd)\x11\xc6\x06\x19\xfd\xd9\xdd1}\vz\xa0\x0f\x1b\xf6(\x98%\xc1\x86:\xaa\x1a\xd4\xd8K܄\x01y \xbc\xdb\xdc\x05\xbe%\x7f\xa8\x94\x9e\xaa\xb4M\xdc\xe4\xd7\xca\xe6U\xfe\xb9\xe0\xef\xeb\xe7\xf7\x9f\xb7\xf0\xceZ\b\xd2\"+\xc7MrS[\x9eܱ\xaf\xb3\x94\xbf\x86D\xf6\xe7\x7fS\xc4З1{F!wyT\x8e\xfa\\\xc81i\xddv\x85\xc2\xc0\xa0j\xac\x9dэ\xd4\x171Y\xea\xda)\xa6}\b\x0e\xcdy\x9f\xaa\xa6\x13\xa3=\x0f\xa9R\x0f/\x99I\x80o\xd5\x03OUg\xfa\xaaX\x1b\t\x1d\xd53\xebI\x14\x9e\x98\xdf\xeb\xd1L\xb5Dk0\x1d\x9bz\xa9<\x9d\xf2C\xca\x1c\xcen\x96\xef0\xb2\x9cxu\xef\xe0Y\xea/FR|\xb9\xfe\xe7c\xa3\xe5~\xbc\x03\xeaĜ/ۼ9\xcbC_\\\xff\xcd\x1dз&.\f\xf23\xa2\xbe֓\x13\r\x8e\x1a\xac\x8f\xb5\xc3\x02\b\xa1Y\xe8\xbd\x17\x85\xac\x1f\xfa\xd4-5\xe2\xbb\xc1\x903{\x87\v{\xbf{sq\xf7\"\xf9\x8b|\x9e-F}+\xd9-\b\xa7\x82=vٸ\xf2O\x00\x00\x00\xff\xff\xb7\xb0(y\xe0\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccX͎\xe3\xb8\x11\xbe\xfb)\n\x9bC.\x96{\a\xc9!\xf0m\xb7\xb3\v\f23h\xb8\x1b\x933M\x96$nS\xa4B\x16\xed\xf5&y\xf7\xa0Hɒe\xf9\xa7{\x83Ō\xfb0\"\x8b\x1f\xeb\xf7\xab\x92\x8a\xa2X\x88V\x7fE\x1f\xb4\xb3k\x10\xad\xc6_\t-?\x85\xd5\xeb\xdf\xc2J\xbb\x87݇ū\xb6j\r\x8f1\x90k6\x18\\\xf4\x12\xff\x8e\xa5\xb6\x9a\xb4\xb3\x8b\x06I(Ab\xbd\x00\x10\xd6:\x12\xbc\x1c\xf8\x11@:K\xde\x19\x83\xbe\xa8Ю^\xe3\x16\xb7Q\x1b\x85>\x81\xf7W\xef\xbe_}\xf8\xeb\xea\xfb\x05\x80\x15\r\xaea+\xe4kl=\xb6.hr^cX\xedРw+\xed\x16\xa1E\xc9\xe8\x95w\xb1]ð\x91Ow7g\xad\x7fL@\x9b\x1e萶\x8c\x0e\xf4\x8f\xd9\xedO:P\x12iM\xf4\xc2\xcc)\x92\xb6\x83\xb6U4\u009f\t\x1c\x16\x00A\xba\x16\xd7\xf0E4\x18Z!Q-\x00:K\x93n\x05\b\xa5\x92\xef\x84y\xf2\xda\x12\xfaGgb\xd3\xfb\xac\x80_\x82\xb3O\x82\xea5\xaczﮤ\xc7\xe4\xd8\x17\xdd` ѴI\x91\xdea?T\xd8=Ӂ/W\x82\xf0\x1c\x8c=\xb7\x1at}9\xb4\xfd\xa9\x8c28\x02F{\x191\x90\u05f6Z\f»\x0f\xe9!\xc8\x1a\x9b\x14|~r-\xda\x1f\x9e>~\xfd\xcb\xf3\xc92@\xeb]\x8b\x9et\x1f\x9e\xfc\x1b\xa5\xdfh\x15@a\x90^\xb7l\xef\x1a\xfeS\x9c\xec\x01\xf0\x05\xf9\x14(\xceC\f@5\xf6>F\xd5\xe9\x04\xae\x04\xaau\x00\x8f\xadǀ6g&/\v\vn\xfb\vJZM\xa0\x9f\xd13\f\x84\xdaE\xa38}w\xe8\t<JWY\xfd\xdb\x11;\x00\xb9t\xa9\x11\x84\x81 E\xd1\n\x03;a\".AX5An\xc4\x01<\xf2\x9d\x10\xed\b/\x1d\bS=>;\x8f\xa0m\xe9\xd6P\x13\xb5a\xfd\xf0Pi\xea\x8bR\xba\xa6\x89V\xd3\xe1!\u0557\xdeFr><(ܡy\b\xba*\x84\x97\xb5&\x94\x14=>\x88V\x17\xc9\x10\xcb\xe6\x87U\xa3\xfe\xe4\xbb2\x0e'מ\x05:\xff\xa5JzCx\xb8\xb4@\a\x10\x1dT\xf6\xc9\x10\x05^b\xd7m~z~\x81^\x93\x1c\xa9\x1c\x94A4\\\x8a\x0f{S\xdb\x12}>Wzפp\xa0U\xadӖ҃4\x1a-A\x88\xdbF\x13\xa7\xc1\xbf\"\x06\xe2\xd0Ma\x1f\x13q\xc1\x16!\xb6\\:j*\xf0\xd1£h\xd0<\x8a\x80\x7fp\xac8*\xa1\xe0 \xdc\x15\xad1\x1d\x0f\xff\xb2pv\xefh\xa3\xa7\xd2\v\xa1\x9d\xd2\xe3s\x8b\x92#\xcb\xce壺\xd42\xd7T\xe9<\x883:=\xf5\xd4<\x05\xf0/\x93\xe839/*\xfc\xe42\xe6T\xe8V\xda\xf1\xef\xc79\xa0^c\xe68.~\xfe\xff\xac\xe0\f ՂFd@B\xdb#\xa7\xcc\x1ay%2\xfc\xd7\bf\n+\xacğS>Zy\xb8a\xe8\xe7\x99#lR\xed\xf6\xe0JB;\x06\xedt=C\x04\xcem\x1f훔\x1dl|t\xb6\xd4չ\xa2\xe3Fv)\xb87.\xb9'\xacCFeE\xd8|θA\xc1\xa2OG\xa6\xecRW\xd1wy\xa9Ѩ\xb0\x9c\xc1\xc4U\xb5\x02)$\xa7\xb2\xfe\r\x97P\x8bPk[-\x01\xad\xf4\x87\xa4\xce\x12Bk4\x11z&t\x90\xae\xe1F\xc2M\xe6\u070f6\x1a#\xb6\x06\xd7@>\xe2\xe2d\xefr\x01\x9e\xba\x99\x9b\xee\xfa\xba\x7f\x06W\xb00h\xab\xb8\x04\xbb\x0e\xc8n\xee3\x9ck\n\xad\x1a\xa1\x9f\x01\xa3\x8d\xcd\xf9u\x05\xbc\xbaV\x8b\x99u\x8f\x81\xb4\x9c\xd9\xf8\xee\xbb\xc5\x1b\"\x9ea>*\xe6\xb8R\xa3_\xbf+#N1\xfa\x1a/\xa31\xdd\x05\x05\x87K\x90\xde\x1a\xec\xf4H9\xa3\U000fd1f9L\x84\xdfU\xdb;\x1e\xe2\xf08\xf6\xbdǬ\xaf\xa7\x10c\xe6J\x98Y?\x0emlGj\xf6\xd4t\xda :\xd6u\xaaӬ;\x97J\xe7\r\x861Mi\x8f\x93\x11\xa0\x80\xedM\n-f\xe9n\"2͆\xc9\xf6ĩ\x8b;j*\x90\xa08\xa1\xa1\xebm-\x1d\xe8\x9d-\xa3\xf7ilȫ<-\x9e\x9d\xb8\xb7\xb1aY\xa2$\xbd\xc3ͷB\xa5?]Ҩ7\xff&\xa7\u0380\x8a\xb65\x1aU?\x13\x0f\x10K\xd0V\x9a\xa8\xfa\x91Oa)\xa2\xa1ГT\xa6\xe7\xd4e\xe7`=\x02\x8fe\x01y\xc0>\xeb\x02+x\x19 J\xfd+*\xd8\xd7h'*\xcc\xe0\xeeE\x80\xf4:\x85j\x99\xfb\x00\x1fa\xfe\aa*\xe75\xd5\xcd2\xdd\xeeQ\xa8a\xb8\xf4\x17\xe2\xff\xfb\xe8߈@\xa3\x1e\xcf\xefw\xeb\xeb\x81\xfdt~\xa2\x8f\x1e\x83\x01\xe9\x06O\x86\x82\xbd\x98c\x86\xd9q\xa0t\xbe\x11\x94_ \v\x06z\x9f\xa5\xb3\xf9\xd9`\b\xa2\xbae\xdd\xe7,\xc5\x16\x89\xfe\b\x88\xad\x8bt\xa1<\xa9Ƌ\xa3\xe0\xe6JȮh\xda\xd6\"\xdc\xd2\xf3\x89e\xe6Hc2d^S\xe1R\a\xfe\x82\xfb\x99\xd5\r\nu\x9e\xcf\x05|q4\xbfu\xc5B\x8f\x12\xed8\x8bnX\xbb\x99ʳ\xe5'1\xe0\x97dv\x01W\xc98\xfbέք\xcd,\xb7]&\xd2\xfc\xe3\x8en\x90\xf0\xf8\rd^l\xa2\xfa\xe3\xf4\xd41hy\x83\xe7D\xce\xf4Ύ\v\x90p\x87a\xf7\x96\xd0]\x85t3\x847\x8a\xea\xffPZ\x170\xe1Ȇ\xf7\xb8\xe3\xa6\x05\x1eC4t\x97\x01\x9b$\xda\xc7/\x1f\x1c\xd2\xef>}\xe6k\xae\xaf\xa5\xe7(%\xa2B\xb5\x98\x15\x80\x02~\x16ڠz\xaf\xb1\x81\x84\xa7\xb7\xe5\xef\xf3ɑ\xde\xf8\x044\xce\xdbo2?\xaf\xf4\xbd~Sx/\xa6\xd45t\xda<\x9c\xfcSx\xabm5C\v\xb7'\x9e\xcd\x05\xac\xd4\xe0ٓ\xfb\xe3Bj2S\xf9<\"\xcc\x00\x8f\x06\x18\x9eP\xc8\xe5\x0f]\x01\x94.KL3\xe4qtp\xfdG\xca\xc1\xb2\v]\xb9\x1bL`\xaf\xa9^¾ֲ\x06\xe5\xec\x9f\tH\xbcb7Q.\xc1\xf1\x97/\x04\xf4>\xbd\a\x8b~ĚA\x1c]\x99\a9hD\xbb\xe4\x89*\xa3K\xeec\xc7ᡟ\xe5\xa6n\x98\x01\xd6\x01^\xb1\xa57\x10\xfc\xd5l\xb9\x91o\x97\xb2e6\xc5\xce\x16\x03\x7f8T#\xe8\x90_\\\xc6+q{\xfc.\xba\x86\x7f\xffw\xf1\xbf\x01\x00.\xee\xf1F!\x19\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[\x93۸\xb1\xf0\xbb~\x05j\xbe\a')I^ח\x87Sz\xf3\x8e\xeddNv\xed)\x8f\xe3}\x86Ȗ\x84\x1d\x10\xe0\x02\xe0\x8c\x95\x93\xf3\xdfO5.\xbc\t$AY\x9e\xf5\xa6f\xb8Uk\x91`\xa3\xef\xdd\x00\x1a\xe0j\xb5ZВ}\x06\xa5\x99\x14\x1bBK\x06_\f\b\xfc\xa5\xd7\xf7\xff\xa5\xd7L\xbe|x\xb5\xb8g\"ߐ\xebJ\x1bY|\x04-+\x95\xc1\x1b\xd81\xc1\f\x93bQ\x80\xa195t\xb3 \x84\n!\r\xc5\xdb\x1a\x7f\x12\x92Ia\x94\xe4\x1c\xd4j\x0fb}_ma[1\x9e\x83\xb2\xc0C\xd7\x0f?\xac_\xfdu\xfdÂ\x10A\vؐ-\xcd\xee\xabR\xaf\x1f\x80\x83\x92k&\x17\xba\x84\fA\ue56c\xca\ri\x1e\xb8W|w\x0e\xd5\x1f\xed\xdb\xf6\x06g\xda\xfc\xa3u\xf3'\xa6\x8d}P\xf2JQ^\xf7d\xefi&\xf6\x15\xa7*\xdc]\x10\xa23Y\u0086\xbc\xa7\x05\xe8\x92f\x90/\b\xf1X\xdb.W\x1e\xe1\x87W\x0eBv\x80\xc2r\x02\x7f\xc9\x12\xc4\xebۛ\xcf\xff\xff\xaes\x9b\x90\x1ct\xa6X\x89|ڐ\x7f\xaf\xea\xfb\xc4cI\x98&\x94|\xb64\x12\xe5YŃ\x1a\xa2\xa0T\xa0A\x18M\xcc\x01HFKS) rG\xfeQmA\t0\xa0[\xf02^i\x03\x8ahC\r\x10j\b%\xa5d\xc2\x10&\x88a\x05\x90?\xbd\xbe\xbd!r\xfb+dF\x13*rB\xb5\x96\x19\xa3\x06r\xf2 yU\x80{\xf7\xcf\xeb\x1aj\xa9d\tʰ\xc0tw\xb54\xa9uw\x8cV\xbc\x90=\xee-\x92\xa3J\x81#˳\x18r\xcfQ\xa4\xcf\x1c\x98nȷJ\x86\xb7\xa9\xf0\xe87\b\xba\xeb\x0e\x14\x82!\xfa +\x9e\xa3&>\x80B\x06fr/ؿjؚ\x18i;\xe5ԀF\xce\x18P\x82r\xf2@y\x05KdJ\x0frA\x8fD\x01\xb2\x8cT\xa2\x05Ͼ\xa0\xfbx\xfc,\x15\x10&vrC\x0eƔz\xf3\xf2垙`_\x99,\x8aJ0s|iM\x85m+#\x95~\x99\xc3\x03\xf0\x97\x9a\xedWTe\af 3\x95\x82\x97\xb4d+K\x88@\xf2\xf5\xba\xc8\xff_P\x8f\xb6\xd4\t1GT[m\x14\x13\xfb\xd6\x03k\x1f3ă\xa6\xe3\x94сr<i\xa4\xc0\xc4\u07b2\xee\xe3ۻOmEe\xda\v\xa5i\xaa\x87\xe4\x83\xdcdb\aʽ\xb7S\xb2\xb00A\xe4NU\xf1G\xc6\x19\bCt\xb5-\x98A5\xf8\xad\x02\x8d6 \xfb`\xaf\xad\x0f\"[ U\x99\xa3\x1a\xf7\x1b\xdc\brM\v\xe0\xd7T\xc3\x13\xcb\n\xa5\xa2W(\x84$i\xb5=k\xf3\xe7\x1a;\xf6\xb6\x1e\x04\a9 Z\xe7X\xeeJ\xc8:\x86\x86o\xb1\x1d˜9\xed\xa4j\xfc\x8e\xf3\x81]\x0e\xc5M\x1f\xafL\xb3;AK}\x90\xe6\x13+@V\xa6\xdfbJ\xd7\U0003afbb\xe9A\t\x18z|\xadϪ4\xe4h\xb4\x8f\x94\x19\x8b\xf3\xf5\xdd\r\xf9l\x9dUx\xdb:\xadJ\x13S)\x81Z\x12\xe9\xeb#\xd0\xfc\xf8I\xfeS\x03\xc9+\xe4<\xc9\x14X>,\xc9\x16vh\xb5\n\xf0}|\x04J!o\xb4u\x9a\xb22}\xc5\xc1\xeb\xd3\x01\x90\xb7\xb4\xe2\xc6\xdb\t\xd3\xe4\xd5\x0f\xa4`\xa22'\xaa6(u\xfc\x0f\xa5^\xc8\aP\xe70\xf1\r5\xf4g|\xb9\xc7;\x04J,Td\xde\xd6\xf3q{\xb4\x0fc\xd2\xf6\xf6\xb2kAd\x9a\\]\x11\xa9ȕ\x8b\xc0WK\xf7vŸY1\xd1\xee\xe3\x91q\x1ez\x99G\xbc\xe3\xa1\x13\xa8\xfe$\xdfi\xa7\xbcg\xf1b\x00V\x8b5\x8f\a0\aP\xa4\x94u\xc4\xdb1\x0eD\x1f\xb5\x81\u009bA\x88\"\x9e\x9eHO\xa8\x87\x94s\x0fB\x93\xed1\x10rJ\xbc\xa88\xa7[\x0e\x1bbT\x05'\x8f\x1do\xb6Rr\xa0b\x829\x1fA\x1b\x96]\x825\x0eR\x841\xca?\xe8p\x00U\xc8\xd0{ 4\x02\xda\xf3\f\xa33\xe7-\xc6v\xb9\xb2\x88\"U*\xc8\xd0mo|8`\xc0m\b\x12\x92p)\xf6\xa0\\\xf7\x98\xaa\x04\rS\x80Z\x9d\x13\xf4\xb4\n8\x86\x13\xb2\xab0`\xae\t\x9a\xf7\xa0\x120\xa1\r\xd0\xfc\xa2\x02\x82/\x19\xafrȯ]\xe6u\x87\td\x1e\xd2f}\x8e\xa0ގB\xf4ᙳ\xccf\x81>\xe1[\xd9ĵ\x9f\xb8\xe0\xd5D\xe9c\t6{E\xff\x18\xd0n\xc2\xef\xa8C\xd0`𥫿\\-\xad\x88\xbb\xbdv\xfbЄ*\xa8ْ\xec8\xa1(\xcd\xf1\xb453PD\xb88\xeaP\x12\xe5I\x95\xa2\xc7\u07b3\x80v=\x00\xb8\xa0<\x87`\xf6$*B\xb3'\x96i\xbf\xdf\xffd\xa9^F\x8e\x1a\a\x19\x862\x81\xf2ÑgG|\x98\xc0\xe0\x00L\x01\x11\xd2,N\xc0\x11&\x1c3\xd1}\x8dI\xebwb\xd6Et~H\xc9k\xdd\xf2\xca\xfb\x87\xe4\xd4A\xca\xfb)\xee\xfc\x1d\xdb4\xa3\"\x92\xd9i\x15\xb2\x85\x03}`Ryқl\x03\xbe@V\x99\xa8\xd5SCr\xb6ہ\u0091Qy\xa0\x1a4\xb2r\x8c!\xc3\xf9{ۍD\x1f\xf6\xe8h\x04\x89b\xb2\x94\x0f\xa1\x8e\x89D?J\x86?D\x14\xf3k\x1b\x8cs\xf6\xc0\xf2\x8ar\x1b\x97\xa9@\xe0\x98B\xd4x\x9d\xd23*\xe44\xcdlϻ\x04\xa2PH\x9d\xa1\x92\x14\x80Io\x81\x83\x82Ӧ\x83B#[\x8a\xb9\x8a\x1c\xa2\x9e\xd8H\xab*\x0e\xdaw\x95\xdb<\xb2\xf1\x19\xcbF(v&\x82p\xba\x05N4pȌTq\x8eL\xc99\xdd\t\x0e02\xe2\xf9\x9a\xb4\x11Ij\b\x18\x01I0\xdc<\x1eXvp\xa9\x1e*\x91M?I.\x01\x13>ChY\xf2H\xb8H\x14~\x82\xad'[}\x8a\xfd\x9f\xf26h\xc9|\xd6\xd6o\xb6\x12r\xe4l\xad\x0e\xf1Am\xf3\xf7\x9f\xc9X&\xfa\x9a\x97\xcc\xd9\x11\xeb\xc7\xffnN \x0f\xea\xf4\xa0\xde\"W\x19\xe85\xb9ٹLgI\x98\xe35\x9b\xb6\x84N\xceu2[\xf6\a\x92\xcd|\xa5O\x14M\x8aM|#\xc1\xd4]\xfc\x01\xe5bCƝ\x8f\x18\xc92\xf9\xa9\xfd֒\xb0]\xcd\xf4|Iv\x8c\x1bP=\xee\x9f\xe5\xea\x83d.\xc1\x8c\x94\xa8\x87WAMvx\xfb\x05\x17R\xea\x85\x1cB\x12\xf9\xd2\x7f\x99\xb0v\xb6\xdf\r\xcf\x13p1\xe3\xfa\xadb\n\n;?nGL\xed;v\xac\xf0\xfa\xfd\x9b\xf8\xf8j\xa6\xe6\xcd5:\xbf>ӣ\xa8\x8d\x9fO\xe1\xc3\x13\x9b\x03\xd5\x03 ;\xe2\xd3KB\xc9=\x1c]\xea\x82+5%(\x1a\x1a't\xaf\xc0.\xcaX\xff{\x0fG\v&\xbe\xcar\xbe6\xf8\x95\x118\xa64\xeb\xf1\x10qbگ\x1e\xa1\xe4\xf1\x06\xd2fo%\xab\x81\xcf\xe7\x9d)D\xd64\xbeʗ\x84+\xf0\xfe\f2\x93T\xa5\xddG3\xc0A\x15\xb9\x87\xe3\v\\\xb3\xe1vv]\x1fX\x89\xee\x00U\xc7\xdaL\xaa@\xdd\xf5\x99r\x96\xd7\x1d\xb9\xe1ǍX\x92\xf7\xd2\xe0\xff\xde~aگd\xbe\x91\xa0\xdfKc\xef|\x13\x8e:Ŀ%?]\x0f\xd6Є\xf3\xf2Ȱ\xf6Z\x9c\x8bi\xa8m5\xef\x99&7\x02\x87+\x8e%\x89]!\bߝ먨\xb4\xc1a\x9c\x90becf\xb4'\xcfo\xa9:\xec\xfe\xeaN}\x87\x9f0\x8c;t\xdc\xe2/\xc75\xf8\xb0^cW%\xa9\x81=\xcb\x12\xfb+@큔\xe8\xc2\xd34\"ѱ\x9e\xa5>i\xd1;\xfcy\xc7\xdb[\xbe\x8d]+t\xb9\t\xad\x82\x18'\x9b\x0e\xac8~\rE6\x8a\xda\x14c\x92\xbb4\xcfm\xa1\t\xe5\xb73<\xfa\fY\xcc5\xcd\x16\xee\xd62IAK4\xcb\xff\xc1Hg\xb5\xf9\x7fII\x99\xd2k\xf2\xda֔p\xe8<\xf3\x93V-0\t]\x96\xd8\x15\xaa\xc0\x03\xe58߃\x0eT\x10\xe06S\xc0\xde\xfbyɒ<\x1e\xa4\x06ԅf\x11\xe5\xea\x1e\x8en\xc9n\xb2˶\x91_\xdd\b\x9c\x14\x16\xf9\xa9\xc1\xd6\x01_\n~$W\x96ī\xafIe\x12\x95-\xb1ٗ\xd5}]\x16\xb3*h\xb9\xf2\njd1\xe24p\x18\xb6Y$j\f\x0eEC\x12\x80/ֵ*8\xfcX/\xbeREK\xa9\xcdf\xf0\xe9<录ڸ\xf9\xaaN\xce\x1a\x9dВa\x12\x8bН+ \x92*T{\xa0S\x9c\x9azm\xff}:\x80\x06\xbf^\xe0'\xc6\x1cP\x1c\xf2^5\xf6\xed&\x1d\xae\xdcz\x05\xfe\x9b\xd0\f\x9f\xa0\xae\x01\xceie\xa0\xa3\x8bɳ\xfcu\x87c\xa7\xb4\xd7s~ԍRp>nj\nr~ʉ̝j\xd3C\xf5\xed\x97ք$\x15\x96\x97\x93:6\x17/\xbc\xb0̅\xf6넒P\xbcvo\x06k\xf0\x80\xac\xe3\xa0j_\xa1\xabҋ\x04\xa0\x84\xb4\x14\xf0{\b\xd4\x05\x137\xa8\x9b\x1b\xf2*\xa9}j\x18\f\f\xb7>4V\xed1\xc9\xf2\x84x\xe5KkB'\x8dt\xea\x1bΔq\x9d\xfe\xf1\x00\n:\xc2;\x9dնy N\"6\x13\x02\x898\xf8^^ಾ\xd2\xf5h\xd1\xe1\x14\xaf\x13\xb9\x80\xf8\xa4x\x8b\xd5;g0\xf7\x83{\xb3&\x14\xa7\x94\x1eC}\x94cL\x12P\xe2\xd6w\x00gQ\x98! 2Y\t;\x81\x82vl\xbbp\xccu\x1e\x96\xa5\x1aI\x9a\xf5\xe3\x05\xa2*\xd2\x18\xb0\"\xd7\x12\v\xfbFgZ\x9akE\xdeQƿ\x85\xd8|\xa5շ\xb4\x89Pc\x16\xbc*\xeagA\xbf\xb0\xa2*\b-PF6\x98c\xcdYG\xe8M\xe5\x19\xbe\x81R@\x7f\x95ɢ\xe4`\xc0W\x8f%\xe2\x90I\xa1Y\x0eup\xf5\x8a \x05\xa1dG\x19\xc7*\x96˳w\xceh\xc2{\x82ɖ\x89)Yj\xe7+\x1b\xe1\x16\x17\xe81\xc5\x1b\x97*=\xe3\x9bЯ[\x05\xf3\xb3\xacR1\xa9P\x8b.\x9ch\xf9JF*\x8eϙ\xd6s\xa6\xf5\x9ci=gZϙ\xd6s\xa6\xf5\x9ci=gZ\xbfO\xa65\x85\x91\xdbP\xb78\x13\x8b\x84\xa5\xe21\x14G\xe0\xfb\xe2\x06_\x83\x1dҘH\x1c\x9c\xb6\x8f\x9b8\xa8H\xe5\xfd@Yu\xcci5\xc1#\x94aX\xab\t:oWަRɯ\xa8z\x0f\x9dz\xa2.P%}3\n\xb1W>\xdaeT\x04\xda@\x85\xb4G{\x8a1gּ\a\xa6̫\x8e^\xfaB\x89\x02h\x98V\xb7K\xa7Q\xba\x06\x90\x98\xea\x7f0\x87\x1bumI\xfa\x11\xb3,֯\xad\xba\xa0~\f\xc1\xeciH]Y\xe5Y\x15\x81\xf8\xb5:\x12\x15\xe9\xd5_\xae\xbe?\xf6_\x86\xe1\x83,>\xe5\x9d\xdf`\x1c\x81\x8a#\xd0vYV\xb7\n\xee\xfbT\xe3\x8b\xe8퐢\xd6Z\xd8gb\x04VW%{\\\xfc^}\x81\x81\xe2G.\xb3\xfb_\xa4\xba\au\x8d\xb3lg\xf11\x02'\f\xb8DUlA!7\x918\xb2\xc5fڻUd\x06\xda0\xe4\xa4*1'\xcc*\x85\x15\xf4\xf1r\xd8\x1b\x04\xf1\xc2\xd5\xccj0K_׃;\xcb_\xe8\xdaڛ^ȣ\xa5\x8ad\x01\x9d\xf8X\xab`\x02S\xdf\r\xf9\xe1\xe4\x91S?܄\xbe\x87\xfe\xd2<\xf6\xf3\xa1\xf4\xd1ܧ\xd4\xe7\xf2\xae\x0f'i\xa3-\xd5G\x91\x1d\x94\x14\xb2\xd2~F\aa\xbd\xb6\xcbt\xbe.\x04\x17\xecR\xbd\xe3_\xc9AV\x91*\xf6\x11՛\xa8f\x9c&\xbeS؈HP\xbb\xd1\xfa\xe1պ\xfb\xc4H_\xe6H\x1e\x999D\x00\xe1\xb6\x06\x82sjb\xdf\u07bc\x10\x0eS02j\x9c\x11@X\xf1ϸ\xb3\xda\xf0v\xc7f\xc9\aK\x10\xe5\xeb\xb9v8>\x1fկ\x19\x88\xb5鱴\xff\xcaX\xf9cH\xf6\x8b\xd8\xf6\xffpͭ\x14\x18tWi\xd2\xff\x1d\xcb\x1a\xe7\x173\xa6\xcc&N\x14.v8\x92V\xae\x98X\x17=\x84\xf4\x84\xfd\x9eV\x98$\xa3\xff\xef\xd5\"\xa9b\xe5\xd2Ň\x97/9L\xe2\xcfty\xe1\x1c\xee|\xf3R\xc2', |\x9a\xb2\xc1\xc4b\xc1Q\x874C\xdccIS\xf8\x9b\x9e=\x19.\xfd\x9b,\xf8\x1b\x99\xfdH\xc1\xafU\xcf\x16GoN!\xdf$\xc7\xd2T\xbf\x85ӷ-\xd5{\xb2\x02\xbd\xa7-\xcb\x1bU\x89чs\n\xef\xe2\xe7\xdcL\a@\xfeT\xcav.\x1b\xa4ꤔ\x11\x04\xa6\xd5\xf8C\x0f\x06\n>\xa4[O\x94\xb7\x16\x157\xac\xe4va\xf8\x81\xe5\xd1\xc9\x13s\x80c} ǯ\x92\x89\xe6h\x99\x0f\x1fkϳ\xeee\xdfT\x93G\xe0\x9cP\x9dBy\xe6\x8ev\xca\xe4\n0h\xa0u\xfa\x93F\xfcyPK7]fw\xeb\xdaHVD\xc0fT\x84CL\u058bdg\x9e\xe2oN\xb2J\xebrܽ\xdf*PGb\x0fƩs\x8fz\x84\x1e\fSW\xbcq\x15\xdem\r\xad\a\x9c$\xe2\x8d)\x93\xd7\xc2E\xc2>>\xf6\x1d\xd0\xed\x81\x06:>\x1cCD\xfb\x18x]\xc8\xfa\xed\xc5\xfc\xa4\xb5\x8fx\xbcU\x8f\xe3\x17\x1fv\xcc\x1fxLF\xfa\x14\x15\xf9\x1d\x87\x1f\xe7\xed\xa6\x9a\x92f\xe2\xee\xa9\x0eo.8\f\x99\x1a\x88$8\xf7n\\\x9dA\xc6\xc4p\xe4\x1b\x0eH\xbe\xcd.\xa8DN\xa5\xecz\x9aǧo>4y\xd2\xc1\xc9S\rOf\xecf\x9ap\\\xb3\xc4?5\fH\x1b\xa8L\xedRJ؝4\x9a\x94\xa5aڊ\xb3C\x88\xce\xc9#\x93x\x98j\x1aO6ty\xd2\xddEO;|\x99T\x92\x89\xc7\xf3v\x0f\x9d\xbd$\"U\x0ejtY)U\vG\xf5oZ\xf3>\xf4\x10\xe9\xad\t\x84c\xfd\xb0U'\x7f\xc5\x1f\xbeifό\x8d\x89\x03\x85\x87\x9a֊\xfe\x01\x80]0lґnr\xe7\x0f\x92\xc5&\x9ah():G{n\xa5\xadz\x8c\x86ʷ4;tW\xd2ȁj\\\xc2(\xa8!W\xf5\x02\xe3K\a\x1c\x7f_\xad\ty'뚋\x86\xb8%Ѭ(\xf9\x11\xcf\x1d$W\xed\x17\xceӀ\xa8\xb6\x85\xde~\x969\xd6\xf9\xa9\xcd\x19\xd2\xfb\u0603ѓ\x9e\x02{T\x14\xaeoK\xf2\xdfw\x1f\xde7\f*}b\xdf;\xc6\xc8\xcd\x03GG{\xb2\xe1\x8f/\xc2\xc2>\x8e\xd6 \x1f\x153\x06Do 9\x97Y\xe3\x89'-\xd9\xdf\xecI\xe0\x91g)\xbc\xf2gO[\x18A\x1b\xf7\xf6G\xa85\xab\x99\xb3\x05\x8c\xca5\xf7\x06]\xcdͮ\x03\xb1[\xb6\xd9>l\x17rk#uV\xe0=u\x86L\xc4Ӹ-\x1eC\xbd\xa0\x8ab1\xb7\xb4\x05B\xe6\xc0T\xbe*\xa92G\xeb_\xf4\xb2\x83C\x88\xc2\xeb\xc5\x19\xc1\xea\xf4\xac\xe8({\xc3\x11\xd1H Bl;\x86\x13ޝ\x83\xc7\xf0f\xcc\xc9m\x98\x17\xc4#\xb0\xf2\x14\x93\x95\xe5\xd4\"\xb1\x90\xedb\x93f\x81\xb6[\xc9Y\x16\x19}u\x98\x13\\\x83k<\xe4\x18ZEL%6\x8c\x0f\xbe\xac\x93\xf0\xa1\xc0\xfb\x8a\x9d\xe4\\>>\x9b\xf0\xb3\t?\x9b\xf0\f\x13\xd6\xfe\xb0r<\xac\xfbMt\xfe\xbbÞ\xbb^\xf3H\xc1h\x80\xe8\xce\xe1\x1e\xac\x9b߂=\xa3;\x9f\x1b\x93\xc7*@C\xd7\xfe\x94\xe5\xcdb\xbeE\xdfuAD\xe8\v\x87N\x87\xceb\xfe\t\x8f\x8c\x14Gr\xfb\xf9\x85n\xa9K0Q?\xcb\xe2\xe7/\xeb\x12\x8b\b\x1c\xff\u008f\x97/\x96\xc5-^t\x0f?Iw\xec\xfe\x94ػ\xad\xfd\xfc\xa0U\xf10N\n\x15\xed\xc1hbgr\xfb\x0f\x00\xf4\x805\xfb}\xbb\x1e}\x8b\x9f\xfd\x90Q\xbf3bc\xc6\xf0s\xe4\xfe\xe9\xd3O\x8e*\xc3\nX\xbf\xa9\\\x11\x11\xa65\x1a\x90ŁZ\ai\x8b\xff\xc4}\xb8x\x1cx\x04Z#\xb4\x161\n\x90O\xae(z\x16IU\xc9%ͱ\xbeL\xec\xd8~\x82\xba\x7fv\x1a\xb7\xf4\xd7\xef\x02ڱ\xbd'\xae\x8eQ\x01\xfel\x05\x1b\x0f\xae8J\xe2\x1c\xf8;\xc6A;\xb4b\xcdz\xf8ߞ\xbeuZR\x87\x87\xe3뺃(\xd0\xc06[\x04U\x82\xc2q\x17ڰ \x95\x0e\xba:L\xf8T\x15ܨ\a~\xe8|\x06\"蹞\x10\xdc\xe7\xf8[\xad\x81h\xcb\xd2\xd0\xca\xf0d\xda\x13\x90d\x10N\xeb\xa3:XL\xe6\x8e'\x1c\x1a\x18\r\xce֍\xa8\xe9\xf0\xf4\xc2\x00\xaf\xdc\xf716\x8bA\x96\x04\x7f\x81\xcd\xc2g\x86\xbc\"\xbb\x82\xc9\xf0\x89\r\xf4\xb7a\xd3N\x8c\xa4aE\xdd\xd6\x05\x84u1\xa2~m\f.]A>!\xb1\xa8#\xf9q\f`\xd0d#\r\xe5-}\xa6\xa1A\x04\xa0\xadw\x1c+t\xf4v<\"\xcd1M\x8e1\xe0\xda\xefm\xba\x18\x03j\x80C\f\xd0U\x86\a\xab\xec*Ώ\xf5֪\xef\x84\x1b\xb8\xe5\xedr\xba\xe0\xa0\r*\x02\n{\x14\xd2$\xc1\xbe\xc6\x18D\x1e,=l;\x9c\xc7\n/\x05_\x9d\xab\r-\xcasxp}\n\xc6~\xffJ\xe5\x9e\x03X\xe4KkܩnĿ\x1e\x05\xe7ʃ\xed\xf0$\xc3\xd9˜\xc0\x03\b\"\x85\xddH\ay\xfd\x01\xb7\x99P\xfcT\x92\x8b\r!Rx\xf4\xe2_\xf9\n3\x8buMv\x80\x89\xeb\xfb\xd6:#L8M\x1b1BQ\xb3\xc1\xbc\x19V\bbn8\x1e\xf1͙fݸ\xf0uN\xee\xfa\xeef\bܠf\x87\x06qp\xbd\xb0\xf5\x95f|J\xae\x97\xc0\xa5ȭ\xc1\xa58\xb4\b\xc4Z\xc7/O\xbb\xdda\xac\xcf!\xd3\x1e4\xe3Wz\xb2\xb0\x1f\x16\xeb4,HR\x80\xd6t\x1f\xa6h\x1f1i߃@\xbfV/\x1cF\x806;\\\xbb\xdf\x05p&C3\x83\xf5궃Pp\xdej\xf5B\x13.O\xf3\f\x82U\U00076a5f\x98\xf7\xa3\x99\x99\x8c\xfaR2\x952\xfay[7D\xde\xd8\x1c\xd2jf\xf8\x82\x8f&\xc0ٞ\xe1(\x01\xb5vOՖ\xeea\x95\xe17'\xad\xb7^?\xa9\xad\xfb}\xc4\x1f\x81\xeaI\xd2\u07b5\xdb\xfa\xd5o+\f_\xf4A\xad\vC\x81\xb8\x0f\x1by\xb9\x9c\x00\xb5\xd3\xf1\xd8\xf1z\x16\xa6\xd6\xe3E?\xd9x\x8ai\xbbm\xb0:\xef\x96\xfd\x9a\x8a\xffb\xe3ҏ\xa8O\xfbë\xa0\xbf\xe2Y\xd2\x05\x13\xf8?\\\xef\xb1+\x0f\xe1s\x8f\xb3\xf0\xc7#C\xee\"I\xec\t\xf2\x7f\xaf\x1b6ˊ\xf89FD\x1bՊn\xf1\b \xa4\xa8Ih\xe3K\x98إ^\xcfՖ\U000416859\x12\x0fҼ\a^\x7f\xef@\x9a\xccv\xedf\xfa\xd8\xfc\t^w᳀\x9c\x1f\x97}ȭ\xf2\xfa\xeeȰ\xf5\x15\x10\x9f\x064g\x8b\ft\x14V\x7f\xa3@\xc21\x18\x1d\x87~\xca\xff)_S\xb3y(\x99\x8c\xaa\xccD\xb2h\x01\xb6ӽ(T\xd2M\x02\xcf@}d\xa8k?\xf9\xb2Y\x8cRr\x8bm\x02\r~\xa7\x9b\xff\x18\xac܍\xceo\xc5ϱX\x91\xf7p:\xd1\uf3a6\x80\xdcV%Y\xab\x8a4\xb9\x11\xb7J\uec40/\xf2\xf0\x17\xca\f\x13\xfbwR\xdd\xf2j\xcfD\x93\xb3\xcfj|K\x95a\x94\xf3\xa3\xc3'\xf2\xee;&(g\xff\x8a\xf9\xa7\xf6\xc3i@u\x16\x12y\x96\x80\xc6Ѓ7\x80\xb9\xaa\xd8\xcfq\x85\xa5\xe7\xebf1\xdfs\x04\x99L\xf9\xc6:'hr\x8a\xd0\xed\x1a\xcfގ\x19\xb8/\xe9c]\x98\x98V\x826+\xd8\xed\xa42\xaebw\xb5£\xf1\xfc$\x02\xfa\x0e;s\xe4>\xe0JX_\xf1\U0006a2e5\x9a0d\xa7}\x95\x8d\xa6\xf6\xbb\x1b\x05=b\xa1/\x134\xcbp\xda\r^jC9\\\u0601\xdb\xd9\x1a\xccF \xffgd\x90\x96&\x85\xb0)\xb3\x06\x14L\xb6q8\xb6\x1f\x97\x19\xd8\x03n\\\xf6ƑD\x10\xbd5\xf7\x81\x1e<\xab\f\xe6H\x9c\x13-ɎF\x06\xa6\xd3N\t3\x0eC\xf9\xcdpYY\x1aɟj(Cn\xd6Sm?WZ\xef\xda\xf5\x15t\xbe\x15\x8a9;P\xb1\x1f\"\xdb\x1c\x94\xac\xf6\x87\xa0\xc9\x03I1\xc9+잔֥\xf8\b\xe4>\x00\xdb*\x02\x1b9\x8a\xa1V\x06\x84\x82\xb8\x92\xaa\\\xfa\xafY\xfb\x8f\x95\xbf\xf4\xdf\x05Z\xe1\xae\xf7\x95\xefז\x02/}\xf5\x8bb\xb8\xb3֮\f\x0et\xd1|z\xc3jBYb1\xbf\xf6='\x9c\xdevv\xb8\xc1:E\x96ш\xc0\xa7\x85\xfdѿkG\x19M\xaa\xd5|\xb0,\x93%\x03ݓ\x88\x1f\x8e\xf8\x8e#`y=\xc1j\xb7z\x871[\x18\x92\xd4\xcfg̱FϬ\xb3\xfd\xfb\x1c\x92\xe9\xd3\\\x91d\xb2<\x0e-\xbc\xd4v\xc7\xf0HGO\xcc\bjS\x0e'qv(B\xce\xf5\xe9{~\xea\xc5\x1b\x1fN\x9d4_B\x1f\x00IB\x0e\x89zn\to\x89\xcc\xce|\xc5hJ\x1bx%\xf9\xe3\xc9xX\x1b\n\xe8kԫ<\x89;\x83^\x8a\x90w\r\xa8Sǌ\xbfl_\xe1\x87g\x05\x1atG-\a\xc1+(\xa5fx\xee#\x84S\n\xec\b\xdf\x1aE\x1e\xea\xa8\xc64fڰ\xdb\xe6\x92Ď\xb0x\xd1_\x90or\xc6\xde\xc2\xe4\x00Pұh\xdd\x10\xb5>W\xb0~.$\x89\x8a\x9f][_A\xeb\x7f\f\x8c\xf8\x10\xb5\xe3pm7\xac\xf7k\xf2x8bj\x81)\n\xe4gS0\x90\xb9\x9f\x95\xbf#\xd2C\x88\xc4s\xf8\x84\x84|:\xc9\r-\x062\xdcDFhC\x95\x99\xe7\xc4\xee:\xaf\x8c\xf9\xaf\xe0\xa7\x06\xa0\x92\x98\xff\xb2\b}\x1f\x1el\xb8\\\x05\xf9\x1el9\xf2p$\x86Ob6\xb4\x8axZ\xa1\x1a\x89M\xd3\xee\xf4c\x1fHG~u\xadO\xe1\x1f\xfbOc\xfa:\xd4\xe0\bm쎯\xbf6\x92\\\xb6\x9av\xa65\xfd7S\x9b\x8f\"K\x01u\x93P\xfc\xcbl\rI\xa4\x03D\x88<\xd2\x1a\xa7\xf5\\\x06\x8f\xc7v\x0f\xf5c\xc5\xe3\xcf{<~\xddj^\xa7V\x88b\xed\xd2NX:d\xeb\xbeg\xe415\x84\x03\xc5\xe2k\xe18\x18\xb7\x86\xc1\x14j\x10͞\xf0\x11\xedZ\xfe\xcd\xc1\xbf\x03\x10IM\x82Պ6\u008d\xa41\x05\xf3r\x8e#=\x9d\\\xe1e+p\x83\xae\x0e7\xeb\x91\xf9\xb7\xf6[\xc1g[P5m8\xcav\x95\xfc#@I-\xbc*6ZMv ^L\"\x87/\xc94\xdc`\xeb\x80;\xb3?Z\xc8\x043\x99\x14Չ\xb8\xa2+\x81\xe9\x99K\xado^w\xf2t\x82\xdao\x9d&o~\xc0\xe6\xe9\x1b\x01J\xdaV?\xb1\xe90\x85\x9e1\xef\x8eת\xab\x84\x83\xad\xac\x8c\x86\x9f\xb6\xa9\x1fh5\x1a.\x12<\xdax\xd8\xc0\xcbU>\xfdL\a\x02|G^סm,\xef\xacͨ\xd6-\a\xba\xa0e\x142i\x17\x00n\x8f\xed%\xb0\xc5l\x83\x1a\x96ت\xa1p1\x83\xbbS\xc9\xcft8\x9dʅ\xea\xd91\xd4ݑ\xd4\xe6\xce\xef\xaaqA\xf1ZA}0\x98M\xb2p\a\x8c\xdd7\x82\x93Ov/\x96\x9fp\x89\x05\x13)\xd0\xdb\x19\xa9@\xcf_\xab\xef\x12t\n~:\xff\x9aP\xd6\x11\t?ԓ\xd7o\xcf^\xc5m&\xc0\xdb\xeb\xb9\xf5\xd9~\xb8\x9e\xdbt\x13V^\xff\xc4b\xa9\x8c\x8do\x19\x92\xf2\xe7\xf5\"9\xf6\x8ej\xf0ٹ\x9f_\x9f;\x8b#c\x8b\x86v=px\xf5\x8f\x907\xb8Ԕ\xe1\x84ۆ\xdcbR\x82\xca\x03\xdd\xf5\xc8\xc5\x1c\xf7\xdb-\x0fl\x16\xb5\xce\"m\x00\xd6\xd0\\\xa6\x1f\x8eD\x1d\xb1Ë\xe8˔!\xf4\xa8\xacGq\x17\xa0\xb2\x865De{\xadn18w\x05\xf9\x85I~\xa4\n\x8b3ϲ\xda_\xfc\xbb\x91\xea\v\x0f\xf6\xd2\xf5\x17\xad\xf2\x8b\x80\xf8\x93\x16`D\xa3\xd2\xc9M\xeb\xa7\xf3\x96\xb7\xf0=m\x88Q\x15,\xfeo\x00\xc1\xdck\xea@\x94\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xcc<ko\xdcHr\xdf\xf9+\n\xbe\x00N\x00\r\xa5\xf5n\x0e\xc9|\tdɛ\x13lن\xa5u\x80\x18\x1b\\\x0fY3ӧf7\xd3ݜ\x11\xef\xf1߃\xea\a\x87\x9c!9\x9c\x91\xb3w\xa6\x16Z\x92\xcd\xeazWuu\xb5f\xb3Y\xc2J\xfe\x15\xb5\xe1J\u0381\x95\x1c\x9f-J\xba3\xe9ӿ\x99\x94\xab\xcb\xcd\x0f\xc9\x13\x97\xf9\x1cn*cU\xf1\x05\x8d\xaat\x86\xb7\xb8\xe4\x92[\xaedR\xa0e9\xb3l\x9e\x000)\x95e\xf4\xd8\xd0-@\xa6\xa4\xd5J\bԳ\x15\xca\xf4\xa9Z\xe0\xa2\xe2\"G\xed\x80ǩ7W\xe9\x0f?\xa5W\t\x80d\x05\xcea\xc1\xb2\xa7\xaa4Vi\xb6B\xa12\x0f2ݠ@\xadR\xae\x12SbF3\xac\xb4\xaa\xca9\xec^x\bav\x8f\xf9[\a\xec\xc1\x03\xfb\x10\x80\xb9\xf7\x82\x1b\xfb~x\xcc\an\xac\x1bW\x8aJ31\x84\x96\x1bb\xd6Jۏ\xbb\xa9g\xb00¿\xe1rU\t\xa6\a>O\x00L\xa6J\x9c\x83\xfb\xbad\x19\xe6\t@`\x8d#d\x06,\xcf\x1d\xb3\x99\xf8\xac\xb9\xb4\xa8o\x94\xa8\x8a\xc8\xe4\x19\xe4h2\xcdK\x1a\x12i\x81@\fDj\xc0Xf+\x03\xa6\xca\xd6\xc0\f\\o\x18\x17l!\xf0\xf2\x17\xc9\xe2\xff;\x8c\x01\xfed\x94\xfc\xcc\xecz\x0e\xa9\xff*-\xd7\xccķ\xc4\xe19|n=\xb15\x11`\xac\xe6rՇ\xd2\af\xecW&x\xeeH~\xe4\x05\x027`\xd7\b\x82\x19\v\x96\x1eН\xe7\x10\x10\x8b\x10\"\x87`\xcbL\x98\a`\xe3\xa1`>\x88\xa98\x98+\f\xf5h\x13*\xf0u\x0f\x8aǟ\x9e\x04\xec[`\xa3~\xa7\x99\xc6\x06\xa4\xb1\xac(;p\xafW8\x04\xacÊ[\\\xb2J\xd86\xa9l\xb5#\xb6\x87\xac\x12\xb34\xf7_\x85\xb7\x9e\x92\xdb\xce3?\xebB)\x81L&\xbbQ\x9b\x1f܍\xc9\xd6X8\x1b\xa5;U\xa2\xbc\xfe|\xf7\xf5Ǉ\xcec\xe8S\xa4=\xa3 \xc1\xb1\x96l֨\x11\xbe:\xfb\xf3r3\x81\xb4\x06&\x80Z\xfc\t3\xbb\x13b\xa9U\x89\xda\xf2h,\xfej\xf9\xa2\xd6\xd3=\x9c\xfe:\xeb\xbc\x03 2\xfcW\x90\x93SB\xafW\xc1~0\x0f\x94\x83Z\x82]s\x03\x1aK\x8d\x06\xa5wS\xf4\x98ɀ`\xba\a\xfa\x015\x81\x01\xb3V\x95\xc8ɗmP[И\xa9\x95\xe4\x7fn`\x1b\xb0*(\xb3Ec\xc1Y\xa8d\x82\x94\xb5\xc2\v`2O:\x80\xa1`5h$\xa6@%[\xf0\xdc\af\x1f\x8f{\xb2\x06.\x97j\x0ekkK3\xbf\xbc\\q\x1b=t\xa6\x8a\xa2\x92\xdc֗\xce\xd9\xf2Ee\x956\x979nP\\\x1a\xbe\x9a1\x9d\xad\xb9\xc5\xccV\x1a/Y\xc9g\x8e\x10I䛴\xc8\x7f\xa7\x83O\xdfɧפ\xfd\x8fs\xa9'\x88\x87ܫW\x19\x0f\xca\xf3d'\x05.W\x8eu_\xde=<B\xc4\xc4K\xca\ve7\xd4\fɇ\xb8\xc9\xe5\x12\xb5\xffn\xa9U\xe1`\xa2\xccKťu7\x99\xe0(-\x98jQpKj\xf0\xbf\x15\x1aK\xa2\xdb\a{\xe3\xa2\x18,\x10\xaa\x92\xac8\xdf\x1fp'\xe1\x86\x15(n\x98\xc1\xdfXV$\x153#!L\x92V;6\xef\xfe\xf9\xc1\x9e\xbd\xad\x171\xa6\x0e\x88\xb6\xd7\x1b<\x94\x98u\xec.G\xc35Y\x86e\x16\x9duu Bt\x15\xbd\xd0:C\xfb\x9d\x04],\xcbИ{\x95\xe3\xfe\x9b=\x94\xaf\x9b\x81\x1d\x1cK\xd4\x057\xe42\f,\x95ޏ<\xac\xf1\xe4\xed+z\xbc}\x81\x03\xa0\xac\x8aCDf\xf0\x05Y\xfeI\x8az\xe0\xd5\x7fi\x1e\"\xc4\x04AҏG\xf1\xa1\x96\xd9\xcf\\X\xd4G\x88\x7f\xbb7\x9c\xac\xcbj\x9eY\xd3\"\u0600\xa9e\x86\xb97\x9a\x03\x95\x88W`\xcb!\xed\xb2\x12.\x87\x98\x83\xd5\xd5!5\xc32\xa4K\xb0\x05\x8a\a\x14\x98Y\xd5C\xcd\x01E\x1f\xda\xe3\xc1\xb8\x0f=1\x81\x88HӢ\xa6\xa7\\\xf7\x82\f\xf3\x1ex\x93I\x04\x1d'\x8a\xae\x82\xd9l\xfd\xee\x99\x02M\x93\xc4\x01\x1c\xa5o\xff\xb3\x10j\xb9\xb1dH\x0e\xeb@\xb5\x1a\"\x8d.rm\\c\xe1\\&<\xae\xb1\xf3\x04\x98F\xb8\xfex{\xe8\xd6v\xff\xb8\xc5b\x04\xe9c>\xbf\xfb\xefz\x0f\xf366!2\xc47v\xcd,\x85Y˸4>R\x98\v`\xf0\x84\xb5\x8b\xa2.T\x97\xa8Y\x1c<:\xb1F\xc1l0\xf9'\xac\x1d\x80\xfe\x00{\x9atC \xc4z|\xc0\x1e\x97\b\x83&\xe7%I\xd2\x03\xa2\xc1=\x9a \xd6\xe0\xfb\xcaRp\xec\v['x\x92\xee\x159z\x129G\x84ކۊ\xe0^\x96\xaf)\xfc\n\xe7\xf1͚\x97\x94=\x91\x128-?.\xa0\x10F(\x83o\xa6\xf0Z}'/ࣲ\xf4\xeb\xdd3\xa7\xe0N\"\xbfUh>*\xeb\x9e|7\x9ey4\xbf7\xc7<Tg\x14\x12\x98֬&\x96\xb4\x13'\x93\xc2\x1d%\xb2\xb8\xe3.7p'A\xe9@\xfa\xd1I\xe8\xe30\x91\x9f\xa2\xa8\x8c\xcbv\xa4\x923,J[\xf7\xce\x118\xaat\x87\xa1/\x98.L\xf5H)\x9b\x7f\xe3\xb3sA+`\xc8+G\xb4K\x1b\x99\xc5\x15ώ\xceT\xa0^!\x94\xe4D\x8f\xc9\xf9\xa8\x83;Q\x1d\xe2PG\xc7\xc8\xc8\xe0\xfa\xf62\xe8\xee5#\x13\x19}\x1f\xc522h \xc5;\x15g\x17\x91\\\xd8\x1d\xe1V\xbb81\xc5wN\xe2\xeat\xd3i\xe1\xe8,\a\nV\x92\xd9\xfc\x85\xa2\x86S\xf4\xbfAɸ6)\\\xbbb\x8c\xc0\xce;.\x9d\x8e\xb6\xc0\x8cNV\xd2$$\xc6\r\x13\xb4\xa0 \xe7%\x01\x85\x8b\xb74\xef~\x14\xbf\x80\xedZ\x19$\xa9\u0092\xa3\xc8\t\xc0\xab'\xac_]\xd0\xc4#\x93\xb5\xcd\xef՝|\xe5c\xe0\x81A5\x01SIQ\xc3+\xf7\xee\xd5y\x81\xff\xa8\xda\x1c\x1d\xf0<\xa3\x1a\x9f\x96h\xd1\xcc\nV\u0382\x92YU\xf4\x9ap\xc1\x9e\xafW=y\xfc\x81\x0eܻ\x811\x84R\xc9D\x05'%rZtw\xf3\xd9\vX\f\xe9\xb4\xcb\fi}\xa2}\xe1\xe9\xec<pT\x8fG\x18\xb5\xcb\xe3?\xa3\xe6\xaa\xc7\x19\f\xe4\xf1~x\xb3\x94Y\xab-,I\xc2(\xad\xa8)\x8a\x12\xed\x81\x11\a0]\xa5$ \x14\xd6\xc8a\x81\x1d\x93{\xb8\x0e\x8bs\xb5\x84+ȹ!\xfa=C\xd3\xe4D\xfe\x8c\xf0f\xc1d\xbe\xe5\xb9]\x7f\xe0\xb4\x12\x9f'\xa7\x9b\xfe\xdb.\b\xb0k\xad\xac\x15!ף\x05/XͤY\xa2\xa6\xb5\xa83Ѱġ\n\xc1\xc8\xea\xee\x02\x16ʮ\xc3\x02\x02ޫ\x923\x8aI\xcap\xab4\xe5]d\x81\x8b\xba\xbdd\xa4'\xb4\xb4R\xbaOM\x96\x9cЪJ\xa1X\x8e\xb9\xc3#W[\x19n\x03\xa4X>s\xf5\x8c\x93y=\x9e\xb1\xc6\xd9\xde\xd6\x16\xcdg\xd4\x0f\x98\xa9\xfd\x12N/\xe7o{?\x8c\x06X\xb0g^T\x05\xe8\xb0\xcc\x1f\xf6dN\x1c;\x9a\x0fɣk\xa9t\xc1\xec\x9cJg\xbf\xff\xa9wD\xc1%M8\x87\xab\xde\xd7^\xe1\xa8\xf2\xb6¾\xc0H,r\x12>\x89\a\x83\x81\xe7\xd3!\xb8!\xce \xcb\xd6@\x15\x1b\xba\x19\xf1\x9c\xcd*\xbb\x85\xeb\x05\xf0\x14S\xd0\xc8rs\x01[\xaa\x18x\x15\xa4\x9d\v\xf3wb%\x15T\xf3J\xe0K\xf8\xf7\x10`4\xce,\xe7\xcb%j\n\xa1¹\x05\"\x03\xb6\\\xe6jk\xa2\xa7ϙ\xcb\x17\x11\x96\\\x87\x9d\x99\xc3\xcb\x7f\x13W\x91\xb1\xbc\x98U\xda\x01'\x87\x1f\xd7Pirrj8\x8d\xb8\x1dyy\xd7S5\xd4\x12JN?\x02\xb1\x84QM\xb86i\xaf'cp\x82\x16G\x92\xf3ױ\xa7\xf8\x86\x17y\x88\x11\x88-Z&\xf8\x89\xa9*>Iѧ\xa9{,\xf2M\xe7ˈb\xd0ϻ\x1d\x8f\x9c:\xeed\xe9~\a\x05F\x99\x1b`\xf6\"\xe6\xa7\x7f\xf8\xc3\xfc\xfe>P>\xc6\x1b*\xb8\xec@\x90N-p\xe96\x10\xac\tY\x8f)\x994P\xf0\\\xf2\xd5z\x14X\xc9,me\xcc\xe1\x7f\xfe\xf9\xdb\xd5\x0f\xbf~\xbb\x9a\xfd\xfb\xaf\x7f}\xf3\xedj\xf6\xe3\xaf\xff2\xffv5\xfbW\xff\xe8\x9fF@\x8cfI'{\xe7ӹ\xfd\xff⩿\xa7\xbf\xfe\xbb\xa9\xb4S\x86\xc9l~\xa0\xd1\x13\xf4\xd6A5\xc9 P\x80s\xb4\xfa\xb7RĪ<th\x93Y\xf4K\xcf\xc7\x03\xba6\x02\x12\x02W-k\xd2\xc6\x7f(\xc59VØ\x91\xdf\x19|\xe7\xd4c\xe0\xed\xd1\xc5\xe5x\xc1\x82\x94\xf2\xbf\x95|Q^\xf2\x18`D\xb1\xdd]\x7f\xbcv\x1b\xe9Q\xdbi\x12\xf8\xb3\x92\xbe_!\xa6By\xd0~\xaad\r\xe5\xc1\xe8K\x02\x98\x03\x97iܼ\xa7B.\xfc\xf2x\x93&g\xa8\xecte=WM\x1bUL\xc6\xcceHA\x8f\xab\xe6\x11\xa5\x1cW\xc7\x11uɔ\\\xf2\xd5<9\xb7T5\xca\xf8\x0e3o\xdcL\xa4.\x94\xb0\x96Zmx\x8ezF{\xa9|\xc93\xcaC\x97|U\xf9|\xde\xd7\x7fLz\x12)\x1as\x94\x9631?\x82I3p\xb7\x85B\xe2\xcbvϩ\x87\x80DB\x8d\x1b\xc4U\x997\x1d0\xed\xcb*\xaa\xcfVƩ\xb5]\xfb]\xf5\xb8BNNK4\a\xb7I:\xb8?\x86-\x9a\xa0q\x063\x8d\xae\xb4\xe6\xf7E\xdc\xd2=\x05\xb8\x0f\xa5c\xd6\v14\aů\x9f\xb0>˪\xc8\xda_\xe2B>\xb6\xbc\x85F\xbf\xa8\xe9G\xa4\xb7[`W>\xa3\x86\x81\\e\x86\xfa:2,\xad\xb9T\x1b\xd4\x1b\x8e\xdb˭\xd2O\\\xaef$\x9eY\xa8\xe9\\\x12\xe2\xe6\xf2w\xee\xd7\xc0|\x8f\x9fn?\xcd\xe1:\xcfA\xd95j\xa8\f.+\x11ղՁs\xe1V\xac\x17P\xf1\xfc?\xcea\xa2r\\bb\x02#\xa9\x85\x80/kخ\xd1\xe1D|{\xf0\"\xa4ҧ5\xaer\x1aw\r\xfc\n\xaa?\xbe\x1c\xb6@M\x8b[\xfd\x15\xf7\x11\x9b<\xad\xcc\x19z\xb7\xe6\xc9(\x1bB@\x00.s\x9e\x85}˖\xd9E\a\x1d\x80\rW\xf9bR\x1a?L\x93S\u06042ӵ\xc7h\x1c\xdd^\xf5\x7f\xd7|\xdd\xf8\xbd\xb0\xd8\xf5\xdd73\xc3sl\xcd\x11\xcd\xc4\x17\xc9|\x15\xac\alh+\xe3\xb2\xcb\x12_\v _\x17*\xef\x03%;n\xe4\xebC\t\x02\xb0\xe5\x123K\xb5bf\xc2w\x015\x03\xdc\x02\xf9H\xda\xf0\xda\xca\xef\\\x91cB\xa8\xed/2̅\xf9\xcf\\\xf4\x0f\x9c\xc2r\xba\xae\xfb\x00\xfaihG\x8d\xe5\xb1\n\xe2\xf9\xbc]s\xea\x00\xd5(_\xdb(\f\xe2\x02\xa6\xabCJC\xcd\x163V\x19\x97\xf3\u0530\xa5\x8e?'\x93<\xae-\tx\x80\x14\x1a6\x01%\x95,sb\xa3]\x8f\xa9cX\xa6\x91\xedo\xb9\xc16\xc2\x05,\x19\x17\xe6\x02\x8cj\xe1\x9f1B|\x81`\xb6\xac,\xa9wEi\xfao5\xe0\x16\x00J\xc1(\xdc=\x93P\xdd\x16\xaa}m\xa0@&m\xd3\xffS\xf0U\x88Ѵ\x10\xa4\xbdN\xc2!b\xed\xf7V\xd23\xbc\x8ek\x14\xb8\xbb}\x89x\xdfc}w\x1b\xed\xff\xee6j:y\xc5\x16\xcf\xe9V\xe26\xf0\x88\xe9F !\x8a\x0f1\xfe\xb1\xe1kw|\x88\x10OX\xbb\xa4\x16r켍\x18P\xf3\xa2\xeeO#\xe8r6\x8bE#\xc1Rㆫ\xca\xf9t㝺\xb1\xac\x86\xa6\x01\x19\xd8Ң\x06\x06:4\x92\xa7焟'\xac}\by!\xdfC\x1c\n\xbco\xaf\x03\xfc\x9bf\x1d\x1b*\xf82\xb6p_\xc0Z\x89\xbc\x1f\xb9\xb8\xc4;\x14\xe0\xaes\t\xeenM\n\xef\\A\xc27\x8e\xfc\xf8\x06\x16\x94\xb2_\x80f[\x18\xdc\xfc]0\x83\xbf\xff\x89\xccZ\r.\x1c\x8f1\xaf0\x13\xd8\xf6\xfe\xfe!\xea\xe4{\xac\xef\x99d+\xd4P\x8aj\xc5%l5+\xcb\xe8u\x9c\xa8Iiz\x81\x82\xef\t\x81\xc2Ap\x9b\xa8\x86\xb2\x9c\xec\xfc\x8d\xb9q\xf7;\xb6>8u\x9d0\x91\xa3/]7\x8c\xcc<\xb4\xa2\x98\x94\xc54\xecr\x93ϧa\xfe9\fﳊ\x03MH\x93\xb3Y6^g\x985h''\x93}$\x13\xbc\xbbMN\x00ǋ\xa2\xb2l\xc1\x05\xb7=+\x9d\xe3\x9e\xe6\xae\xf5\xfd~\xd6\x146h\x85ʞ\x8co\x90\x92\xdd\xdeM\x1f\x84y\x9f\x82t\xb3G\xda3\n\t\xcda\x86D\x0112\xc5\xec\xda\xe1{\x80\xba\xa8\x1f\x8d\x9c\x17\xa5\xefz\x88\x86\xfe\xc9}F=\xbd\b\x9b7n\xbf\x99\xe6e\xb0\xa8\xb2'\xb4C>\xa0Ee\xcc\x1a\xd2\xe4D\xb3\x1f7\xf9\xa2\xb7Ux\x9a|\xe8\xa2Vc\xe0\a2qp\xd3@\xb6\xcbK\x9f\\u\anTQ\n\xced\x86n\x88\xcfX\x06`\xd3J[\x86O\x1750YӪLC%-\x17!\x18h\xb4T\x02P\x12\xf0\xb9\xe4zh몿\x11\x99\xae\x19\xfc'\xad\x1d%\xa140`\x87sr\x86\xb96\xeaT\x7f\x89\xb8\x0e57Lg\xfb\x97!\xa0$\v\xea~\x10*h^lk\xa0\x8a\xbbE\x19\xcfc8U\x1f\x00\xde6\x80\xe8\xbe\x1asq9\x89\x17J\xc8\x16\xddɸ֖\xfd\x8e\xe0\x01\xf8\x85K9\x1d\xc7鴕C\x95\xb5UȧU\\Be\xd0\xe5G\xdc6\xbdq4\xda-\x85\x99\x1cI \x96\x95\x10\x9dy\xe8\xff\xf4\x86\tJ#\x98\x05\x81t\xd6\xe9\xcdO\xb0V\x15\xb5?\x85%\xa6\xab9\xfexE\x1bN\xe6\xec\x18;\xaa\x0f#\xeeR5>\x82\xf5\xb5\xfdt\x14c\xe7OZg\x04 \x84\xc8\xe0\"\rZ\xcb\xe5ʀD\xea\xf5g\xbd\xf2\xb0\x8a<\xab\xa4\xe36\xd4\v҄\x8e\xd7\xcd!\x93\xb0jNOt,ޱM\xd0\xf1\xb7n`\xf4!\xc1\x1fZE\xb2w\t\xc014\x8e\xb2\x1c c7\xa8\xa7\xe0rsM\x03\x9b\xbdh\x067װ\xa8d.0b\xb4]\xa3\xa4\x13\x88|Y\xf7\xcfE?\x8f\x1f\x1e\"Wi\xeb+\xda\\\xe4\xedxI\x98R\xd9s\x88,5.\xf9\xf3\x04\"?\xbb\x81\x91\xe1%\xb3k\xe0ҕ\x1fX\x0f\xfb\aK\x0f1\xe8\x91n\xc0\xa7P\xd4:C<c)\x87G\xe7\x14#\x8a<\x9e'Gx\xd0\xcd\xd4\xe2g\xd1\xdduϼ\xa4\xc9\t\x14i,\x05\xcfX4Js\x04\x93^\x17\xffe\x0f\x86\xf3\x871\xa3l|\xb2_\x86\xf6\x1e\x17\xea\xdbe\xf4ō\xde$\xa9\x9b\x12\xed\x9a)\xbb\x15\xa4\xdeS#\x879Ӆ\xc36S%\xf7\rfT\xa2\xa5\xcfkXr\xc9MO\a\xf2`cɨ\xe2\x1cq\xc5\xc3;b\xe1\xa0,W\xf2gR>\x94ٱ\xf4\xf4\xeb\xe1\x17#\xbd\x86\xf1 \xee\x01L\bL\xd5\x1aM\xa9|\v´N\xc3\x1d\xca\xe9y|\xe8\xe1a\xbf\xe1\xcd@\xb5c\xcb\u07bbh'\xc9\x04s\xf4\x87\x8e\xe7\xc9 W{5\xf7\xc1}\xd5p\x97\x18\xa6\x16\xb4\xecm\x9d\x9c뀄~\vH\xa6\x85\xaa\xc9\a\xe6z\xed\xb4u\x8a\x8e\xfa\xab%T\xd2\xed\r\xb9\xa5g\x9a$=\x9f\xdc҉\x0f\xaab\xe7s\xd2\x06\xda\xe40 Ֆ\xben\x81s\x10⢆\xf6\x01\x82MҪ\x98^\xf5@\xder!h\x1f@c\xa16\x98\x03\xa5\x85\x1aEM9\x16\x1d\x1fy\x93^\xa5ɴ\xc4\xf8\xfb\x9fУ\xa3\xe7\xbbm\xb8/\xa1\x82E{\xca\xe7\xf0\xfd\xc3 \xb4\xc3\xc3\xf3\f\xb25\x93+\xdc\xcfd_\xf7\xf9\xc8֎`\xd8+\xa3\x82m\x8et\xee4\xf4\xa8\x96\x9cr_\xa8ʘ\xef\x0e\xf5\xa6ƀN\xae`fw\x87\xed_n\xb9\x9e\x9d\xd4&\x8d\xf9\x17\xdc\xf0Ã\xe1\xd3\xd9\u0605\x12\xd9\xd7\xf8 \xba\xf9c<+{\xa9ð?\xbaZj\xac\xf0M\xdepٓ\fݽ}\xf8\xf0\xda\xc48cB\x01ݡD\xbd\x16*\xec\x90TƢ\x9ebO\xd1<\x88\x0e\xa9\xe2b!\x1cV\xa6\x93@\xde<\x95\x0e2%\x0f\xecՃR\xff\x1e\xf0]\x8d\xd9!J\xd68hp\\\x0eX\xdb$\x89\xbe\xc4(v\x10\x0e\r\xa1\x15\xd0\x0f\x97u\xfb\x8c\xef\x81\xdf\x11E|\xf8[)\xfb.\x02\xbf\x84=](\xfd,j\xa5~m\xfe\xb0&\bc\xfe\x8fĜ\x82\x96vG\u05cb\xf7~T8\x05\x14n\xd8BUv\x9ff\xb6:\xe2\"\xc3\xdf\x129\x05G\xf7\x17R\x8e`\xe8\xfefJ\x94H\xecIn\x8e\xca\xd3\xc3\xde0?=\xa25\x7fԥ\xe7\xdd\xe1\x9fy\x99@Wo\xdas\xf0\xd0E\x87\xbc%\xd7\xc0\xe4\xf6\x93j\xd1\xfc\xa1\x899\xfc\xe5o\xc9\xff\r\x00XG\xae\xf8\x7fH\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcW͎\xdb6\x10\xbe\xeb)\x06\xe85\x92\x13\xb4\x87·\xd4M\x81E\xdbt\xb1\x0e\xf6NI#\x9b1E\xaa3\xa47\xeeϻ\x17CJ\xb6d\xcb\xde\xdd\x06\xc8J\x87\xd5p\xf8\xcdp~>\x8e\xf3<\xcfT\xa7\x1f\x91X;\xbb\x04\xd5i\xfc\xe2\xd1\xca\x17\x17\xbb\x1f\xb9\xd0n\xb1\x7f\x97\xed\xb4\xad\x97\xb0\n\xec]\xfb\x80\xec\x02U\xf836\xdaj\xaf\x9d\xcdZ\xf4\xaaV^-3\x00e\xad\xf3J\xc4,\x9f\x00\x95\xb3\x9e\x9c1H\xf9\x06m\xb1\v%\x96A\x9b\x1a)\x82\x0f\xa6\xf7o\x8bw?\x14o3\x00\xabZ\\B\xa9\xaa]\xe8\xf6H\xba\xd1U\xc4#\xfc3 {.\xf6h\x90\\\xa1]\xc6\x1dVbeC.tK8-$\x94ރ\xe4\xfdO\x11\xf0q\x04\xf8\x90\x00\xa3\x8e\xd1\xec\x7f\xbd\xad\xf7\x9b\xeeu;\x13H\x99[.F5\xd6v\x13\x8c\xa2\x1b\x8a\x19\x00W\xae\xc3%|T-r\xa7*\xac3\x80>(\xd1\xfd\x1cT]\xc70+sO\xdaz\xa4\x953\xa1\x1d\u009bC\x8d\\\x91\xeeD%\xe1\x80k\xc0o\xb17\v\xde\t\xa0n\x0e\xd1+\x80\xcf\xec\xec\xbd\xf2\xdb%\x14\x12\xbf\"\xa9\xc9\xc6^AB7ġ\x17\xf9\x838ɞ\xb4\xdd̙\x1d\x87\v\xd8+\x1fx\xc6Z\x94\x17\xddV\xf1\xd4\xd4z\xbca\xc6\xd4\bc(\xb5\xa2\"\x8c\xc9\xf9\xa4[d\xaf\xda\xc1ӄ\xf8~3XHp\xb5\xf2I\x90\x96\xf7\xef\xe2\aW[lc\xd5ʗ\xebо\xbf\xbf{\xfc~=\x11\xc3\xf4\xa4\xff\xe4G9\\\xaf\x15\xd0\f\n\xfa,\x9f2\x00~\xab<\xa8!3\xda\xf6\xff\x8d ]\xf9\x19+\x0f\xec\x1d\xa9\rB傩\xa1D \x14\x11\xd6o\xa0<@\x8d\x95\xab\xb5\xdd\x00\xee\x91\x0e\xa0=\xb6\xa0\xed(\xe9#@\xe9?\xb4\x9eA\xd9\x1a\xaa-V;\xd9(\xaa{\xa9#\x04\xb6\xaa\xe3\xad\xf3<\x85\x00\xc2α\xf6\x8e4rq\x04\xec\xc8uH^\x0f͕\x9e\x11\x89\x8c\xa4\xb7B'\x8fD;\xed\x82Z\xd8\x049\x1e\xa1/\x7f\xac\xfb\x04\xa5z\xd6,\x1e\x112\xda\xc4/\"V\xb6\x0f\xd8\xc9\xc1\xf4\xac\x91\x04\x06x\x1b\x03X9\xbbG\xf2@X\xb9\x8d\xd5\x7f\x1d\xb1Y\x92#F\x8d\xf2\x92\xaa\xd8`V\x19\xd8+\x13\xf0\x8d\x04\xed\f\xb9U\a \x14\x9b\x10\xec\b/n\x18\x05*\xbd\xbf;BжqK\xd8z\xdf\xf1r\xb1\xd8h?Pk\xe5\xda6X\xed\x0f\v\xc9\x12\xe92xG\xbc\xa8q\x8ff\xc1z\x93+\xaa\xb6\xdac\xe5\x03\xe1Bu:\x8f\a\xb1r|.\xda\xfa;\xea\xc9xh\x9e+-\x94\xdeȃ\xafH\x8f\xf0a*\xe4\x04\x95br\xca\xc2PG\x0f\x1f֟`\xf0$e\xaa\xaf\xe2\xa3*_ˏDS\xdb\x06)\xedkȵ\xb1\x06\xd0֝\xd3\xd6Ǐ\xcah\xb4\x1e8\x94\xad\xf6<\xb4\x95\xa4\xee\x1cv\x15\xaf\x1f\xe9\x97\xd0I\xcf\xd7\xe7\nw\x16V\xaaE\xb3R\x8c\xdf8W\x92\x15\xce%\t/\xca\xd6\xf8R=\xfd%\xe5\x14\xde\xd1\xc2p\x11^I\xedU\x9eZwXI\x8a%ʂq\\\x87\xc6\x11\xa8\t\xe2\r\xba\x9bFr\x9e\"\xe49]5\xe7+\xb3\x0e\x8b\xe2\xe0\x9d\xbdq\xb1\x9d'\xf2jL\xe5%T\xf5*q\xe23N\\4\x84\xbc\x0f\xa7\xedCĐ\xe1i\x8b~+E\xec\">(cR\xe5\xf6\x9a\xbd\xe3\x89qgP\x9f\xe5\xe0\xc3\x1bЖ\xbd`\xbb\x06\x9c5\x87\t\x97߄\xc4/\x9a}\x01\x7f\xc8&\xafvȀM#\xfc%9\x16/w\xae\xd3\xea\n\xdf\x0f\x7f)\xa2\xa5s\x06\x95\x9d\xacJ;j\xc23j\xc9\xe1b\xae\xb8]\xc1q\x06XfW\xb3q\xbd\x86\xe3ΡN\xaa@\x14\xc9\"I]3A\x04P__ŕk;\x83\x93\xe1\xe3\x99JZ]\xee\x88W\x11\xd5\xc9i\xaf[\x1c\xae\xbe\xa3W\x17\x90\x00O\x8a\a\xeb\x97\xd4\x06ҳ\xad\xf2i\xda\xc9\x05\xf3B\xc3\x06cTip\t\x9e\x02\xbe\xa6m\x90\xc8\x11?s\xce\x0fQIR\xa1\xe2D-\rۑ+\r\xb6\f\x8d\v\xb6\x86:\xd0po\x8c\x0f{y\x18\x19jf\xec\xddt\xf2\x85\aTD\xea\x90M\x16\xe2\fũ\xba\xb0~昳\xc4p7\x068\xb2VhK$\tC\xc4?\xebn\xaf\xa8LL\xa1|v\x01\b\x8a0Mz2\xad\x84\xaaB\xe6&\x18s\x95\xeedv\xd9 \x9d\xad\xc6q\xfb\xff\x1c\xe8^6εՑ\x87_\xd8IC|\x04\xab\xef\x04\x89P3\x9e^e8=\x9bG\xa7\xc1\x9aA\xd4\xdc\xf7\x8bL\xc5N\xf8\xf7I\x8b\xc7\xd1\xd0/J\x9b\xb9\x1eA\x1b\xda\xcbh\xe4\xf0\x11\x9ff\xa4w\xf6\x9e܆\x90\xa7W\xb6<\xf9\xe9,3k\xc9|\xf6\x8a\xdae\xafȿ\x94P\xd6\x13\xe5\xe7\xb9D\x98\xe3\x02\xb1\xb7\xf9\xad\x99$\xa5y\xddg\xf9\xabz\xeeq\x1e\xea\xb2\xfb:w,\xaf\xbe\xf7\xa4\xe0d\xbc\x9aAm\xdd\x1eit\x7fJ{\xc6fL\fv\xed\x86~M[\xce^\x82\x17B\x96!\xb9\x1eE\xb8\xffU8\x96\x84\xf2\xf8\x1b`\t\x7f\xff\x9b\xfd7\x00\xbbZ\x12/\xd3\x11\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcUK\x93\xdb6\f\xbe\xebW`\xa6\xd7JN\xa6=ttk69\xec\xb4\xcdxv3\xb9\xd3$l1K\x91,@z\xbb}\xfc\xf7\x0eH\xcb\x0fYn6\x97J\xba\x88\xc4\xe3\xc3\xf7\x81`۶\x8d\x8a\xf63\x12\xdb\xe0{P\xd1\xe2\x1f\t\xbd\xfcq\xf7\xf4\x13w6\xac\xf6o\x9b'\xebM\x0fw\x99S\x18\x1f\x90C&\x8d\xefqk\xbdM6\xf8fĤ\x8cJ\xaao\x00\x94\xf7!)Yf\xf9\x05\xd0\xc1'\n\xce!\xb5;\xf4\xddS\xde\xe0&[g\x90J\xf0)\xf5\xfeM\xf7\xf6\xc7\xeeM\x03\xe0Ո=\x18t\x98p\xa3\xf4S\x8e\x84\xbfg\xe4\xc4\xdd\x1e\x1dR\xe8lh8\xa2\x96\xf8;\n9\xf6pڨ\xfe\x87\xdc\x15\xf7\xfb\x12\xea]\t\xf5PC\x95]g9\xfdr\xcb\xe2W{\xb0\x8a.\x93rˀ\x8a\x01[\xbf\xcbNѢI\x03\xc0:D\xec\xe1\xa3\x1a\x91\xa3\xd2h\x1a\x80C\xd9\x05f\vʘB\xa4rk\xb2>!\xdd\x05\x97ǉ\xc0\x16\f\xb2&\x1bŤ\x87O\x03\x96\x12!l!\r\b5\x1d\xa4\x00\x1b< \x90\f\xf2~\xe1\xe0\xd7*\r=t\xc2WWM\x05\xc8\xc1@\xe2\xf4\xf0n\xbe\x9c^\x040'\xb2~w\v\x02'\x952O J^\x1b<\x9cʞ\x03(\xf6]\x1c\x14_f\x7f,\x1b\xb72W\x9b\xfd۲\xcfz\xc0\xb1t\x99\xfc\x85\x88\xfe\xe7\xf5\xfd\xe7\x1f\x1e/\x96\xe1\x12내`\x19ԄT\x88+\xe8\x11\x82G\b\x04c\xa0\x89U\xee\x8eA#\x85\x88\x94\xec\xd4Z\xf5=;<g\xab3\b\x7f\xb7\x17{\x00\x82\xbaz\x81\x91S\x84\\\x94<4\x05\x9aC\xa1\x95\\\xcb@\x18\t\x19}=W\xb2\xac<\x84\xcd\x17\xd4\xe9\x04\xb0\xbe\x8fH\x12\x06x\b\xd9\x199|{\xa4\x04\x84:\xec\xbc\xfd\xf3\x18\x9b\xa5nI\xeaT*\x94H\xdby\xe5`\xaf\\\xc6\xefAy\xd3\\\x04\x86Q\xbd\x00\xa1\xe4\x84\xec\xcf\xe2\x15\x873\xa2\xea\xf7\x9b\x90h\xfd6\xf40\xa4\x14\xb9_\xadv6M#E\x87q\xccަ\x97U\x99\x0ev\x93S ^\x19ܣ[\xb1ݵ\x8a\xf4`\x13\xea\x94\tW*ڶ\x14\xe2\xa5|\xeeF\xf3\x1d\x1d\x86\x10_\xa4\xbd\xea\x9e\xfa\x95)\xf0\r\xf2\xc8L\xa8=RCUNN*X\xbf+z=|x\xfc\x04\x13\x92\xaaT\x15\xe5dʷ\xf4\x116\xad\xdf\"U\xbf-\x85\xb1\xc4Dob\xb0>\x95\x1f\xed,\xfa\x04\x9c7\xa3M<u\xacH7\x0f{WƮL\x80\x1c\x8dJh\xe6\x06\xf7\x1e\xeeԈ\xeeN1\xfe\xcfZ\x89*܊\b\xafR\xeb\xfc29=ո\xd2{\xb61]\x037\xa4]8\xfc\x8f\x11\xb5\x88+\xfc\x8a\xb7\xddZ]\x8f\xd56\x10<\x0fV\x0f\xd3Ὲ\v\xa7Aq\xc9\xdf\xf2`\x90\xf74n\xe7;7\x8b\x87\"\xb2%\x9c5l{\x16\xecU\xbc\x94\xa1\xfa\x8d\xcc\x14\x9f\x89\x1b\x9d\x89J\xf3\x1d\xe7\xbcZrz-\x17H\x14\xe8ju\x06\xeaC1\x92\xa1\x95\x94\xf5\fʿ\x1c\x1c!\r*\xc13\x12\x02z\x1d\xb2L+4`\xf2\x15\x7f\aZ\xce\xef\xa4HA#_\x1dE\x00\x9bp\\\xc0\xf4\x1f\xea\xc8\xe7\xb3sj㰇D\x19\x9b\x8b\xbd\xa3\"\x8aH\xbd\xcc\xf6\xca\xdd\xf7\x15\n\xd6b\xb3\xa4\x01NW\xedWE\x90\x0f}\x1e\xaf3\xb5\xf0\x11\x9f\x17V\xef\xfd\x9a\u008e\x90\xe7-/.\xeb\xca\x1e\x9a\x1b\x95.\xb0\xb4ؔW\x8b,\xa3М\xb1\xc8)\x90ڝ\xf3\xcays\x9c\xf4=\xfc\xf5O\xf3\xef\x00_։ȱ\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcW\xcfs۶\x12\xbe\xeb\xafؙwx\xef͘r\xdc63\xadn\xad\x93\x83'Nꑓ\xb4W\x88\\\x91\xa8A\x00\xc5.\xa4\xa8\xd3?\xbe\xb3\x00)\xc9\x14\xf5Ù\xb6\x86/\x04\x16\x1fv\xbf]|X\x15E1Q^\x7f\xc6@\xda\xd9\x19(\xaf\xf1\v\xa3\x95/\x9a>}OS\xed\xaeW7\x93'm\xab\x19\xdcFb\xd7Α\\\f%\xbe\xc1\xa5\xb6\x9a\xb5\xb3\x93\x16YU\x8a\xd5l\x02\xa0\xacu\xacd\x9a\xe4\x13\xa0t\x96\x833\x06CQ\xa3\x9d>\xc5\x05.\xa26\x15\x86\x04\xde\x1f\xbdz5\xbd\xf9n\xfaj\x02`U\x8b3\xa8\xdc\xda\x1a\xa7\xaa\x80\xbfG$\xa6\xe9\n\r\x067\xd5nB\x1eK\xc1\xae\x83\x8b~\x06\xbb\x85\xbc\xb7;7\xfb\xfc\xa6\x83\x99g\x98\xb4b4\xf1\xbb\xb1\xd5{\xddYx\x13\x832\x87N\xa4EҶ\x8eF\x85\x83\xe5\t\x00\x95\xce\xe3\f>\xa8\x16ɫ\x12\xab\t@\x17br\xab\xe8\xa2[\xddd\xa8\xb2\xc16\xd1&_Σ\xfd\xf1\xe1\xee\xf3\xb7\x8fϦ\x01*\xa42h/\xa4\xce\xe0\xcfb;\x0f\xc3\x00@\x13(\xe8\xdc\x01v[\x0fAYP\x81\xf5R\x95\f\xcb\xe0ZX\xa8\xf2)zp\x8b߰d vA\xd5x\x05\x14\xcb\x06\x94\xa0d\x83\xbd\xb3\x8c\xaba\xa9\rN\xb7s>8\x8f\x81uOy\x1e{\x05\xb57{*\n\x19\x12x\xde\x05\x95T\x16\x12p\x83=yXu\\\x81[\x027\x9a \xa0\x0fHhs\xadɴ\xb2]4;\a\xf3x\xc4 0@\x8d\x8b\xa6\x92\x82\\a`\bX\xba\xda\xea?\xb6\xd8$\x8cɡF\xb1\xf0\xa7-c\xb0\xca\xc0J\x99\x88W\xa0l5@n\xd5\x06\x02&\x06\xa3\xdd\xc3K\x1bh\xe8\xc7{\x17\x10\xb4]\xba\x194̞f\xd7\u05f5\xe6\xfe\x9a\x95\xaem\xa3ռ\xb9N7F/\"\xbb@\xd7\x15\xae\xd0\\\x93\xae\v\x15\xcaF3\x96\x1c\x03^+\xaf\x8b\x14\x88\x95\xf0i\xdaV\xff\t\xddŤg\xc7\xf2F\n\x928h[\xef-\xa4\xdb\xf1\x82\xf4\xc8}\xc9Օ\xa12'\xbb,h[\xa7|\xcd\xdf>~\x84ޓ\x9c\xa9\xaeĶ\xa6t,?¦\xb6K\fy_*S\xc1D[y\xa7-\xa7\x03J\xa3\xd12P\\\xb4\x9a\xa9\xafuI\xdd\x10\xf66I\x11,\x10\xa2\xaf\x14c54\xb8\xb3p\xabZ4\xb7\x8a\xf0_Εd\x85\nI\xc2E\xd9\xda\x17\xd8\xdd_6\xce\xf4\xee-\xf4\xf2x$\xb5\x03\xc9x\xf4XJb\x85[٩\x97\xba\xccWj\xe9\x02\xa8\x9d\x82tL?'j\\\x01d\xa0-\xc3&\x1d\xf9\x10\x17F\x97\xefp349Ws2\xde\x1e\xc2\xf4\xde\xfe\xfa\xcd\xeb\xd77?\x80O\xf3\xf0\x84\x9bT\x1fBS\xfaHB\x81\xc0*\xd4\xc8I\xb8F\xe05\xc1:(ﱂ\xa5\vW\xb0nЦmb/\aua`\x05\x8b\rH\xc1u\xba\xd9\t&\x18\x97\xd9\x1a֏\x8c\x8f\r\xee\xed\x17D\x02\x15\x10\x9c5\x9b-\xafjaP\xb0\xb7\x85L\xc8\xe96i>\xc4\\\xba\xd0*\x9e\xc1b\xc3x\xb0h\xa31\x826\x03\x0e\xf1p\xf9Hi\xc9\x7f\xe6\xe8Lz>&#\xe1dݨ\xe7\x8f\xcb\xffpZO\xc1\xb8\x9a\xba\xa2\xc9$\xfd\xff0\x80\xe3\xf52.J\xa3\x9e\xf4Z$y\x92\xa8D\xfc\x85\xdd}\x9f\x0e\x8f\x96\x816\xb6\xe3\a\x14\xf0S\xf2\xf9\xde\xd5'\xd7o\x9deѰ\x93F\x9f\x9d\x89->Z\xe5\xa9qgl\xef\x18۟=\x86TE\xa7M\xfb\xcekۦ\x9c0\x8c\xe6\xe8\xb9s\x94\xe2\xc5\xe3\x91v\x06\x17\xa1\\\xe0SgyQ\xa0\xb7\x8fw/\xa1\xf0\x88\xf9\v\x92tg\x97\x8eN;\xbe3<\x8d\x87a\xab\x9d'\ro\x1b,\x9f(\xb6g\x8e}\x136\xf3hO%\xe1č\x96\xff\xd4i\x9e\xbfMҫ\xf6\xb7I\xb6\xf4\xc2\xf9..0Xd\xa4\xdd{\xbe\xd6܌\"\x02\xac\x1b]6ϤS\x11\xb9R\x8f=\xbc\x17\xb8/r\xa8\x03\x8e\xc8A\x91dbdZ\x9c?\x98>\xf2F\x1e;\xa0\xe8\xb4pr\x01\x06\xb1\xe28б\x93/m\xb2\xef\xa9.c\b\xa9\x91ɳҿ\x0e7L'\x97Ig\xafy\x9f\xe6\xf7\xb3\xc9\xc9\\\xf7\a|\x9a\xdfK\x1b\xccJ\xdb\xec\x8d\x0fX\x90\xae-V k\xa2\xe2\xbb\xc7\xf3\x00\x13\x06\xbf\x03.\xc8(~\xf1:k\xdc\x19\x17\xdfn\r\x85\xa9\xee=\xd64\xe4&\x03\"IS\x0e\xa5\xb2\a\xa0 \x8d_\x85\x06\xbb\xb7[¡\r1\xb6ǟU\xe9\x12\v\xd6\xed\xdf\xf9\xb6\xb6H\xa4j<\x13\xf5h\xef\xf3>o\x15\x1eT\x8f\x03j\xe1\"ú\xc9\x11\rYY+\x02\x1f\\\x89DX\xa5\xeb\xea\xe2X\xfev\xb5\xf6i~\xff\xa2D\xfaFѹh\x1e\xc4f\xacз\xe22\xf0{:\xb9\xec\xa5.\xe0\x03\xaeGf\x1f\xfa\x90_\x12I\xd7\xf8\xed:̯lQ\x7f\x19\xc1\xe9\x83\xdfv\xa3\x15\xe6U[\x0f\xbbҫޑ\x11\xe8\xfe\x1e\x8eu\xc1\x1d\x93}[\x0ew\xfc_\x02B>\xe8aGp\xbf\xbe\xab\xfdG:\xd0Q\x81=\x98$\xf9\x99X\xedaw\xde\u0380C\xc4\xc9_\x03\x00\xb3ή\xf2\xcc\x12\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Zߏ\xdb6\xf2\x7f\xf7_1\xd8>\xb4\x05\"\xbbɷ\xf8\xe2\xe0\xb7ds=\xec]\x9b,\xe2M^\x8a>\x8cőͮD\xf2H\xca\x1b_\xaf\xff\xfba\xf8Ö,َ\x9d\xa0Y\t\xd8\x15\x7f\xcc|8\x9c_\x1cnQ\x14\x134\xf2\x03Y'\xb5\x9a\x03\x1aI\x1f=)\xfer\xd3ǿ\xb9\xa9Գ\xcd\xf3ɣTb\x0e\xb7\xad\xf3\xbayGN\xb7\xb6\xa4\xd7TI%\xbd\xd4jҐG\x81\x1e\xe7\x13\x00TJ{\xe4fǟ\x00\xa5V\xde\xea\xba&[\xacHM\x1f\xdb%-[Y\v\xb2\x81xf\xbd\xf9a\xfa\xfc\xc7\xe9\x0f\x13\x00\x85\r\xcd\xc1h\xb1\xd1u\xdb\xd0\x12\xcb\xc7ָ\xe9\x86j\xb2z*\xf5\xc4\x19*\x99\xf6\xca\xea\xd6\xcca\xdf\x11\xe7&\xbe\x11\xf3\xbd\x16\x1f\x02\x99W\x81L詥\xf3\xff\x1a\xeb\xfdY:\x1fF\x98\xba\xb5X\x0fA\x84N'ժ\xad\xd1\x0e\xba'\x00\xaeԆ\xe6\xf0\x06\x1br\x06K\x12\x13\x80\xb4\xc4\x00\xab\x00\x14\"\b\r\xeb{+\x95'{\xcb\x14\xb2\xb0\n\x10\xe4J+\r\x0f\t\xe8!\x02\x84\x88\x10\x9cG\xdf:pm\xb9\x06t\xf0\x86\x9efw\xea\xde\xea\x95%\x17\xe1\x01\xfc\ued3aG\xbf\x9e\xc34\x0e\x9f\x9a5:J\xbd,\xa29,BGj\xf2[\x06\xed\xbc\x95j5\x06\xe3A6\x04OkR\xe0\xd7\xd2A\xdc\x11xB\xc7p\xac'q\x94q\xe8\xe7\xe9\xceccҰ\x88\xe0\xd6\x12\xee\xa7F\b\x02=\x8d\x01\xd8\xc9\x13t\x05~M,\xf9\xa0X(\x95T\xab\xd0\x14\xb5\x05\xbc\x86%\x05\x88$\xa05#\xc8\f\x95S\xa3\xc5Te\xa2i\f\x7fwX}\xa2lx\xfc\x97F\x95\xba\xf9Ϡ\x03W@\xb9\x88o\x1c\x9c:#\xd7\x0fݦs\x8c\x1f\xd6\x14\xc0e歩5\n\xb2\xcc~\x8dJ\xd4\x04\xec\x1e\xc0[T\xae\"{\x04F\x9e\xf6\xb05}0\xef3\xbdN\xcf%\xc2H\xb6\xb3\xf0\xda\xe2\x8a\xe0g]\x06\a\xc5*m\xa9\xa7\xd3n\xad\xdbZ\xc02s\x01p^\xdbQ\x05\xe7\r\x8b\xb3\x12\xddL\xf6\xc0\xce\xfa<\x8f\xa3\xef\xd0\xce\xfetZ\xb2\x8dH\xad\xc6-\xe8\xe5\x8aƭ'vo\x9e\x87\x0fW\xae\xa9\t\xae\x99\xbf\xb4!\xf5\xf2\xfe\xee\xc3\xff-z\xcd\x00\xc6jC\xd6\xcb\xec>\xe3\xd3\t\x0e\x9dV\xe8\x8b\xfa\xbfE\xaf\x0f\x80\x19\xc4Y 8J\x90\x8b:\x19\xdbH$Lq{\xa4\x03Kƒ#\x15\xe3\x067\xa3\x02\xbd\xfc\x9dJ?= \xbd \xcb\xfe4oT\xa9Ն\xac\aK\xa5^)\xf9\x9f\x1dmǺ\xc7Lk\xf4\xe4<\x04W\xab\xb0\x86\r\xd6-=\x03Tb\xd2#\f\rn\xc1\x12\xf3\x84Vu\xe8\x85\t\xee\x10\xc7/\xda\x12HU\xe99\xac\xbd7n>\x9b\xad\xa4\xcf!\xb3\xd4M\xd3*\xe9\xb73v\aV.[\xaf\xad\x9b\t\xdaP=srU\xa0-\xd7\xd2S\xe9[K34\xb2\b\vQ\xbc|7m\xc476\x05\xd9\xecҏhM|C\xa4\xbb`{8\xf6\x81t\x80\x89T\x94\xc9~\x17\xb2\xefz\xf7\xf7\xc5\x03d$\xd1L\xe2\xa6쇺c\xfb\xc3Ҕ\xaab\x1f\xc0\xf3*\xab\x9b\xa0\x03\xa4\x84\xd1R\xf9\xf0Q֒\x94\a\xd7.\x1b\xe9Y\r\xfeݒ\xf3\xbcu\x87doCZ\xc1>\xb45\xac\xe6\xe2p\xc0\x9d\x82[l\xa8\xbeEG\x7f\xf1^\U0006ee027\xe1\x93v\xab\x9b,\xed\x7f\xe2\xe0(\xdeNGNu\x8el\xedA\xfe\xb20T\xf2Ʋly\xa6\xacd\xf2t\x95\xb6\x80\x87\xe9N_N\xe3\x0e\x80\x9fQ/w8\xe8\x9c\xd2\xf1\xf3j\x8cP\x06\xac:\x0e;{\xe3\xe4\xb0\xeb4t\x84dv\xe1\xbb9\x96\x8cv\xd2k\xbbe\xc2\xd1{\x1f*\xc4ѽ\xe1WiAg\x16\xf7F\v\x1a\x83\xcdS\xc1\xaf1j7'o\xec\xdcZ\xa5\x86\\\xf8\xd5\xea\"`F\x8b3\xb8\x12G\x04K\x15YRl\xb5\xfalf2\xa0\t\xbd\x9ca\x88\U0007899c\n\x19\xa3\x88_\xde\xdf尐\x85\x98\xb0\x0f<\xffY\xf9\xf0[I\xaaE\x88\xa2\xe7y\x8f\xaa(\xbfwU\x14 \xf3`\x01\"\x18I%\xf5\xe2\x12H\xe5<\xa1H\x8d\xec\x0e,\xa5\xbeg\xd1\xe7\x1d\x05\xc9\xef>~y\x94\n\x90}\xb0\x14\xf0\xcf\xc5\xdb7\xb3\x7f\xe8\xb8\x0e\xc0\xb2$Ǆ\xd0SC\xca?\xdb\xe5\xfd\x82\x9c\xb4$8\x8b\xa7i\x83JV\xe4\xfc4Q#\xeb~}\xf1۸\xfc\x00~\xd2\x16\xe8#6\xa6\xa6g \xa3\xccwn=\xab\r+7/|G\x11\x9e\xa4_\a\xa0F\x8b\xb4\xc0\xa7\xb0\x04\x8f\x8f\x04:-\xa1%\xa8\xe5\xe3\x88\xfd\xc4\xf7\x86\xbdR\a\xe6\x1fl=\x7f\xde\xc0wьo\xf8\xf3&\xc2\xd8\x05\xf0\xae\x81\xed\xe1D+\xb3r\xb5\xa2}zv\xf8\xc3ShC\xca\x7f\x0f\xda\xf2Z\x95\xee\x90\b\x84\xd9GDOIb\x00\xef\xd7\x17\xbf\xdd\xc0w\xfb\x19,\x83#\xac\xa4\x12\xf4\x11^\x80Lg$\xa3\xc5\xf7Sx\bz\xb0U\x1e?\xb2\xbf(\xd7ڑ\x02\xad\xea-\xafn\x8d\x1b\x02\xa7\xf9lEu]\xc4TI\xc0\x13nAWG\xf8\xe4-b\xd5D0h}O-\x8fm\xfa\xc3\xdb\xd7o\xe7\x11\x19\xab\xceJ1\x1c\x8e\xa8\x95TXs6\x94\xe2t\xd0;\x06\xdd\x06z\f\xb3\\\xa3Zq\xb2\x13\xb6\xa3j9g\xb9\xca8\x87y\xcaev\x19\xf2\x96O\xf2\x12_-\xe6\x7f\xa2$X\xf5>G\x12\xdd\xc3\xcd\x15\x92\xe0\x1a\x8cU\xe4)\xd4w\x84.\x1d\xe7\xa9%\x19\xeffzCv#\xe9i\xf6\xa4\xed\xa3T\xab\x82\x95\xbe\x88\x0e\xc2\xcd\x18\xb8\x9b}\x13~]\xbb\xf0p\xba\xfe\xdc\xd5\xf7\xaa\x01\x7f\xbd\b\x98\xbb\x9b]#\x81\x9cO\x7fz\x8c<*\x87EJ\xf1\x0ei\xb2\xd1>\xade\xb9Χ\xab\x8eWoPD\xb7\x8fj\xfb\x95l\x87\xe5\xdcZF\xb4-Rq\xb0@%\xf8o'\x9d\xe7\xf6k\x04\xdb\xca\xcfr.\xef\xef^\x7fM\x8bj\xe55\x9e\xe4ȩ!\xbe\x1f\x8b=\xaa\xa2AS\xc4\xd1\xe8u#˃ќ5\xdf\tޤJ\x92\x9dON\xca\xf0]opN\x84G\xf2\xefݘ\xe9\xe4\x82ey\\\x8d$\x96ݺ\xe9\xa9\xf4\xf3\xa4\xbcΫ\xc2\x03\xae\x1c\xa0%@hаF<Ҷ\x88\x99\x8dAiy\xad\xe8s\xfa\xb6$@cjI\"e+#\x14S\x9e\x9dă.\xacoz\xc9V\xe6\xba\u0602\xbc\x97\xea+\n\xe7\xfd\x01\x90/+\xa8\xbcLN\xd1*\xb9jm8\xf3\r%\xa5ں\xc6eMs\xf0\xb6\xa5k\x04\xc9e\xc4\xf9\xe9\xf5\xe7\xa5\xf2Ь\xe1gJ\x9c\xe3\xab\xea\x15>\x87\x8b!\xd56C(\x05<j#q\xa4ݒ\xf3\x03\xeb\xe5\t77\x93\vv;*\xe5\xfc\n\x1dH\xd7\x11\xd2\r\x92\xf3\xa4\xe8預O\xc0\xdd\n\xf4\b\xb9\xb1\xf3\xe5Q\xdc\\ \xe2cO\x1fw\x01˱\xba\xc2\xc1\x18>\x9b\x1f4\x19-\x0eZ\xfan\xf0\xa0\xb3W%?\xa9k|`k\x0f\f\xf0d\xdd&\x8c\xcfj\x16\x83\xa3\xcfW=\xba\xba\xberSj>\xe6\xf5*\xc8\xd7\xec\xf9\xed\x90L\xa8\xb8Z\x91\f\x83\xef\x870G\x00\xbe\x17J\x8c\xc7J/]rq&\x17I\x025\x12\xe1\xb8Ƨ\xc9\neM\"\x91t\x97RYR\xc5\xf5\xd9h\xa4\xb9\xe0\x91\xe0\x1d?(\xf15\x86\v\xf5\xe5oݎf\xebH\x84\xf2و\x10\x86\x11\xbbҶA\x1fK\xf1\x05\x93\xb8\xce{\x8d\xdalC\xce\xe1\xea\x9c\xd1\xfe\x12G\xb180O\x01\\\xea\xd6\xef\nA\xbd\x88\xf4\xadK\x8a6\xbd\x04\x8b\x19-\xb1\xf4\x80p\x15&\xabt\xd5\xd6u\x98\x93\xcb\b\xf90\x1f/\x86\xc3uޒ\x86lr\xf5\xf1H!\xea\x14@\xbe\xf1<\x87\x90ǌY\xddΥ\x9d4\xbbS\xee\xfb\r=\x8d\xb4\x0enj\xf7O\x91\xf5k\xc4K\x16\xf0S\xb0\x86\x8b֟\x18]c\xee\x19$\xacu\x9d-\\{\xacA\xb5͒,\vg\xb9\xf5\xe4\x0e\x1c\x7f,\"\xec$9B\xb83?oj\xa4\x94*%%*\x0e\x16\xc1\xe4\xbc\x06!\x9d\xa9q\xbb[Kȹm3\xf4\xee)\t\xda)y\xb6tC\xc7r\x88\xd3%̀\xe9\xb5V#\n\xd45r\xa9\xfc\xff\xff8:\"*&\xdf9\xad\x0e\xc2H\xeagq\xbe\xda\xfaq\xf6\x9f\xcf\xe1D\x0e\xe4\x14\x1a\xb7\xd6\xfe\xee\xf5\x19\xd5X\xec\x06f\x13\x91\xbb\xc8\xc8\x00\x83\xa43\xb5\xa4\n\x03\x8a\xd0q8\xd3K\xf4\xb7\xff\x8f\x03\xd7h\xf1\xa2G\xe1L\xbcJ\xff\xc70\x84\b\xb0 \x83\x96}B\xb8ú=\xbc\x91}\x06N\xf2\xd9:d\xbb1\xfd\x8d\x05\xb3\xa1\x8dsş\xcf\xea|'\xe1.\x0f@\xfd\x05\xb9\xc91\xa5\xf9\xf2\xb1gT\x9d\x06\x8d!t\x8a\x0e\xedt}\xd3mi\x97\xb9V\xe1\xe6\xf0ǟ\x93\xff\r\x00\x8b\xcb\x17\x16\x81$\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_\x93۶\x11\x7fקع<$\x991\xa5\xc4\xcdt:z\xb3\xcfM\xe7\xdaľ\xb1\xce~\xc9\xe4aE\xacH\xe4H\x00\x05@\xe9\xd44߽\xb3\x00!\x91\"%\x9d\xe4Ɩ4sG`\xb1\xfb\xc3\xfe\xc3b\x99e\xd9\x04\x8d\xfcH\xd6I\xad\xe6\x80Fғ'\xc5On\xfa\xf877\x95z\xb6\xfe~\xf2(\x95\x98\xc3m㼮ߓӍ\xcd\xe9\r\xad\xa4\x92^j5\xa9ɣ@\x8f\xf3\t\x00*\xa5=\xf2\xb0\xe3G\x80\\+ouU\x91\xcd\nR\xd3\xc7fI\xcbFV\x82l`\x9eD\xaf\xbf\x9b~\xff\xc3\xf4\xbb\t\x80\u009a\xe6`\xb4X목ɒ\xf3ڒ\x9b\xae\xa9\"\xab\xa7RO\x9c\xa1\x9c\x99\x17V7f\x0e\xfb\x89\xb8\xb8\x15\x1cA\xdfk\xf11\xf0y\x1f\xf9\x84\xa9J:\xff\xaf\xd1韤\xf3\x81\xc4T\x8d\xc5j\x04G\x98uR\x15M\x85v8?\x01p\xb964\x87\xb7X\x933\x98\x93\x98\x00\xb4\xfb\f\xd02@!\x82氺\xb7Ry\xb2\xb7\xcc\"i,\x03A.\xb7\xd20I\x87\x0f\xe8\x15\xf8\x92Xd\xd0*J%U\x11\x86\xa2\xaa\xc0kX\x12\xb4HX,\x7f\x7fsZݣ/\xe70e\xc5M\x8d\x16S\x95x\xb64\xfcܑԎ\xfa-\xef\xc3y+Uq\f\xd9\xff\x19T;\x1d\xf1\xdck\xf1L$\x0f%\x05\x9a\x84\xa61\x95FA\x965R\xa2\x12\x15\x01;(x\x8bʭ\xc8\x1eA\x91\x96=l\r\xb5$\x11ɇį3s\x89v.QE\xa4m'\xa3\xf8\x8fݡsr\xef\xb5h\x17@\xeb\xd4\xe0<\xfaƁk\xf2\x12\xd0\xc1[\xda\xcc\xeeԽՅ%\xe7F`\x04\xf2\xa9)\xd1\xf5q,\xc2ğ\x8bc\xa5m\x8d~\x0eR\xf9\xbf\xfep\x1c[\xbbh\xea\xb5\xc7\xea\xf5֓\xeb!}8\x1c\x8eZ\xe3`+\xc8~9\xb8KF\xfaF\xab\xbe^_\x1f\x8c\x8e\x81\xed0M\xf9v\x9a[\n\xa9\xf6A\xd6\xe4<֦\xc7\xf5U\xd1\xe7'\xd0ǁ(t\xfd}xpyIuH\xdd\xfc\xa4\r\xa9W\xf7w\x1f\xff\xb2\xe8\r\x03\x18\xab\rY/Sv\x8d\xdf\xce\xe1\xd1\x19\x85\xbef\xff\x9b\xf5\xe6\x00X@\\\x05\x82O\x11r1_\xc41\x12-\xa6\x18<ҁ%cɑ\x8a\xe7\n\x0f\xa3\x02\xbd\xfc\x8dr?=`\xbd ˩\x16\\\xa9\x9b*d\xa45Y\x0f\x96r](\xf9\x9f\x1doǱ\xc8B+\xf4\xe4<\x9b\x8f\xac\xc2\n\xd6X5\xf4\x02P\x89I\x8f1Ը\x05K,\x13\x1a\xd5\xe1\x17\x16\xb8C\x1c?\xb3\xbbK\xb5\xd2s(\xbd7n>\x9b\x15ҧ#5\xd7u\xdd(\xe9\xb73N\x99V.\x1b\xaf\xad\x9b\tZS5s\xb2\xc8\xd0\xe6\xa5\xf4\x94\xfb\xc6\xd2\f\x8d\xcc\xc2F\x14o\xdfMk\xf1\x95m\x0f\xe1\xe4\x85G\"2\xfe\xc2Ax\x81y\xf8d\x04\xe9\x00[VQ'{+\xa4\xfc\xfe\xfe\xef\x8b\aHH\xa2\xa5\xa2Q\xf6\xa4\xee\x98}X\x9bR\xad8C\xf3\xba\x95\xd5u\xf0\x01R\xc2h\xa9|x\xc8+Iʃk\x96\xb5\xf4\xec\x06\xffn\xc8y6\xdd!\xdb\xdbPv\xf09\xd3\x18vsqHp\xa7\xe0\x16k\xaan\xd1\xd1g\xb6\x15[\xc5el\x84gY\xab[L\xed?\x918\xaa\xb73\x91*\xa1#\xa6=\xacn\x16\x86r\xb6,+\x97\x97ʕ\xcccL\xad\xb4\x05\x1cTC}M\x8d\xa7\x00\xfe.1\x7fl\xcc\xc2k\x8b\x05\xfd\xa4#\xcfC\xa2sn\xc7\xdf\xd7c\x8c\x12b\xd59P\xa3D`\x94X\x10T-\xe9\b\xcbMI\x96\xbak,\x19\xed\xa4\xd7vˌ\x99\xc3\xd0]\x8eZ\x87\x7fF\x8b3{\xe3\xb3$\x04\x90\xa5\x15YR9\xa5ts\xaaL\x1a\xf0\x84n\xb50\x84x\xdc\x1e\xa7R\xf3(\xe0W\xf7w)\xfd&\r\xb7\xd0\a\x19\xf6\xacz\xf8\xb7\x92T\x89pZ\x9d\x97=\xea\b\xfc\xbb[E\x10,\x83\xf5\x87`$\xe5\xd4\xcb\xff \x95\xf3\x84\xa2\x1d䰳\xd4ν\x88\xb9\xe5(H\xfe\xed\xcf\t\x8fR\x01r\xae\x93\x02\xfe\xb9x\xf7v\xf6\x0f\x1d\xf7\x01\x98\xe7\xe4\x98\x11z\xaaI\xf9\x17\xbb\x92@\x90\x93\x96\x04\xd7E4\xadQ\xc9\x159?m\xb9\x91u\xbf\xbc\xfcu\\\x7f\x00?j\v\U00104d69\xe8\x05Ȩ\xf3]\xfaL^Þ\xcf\x1b\xdfq\x84\x8d\xf4e\x00j\xb4h7\xb8\t[\xf0\xf8H\xa0\xdb-4\x04\x95|\xa4q\xcb\x03\xdcp\xf0w`\xfeΡ\xf5\xc7\r|\x13\x83\xe5\x86\x1fo\"\x8c\xddAٍ\xbe=\x1c_\xa2\aoeQо\xa2=\xfc\xf0\x12Z\x93\xf2߂\xb6\xbcW\xa5;,\x02c\x8eĘ\x90H\f\xe0\xfd\xf2\xf2\xd7\x1b\xf8f\xbf\x82upD\x94T\x82\x9e\xe0%H\x15uc\xb4\xf8v\n\x0f\xfc\xaf\xdb*\x8fO\x1c\xf3y\xa9\x1d)Ъ\xda\xf2\xeeJ\\\x138]\x13l\xa8\xaa\xb2X\x92\b\xd8\xe0\x16\xf4ꈜd\"vM\x04\x83\xd6\xf7\xdc\xf2\x98\xd1\x1f\u07bdy7\x8f\xc8\xd8u\n\xc5p\xf8\xe4ZI\x85\x15W\x1d\xedy\x18\xfc\x8eA7\x81\x1f\xc3\xccKT\x05\x17\x15\xc1\x1c\xab\x86k\x83\xab\x82sX\x0f\\\x16\x97\xa1>xV\x96\xf8bg\xeb35\xc1\xae\xf7)\x9a\xe8^\xf1\xae\xd0\x04\xf7B\xac\"O\xa1\xcf\"t\xee\xb8\x1e\xcc\xc9x7\xd3k\xb2kI\x9b\xd9F\xdbG\xa9\x8a\x8c\x9d>\x8b\t\xc2\xcd\x18\xb8\x9b}\x15\xfe\\\xbb\xf1p\xd3\xff\xd4\xdd\xf7\x1a\x13\x9f_\x05,\xddͮ\xd1@\xaa[\x9f\x7fF\x1e\xd5â\xad\xa4\x0eyr\xd0nJ\x99\x97\xe9\x16\xd3\xc9\xea5\x8a\x98\xf6Qm\xbfP찞\x1bˈ\xb6Yۤ\xcbP\t\xfe\xdfI\xe7y\xfc\x1a\xc56\xf2\x93\x92ˇ\xbb7_2\xa2\x1ayM&9R\x9d\xc7\xdfS\xb6G\x95\xd5h\xb2H\x8d^\xd72?\xa0\xe6\xda\xf4N\xb0\x91V\x92\xec|rR\x87\xef{ĩJ\x1e\xa9rw4\xd3\xc9\x05\xdbr\n\x8d+\xb5\xbf{s\x06\xc7bG\x980\xecm\xd8\x16\xb7\x89\xd7A\a\xec2<!\xb6vI\xe7\x1c\xa8>uB\xa6\xad,\xc2Q\xbbK\x1f\xdc\xc1\xe1\x86\tv;\x9f\xddO\x8d\xc6HU\\\x8455\x12\x17\xe4\xbdT\xc5H\x81\xdem\x01\x9f*\xe3O\byNH}8\x00\x02h\t\x10j4l\xa1G\xdaf\xb1Z4(-k\b}*\x89\x97\x04hL%I\xb4\x15\xe0\b\xf7\xb4M\xae\xe6V\xb2hl\xb8\x84\r5\xa5\x9a\xaa\xc2eEs\xf0\xb6\xa1K\xc2'I\xe0\xbe\xeb\xfc\xf4\xfe\xd3V\x994\x99\xfbLOx|W\xbdN\xf1p3\xa4\x9az\b%\x83Gm$\x8e\x8c\xf3\x05n\x10\xe8\xbc\xe0\xe6fr\x81\xb5c$\x9d\xd1A\xdb\xc0\x94nP\xb2\xb7\x81\xd8^\x1fX\x1f|I\r\xe18`\t\xd7\x04(wg\xf8.\xd4G\x98\xc1r\xecJ\x7f@c\xb48\x18\xe9'\u0083\xc9}f:\x9c\xe8\a\xfd\xc1l\xaf\xb1~\xd2\xf3\xf8\xa6\xd7\x1c\x84\xe3\xe9\xc6JX\x90\xbc.\x1e\xab>\xf5\x8f\xf5\xea\x13Z+\xb9\xe6\x1bb\xaf\xc9{\xc6\aF\xf3\xc0\xed\x90Mh\x8aZ\xd1\x06\x8a\xac9/\xb4v\x87\r\xba$y\xcc\t\xba\xfc\xe2\xd2Х͵\x15$\xc2U\x8fo\xa2+\x94\x15\x89\xc4s\xd0\n\xe4\x1f\xbf\xb7q\xa1e\xfb\xb5\xdb1j\x1c\x89\x90\x95G@\x0f\x0f\xe7Ԁ\xe7\xb6_\xc6,\xae\xcb>\xa31W\x93sX\x9c\v\xba\x9f#\x15[\x1f\xd3\x12\xc0\xa5n\xfc\xae\xe5\xd3F_\xab\x8a\xaf]\xeb\x1a\xd3K\xc0\x84\xd71g\xa0\xdc3͘\x1b\xee\xf2\xc0i?<\x95\xdf\xde\xd2fdt\xf0Bd\xff͒\x97\x8c4\x062\xf81x\xc7E\nh\x05]\xe3\xff\t$\x94\xbaJ.ϯ\x88@5\xf5\x92,k'\xbc\x9aIj\xda\x15,\xf1J\xbeS\xe6\b\xeb=\x87ּ\"\xb2j\xdb\x0e9*n\xe3\x05\xa7\xf6\x1a\x84t\xa6\xc2\xedn3\xa1\x80\xb5\xf50+\xb6e\xc2\u038dZ\xe6\xc0\xc5\u0091c\xf6tCp\xf7\xeailr\xfcEV\xff3|+\xd5\xff\xec_\xc5\xfd9\x12N\x94\tΣ\xf5\xbb$q\x8d\x83,z\x1c\xce\xe5\xc6 \x8f\xc4\xe5)\xad/\xe6sf\xb3Q\xed\r\x06\x03r\xd1\xe1\xddvػ#\xcd2]t\xdd\x1c~\xffc\xf2\xbf\x01\x00\xc5p\x17\xe3F\"\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc}[o\xe4\xb8r\xff{\x7f\n\xc2\xff\x87\xfd\ap\xf7\x9cE\xceC\xd0X\f0\x99K\xc69gg\f{2\xfb\x10\xe4\x81-UwsM\x91\x1a\x92\xb2\xa77'\xdf=(\xde$uS\x12վ\xecn,\x03\xbb\xa3K\x91\xfcU\xb1n,\xd2\xcb\xe5rAk\xf6\x15\x94fR\xac\t\xad\x19|7 \xf0_zu\xf7/z\xc5\xe4\xab\xfb\x1f\x17wL\x94k\xf2\xb6\xd1FV7\xa0e\xa3\nx\a[&\x98aR,*0\xb4\xa4\x86\xae\x17\x84P!\xa4\xa1x[\xe3?\t)\xa40Jr\x0ej\xb9\x03\xb1\xbak6\xb0i\x18/AY\xe2\xa1\xe9\xfb\xbf\xac~\xfc\xeb\xea/\vB\x04\xad`M\x14h#\x15\xe8\xd5=pPr\xc5\xe4B\xd7P ͝\x92M\xbd&\xed\x03\xf7\x8do\xcf\xf5\xf5\xc6}n\xefp\xa6\xcdߺw\xffδ\xb1Oj\xde(\xca\xdb\xc6\xecM\xcdĮ\xe1T\xc5\xdb\vBt!kX\x93O\xb4\x02]\xd3\x02\xca\x05!\xbe\xeb\xb6٥\xef\xf5\xfd\x8f\x8eD\xb1\x87\xca\u0081\xff\x925\x887\xd7W_\xff\xf9\xb6w\x9b\x90\x12t\xa1X\x8d`\xad\xc9?\x96\xf1>\t\x1d%L\x13J\xbeځbo,\xf0\xc4\xec\xa9!\nj\x05\x1a\x84\xd1\xc4\xec\x81к欰\xb8\x13\xb9\xedP\n_i\xb2U\xb2j\xa9mhq\xd7\xd4\xc4HB\x89\xa1j\a\x86\xfc\xadـ\x12`@\x93\x827ڀZEB\xb5\x925(\xc3\x02\xca\xee\xea\xc8N\xe7\xee\xd8\xc0\xf0B,\xdcW\xa4D!\x027\x04\x8f'\x94\x1e>\"\xb7\xc4\xec\x99n\x87\x1a\x86G\xa8 r\xf3+\x14\xa6\xed\xa0\xbbnA!\x19\xa2\xf7\xb2\xe1%\xca\xde=(\x04\xab\x90;\xc1~\x8b\xb45\x0e\x1c\x1b\xe5Ԁ6\x84\t\x03JPN\xee)o\xe0\x92PQ\x1eQ\xae\xe8\x81(\xc06I#:\xf4\xec\a\xfa\xb8\x1f?[扭\\\x93\xbd1\xb5^\xbfz\xb5c&̨BVU#\x989\xbc\xb2\x93\x83m\x1a#\x95~U\xc2=\xf0W\x9a\xed\x96T\x15{f\xa00\x8d\x82W\xb4fK;\x10\x81\xc3\u05eb\xaa\xfc\x7f\x91\xa9\xbdf\xcd\x01eT\x1b\xc5Į\xf3\xc0N\x88\x19\xec\xc1\xa9\xe2\x04ϑr\x98\xb4\\`bg\xf9u\xf3\xfe\xf6KW(\x99\xf6Li_\xd5C\xfcA4\x99\u0602r\x1c\xb6\xa2\x894A\x94\xb5d\xc2\xd8\x06\n\xce@\x18\xa2\x9bM\xc5\f\x8a\xc1\xb7\x064ʻ<&\xfb\xd6j\x1d\xb2\x01\xd2\xd4%5P\x1e\xbfp%\xc8[Z\x01\x7fK5\xbc0\xaf\x90+z\x89L\xc8\xe2VW\x97\xb6?Hd\xed\xe1\xed<\b\x1aq\x80\xb5^\x8b\xdc\xd6P\xf4f\x1a~ƶA]l\xa5\xea)\x19T<}\x8cғ\x1f/\xa7EP-\x1e?\x99\x922\xbc\xfe5~\x8d\xf2\x86,o\x04\xfbրU\xa6n\xfaé\xbej\xb5\xf2\xf1\x0f\x8a\xd11w\a\x81\xc6\xdfR\x1dn\x1aqN\xd7\xdf\xd9/\x03\x92\xa0\xc9\xc3\x1e\xcc\x1e\xe5Y\x12)8\xea\x8aZ*C\x1ePW\xe30|\xafɃUL\xa5L\xd0|`f/\x1bC\n\x05\xd4\xce2\xa9\x9c<\xe3\xffSqh'\x9bT\x9e^xr/yS\x01A\xc19\x05@4\x9c\xd3\r\x8751\xaa9\xc5\xcd᳑\x92\x03\x15GO\xe1{\xc1\x9b\x12\xcah\xf8\xf49`\xbd?\xa1\x82\x9a\xd9P&PˠyFf\x8b\xf6\xa9\xb5pT\x01\x11\xd2$\xe81\xe1\xe8\x11&\xba؞\x8e\x9c\x19\xa8\x12=\x1e\x95\x89L\xbc\xa8R\xf40\x80Vp\x91\x1e\x05V$\xe2u1g\xc8\xf8m+\x04\x16\xaf?/TL\xa3\x8c\x87Q^KΊ\xc3\x04^\xef\x93\x1fu&ag\x84d\x03{zϤ:!I\xac\xc6\xc3W;\x0eOD\xd5H\xb2\x89D\xca\xf3\x06\x9c\x04+=\xe2\xcf\xf7\xa0\x14+S\xa2B\xcbҺה_\x0f\xea\xdf\x13\x88\x1c\xd5/\x87\x1a\xc8\x1ex\xad=8\a;O\xd2\xf8\xcd\xe5y\x8e\b\x8f\x0e\x95\xc8\xf8\x7f\xe97\x91A\t\xb2\x9e\xcfE\x9c\x02zE\xbe\xec\x81\xdc\xc1A\xdb)\x10\x99h\xa7\xc6%\x91\xb6\x93\x94\xf3\x03\xf9\xd6P\x8e\x8a\xfa\x94\xa3\x84l,:L\xb9\xc0\xe2\x92\xc0j\xb7\"\x17\x85\x14[\xb6\xabh\xad/\x88T\xe4\xe2W\xb9ѫ\r5\xc5\xfebu\x9eX\x9c\x98o\xfc\xddKy\xa7\xd7\xe3 \x7f\xc4wZ\xaf\x8a\x146\x10\x8b\x12\xee\xf5\xa5\xf7y7@\xe0;\x14\x8dI\x8e\xb5l\x94\xb7,\xb5\xd4fX\x1d\f\x9b\xfc^P\x91z8\xa2K\xf2ħ\x17\x02\x05\xc9@\fz\x8e\x8c\x14\x80èp\xae\xb7\xef*ٸw\aA!\x1b\xaa\xa1$R,\x92\xcd\"\xb7P\x8b4\x1c\xb4o\xabDy옧\xcbv\xfc6R \x9cn\x80\x13\r\x1c\n#\xd5)\x989\x90\xe6\xdb\xdb\x01(\x13F\xb6\xaf\x18\xdb\x01\x8c\x90$\xe8\xc0<\xecY\xb1w\x9e9\x8a\xa7\xd5!\xa4\x94\xa0\xd1\x1e\xdbP\xf304\xc8I\xf6g(\x98\xeci\x95chN\xb1\r\x125\x1f\xda\xf8\xe5\xa9\xc9\xf1\xf7\x8d\\\f\x92$\xe4\xff(\xb0L\x1cK^6\xb2#\xf3\x1f\x7f\xafN(\x0f\xca\xf4\xa0ܢ\xb82\xd0+r\xb5%P\xd5\xe6pI\x98\twG[\xc7\xdc\b\xe7\x9d6\xfeļ\x99/\xf4\x99\xacə\x13\xcfĘ\xd8ğ\x90/\xd6d\xdcz\x8b\x91͓\xbfw\xbf\xba$l\x1bA//ɖq\x03\xea\b\xfd\xb3T}\xe0\xccS\x80\x91c\xf5\xf0\xaaгz\xff\x1d\x93\x9a1\xabJH&.\xc7\x1f\x13\xd6\r,\xfb\xe6y\x82.:7\xdf\x1a\xa6\xa0\xc2ܪs0\xbbw\xac\xa3\xf9\xe6ӻ\xd3\x1c\xd3\x19\x927w\xd2\xf9\xfc\xe9ш\xba\xfd\xf3\xc1bxb}\xa0\x18k\xdbD\x9e\xbe$\x14]f\xe7\xba`&\xb5\x06E\xc3\xcb\x19\xcd+\xb0IS\xab\x7f\xef\xe0`ɤ\xb3\xa0\xe7K\x83\xcf\\B\"\"\x9c\xc4\x10\xfb\xe4\xd3I\x0e'\xbcaB\x1e&[\f|\x10\xe6\xa6B\"\xe7\xf8(]\x12\xae\x80\xfd\x19\xc3\xcc\x12\x95n\x1bm\x00\x81\"r\a\x87\x1f0\xa7\xcam\x12P\xef\x99_\v\xd0`\xe7L.C\xdd\xf5\x95rVƆ\\0v%.\xc9'i\xf0?6\xca\xd3VP\xdeIП\xa4\xb1w\x9e\x05Q\xd7\xf1\xe7\xc4ӵ`'\x9apZ\x1e\x01\xeb\xe6ʝM\xc3\xf9\x11\xb1g\x9a\\\t\x8cW\x1c$\x99M!\tߜk\xa8j\xb4\xc1\xfc\x84\x90bimf\xb2%\x8f\xb7T=\xb8\x1fݨo\xf0\v\x9aq\xd7\x1d\xb78\xc3qA,D\x96vՀ\x1aر\"\xb3\xbd\n\xd4\x0eH\x8d*<O\"2\x15\xebY\xe2\x93g\xbdÏW\xbcG\xcb+\xa9k\x89*7\xe3\xad\xc0\xc6\xc9WGR\n\xe7\x8e\xc8ZQ\xebbL\xa2\x9b\x9b\x9b:\x9b\x17s\xa7f\xa7\xefvf\x92\x8a\xd68-\xff\x1b-\x9d\x95\xe6\xff!5eJ\xaf\xc8\x1b\xbb\xc2ˡ\xf7̧G;d2\x9a\xac\xb1)\x14\x81{\xcaq\xa5\n\x15\xa8 \xc0\xad\xa7\x80\xad\x1f\xfb%\x97\xe4a/\xb5\xcdX\x91-\x03^\"\x81\x8b;8\\\\b\xf3\x93Mv'\xf9ŕ\xb8p6\xfcd\xc2F\x83oW\".쳋Ǹ2\x99\u0096\xf9\xda\xf7\xe5]L\xbc.+Z/\xbd\x80\x1aY\x8d(\r\x91\\d\x1a\x90\x98\xee\x9aR\xbb\x98\xe4\x9d\xdc\xd5\xe2\x91\"\x8a\xa9\xb3\x8f\xe9\xbc\xdd@\x7f\xae\xc3\x17}\xcf4\x91㚌||\x1e+\xea[Q\x12\xba5\xd0[\x11\x8a\xfe\xffj\xf1(5\xda\x1bC\xa2\xb31\x19GC&\xd1\x02<J\x93\xf8\x05ǜ.\xceq\x18\x11\x97\xa9w\x8eF\xf4\xfe{'\x9fH\x85M\x11\xf6\x06\xf2\xd4\x0e-.&\xd3\xe3\xd5\xf8\xac\xae\xbeu_\x06\x99\xf6\x84\xec\xf4\xa7jנ\xc2ы\f\xa2}\x19\xc2\x05S\xbb\xec\xc8\x04\xa1aM\x0e\x94\x17(JjY.&\xa8\xf9kO5\xd9\x00\x88\x00_\xf9G0\xe5\x15\x13W\xb6\x01\xf2c\xd6\xfb\xb9\x8620\xd3\xc3\xf5\x9c\xce\xe6\xdbȓ\xc8\xf9xÙ\xacZ\x96\xb8\xf8\xac\xa0'\x18\xa7yo\xeb)b\xfe\xb6M\x19d\xf6\xc1\xb7\xf2\x83&[\xa6t\x8c']\x9f\x1a\x9d\xcb\xeb\x99\xec\xc3~\x7fa\x15\xc8\xc6<'\xc0\xef\xdbf\xa2*\xc0\x01W\xf4;\xab\x9a\x8a\xd0J6\u0086D\x86U\xb1\x1a\xc1\xc3\xfb@\x99\x89\xab\x89\xa8\xf9pr\x15\xb2\xaa9\x18 \x1bئ\xeb\x14R?\x85\x14\x9a\x95\xa0Bu\r\x0e\xbfA\x17\x8bP\xb2\xa5\x8c7\xa9U\x9a'\x80Y\x8a\xf7J\x9d\x15\x80~v_FyB\xe3\xfa\xd0\a(\x8b(q\vY\x80\xe9,f\b\x88\x02\x11\xc7L\x16\xaadۄ\a\xc3B\xc3r\xf5\\\x9e\x02\xc7\vDS\xe5\x01\xb0\xb4\x13\x92\x89єW{-\xc9\a\xca\xf8s\xb0\r%\xef\x83T7@\xcbsr$\xbft>' t\xa3@G\xdd\xf1\xc0x^\x9f\x91s\x84\xd3F\x14{\xb0JH\xf4u\x83#τ6@seAn\xc9M#\x04\x13\xbb<\xdee'\"\xf3\n^R?\x88\xb5W\x11ϩ\x89~i\x9by\xa4&j\x99\xe0\xaa\x19,\x1f2{\xe1\x94\x16\xa1\xc6`\xb8o\xb5\x91$\xaa\x11]\xeb\xb2zz\x89\x9e\x13I\xfb^L\xbe\x99\x19\x8e\xe0/V2\xaf\x17\xb3\xf8z%X\xcb'*,\x89gu\x1e\xb1\x81\xe8\x0e\xe83$\xf1\xaaG\x00'h\x88C\x90t;ug8\x92\x1b \xb4,\xa1D\xbbg\xdd\xc5\x10\x96\xb8\x82́\xe2\x82'\xf2\x04\xb38\x9b\f:q\x95\x01+Q\x97\x8d\xb8\x13\xf2A,m0\xaeg\xeb\x90\\W\xf1\x89\x9b7g+\xa3i\xfd\x92E\x93\xe4h\xa1\xbe\xbcf\xd2\xed\xf8OϠe\xb2\xe5&\xf3\xc5i)\x98\xd2kn\xe3\xc0\xe2\xcc^\x8c\xb5?\xf2\xb1_\x14~\xeb\x8a\xfcC@\x9f\x98}ӆ\xec*M*Q\x18\xeb\xb7\x14,\xedV\x8a2\x86\xff)\xc1\xf0Ҵ\x81\xb6|\x11\x85*\xb8\xc8v\xc5⸠\xd1F7\r痨\x93iÓ\xe10\x16\xfd\xab&\xa1\x91\x1eQ\"\x1b\xbax\x95V`\xd9\x10:\x02GŞ8B\xab\x19;\x85ϗ\x04h\xb1\x0f\xe3\xdfJU%\x97\xed~\n\b\xbf\xfe\xcf\xd5O\xb6\xb2\xed\xf5\x7f\xbd\xfa)\x163\xbcv\xff\xff\xfa\x12\x17%\x86\xdf}\x9d\xa0\xbc\x95'ܴ}\xec\xae\xe4S\xce;\x9d\xb7y\xd0\x10\xd9H\x14\x87\x14Y\xbf~\xed+\xf9\x06\xb2\b\x83vbT\x11d\xf175\x8f\xd8I\x05\xcacXܩc\xe9\xf39\xb2%T\xf5\xcaв\x9f\xc1)i\xc6\xec\xcd1\xe6\x91\x10.Ѵ\xf3\xe7\x0f\x83\xe3S(\x9bN\x1dV\x1f\xc5\xe3\xd2\xe8\bb\x82VB}t`\f\x94t\x98f~\xfb\xc1\x1f\vS\x03\xd5\xe7\xda\xebCo\xd9ς5A\xa7\xa3\xc0q\xf8\xd6\xd6c\xaa\aA\x8dV\xdeg\x84\xaf\fTo\n\xfcدB\xe2RG\xa2\x1d\\\x7f\xf0\xca\xd9\xef)b\x9a\xfc\x95\xece\x93\xa8\x99\x1c\x81l\xa2vfz\xc0\xbd2\x1a\xa7qq\xdb\xcd\xfd\x8f\xab\xfe\x13#\xbdR\xb29\xd2\x04!\x1b\xf2\xb6yw&Jv\xcfʆ\xf20k\u06ddMN\x80Z9KP\xc3\"S\xc6\xdd<\x0e\xdf\xf7\x04\x8e|\xf6\x05ͫ\xb9B4\x1ei\x1c/S\xa5\xde9\xc2uN\xc5Mo\xd1\xe9\xb4\xeb\xadp\xccY\x9c\x1a\x9cky\"\xf0;V\xd2̯\x9fɉ\x13'jez\x88\xe4U\xc8d\x96\xe2\ruzb\x12\x9f.jfw\xff\x1f\xcbE\xd6\"\xe9S\u05fb<}\x95K\x16>\xd3\x15-s\xd0y\xf6\xea\x95\x17\xacYy\x99J\x95\xcc\xfa\x94Q\x854\x83\xddc\x16?\xfcLG\x95\xc3\xd5&\x935&\x8f\x8a:{\x95\x18\xeb\xc5ckG&\x11\xcb\x13\xfdN\x9f\x9e\xb7:\xe4\xc5jB^\xb6\x12dT$F\x1f\xf6\xf2^\x13\xb5\x1e1v\xf9\x99\xd65\x13\xbb\xf5\xe2\\\xd1\x19\x15\x9bi\x91\xf9tԑ\x9e\xcctC\x8c6bKP\xc1d\x83;X\xe1\xe8\xddN,\x8f\a\x0f\xc8\x15y#\x0e\x9en\x82N\xfc\xdam\xbf\t\xde`+\x94\xb5]\xb1\xe9n[\xb4d\xc7I\xf9\xe4\x82\xc6\xe2\x18la5\x87\xafR\xf5\x1ce\xbd>\x03\xe4\xcfG4\xba\xf9\xe8\x97\xf4ƫ\x86\x1bVs\xc0l\xfc=+\x93\xbb\xe6\xcc\x1e\x0e\x11\xe4_\xa5\xdd\x13\xe6v\r\x92\xcf7Q\x9f\xae\x8e\x02\v\xaa\xc9\x03pN\xa8\xce\x19~\xe1\xce0(\xe4\xd2\xee\x14E\xf6\x06!\xf1'\x1f\\\xba\x9d\xe5v\xe3\x9b\xe5^\x95\xa0[P\x81\x92\x80\xb1\xda\"\xdbDMs+\xe1+\xdbI\xe1\xee}k@\x1d\xec\xb6\xce֣\x8a!tP7\xba\xe1\xad\x02\xf4\xcaxh\x19\xe7$\xbch\x15\x14y#\x9c}?\xee\x8f\xfd\x06t7|Bu\x8e\x91Q\xb2\x8d\x81υ\x8c_/\xe6\xbb\xe2\xc7\x1dO\xbfu\x84\xf8\x93\aS\xf3éI\xff%GD~Ǡ\xea\xbcm\t9\x81U\xc66\x84\x1e6O\x18\\M\x85W\x13\x86\xae\xbd\x02\x863\x861\xca\xe2g\r\xb3\x9eg;A&R9\xdb\a\xe6\xe1\xf4\xec\x01\u05cb\x86\\/\x15t\xcd\xd8\x160\xa1\xb8f\xb1\x7f*\xb8\xc9\v\xbf\xa6\xca\xfd3\xca\xfcG\x9d꼞v\xec\xecPGs\xfd\xe9l\fs\xa7Ƌ\x05d/Z\xa6\xff\xb2A٤\x90L<\x9e\x13\x9a=b\x95\"\x14;|\x92%\\Ke\x12\x02֓\x9a\xeb\xe3\xf7\x13kɝ\x00J\xf2\x92\x88\xf0\xea\te\xb7H\x16\xdc\xfd\xf3\x06\x95^\xf6U`\x8fi\x82N\xd5\xd6z1\x7f:ܜ\x92\xe9\x8c\x17\v*\xb9\x14\xbbު\v%%`}i\xbb\x86N\x92\xc1\x9e\x8d\a+y\x0fe\x1b\xf7\xf8e[\x7f\xc6I#\f\xe3\xb6Tg\xcb\x04\xe5\xec7,\xba\xb4\xa5\x98\xaa\x11\x97\xc3\xf5\xab\n\x96\xf1\x8c*\x86g\\AXL\xb3\xb7\xb1Z\xd8\x1e\xe3\xe2}\x1ck|~\x03%/\xfd\x99\x8d#$\xbd\x03\x17G\xa6\xd8no|=\xba\x1d6\x1a\x11\x96\xb0\xf5#\xca)\x10\xfbY\x96X\xf7\xac&\xf8ts\xf4z\x87\x1fn\x90[P ,\xea\xe4\xdfo?\x7f\x8al8!Kܮ689\x8b\xc4\x01S\xfaD\x80_i\xf4\x95w.\xe8\xb3Y\xea\xd9\x02;\xee\xcfҚ\xfd\x1b\xae\xed\xa7\x9e\xe5Ȫ?\xbb\xd1\xd2\b.\xae-,\x88%?a0d\x03ȧ\bՠ\x06\xbb\xda\xf6(\xf6\xcbӻg\xd5A\xe9\xce%\fΆ7\x00\x05\x86\xc7o\xae\xaf\xdc\xd1=C\xad|\xc0Y#\x0e\xae\xf2\x00K\x88U\xb9\xac\xa92\a\xab\xb6\xf4e\xaf\x0f\xc1\xb8\xaf\x16g\xd8\xc0ӳ\x16\x93\xf0\x86#\x16q\x80H\xb1\xb7x}\x8c\xdd9\xfd\x18\xde,5\xb9M\xea\t\xfb\x11\xa0<\xed\xc9\xd2\"\xb5Ȭ\x86z\xb2\f\xa37\x1a\xd7_\xf5y\xba:|=n\x920\x01\x11\xb2t\t2\xd7_}\"J\vZ\xeb\xbd4sg\xf9\xb8Y\xb2}\xb85\xd44\x8f\x19\xa4#\xd0\x1b'+\xf6QH1\xb3\x15\xf4Y\x186\n\xb3\xb6\x9f%\xc8\xda\nGk\b\xec\x12\xb7\x90/\xbb\u009dy\xf6\xcf٧\xfe8x\x924\xf1\\G<EF\x9a\x04Ri%3\x1a\xd1L\xcc\xfcI\xa0ƽ\xb5\xccZ\x9d<YJ\xd7\xecL\xa1\xe8\xf0\xcaŊ$\x8f\x8f\xc9<\"\xe6w\x05zD\xab\xe1\x01\xc8e\xc3\xe1܃Uo;\xdfO\x1f\xad\x1aZ\xeb谱j\xb3\xc0\xbf҅7\xfdC\\='<\xe5.'\aHڎT\xee,\xba\x02\xe31\xdd\x14\x05h\xbdm\xb8wۉs\f\xa3\x17\xcbt\xec\xf1j1\x83iM\xcd%-A\xbd\xb5\a\xfbM\xc0\xfa\x1f\xbd\x97\x8fd\xd6\x1d\r\xd8\xf8BԎ\xf3\x93.w\x7f\x94檩\xa2\x9c\x03\xff\xc08\xe8w\xf2A`\xbfR/\x1e\r\xe0:\xf5]\x90\x85B\x8a\xa2Q\xe8^\x1c\x88h\xaa\r:\xb9`̐\xa0\xbb-\xbb\x83\xe3kq\xc7c\xb4w\xc9\xe2\xce\a\xc5\f\xdc\xd6Ti\xb0#\xc9\x18\xc1/G\x9f`\xe7)\xd9rj\xc3!\xac\xb5*\xa8\x81h\x80m\vI\xaa\x04\xab\xb8\xac\xfaFZ\xfc\x80y5!\xcd\xeaq\x93:m\x7fG\xa6\xf5\xc0\x03\x9d0\xd5=\x1c\xfa\x16\xb9\xa05\x9e\n\xee\xf9h\x99h\xbc\x82D/\xf2\xf8 \xe7E\x9e\xa4\xf9\x9a{_\xff\xa7\r\xad\x12Q´\xdey{Jƞ\xbd\xae\xcaN\x19ag\xae\xf8<\x18V\x0e>P\x1d+\xff\xcb\xd5(m\xb7\xffɺ\xea\x85T\xb8\xfb\x04\xeeA\x10\x9c\x8a\x94q\x88\x1eI\x8a\n&YlzA\xfd\xa0#\x1d\\,\xb3\"~k\xa82\xb1\xeb\xa7\xe9\x04Wl\xbd\xc6s\x96a\x89_/f\x8aψz\xb2;\x1d\xf59\xa8\xdbm\x98>\x8fV\x84=bh\xfd,IR\x81\xd6t\x17\x82\xd0\aP@v 0S\x15Ӳ\t\xa2\xed\xfeS\xb9\xed\xb2\xcc\xe5\xa9hap]\xd56\xe0\xf2\xf3q\xe1\xd9K\xb8\xbdAw\tu1\xa6*\xfcN\xd7\x1b\xa0Z\x8a\t,>t\xdf\xf5\xf9u\xdb!\xbf\xacD-[Q\xda\xf04\xf6\xb6\xa8\xfc\x84*.\xb3X\xd1Y\xcd\xe1\x17n/\xcdr\xb3?\xc6\x17\xdb\xcc\x1f\x13N\x94\x10_\xba\xc1z\xdb\xd6\xcf\xf1\x80\x9f\x10\xf5gŮ\xe6\xcaܸ}\xb14߸\xdd~C\x19\xedi\x11\xc4\xebc\x8fR05F\x1aʃ\x91A\xb9\x8c/ؖ\ah݆\x13\xea9?\\\x1eS\xee,8a\v-\xed}{\xee\xab\xd7\x04\xedY\a\x03\r\x85\x04m\x92H\xd8:\xdf\xf1I\xf8\xe1<\xfbg\xa9\xa2\xc4fa\xfc\xb1}{\bGK\xd0;\xcc ґf8T>Ό3\xba>h\xce\b\xa9\xf7TO\xb9\xa7\xd7\xf8N\x18C\xd7\\E'ԛ\xb7Eަ\xec%\xf9\x04\x0f\x89\xbb\x0eZ\xbbphgU\xe2\x95+q\xad\xe4\x0e\xd7\xd8\x13\x0f1\x8d\xcb\xc4\xee\x83T\u05fc\xd91\x11\xeb\xe1\xe7\xbd|M\x95ax\xe0\xb4\xebO\xe2[oƒϦ\xbf\x1e~\xe0\x12\xb8)]\xde}8\xd5\u0088\xbe\xab=x\xeb\xc5|\xf5\x10\x80\x9fR\x80^C\xff\xa0\xfd\xacŧ\xa1\xdd\x15\x9e&\x97\x9a\xc6~m\x9d\xf5\x892<\x8fD\x9b%l\xb7\xf8w\x18\xecR\xcbr\x89g\fx\a\t5\x84\r:\xed\x9fX\xc0mV\t\xdaq\xd5\xd2\xf7\xccz\t\xf8\a\x17\x94\xb5:\xf6(ي\x1e\\F\x92\x16\x05\xc6\x04\xf0J\x1b\xca\xe1\x89\xf5\xb4\rU\xfd\\\xc9Q!W\xdd\xf7\xc3\x04lՇ\xdfT\x86\xd0ل\xbf3\xe8<\x95\x0e\xc0\xabw\xb4\vђliJ\xcbM)\x13\xb4\xb4\x86\xf2\x81\xadry\xb2\x84חHeH=\xc6Ms\x9d\xaa8\xbf6\xed_B\xb6\x15{*v)\x99\xc2\xcb\xec\x95lv\xfb \x9bC\x0e\x11)\x1bl\x9e\xd4VoxL\x15\x98F\x89\xce\xfa\xaa/O9\x9dq\x1d\xee\x8e\xc7ߏPԞho\x9fOkO\u05cb\xf9L\xb8\x19\xa58i\xfb\x13\x14\xa9>\x88\xa2K\xf7dG\x91_e`#\x1b\xcb\xc7\x10J\x82\x10\xb5\xf1\x93\x81\x10)\x0e\x81\xd0\xf5%ڈ\xe7\x0f\x83Ȑ\x8fr&\x1c\xe3N\x8ce\xfa8\xa9\xe9Aw\x9d\xa0\xbe\xbb3\x0f\x0e\xdd\v\xfe\xceA\xa0\x1f>Ή|m\xdbP\xfe\xb9\"\xd6\xfb\xe8m\xbd?;vm=\xb6n\x14\x1bwtb\x14\xdb6\x13\xe2\xcd\xff϶\x8b\x13J\xe1O\xe0m8\xfc\xd3\";\xd1;2\xbcLhR\xc9\xdd\a\xaa\xf0\x04\x9b\xb3\x10\xf9\xc5\x7f\x9b\x88\xe7=\xd9\xe7\x8c\xe8Cϟ,\xa6O\x9a\xa5\x93\x9bV\xc0\xcb\x0eξ\xa551\xaa\x81\xc5\xff\x0e\x00ߌ8c\xa8r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=ks㸑\xdf\xf5+P\xbe\x0f\x93\xa4,\xcdN]\xea\xea\xca\u07fc\xf6\xecE\xc9\xec\x8c\xcfvf\xeb\xeeS \xb2eaM\x02\f\x00JV\x1e\xff\xfd\xaa\xf1\xe0K\x04\tJ\xb6w6gk\xaavE\x81\x8d~\xa1\x1f@\x03\x98\xcf\xe73Z\xb0\xaf \x15\x13\xfc\x82Ђ\xc1\x93\x06\x8e\xdf\xd4\xe2\xf1?Ղ\x89\xf7\xdb\x0f\xb3G\xc6\xd3\vrU*-\xf2[P\xa2\x94\t\\Úq\xa6\x99\xe0\xb3\x1c4M\xa9\xa6\x173B(\xe7BS|\xac\xf0+!\x89\xe0Z\x8a,\x039\x7f\x00\xbex,W\xb0*Y\x96\x824\xc0}\xd7\xdb\xef\x16\x1f~\xbf\xf8nF\b\xa79\\\x10\x95l -3P\x8b-d ł\x89\x99* A\xa0\x0fR\x94\xc5\x05\xa9\x7f\xb0/\xb9\x0e-\xb2w\xee}\xf3(cJ\xff\xa9\xf5\xf8\x13S\xda\xfcTd\xa5\xa4Y\xa3?\xf3T1\xfePfT\xd6\xcfg\x84\xa8D\x14pA>\xd3\x1cTA\x13Hg\x848\xfcM\xd7sB\xd3\xd4p\x84f7\x92q\r\xf2Jde\xee91')\xa8D\xb2\x02\x9b\\\x90;Mu\xa9\x88X\x13\xbd\x81f?\xf8\xf9Y\t~C\xf5\xe6\x82,\x94i\xb7(6T\xf9_\x91Z\x0f\xc0=\xd2{\xc4Mi\xc9\xf8C_o\x97\xe4J\nN\u0a50\xa0\x10e\x92\x1a\x01\xf2\a\xb2\xdb\x00'Z\x10Yr\x83\xca\xf74y,\x8b\x1eD\nH\x16\x1d<\x1d&\xed\x87c\xb8\xdco\x80dTi\xa2Y\x0e\x84\xba\x0eɎ*\x83\xc3ZH\xa27L\x8d\xf3\x04\x81\xb4\xb0\xb5\xe8|\xea>\xb6\b\xa5T\x83C\xa7\x01\xca+\xef\"\x91`\xf4\xf6\x9e\xe5\xa04\xcd\xdb0/\x1f \x02\x18j袠\xa5\x82\xb4\xf5\xf6M\xf3\x91\x05\xb0\x12\"\x03\xcagu\xa3\xed\a\xf3\x05\xa9\xce\xcdX\xc2o\xa2\x00~y\xb3\xfc\xfa\xefw\xadǤ\xcd\xd1\x7f̫礒\x06a\x8aP\xf2Ռ\x12\"ݰ%zC5\x91\x80j\x00\\c\x8bB\xc2ܳ:%B6@\x15 \x99HY\xe2Ed^V\x1bQf)Y\x01JkQ\xb5.\xa4(@j\xe6ǡ\xfd4\xccK\xe3\xe9\x10\xfa\xf8A\x8a\xed[VMA\x19\xcdt\xa3\rR\xa3\x1a9\xb5\x83\x87\xa9\x9a\x1e#A|L9\x11\xab\x9f!\xd15\x82\x8e; \x11\x8c\xa7\"\x11|\v\x129\x92\x88\a\xce\xfeV\xc1V8$\xb0ӌjP\x9a\x98\xf1\xcciF\xb64+\xe1\x9cP\x9e\xceZ\x80IN\xf7D\x02\xf6IJހg^P]<~\x14\x12\b\xe3kqA6Z\x17\xea\xe2\xfd\xfb\a\xa6\xbd\xd1MD\x9e\x97\x9c\xe9\xfd{c?٪\xd4B\xaa\xf7)l!{\xaf\xd8Ü\xcad\xc34$\xba\x94\xf0\x9e\x16ln\b\xe1H\xbeZ\xe4\xe9\xbfyy{\xfb\x10\x18\x99\xf6\x9f1\x99\x13ă\xb6\xd4j\x97\x05eyRK\x81\xf1\a#\xafۏw\xf7M\xcdc\xca\t\xa5nz\xc0\x17/\x1f\xe4&\xe3kp\xb6`-En`\x02O\v\xc1\xb86_\x92\x8c\x01\xd7D\x95\xab\x9ciT\x83\xbf\x96\xa04\x8a\xae\v\xf6\xca8&Tڲ\xc0\xb1\x9bv\x1b,9\xb9\xa29dWT\xc1+\xcb\n\xa5\xa2\xe6(\x84(i5\xddm\xfdg\x1b[\xf66~\xf0>3 Zo+\xee\nHZC\r\xdfck\x96\xd8\x01\x85&\xb92%\x1d\xb3<4\xfa\xf1\xb3\xcah\xf2(J\xfd\x13\xe3\xa9\xd8\x1d\xfc<\xa6k\xf8\xf9\xbe\r\x82P\x89\xda\x048hK\x89ܱ\xa3sK3E\xd2\xd2<\xd8mX\xb21\x8dҲ\x8d\xa9\xc3\xca\x184\v*\x855Hil\x1fQ\x8f\xac(\x0e\xb5\x83\x10\xa6!\xefA>\x06\xfd.\x01v\xe8\x1c\"ߋ{\x85)\x1a\xb5^\xe0\x95\xbdGZP\xc9[f9FD\xceT'\xfa\xc0LO#\x13?\x97\x06\x8a\xd7\x1fPd\x87>C\v\x92\n\xb2c\xda\xd2\xe5iB\xfa\x1c\xd1\xf8xg\xe4ۇ\xbb\xfd\\\xa3\xa0\xd0\xeb\x18w\x90\xbbȥz\x91\x00O\xd59\xb9{d\x05I\x05(\xfeN\xfb\xa0&_\x90\xfbM\x9f\x1ex\xe2̴ִ\xb3cLٞB\x88\x00/\xf3\x10\x9b\xe6\xf6\xd5ை\\\xe0\xc7\xc0p\xaf?i)i\xb4\x88\xae]cT\xb6\x8dؑL\xb4\x98l\x020\xb5\b@\x1aE\xc5\x04E1h\fh\n\x06҈\x1dJ\x1e\xe1\xf9P\xd8j\xc1\xb9\x1b\x06\x18\anĎ\x13\xc6ݘ\xa7Jp\xd76\b\xbbgH{\xa5;\x9af\xa5\xa9\xd4Q\xbc\xbfÖH\x1a\x1d\x8b\xba+r\x03`]\xaf\xc7\"\x8d\x0e\x91I\xe88w\xaf\x8b^\x9fz\x7f4\xfd\xf6\xfc\x12\xf03N-\xca,\xa3\xab\f.\x88\x96=f\u05feK\xa5\xa4\xfb\xceo\x89\xe0h\r\x81'\xfb\x1b\x91\xb1d\x7f1\x1b\xe4p\xafZ]u\x81\x04\xac\x10Z\x8d*\xeb`\xd6\n\xed6,\x83>r7@\n\t[&J\xe5_\xf1Qgex7\xd4\xd8\x1a̧\xd4\x06R\xb2\am썷+=p+K\xf3\xdf%\x94\a\x01G\xc8\xc6\xcc\xc9\x0fB\xaeX7\xf2\xc4\x1f\f\x9c\x9e\xe7\xb7Pd4\x81\xd9\x04\xcd\xf9\x99i\r\xf2\x18\t\xfcѼ\xe9\auN\x9fX^\xe6$\x85\x8c\xee19\x86\xd4\a\xd5\xc6,c\xa2\xe5\a\xbd\xe7e\x9f\xa2[N\"\f\x14\x16H\xb6\x85\xb4\x0e\b\xb9\xcf\xc71$o\x19\x12\x0f\xf3\x9c(\xd1\x03\xb6\xd9\x04\x8d\f\xad\xfc\x90B Ig\xe4\xa2wU\x85\x04\x9a\x12\x9aH\xa1T\xc0\x009'F\x96\xcdLIm\x84D\xce\xe8\r\xb5f\xac\xf2\xf9+\xd0;p\xa6@\x96\\-\xa6Hʦ\x9a#\x92\xb2\xc9gk,\x80ހl\xcd;\xa0\xcc,44\x99\\\x1c\xea\xeca\xdaZ\xffI\xd06\xf78Fin\xfd\xcb-\x141\x04\x12\xebV\xb4`2tH\xc9j\xdf\x1e\x7f=0\xb5 \x8f\x00\x85\x1d\x85\x02\xa9\xf5\xa1^\x06\x1aR\x02[\xe0\x84\x19\xf0{\xb2\xa1[\xc0\xf1\vO\x05\x1aK3|{`.\xd7\x04\xf2B\xef\xcf[H!T\xc1\xb3}\x05\xda\x1b\xf6\xbd\x83w(\xd0\x11+9\x1c\xa7!Yהe=\x16\xf2\x80\xdd\x7f\xf2m+7[\xe6+\x908\xe6R\xba\xc74\xc9p\xa9\x91\xe6\xf6\xc2\xf4\xd12Y\vyH\r~r\xc6q\xa4_\x90\xefz\x7f\xb6\xaa\x83*\xff\xd0\x1b\x1a!\x12\x7f\x10\xa5\x8c&\xca6>\xa4j#J\xf9m\x91\x85sQ\x91Da\xd3C\x92j\"\x1c\xba\x15}/\x86\xf3\x8f\x82\xebM\xb4,\\\xebC\xccs\xfc\xe1ے\xc6O\x00\x8fф\xd9Ƈt-ﾐ\x1d\xc0c\x8b\xb4^\x90\xa4-\xb9\x17%\xed\x7f\x80Ə\x1f\xdb\xf8\x90\xb4=\xd0oh\xfc\x14TjF\xb3l\xff\x03e\x19\xa4\x11\xb4\xf5\xfa\x17\xfcw\xd3\x06\xd5\xf04\xc8\x00\x89\v\rH\x86!\xb9\xdb\xd6\r\xbb~\x12\t\xb95/\xdbd%\x15\xe8G\f\xf3(\xdf{\xfe\xa0\x8b`\x0f\\Ⱦy\x85\b\x87\x10\x93\xbc\x8f:\x86\x97s\x0e1\x9a\x10\xa1\r1\x1a\x11\xe3,Ns\x18A\x90\xd1c\xf9\x99I\r;\x90\x93\x9cH\x10b\x1d;\xbd\x06u\x83\xae\xe6Tw\xf3\xcd\xc9r\xc8\xfd\x9c悂 ɡ\xec_\x8d\xdc!\x97t\x9a[\xfa\x86$;8\x1f2\xf0\xa3O\xbc.f\x83l\xe9\xf5h>\xf1\x89\x9ab2\v\xbb=@\xea\xa5\xdeI)\xa7zd\xc52\xcf!eTC\xb6?\n\xfd6\x88\xbe\xccT\x98\x89x/E\xb6n\xe5\xa98_\xc3\x1a\uf6e9\x80\xbf\xf8\x16\x87\x8b\xc3\x7f1\v\xcdfM\x17{\xe0-`%\xaf\xd3\xdeN?\x1cv}ʳ\\\x9b\xbc\xed\xdcc\xb7cYfr|7\xcf\xd8D-\xdc\x1d[\x13\xa6=5+\x8a\x8f\x04'\v\xbb\xa8\xbf\xa8\x97\xb0\xab\xe5hD\xb0\x83\x9dY\x85\xb4\xfd\xe3\x84\nՄÓ\xae[!\xd9\x01\n\xd64S\x1d\x12\xdc\xfa\xd8$2\xceɪ\xd4\xc7a\xe0ri\xf3\xeeZd\x99\xd8\x11e\xd6\xfe\xb0dd\xcd\x1e\xfc\xfc\xf5o\xdc$څ\xc5\xf9\xb7\x8bI3\x13f\x1a\x93\xf1\x87k\xa0i\xc68\xdcA\"x\xaa\x8eR\xdb~P\xde\\\xa5\xee1NT+\xf7\x13\x86\x94\x1e\x83\xde\xd5\x1b7\xa3h\xa3ǜ)T\x03\\\xc9\xf4\fL\r\a\xcf\t,\x1e\x16d\x05\t2\x1d\r\xa0[\x8b\xeb\x81\xe8x\x88\xc5\x14\xa9\xd8\xf1\x05\xb9\xf4Ӗ\xb6\x93\x84b\x88\x8arF\xbcp6\x05\xd6BB\x8b\x84\x1e\xb0f\x91=\x112\x85\x94P\xb4:\x0eY\\kjH\x13G\xafQ\x10.*h\x87\x02[\v\x99Sm\xe2\x83\xff\xf8\xfdl\x82Y\x1e\t\x94\x87\xac\xb5\x86\xbc@7x\x8c\xe4\xefݻ\xb5\xa8}\xed\x93\x0f\xae\x1c\x8b\xb5pe\x11=@\x04w\x93\xc9b\xcbRH\xfb\x97Q\xc7C\xfdD\xb1;N\v\xb5\x11\x1aM\x83(c\xa6\x17z\xa9\xc2\x7fWw\xcb\x0e\xb4Nj\x84\xeaG\x8c}Ԃ\xec(\xd3\xe8R\xc9\xd5ݒ|\xc5\xda&\xf0o\xa3:a9\x93.%\xc7\f2\xd0\xdf-\xd0t\x7f/\xfe\xac\xaau?_vs\xee\x15Q\x02\xc2\xc0\xa9X\x90\x12ם\x95\x19\x03\xa2읔\xab\xe7\x87;+x\x1f\xbeCE*u\xaf\xf9\x19\xf4p\xf8\x0f\xd7\xd7s\xb1\x05y\ns\xaf\xa9\xa6?\"\x90\x0eO\x1181Н\xc2\x18\xfe\x9aYM\xbf4\x1a\"u\xb9n@e\x8a\x9c\x9d\xa1[8\xb3\xa5pgnf\xb2d\x99\x9e3\xde\xec\xc7\xfb(\xec\xe98\x86X#l\x85\xae\xee\xc5\x0fʪ\xfcI\xfc\t\xc0\xec\t\b\n\x91\x92\xad雬Y\x06D핆ܻ\xafz\x9e\xbdQf\xd5\xfd\xa0\xde\xd2,s`\x14\xce\";\xa2\x8eNʇ\x1cO\x1f\xd3nAi\xd6)\xc78\x8de\x16b\x0fä\xfb\xa1\xc5\x19T7M\x1f!PHP\xa5\xf1Xj\x90e\r\xa6\xb7\xb95\v\"WHHp\x8a\xfe\xc2\x15\xed0\xc8R\xe7\rp\xfd\x19\xa4E\xa3\x8aZ\xd0X\x02\x8e\x84\x94\xe0\x12\x80\xc4X\x83q\xb2.\xb1\xaciA\xd0L\x04\x95\x84q\xa5\x81\xa6/&<xJ\xb22\x85\xf4*+\x95\x06y\x87՟\xa9\xaf~U\xa7\b\xf1\xe3 dWX\x95\xb1\xc4,d%\xb6\xd1\xdcT\x9f\x86t\xbb\xae\xb1\xda\x17nE\fe\xedH\xa8\xd7\xcaF\x8d\x8b\x02\xb3Jz\xf6\xbb\xb3s\xa3\x02\xed\xde\xdb\xfd\xd8\x15\x15ϦI\xc6\xd9D\v\xfdo\x04\xebl\"\x8c\xd4\x04\xb9\xf7-B7\xa5^U\xf9\xbe\x80\xdcC\xb0;\x92\xaf\x166\x7f!\xd9w\xfb\xff\xff(\xfd畷\xc2\xd4FS\xc6Q\xceX\x94\xde\x123ƖT\xfb*\xae\x00L\xc6-\xc3}9̐T\xbf\x11f>\xeb\xd8\t\r\x96J7\xdd\x00\xf8\x97\xe2\xe4F\x88\xc7\x18\xee\xfd\x01\xdbյ\xb5$1;6\xc8\n6t˄tl\xa9\xa3%x\x82\xa4\xd4A\xcbB5I\xd9z\r\x12kl\xcd\xfe\x83\xaa\\c\x88Y\xe3K\x15^X\xc1\x06\x1d\xbaj\xa1\xa3H\r7B\xa4`\x00\xd4\xe7\xcd\xfd\x1f\"\x8e\xb9\x85\t R\xb6eiI3\x13KP\x8e\x1d`\xe8S\xe1\xd7OߨB\xc4kus\x12\xc0\x13\x89Bl\x95\xe3\n\x0e\x18\xe4\xe7\x98\x1c\x1d6\r\n\xb5\x9aT\x1a\xec\xbb^\xfe2I-\x16\xd6\bٰI絰\xeclSFW\x90\x11\x05\x19$zhr5F\x0f\xa6\x19\xdd\x00s{\xacl\x1d\x0e\xb7\n\x84\xd4,\b\xd1}0\xc75\xb3$&|EE3\xa1\xb5\xa9&E\xa3LhQd\x01\xd75A9\"\xed\xc6$\v\x12kK\x0e\xf9\xee\xb5\xe98\xb6Wo7\x92\x10\xe4z\xa56oLo2\x9d\xf1\xae\xb6N\xe2\xfa\x88%\xc1\x7f˃\x1e\x82\xe3!\xc8z\xe48\x03՜\xd9cV\x0e,N\xa0\xad\xf81X\xbd\xfa+\x95\xddq\x03f\x82\xe8F\xc7\xd4\xcb\n\xae\xea\xe6_Dn\xc6e\xdd9\x8f5If\x9f\x9ao\x9ec\xc1\xa0\x17Hz\x8e\x13Q\x1a\xeb\n\x87\xd7#;\x11Ϩ䞓A\xb1\x1e\x18?9\xd5\xc9\xe6c\xb5\x8a\x18\xf1F\x87W]\x00\x845\xb3\x1c#\x83\b\x90\xa4\n-|\xf1znv\x89\x99L\xb2\xf9\xc4\xe4I\x97\x9f\xafù\xe7\x11\x9az̠u\xdb^:\x81Q\x13W\x97\xaa\xf8_L\xbcV%\x82&+V焒G\xd8\xdb\x10\v\xf7.\x16 \xa9o\x1c\x89\x82\x04\\\xdf0\xfa\x88\xb0\f\xa8\xfe\xbd\x87\xa7k\x8b_\xe4\x1fX\xdd\x1f\xe4+\xe2\xe7\x16S,\xdf\xf0\x01\xd2\x1a5\x9az\x94\xc5\r\x9f\x9e\x9d\x7f\xcfb\x97\xfc\xc7\xcb\xe5H\xb2\xa3թ\xd9W\x9dС\x1a=\xc2\xfe\x1d\xae\xc5efuTmX\x81&\x05\xd5ˌ\xb3)\x02\xb7\x9f\xaf4ciՙM\xb1\x96\xfc\x9c|\x16\x1a\xff\xf3\xf1\x89\xe1\x8eJT\xa6k\x01\xea\xb3\xd0\xe6ɋr\xd9\x12\xf1\x1a<\xb6=\x99\x01ʭ'A&6w\xb5\xda \b\xc7T%\x0f\xa6ȒcJfY4\xa1;\x04㺴\x9d\xe5%\x96\xe5\xe04\x05\x9f\xdbIѾޜ\f\x84l\x89\xe0Y:v\x9d\xdec\xbecQ2+\xbdf\xcfH\xea\xd7\xe8\xcc>_\xaa\xe1\x81%\x13\xfa\xccA>\x00)\xd0-\xc4k\xcb\x04C}\xb4z\xc5G\x0e\xfe\xcf\x19\xf3@\tj\xf73G\xf3\x1b\xd9ҋ9\xaa\xf9`\xad\xd1iT\x1a\xefm\u009d(\xee7O\xba\x98\xe65&\xca똡ݠ\x05G\x0f%95\x9b\xb6\xfe\x8e\x1e\u058c\x82\x7f\x92\x822\xa9\xb08\x02\x8f\xfaȠ\xf5\x9b\x9b\x10l\x80\x89\xec\xb6\xc0\xeePU\xb64\xc3934̜@f\"\x16Ġ\x1b#\xe1&G\xa1\x00\xf5\xa5^,;{\x84\xbd]ʍ\xea\xb6i(Ζ\x1c'\xefyz8ી\xc3lV93l8;5\xac\x9a\xa0\x90\x13\x9a>\xcd\xf1\x94\x18\xc9A\x83\x9a紘;E\xd6\"\x1f1@C\xdbR{5\xaa\x7f\x0f\xaa\v\xcc\x17\xb3gR\xe5B(=\t\xad\bE\xbf\x11J\xdb\xf9\xbfV\x9c\xdd;A(\xfc\xa4 \xa1k܅\xa6\xb4\xa8\xf6\xba\xa1\xc1\x8d\x99\x02o\xfe\xddo@\x81[\xffq\x93\x8d\x160f\x8fg\xb5m\xb0\x932gv\r\n\xff\xdf\xed1G\x9d4\x950\t\xa8`A\xc2d\x9f\xd0\xe2\xe0!\x1f\xaa\xf9Tj\x84\x8b\xf3\x9c\xa3 I\xd4d\xf0q\x014\x8a$\xa6]\x87\xb0\x8fO\x8d\xa9a\x8a;\x14!\x89\xd2\xd6cp\xc4\x0f\x1eoA\xbb\xe7\x83D\xa3{e\xdf\xf6c\xcc\x013&\x8aʇ\x12\r\xa3\x9aE\x02&\xa4\xa1\xca\xdfZH\x913\xbeDm\xbf \x1f\xa2ߙ⠽0\x8c\x15\x0f\xd5%\x8d\x8a#҃V\xfb\xa9mg\xb5\xf4\\\xef\xde``\x85\xc8Δ\xfc5\x85{\xb8\x16abh\x9cʭ\xa7O&\xe0\xe1zz\x87\x05%RU\xb9\xb3\xc5+\\\xd1\xf4L\xa2\x15\xfc#֡\x1d\xc9\xf0/\xf6\xed\x8ap\xf4,\xbbp\xf5f\xe8\xafb)\xeeUu\xb5\xc3\xc0\x13Q\xe26b\x93\xbc\x98b\xb9\t\x10\xadh\xac\x17\x88\xf4w\xf5g\xe8\x04\x8cÿ\xb9\xd1$\xc6G\xe7\xab\xeaϜ\xe0F\xad\x97\x14\xab\xab)|\x8dq\xe4++\xbd\xd5n\ue3679\xcaЄ\x1dXi\xe9\x8fر\xe2\xae\xea-\xf1\r\xb4\xf1D\v\x92\x88\xbc\xc0M̮^r\x02\x1e\x89\xe0\x8a\xa5P\xb9~\xa7\x02\xb8\xb7\x9d\xac)\xcbʾ\xcd\xca\xcf\xc6\xf2\xa99\x94\xb3&Q\xad'\x04\x97S\x10\x99\x1b\xef:{\xc6\xdec-~!\xa7ű\x11\xfax#az\xbcXH\x86\xea'^\"dt\xf5\xbe\xb8Q\xf2-f|\x8b\x19\xdfbƷ\x98\xf1-f|\x8b\x19\xdfbƷ\x98\xf1-f\x9c\x1c3\xc6`87\xb5?\xb3\x13\xb1\x8a,A\x18C{\xa4/Wl\xe3\xf6H\xf8\xa0,\xe0\x93\xe3\xc6ٲ\x1fd\xcf\xee\x99\xc0\xb6\a5\x1b\xb1\xb4U\x89\x90\xc9\xda\xfc\xd8q\xe70\x8e\a\xccϰk\xc5#\xe0\x88|\xc6\xdd\v\xcbAȝr\xec6\x03\x03\x10\x03;\x17\x1c\t1\f;rϊg\xd2\xf4]\v\xfeT\xca\x1c\xa8_J1K\xf1A\x1a\x03\xc8\xc4\xe01\x18\x83\x8e\x9a\xd2h]\n\x8dP֭#|\x01]\n\xc1\xeehSUI\xe8\xd8\x18\x80\xfa\x1c\xfa\xd4+\xfa\xb3ߝ\xfd:D\xf4\xbcB\t\x8aᐷ\u058c\x87\xec#\xae\xff4K\x12\xdbա\xbf\x9e\xa1\xf0\xac\xba\x1fR\xf6J\x8b\xbbL\x0e\xc0k\xabu\x87˿&{\xa3!\xff>\x13\xc9\xe3OB>\xe2\xd5\x19%\xd7'\xf1\xb9\a\xde\xe1\xd12H4Ya3\x7f\x86\x172\nm\x04\xa4\xa4,0}\xb5g\xca\xeap\t\xfa\x12\xc1\xbc\xb3\xb5\xea\n\xb4Y\xbawgY\xbcS\x955\xa9{\";C!I<J\xe1|t䈚\xb1\xe3i\xb0\xcf/\x85\x8b@\\Jq*O\xbb\xf0\xa2\x0e\f\xa0jϓ\x8d\x14\xbc>F\x17a]\x9a\xa9=W넓|S\xac\xf2\xef\xcd\xe9]\x8b\xd9\x11\xea\x1aQ\x11\x1cǐV\x810\"E\xcd\xf1\xfc\xdb\x0f\x8b\xf6/Z\xb8ras\x06z\x00\x18n]\xc2cr1\xd7jlNr\xb6\xd5\x1f\x9d\xdb\x1d\xe8\x01`\xb8\x8b\x87e\xd6\nx\b-\x1b@\xbe\x18\xe2h\xb68v<\x8f\xcf\vv\xeb]B\xed:\xec\xee\xbe֞\xb2n\x17ڎ\xa7D'\x14\x10\x0f\x9a\xc4x-\xf9\x85K\x84\x8f+\f\x8e\x9d\xf5\x8d(\x02nqi\xb0\xf4\xb7b\xc1\bD2\xa1\xe0w\xd4uu+\xa9&\x91\xf3\x8f\xf9,\xbaB\xeb%\ny_\xa6|7\x9agq\xa5\xbaS9\xf6*e\xb9\xaf\\\x8c\xfbz%\xb8\x13\noG\r\xdcDu\x18\v\xf2\xfc_\xdcl\xd5p\x19mT\xf1\xec\xc8,S,\u038dZ\xd00\xcaS\x8bb\xa3\xb8\x1a?t\x1a8\xbe|\xd9\xeb\xab\x16\xbb\xbe~\x89\xeb\xa8ڌ6\x98Z\xc4\xda\x7f\xabS\xbc3\xce~\t\xe5<\x95MB\xb6\xc2\xe4\x00BqC\xe0K\a\x16*\x8b\x0f\x19_1&\xcf\xcbL\xb3\"\xab\x0fz\v\x0067\x0f\xf8C\x90~\x16\x8c\xd7G\x80}\xb9\xad,ۢ\x93aPEv\x90e\x84\xaaX.$\xf6\xe2\xb3D\xcc\x01\x1d\x17\x8erwʓ\xbb-\xed\xdcNc\x9aS\x06\x8cG\xcd\x03\xa0\x13\xca\xfdAR\x8b\xd9dg\x12k\xc7\x0e\xa2dc\xca쳿\x96 \xf7\xc4\x1chV\xc5I\xd5\f\x87\x1f\xe8\xaa\xccj\xf3\xe3\xcc\xe1К\xd0A\xb2Q\x9b\arɭw\xee\xe2d\xde\x01\xd5L\xaeШb\xce\x14\xec'\x00\x82\x8b\n\xc2\xec\xf8@\xbcKD\xb8eG\x12ϔj=G\xb2\x15\x15\x8dĪ\xd1/\x9cr\x1d\xbf\x1b3F\xda\x13v_\xb6\xf8\xf5L\xa9ה\xe4+ґ\xb4\xfd\xfcD\xb2\"R\xb0\x17N\xc2^n\x17\xe5\x04\xee\xc5\ue69cλWI\xc7^=!{͔l\xe2n\xc8\bC8Y=bҜ\xf8\xe4,f\x97c\xe4\xee\xc6\xd1 2\x1e\xfb\x86\xcf\x1fB~j,\x1c\xcd\xe7)C\xebUӵWߝ\xf8\xfa)[\x94\"E4\x99\xbe\xfb\xf0\xe4\xa5/<V[\x8e./N\xd1\xdaQ}\x8d\xd3\xd4/\x1d\xc4:k=\xfe\xb8XlՊ\xc5\xf1\x8bk\x9a\x98ۢCbCA\xa3f6\"\x13\x0f\xc4,2\xd7aS;0u\xd7Hc\x13E\x14\x14T\xfa\x9b\xe1L\tX\xd0e\x7f\xa4ɦ\xbd\xc2J6T\xb9\x03\xc9\xc9Y\xb5(\xfd\xdev\x80\xdf\xcf\x16\x04o]\xf4e%5\x91\xe7D\xb1\xbc\xc8\xf6XNJΚ/\x9c\xa6%A\xed\xf4=\xff(R,\v\x95\x17'H\xf6\xb6\x03\xab#Y\x89\xf7\xa7\x02\xc7Z\nA\xfex\xf7\xe5sʹ\xc2%.\x9dc\xe7lH\x18\xccvE\xcd7_\xf8o2`\x8c\x93v\x12\xaf\x8d\xe4\x9dd\xfaX&\x8e\aд`\xff%E\xe8\xec\xeax\x1e\xba\x9b\xea\r,\xaf\xbd\x0f拯\xbf\xac\x98\xb6\x02\x8c\x18*\xae\x0e\x9a\xb1\xe5\xba\x05\xb5]\x02ݼ\x9c\x1bR3\xb6\xaa\xa8\xc5y\x84\x04\x99{y\xb3\xb4\xb8\f\xf5\x84j\x8d\xdb/\x84\xbb\x0e\x92\xc9t\x8e\x97n퍽R\xe7-<|T\xb0\x98\x9d\xe0$\x0fo\x9a\x0f\xb2\xdd_2\x8f\x04#䦁9\xe0\xe7)8\ro\x1a\x1f\xdd.\xfe\x028yV\xf7c57\\\x9cM,\xf0|\xf6\tKOw\xe8\xbe\xde\x03\xe6y\x93sp7o\xcb\xe0\xd45r\xbd\x10\t)\xf0u\xe6\r\x90sA\xce\x0e\xd9{D\xde\xcc\u009bYx3\v\xbf\x90YP\xeeB\x10\xbc\xf8\xe2:\xb8\x9e\xd1b\xdf]畞\x82l\x0f\xd5\xdci1Z\x85mn\x1486~\x18\xab\xb0\xf6\xa8\xb8\x1b\t.f\xc7[\x8a\xbb6\xa8\x1e\xba\xfd\x85\r\xbe\xd3P\x8e\x87\xc7\x16\xf3=\xb9\xf9\xfaN5T\xcd\x0f}7\x9b\xe5晫\x12\xa0\x00,\xc6\a\xef\x06{.6j!\xe9\x03|\x12\x89\xa9\x7f\x88Q\x93\xf6\x1bn\xfe\xd6\f\x17\x9fG\xfa]*n\x10\xf6\xc2$\xd5\xdd\xef]\x80\xf5\xae\xb4\xb6W1\x97%\x85o\xef\x1c\x19\xb7Zg\xa7\xe8\xc8\xfd\xfd'K\xa9\xb9J\xeb\xda݊\x85a\x9a\x02\x14\x81瀅\xb6\xc2\xff\xc5\xddbx\xddF\x00b㾢\x9a@\t\xc8?{\xfc\xf3Qd\x96E&h\x8au\x96|\xcd\x1e\"(\xfes녆\xee\xbb]\x83\x8d+\xc0\x9c\xdf\xec\x85Y\xf7|\xb4\xaa\x8e\x87\x06\x98_f\x19d?\xb0\f\x94E<ԴC\xe5\xcdᛇE\xa8x\xa5\x8d\xaa:\t\x02\xf6\xa4\xe2\xbc;)@b֊\x96\x82\x93Ry\xcd\x1ffFL\xcd\xe8\xa8Oض.~\xf2\xa3GE\x88\xfck\xff\x9b\x8dԾ1\x8eq\f\x0f\x98\xbb\x10,\xaa\x94H\xf0b@\xbcbF\xbbcV\x87R\xca\xc1\xb9\xd6\x11\xa5\x1f\x9e\xd8\x19\xe0#\x0e\xe6\xff\x15\xbc'\xc0\x18\xb7\n\xf7\xee]\xafG\xcb\xcbϗ\xb6\xec\xf6o\xb8\x88\xcbiua\xdc\xd9\xc7\x12U\xfb\xfd\xf7 3\x86sp\f\x8d\x1cK6CwJ\xe2\x8c\xcb\xf7\x19M\x1eE\xa9\x7fb<\x15;\xbb\x9a\x0189\x87|m֘W\x15\xbf\xd8u\x0fTw]\x80ߢhj\xa2\xc3\x15\xcf\x03\xdc.\x15|\xd9qܵ圛Z\xf2\xd0\rT\xe3,\xfc\xf3\x014o\x10\xfb<p\xa9\xfaH\xeb\x00 \xc2/\xac+{\xa7\x99_\xcfg\xaa\xe2\xedb6\xd1:\x85\x9dh\x7f,8\xef\xbfUn^\xdd~7\x8b\xd0O{\x93\xdb\xc5,\xc8RO\x8e\xbd\xab\x92$\xb4\xc0뚜\xe1\xb6\xc5\xf2\x06\x88\x89\x83i\xb5?\xb5\x0f\xb3\xb0\xe9M\x91\xb3\x92f\xb7@\x95\xe0\xc7\b\xf9\xba\x05\x01oH\xcd\xcc\xda\xeen\x83b\xc1\v\xe8*?(\xd6mA\x99I\xc1\x15\xf4\xde$a\x11\xc3\xeb:\xa5\xbf\xf2sA\x96\xfa\x9d\"I\x06T\xba+(\xaa \x03\xb5\x1d/I\x9c\xa2\xec\xf5ե\xc7\xd0]\xdf\x1d\xea-\x04³\x16\xa2\xc2jG\rZ.%e}\x86\xb6bF\xf0\xa6B\x7f\x8fcJ5\xcc\x11\xfeq\xfa\x1d\xe4\xc1\x8f\xe6\x8e\xc9\xdb\xf2(\xf1\x7fj\x02\xa89a.B\xf6\x14\xd9\xeb5\x0f\xc5\x1f2\x92)K\xf1\xf6\xccB\x8a\xb4Ljn.^\x9d3wV\xf1F\xf8\xf2\xa9n٧\n5\xb9TyU~UJr/\x1e5BH\xaf\x80+\xe1VW\xb0j\xa1iֈ\xab\xf4\xa6V^+jw\x81Ͱ\xbe\xbb\xbb\xfc\xfbe}\u07bc\x86uOv\x9828ޑ\xd5a\f@\xdc܋\xdbE\x94\xec\xed\xfc\xd5\x1e\xad\a%+\xe7g\xc9\xce8\xdasRP\xa4ɼ\xe3\xef\x8d\xed\x01\xe9oVE \x06\x01\xbf4=\xf5\xaaաX\x90Ó\xbe-\xcd.\x9fc\xa4\xf3\xb9~\u074b\a!v̐\xbb\xffX\x8bXct\xee\xb2W\x1f\xef\xfe\x8cK\a\xb2Z\\\xf4^\x03]o\x87\xb9*̜\x97\xd0ms_\xd2\b\xe3n\xb0\x8d\xe7\x8e\xdbff_\xf4\xda\xebɞŝ\xa61'\x9f\xe1p\xfasN>r$\xe2pp\xdbc\xd6 5\xd5+&\xb1\x9cB\xe2\xb6z˜Wr\xd4 \xae{\xb60:\x9b!\xb1\xc0\xae\xee\xc6\x1eX\xa2\xc8oغ\a\x94Y\x81J\x90\xd0\xdf\u03a2\x83\xfc\x01\xf2\xc2\xc1}o\xe0t\xf0\xd0\xec\x00L\x1b\x9a\xe3\xa6<\x9aOʕ\x9f'T\x17\xe4\xef\xff\x9c\xfd\xdf\x00\x06\xc3\xf0\x05\x92\x9d\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVM\x8f\xe36\f\xbd\xe7W\x10\xe8\xb5vv\xd1\x1e\nߊ\xb4\x87A\xdb\xc5`\xb2\x98\xbbb3\t;\xb6\xa4\x92T\xa6)\xfa\xe3\vJ\xf6$\x938\xdbl\x0fM|\xb1ď\xa7\xf7H\xcaUU-\\\xa4gd\xa1\xe0\x1bp\x91\xf0OEooR\xbf\xfc 5\x85\xe5\xe1\xe3\xe2\x85|\xd7\xc0*\x89\x86\xe1\t%$n\xf1'ܒ'\xa5\xe0\x17\x03\xaa뜺f\x01\xe0\xbc\x0f\xealY\xec\x15\xa0\r^9\xf4=r\xb5C_\xbf\xa4\rn\x12\xf5\x1dr\x0e>\xa5>|\xa8?~_\x7fX\x00x7`\x03\x82|@\x16u\x9a\x84\U0004f122R\x1f\xb0G\x0e5\x85\x85Dl-\xfe\x8eC\x8a\r\x9c6\x8a\xff\x98\xbb\xe0^\xe7P\xeb\x1cꩄʻ=\x89\xfer\xcb\xe2W\x1a\xadb\x9f\xd8\xf5\U000c0c81\xec\x03\xeb\xa7S\xd2\nD\xb8\xec\x90ߥ\xde\xf1\xac\xf3\x02@\xda\x10\xb1\x81\xec\x1b]\x8b\xdd\x02\xc0\x0e=\x91W\x8d\\\x1c>\x96p\xed\x1e\x87L\xb2\xbd\x85\x88\xfe\xc7Ǉ\xe7\xef\xd6\xef\x96\x01:\x94\x96)\x9a\x04\r\xfc]\xbd\xad\xc3\xdc1\x81\x04\x1c\x8c\x90@\x03\xb8\xb6E\x11h\x133z\x85\x02\x19\xc8o\x03\x0fYVp\x9b\x90\xf4,\xaa\xee\x11\x9e3\xff\xe31\xeb\xb7\xcd\xc8!\"+MԔ\xffYŝ\xad~\t\xb8\xfd\xed\xac\xc5\v:+=\x94\x9cy\xe4\v\xbb\x91\x1e\b[\xd0=\t0FFA_\x8aі\x9d\x87\xb0\xf9\x1d[=\x01<\xe7E@\xf6!\xf5\x9dU\xec\x01Y\x81\xb1\r;O\x7f\xbd\xc5\x16#Ȓ\xf6N\x8d.\xf2\x8a\xec]\x0f\a\xd7'\xfc\x16\x9c\xef.\"\x0f\xee\b\x8c\x96\x13\x92?\x8b\x97\x1d\xe4\x12\xc7o\x811S\xdd\xc0^5J\xb3\\\xeeH\xa7>l\xc30$Oz\\斢M\xd2\xc0\xb2\xec\xf0\x80\xfdRhW9n\xf7\xa4\xd8jb\\\xbaHU>\x88\xb7\xe3K=t\xdf\xf0ع\xf2.\xad\x1e\xad\x06E\x99\xfc\xeel#\xb7\xceW\xc8c\x8dT\x8a\xa9\x84*\x9c\x9cT \xbf\xcbz=\xfd\xbc\xfe\f\x13\x92\xa2T\x11\xe5d*\xb7\xf416\xc9o\x91\x8bߖÐc\xa2\xefb \xaf\xf9\xa5\xed)\x17n\xda\f\xa42\x95\xb6Iw\x19v\x95g\x15l\x10R\xec\x9cbwi\xf0\xe0a\xe5\x06\xecWN\xf0\x7f\xd6\xcaT\x91\xcaD\xb8K\xad\xf3\t|\xfa\x15\xe3B\xef\xd9\xc64;oH;3%\xd6\x11[\x13\xd7\xf85o\xdaR[\xdaj\x1b\x18ܜK}\x17\x92\xec\xf1\x95XƉT\xd0\\̩\xb0\xbd\a\xcd\xfcX\xb2\x7f\xdc;\xc1\xcb\xc5\vL\x8ffs\x99\xbf\xa7-\xb6Ƕ\xc7\x12\xc2ƍm\xff+\x14{Ч\xe1:g\x05\x9f\xf0uf\xf5\x91\x83Mh\xbc\x1c57kc\xbc\xc4v4\xddȷOV\xac\xf2\xc5x=\xf23\xdfc \xe0併t\xf0W!gn\x84+\x1bR\x1cf\xd0\xcc\xe2y\xf0\xdb`3Y\x9d%vZ\xda\tG\xb1\xc7<\x05\xd7L\xc0\xdbZߚsw\x11Z\x9e|=\xff7g\x9bK\xc48\x9b\xbbʨf7,\xe3\xccƍ\xfe\x1aQ\xa6\xbew\x9b\x1e\x1bPN\xd7\xde\xc5\xd71\xbb\xe3\xc5^\x9cJ\xed3\r(\xea\x86\xd8,\xbe(\xd8խ`\xcf\xe3U\x14k\x9e\xd7=\xfa[-\x02\xafNN\xc9gBn\x8e\xb7\\Wo_\x9b\xd7}V>a\x1a\xb0Y_)\xcd\x10y\x17S\xb3\x92\x96/\x9f\xd9Ϛ+\x96\xd6\xe7\xb6\xd3 y\xd7/\xd3WM}?\x84\xd9\n\xb8Z\xcc0\xbb\xb3\xe3\x89\x06v;l@9\xe1\xe2\x9f\x01\x00\xd9Ո\xaf\x10\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVK\x8f\xdb6\x10\xbe\xfbW\f\x90kd'h\x0f\x85.E\xb0\xe9!h\xd2,\xb2\xe9\xdeiqdMM\x91\xeap\xa8\x8d\x8b\xfe\xf8bHi\xfd\xde\xdd\x14E-\x01\x86\xf8\xf8\xe6\xf1\xcd|dUU\v3\xd0=r\xa4\xe0k0\x03\xe17A\xaf_q\xb9\xfd).)\xacƷ\x8b-y[\xc3M\x8a\x12\xfa/\x18C\xe2\x06\xdfcK\x9e\x84\x82_\xf4(\xc6\x1a1\xf5\x02\xc0x\x1f\xc4\xe8p\xd4O\x80&x\xe1\xe0\x1cr\xb5A\xbfܦ5\xae\x139\x8b\x9c\xc1g\xd3\xe3\x9b\xe5\xdb\x1f\x97o\x16\x00\xde\xf4X\xc3\x18\\\xea1z3\xc4.\x88\vM\xc1\\\x8e\xe8\x90Ò\xc2\"\x0eب\x89\r\x874\u0530\x9f(\x10\x93\xf9\xe2\xfa}F\xbb\x9b\xd0>Nhy\x81\xa3(\xbf>\xb1\xe8#E\xc9\v\a\x97ظ\xab\x9e\xe55\xb1\v,\xbf\xed\xadW0FWf\xc8o\x923|m\xff\x02 6a\xc0\x1a\xf2\xf6\xc14h\x17\x00S~r0՜\x9a\xb7\x05\xb1\xe9\xb0\xcf9ׯ0\xa0\x7fw\xfb\xe1\xfe\x87\xbb\xa3a\x00\x8b\xb1a\x1a\xd4Ƶ\x10\x81\"\x18\x98=\x81\x87\x0e\x19\xe1>\xe7\x13\xa2\x04\xc689\xfd\b\n0\xfb\x1f\x97\x8f\x83\x03\x87\x01Yh\x0e\xbe<\a\xf5u0z\xe2\xd7\xdf\xd5\xd1\x1c\x80\x86Rv\x81\xd5B\xc3\b\xd2\xe1\x9c\x0e\xb4S\xf4\x10Z\x90\x8e\"0\x0e\x8c\x11})=\x1d6\x1e\xc2\xfa\x0fld\xef`y\xee\x90\x15\x06b\x17\x92\xb3Z\x9f#\xb2\x00c\x136\x9e\xfezĎ !\x1buF0\n\x90\x17do\x1c\x8c\xc6%|\r\xc6\xdb\x13\xe4\xde\xec\x80QmB\xf2\axy\xc3A\xa2\xca\xfb)0\x02\xf96\xd4Љ\f\xb1^\xad6$s\xd75\xa1\xef\x93'٭r\x03\xd1:Iา8\xa2[E\xdaT\x86\x9b\x8e\x04\x1bI\x8c+3P\x95\x03\xf1\x1a~\\\xf6\xf6\x15O}\x1a\x8f\xcc\xcaNK,\n\x93\xdf\x1cL\xe4.\xf9\x0ez\xb4aJ\xd5\x14\xa8\x92\x93=\v\xe479u_~\xb9\xfb\n\xb3'\x85\xa9B\xca~i\xbcƏf\x93|\x8b\\\xf6\xb5\x1c\xfa\x8c\x89\xde\x0e\x81\xbc\xe4\x8f\xc6\x11z\x81\x98\xd6=\x89\x96\xc1\x9f\t\xa3(u\xa7\xb07Y\x99`\x8d\x90\x06k\x04\xed\xe9\x82\x0f\x1enL\x8f\xee\xc6D\xfc\x9f\xb9RVb\xa5$\xbc\x88\xadC\xbd\xdd\xff\xca\xe2\x92ރ\x89Y&\xafP{Y\x11\xee\x06l\x8e\x1aOQ\xa8\xa5I!\xda\xc0G\x88\x00f\u058b\xcbx\xc7\xf9\xbc,\x14\xd3a\xd1\xd2\xe6t\x14\xc0X\x9b\x8f\x1a\xe3n\xaf\xee}\"a\x17\xe2\xbe\t\xbe\xa5\x8d\xd6p\x1b\x18\x06\x0e#Y\xe4j\x8es\xf2$\xf1\x140\xa1\xb3g\x95z5\xe7\xfa6\x8cV)6\xae~ƓǅjT\f\xf9\xa2u{\x80\\y\xdcOZ\xed\x05\xbd\xc5S\xed\xd1WB.\xef\x88\x16\x1eH\xba\xd27\a\a\f\xc0\xcbX\xd0g\x8b\xbbK\xc3'\xbe\x7f\xed\x10\xb6\xb8S\xbdU\x97#6\x8c\xa2\xba\x19ѩ\fj\xd3.\x01>\xa5(ꚹ\x88\b\xaa\x1ed\xe7\xdd[ܝ'\xfaYr\xa7{\xc3\xf3.\x9fi\xd9\xfc\xe8\xb9;\a\xc2\xd8\"\xa3\x97啵\x17\xf4@/6\xecQ0_\x9alh\xa2*w\x83\x83\xc4U\x18\x91G\u0087\xd5C\xe0-\xf9M\xa5\xf4T\xa5l\xe2J\x1d\x8f\xabW\xf9\uf2bd\xaf\x9f\xdf\x7f\xae\u1775\x10\xa4C\x86\x14\xb1Mn.˃3\xf65\xa8\x8a\xbc\x86D\xf6\xe7\x7f\x93Đ\x895\xee\x05\x89T\x8d\xa0v\xa7ׅ\xec\x93\xe6\xed\xaeP\x18\x18T\x8d\xb52\xfa\x89\xfa\"&\xf6\t\x9f\xd6!84\xe7u\xaa\x9aN\x8c'瓾\x95\xd6\xde\xf7\xf4$\xc0\xb7j\xcfS՛\xa1*\xb6\x8d\x84\x9e\x9a\x93ճ(ԋ'\xf3p;-S-\xd1\x1c\xcc\xdb\xe6Z*W\xa7|\x912\x1b\\^\xf1\xf7\x02#\x97\x03\xaf\x1e\r,^\x10u\x14#\xe9\xa4\xc1_\xa2\xffy\xdb\x14\xe7z:\x03\x9a\xc4\xda\x13\x13\xe6\x11$h\xb0\xff\xd1\x190t&\xe239\xbfl\xe1Vw\xce48j\xb1\xd95\x0e\v \x84\xf6\f\xf2;\x8f-}ѧ\xfeܷ\nލ\x86\x9cY;\xbc0\xf7\xbb7Wg\xaf\x92\x7f\x91ϳ\xc1\x88<\xa2\xadA8\x15\xcbS\x95\xd5 \x9cp\xf1\xcf\x00\xb7\xb0(y\xe0\r\x00\x00"),
 }
 
 var CRDs = crds()
@@ -17,6 +17,7 @@ limitations under the License.
 package credentials
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -62,7 +63,9 @@ func NewNamespacedFileStore(client kbclient.Client, namespace string, fsRoot str
 }
 
 // Path returns a path on disk where the secret key defined by
-// the given selector is serialized.
+// the given selector is serialized. The file is only rewritten when
+// the secret's data changed, in which case the rotation is recorded
+// so that consumers of the file can pick up the new credentials.
 func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (string, error) {
 	creds, err := kube.GetSecretKey(n.client, n.namespace, selector)
 	if err != nil {
@@ -71,6 +74,12 @@ func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (strin
 
 	keyFilePath := filepath.Join(n.fsRoot, fmt.Sprintf("%s-%s", selector.Name, selector.Key))
 
+	existing, err := n.fs.ReadFile(keyFilePath)
+	rotated := err == nil && !bytes.Equal(existing, creds)
+	if err == nil && !rotated {
+		return keyFilePath, nil
+	}
+
 	file, err := n.fs.OpenFile(keyFilePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to open credentials file for writing")
@@ -84,5 +93,9 @@ func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (strin
 		return "", errors.Wrap(err, "unable to close credentials file")
 	}
 
+	if rotated {
+		NotifyRotated(keyFilePath)
+	}
+
 	return keyFilePath, nil
 }
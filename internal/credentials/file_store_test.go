@@ -91,3 +91,36 @@ func TestNamespacedFileStore(t *testing.T) {
 		})
 	}
 }
+
+func TestNamespacedFileStoreRotation(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t)
+	secret := builder.ForSecret("ns1", "rotated").Data(map[string][]byte{"key": []byte("old")}).Result()
+	require.NoError(t, client.Create(context.Background(), secret))
+
+	fs := velerotest.NewFakeFileSystem()
+	fileStore, err := NewNamespacedFileStore(client, "ns1", "/tmp/credentials", fs)
+	require.NoError(t, err)
+
+	selector := builder.ForSecretKeySelector("rotated", "key").Result()
+
+	// the first serialization is not a rotation
+	path, err := fileStore.Path(selector)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), Generation(path))
+
+	// unchanged data is not a rotation either
+	_, err = fileStore.Path(selector)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), Generation(path))
+
+	secret.Data["key"] = []byte("new")
+	require.NoError(t, client.Update(context.Background(), secret))
+
+	_, err = fileStore.Path(selector)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), Generation(path))
+
+	contents, err := fs.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), contents)
+}
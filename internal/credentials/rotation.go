@@ -0,0 +1,49 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import "sync"
+
+// FileConfigKey is the key under which the path of a serialized
+// credentials file is passed in a plugin's config.
+const FileConfigKey = "credentialsFile"
+
+// rotations tracks how many times the credentials serialized at a given
+// path have changed since the process started. Consumers that hand a
+// credentials file to something long-lived (e.g. a plugin instance) record
+// the generation they were initialized with and reinitialize once it moves.
+var rotations = struct {
+	sync.RWMutex
+	generations map[string]uint64
+}{generations: map[string]uint64{}}
+
+// NotifyRotated records that the credentials serialized at path have changed.
+func NotifyRotated(path string) {
+	rotations.Lock()
+	defer rotations.Unlock()
+
+	rotations.generations[path]++
+}
+
+// Generation returns the number of times the credentials serialized at path
+// have changed since the process started.
+func Generation(path string) uint64 {
+	rotations.RLock()
+	defer rotations.RUnlock()
+
+	return rotations.generations[path]
+}
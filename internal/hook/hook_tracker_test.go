@@ -39,7 +39,7 @@ func TestHookTracker_Add(t *testing.T) {
 		podNamespace: "ns1",
 		podName:      "pod1",
 		container:    "container1",
-		hookPhase:    "",
+		HookPhase:    "",
 		hookSource:   HookSourceAnnotation,
 		hookName:     "h1",
 	}
@@ -57,7 +57,7 @@ func TestHookTracker_Record(t *testing.T) {
 		podNamespace: "ns1",
 		podName:      "pod1",
 		container:    "container1",
-		hookPhase:    "",
+		HookPhase:    "",
 		hookSource:   HookSourceAnnotation,
 		hookName:     "h1",
 	}
@@ -115,7 +115,7 @@ func TestMultiHookTracker_Add(t *testing.T) {
 		podNamespace: "ns1",
 		podName:      "pod1",
 		container:    "container1",
-		hookPhase:    "",
+		HookPhase:    "",
 		hookSource:   HookSourceAnnotation,
 		hookName:     "h1",
 	}
@@ -133,7 +133,7 @@ func TestMultiHookTracker_Record(t *testing.T) {
 		podNamespace: "ns1",
 		podName:      "pod1",
 		container:    "container1",
-		hookPhase:    "",
+		HookPhase:    "",
 		hookSource:   HookSourceAnnotation,
 		hookName:     "h1",
 	}
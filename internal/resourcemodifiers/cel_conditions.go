@@ -0,0 +1,102 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+
+	// celPrograms caches the compiled programs by expression, as the same
+	// conditions are evaluated against every item of a backup or restore
+	celPrograms sync.Map
+)
+
+// getCELEnv returns the environment of the CEL expressions. The namespace of the object is
+// exposed as sourceNamespace because "namespace" is a reserved word in CEL.
+func getCELEnv() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(
+			cel.Variable("object", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("name", cel.StringType),
+			cel.Variable("sourceNamespace", cel.StringType),
+			cel.Variable("targetNamespace", cel.StringType),
+			cel.Variable("backupName", cel.StringType),
+			cel.Variable("restoreName", cel.StringType),
+		)
+	})
+	return celEnv, celEnvErr
+}
+
+// compileCELExpression compiles the expression, which should evaluate to a bool.
+func compileCELExpression(expression string) (cel.Program, error) {
+	if prg, ok := celPrograms.Load(expression); ok {
+		return prg.(cel.Program), nil
+	}
+
+	env, err := getCELEnv()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating CEL environment")
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, errors.Wrapf(issues.Err(), "error compiling CEL expression %q", expression)
+	}
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, errors.Errorf("CEL expression %q should evaluate to a bool, not %s", expression, outputType)
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating program of CEL expression %q", expression)
+	}
+	celPrograms.Store(expression, prg)
+
+	return prg, nil
+}
+
+// matchCELExpressions returns whether all the expressions evaluate to true. An expression
+// failing to evaluate, e.g. for referring to a field the object doesn't have, doesn't match.
+func matchCELExpressions(expressions []string, vars map[string]interface{}, log logrus.FieldLogger) (bool, error) {
+	for _, expression := range expressions {
+		prg, err := compileCELExpression(expression)
+		if err != nil {
+			return false, err
+		}
+
+		out, _, err := prg.Eval(vars)
+		if err != nil {
+			log.Debugf("Failed to evaluate CEL expression %q, treat it as not matched: %v", expression, err)
+			return false, nil
+		}
+
+		if matched, ok := out.Value().(bool); !ok || !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -16,9 +16,15 @@ type JSONPatch struct {
 	From      string `json:"from,omitempty"`
 	Path      string `json:"path"`
 	Value     string `json:"value,omitempty"`
+
+	// renderedValue is set when the Value is rendered from a template into a JSON value
+	renderedValue bool
 }
 
 func (p *JSONPatch) ToString() string {
+	if p.renderedValue {
+		return fmt.Sprintf(`{"op": "%s", "from": "%s", "path": "%s", "value": %s}`, p.Operation, p.From, p.Path, p.Value)
+	}
 	if addQuotes(&p.Value) {
 		return fmt.Sprintf(`{"op": "%s", "from": "%s", "path": "%s", "value": "%s"}`, p.Operation, p.From, p.Path, p.Value)
 	}
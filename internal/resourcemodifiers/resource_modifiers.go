@@ -49,6 +49,8 @@ type Conditions struct {
 	ResourceNameRegex string                `json:"resourceNameRegex,omitempty"`
 	LabelSelector     *metav1.LabelSelector `json:"labelSelector,omitempty"`
 	Matches           []MatchRule           `json:"matches,omitempty"`
+	// CELExpressions are CEL expressions which should all evaluate to true for the object to match
+	CELExpressions []string `json:"celExpressions,omitempty"`
 }
 
 type ResourceModifierRule struct {
@@ -63,6 +65,37 @@ type ResourceModifiers struct {
 	ResourceModifierRules []ResourceModifierRule `json:"resourceModifierRules"`
 }
 
+// Context carries the information of the operation the resource modifiers are applied in,
+// it's exposed to the CEL conditions and the templated patch values.
+type Context struct {
+	BackupName string
+	// RestoreName is empty when the resource modifiers are applied at backup time
+	RestoreName string
+	// TargetNamespace is the namespace the object is restored into, it's the
+	// namespace of the object if not set
+	TargetNamespace string
+}
+
+// variables returns the variables of the object available to the CEL conditions and templates.
+func (c *Context) variables(obj *unstructured.Unstructured) map[string]interface{} {
+	vars := map[string]interface{}{
+		"object":          obj.Object,
+		"name":            obj.GetName(),
+		"sourceNamespace": obj.GetNamespace(),
+		"targetNamespace": obj.GetNamespace(),
+		"backupName":      "",
+		"restoreName":     "",
+	}
+	if c != nil {
+		if c.TargetNamespace != "" {
+			vars["targetNamespace"] = c.TargetNamespace
+		}
+		vars["backupName"] = c.BackupName
+		vars["restoreName"] = c.RestoreName
+	}
+	return vars
+}
+
 func GetResourceModifiersFromConfig(cm *v1.ConfigMap) (*ResourceModifiers, error) {
 	if cm == nil {
 		return nil, fmt.Errorf("could not parse config from nil configmap")
@@ -84,15 +117,23 @@ func GetResourceModifiersFromConfig(cm *v1.ConfigMap) (*ResourceModifiers, error
 	return resModifiers, nil
 }
 
-func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, log logrus.FieldLogger) []error {
+func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, modCtx *Context, log logrus.FieldLogger) []error {
+	_, errs := p.ApplyResourceModifierRulesAndGetApplied(obj, groupResource, scheme, modCtx, log)
+	return errs
+}
+
+// ApplyResourceModifierRulesAndGetApplied applies the rules matching the object like
+// ApplyResourceModifierRules, and also returns the indexes of the rules applied to the object.
+func (p *ResourceModifiers) ApplyResourceModifierRulesAndGetApplied(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, modCtx *Context, log logrus.FieldLogger) ([]int, []error) {
+	var applied []int
 	var errs []error
 	origin := obj
 	// If there are more than one rules, we need to keep the original object for condition matching
 	if len(p.ResourceModifierRules) > 1 {
 		origin = obj.DeepCopy()
 	}
-	for _, rule := range p.ResourceModifierRules {
-		matched, err := rule.match(origin, groupResource, log)
+	for i, rule := range p.ResourceModifierRules {
+		matched, err := rule.match(origin, groupResource, modCtx, log)
 		if err != nil {
 			errs = append(errs, err)
 			continue
@@ -101,27 +142,35 @@ func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstruc
 		}
 
 		log.Infof("Applying resource modifier patch on %s/%s", origin.GetNamespace(), origin.GetName())
-		err = rule.applyPatch(obj, scheme, log)
+		err = rule.applyPatch(obj, origin, scheme, modCtx, log)
 		if err != nil {
 			errs = append(errs, err)
+			continue
 		}
+		applied = append(applied, i)
 	}
 
-	return errs
+	return applied, errs
 }
 
-func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) (bool, error) {
+func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResource string, modCtx *Context, log logrus.FieldLogger) (bool, error) {
+	return r.Conditions.Match(obj, groupResource, modCtx, log)
+}
+
+// Match returns whether the object of the group resource matches all the conditions.
+// The context is optional and only used by the CEL expressions.
+func (c *Conditions) Match(obj *unstructured.Unstructured, groupResource string, modCtx *Context, log logrus.FieldLogger) (bool, error) {
 	ns := obj.GetNamespace()
 	if ns != "" {
-		namespaceInclusion := collections.NewIncludesExcludes().Includes(r.Conditions.Namespaces...)
+		namespaceInclusion := collections.NewIncludesExcludes().Includes(c.Namespaces...)
 		if !namespaceInclusion.ShouldInclude(ns) {
 			return false, nil
 		}
 	}
 
-	g, err := glob.Compile(r.Conditions.GroupResource, '.')
+	g, err := glob.Compile(c.GroupResource, '.')
 	if err != nil {
-		log.Errorf("Bad glob pattern of groupResource in condition, groupResource: %s, err: %s", r.Conditions.GroupResource, err)
+		log.Errorf("Bad glob pattern of groupResource in condition, groupResource: %s, err: %s", c.GroupResource, err)
 		return false, err
 	}
 
@@ -129,8 +178,8 @@ func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResour
 		return false, nil
 	}
 
-	if r.Conditions.ResourceNameRegex != "" {
-		match, err := regexp.MatchString(r.Conditions.ResourceNameRegex, obj.GetName())
+	if c.ResourceNameRegex != "" {
+		match, err := regexp.MatchString(c.ResourceNameRegex, obj.GetName())
 		if err != nil {
 			return false, errors.Errorf("error in matching regex %s", err.Error())
 		}
@@ -139,8 +188,8 @@ func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResour
 		}
 	}
 
-	if r.Conditions.LabelSelector != nil {
-		selector, err := metav1.LabelSelectorAsSelector(r.Conditions.LabelSelector)
+	if c.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(c.LabelSelector)
 		if err != nil {
 			return false, errors.Errorf("error in creating label selector %s", err.Error())
 		}
@@ -149,7 +198,7 @@ func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResour
 		}
 	}
 
-	match, err := matchConditions(obj, r.Conditions.Matches, log)
+	match, err := matchConditions(obj, c.Matches, log)
 	if err != nil {
 		return false, err
 	} else if !match {
@@ -157,6 +206,15 @@ func (r *ResourceModifierRule) match(obj *unstructured.Unstructured, groupResour
 		return false, nil
 	}
 
+	if len(c.CELExpressions) > 0 {
+		match, err := matchCELExpressions(c.CELExpressions, modCtx.variables(obj), log)
+		if err != nil {
+			return false, err
+		} else if !match {
+			return false, nil
+		}
+	}
+
 	return true, nil
 }
 
@@ -203,14 +261,48 @@ type patcher interface {
 	Patch(u *unstructured.Unstructured, logger logrus.FieldLogger) (*unstructured.Unstructured, error)
 }
 
-func (r *ResourceModifierRule) applyPatch(u *unstructured.Unstructured, scheme *runtime.Scheme, logger logrus.FieldLogger) error {
+// applyPatch applies the patches of the rule on u, the templated values of the patches
+// are rendered against the origin object which the conditions are matched on.
+func (r *ResourceModifierRule) applyPatch(u, origin *unstructured.Unstructured, scheme *runtime.Scheme, modCtx *Context, logger logrus.FieldLogger) error {
+	render := func(value string) (string, error) {
+		return renderTemplate(value, origin, modCtx.variables(origin))
+	}
+
 	var p patcher
 	if len(r.Patches) > 0 {
-		p = &JSONPatcher{patches: r.Patches}
+		patches := make([]JSONPatch, len(r.Patches))
+		for i, patch := range r.Patches {
+			if isTemplate(patch.Value) {
+				value, err := renderJSONValue(patch.Value, origin, modCtx.variables(origin))
+				if err != nil {
+					return err
+				}
+				patch.Value = value
+				patch.renderedValue = true
+			}
+			patches[i] = patch
+		}
+		p = &JSONPatcher{patches: patches}
 	} else if len(r.MergePatches) > 0 {
-		p = &JSONMergePatcher{patches: r.MergePatches}
+		patches := make([]JSONMergePatch, len(r.MergePatches))
+		for i, patch := range r.MergePatches {
+			data, err := render(patch.PatchData)
+			if err != nil {
+				return err
+			}
+			patches[i] = JSONMergePatch{PatchData: data}
+		}
+		p = &JSONMergePatcher{patches: patches}
 	} else if len(r.StrategicPatches) > 0 {
-		p = &StrategicMergePatcher{patches: r.StrategicPatches, scheme: scheme}
+		patches := make([]StrategicMergePatch, len(r.StrategicPatches))
+		for i, patch := range r.StrategicPatches {
+			data, err := render(patch.PatchData)
+			if err != nil {
+				return err
+			}
+			patches[i] = StrategicMergePatch{PatchData: data}
+		}
+		p = &StrategicMergePatcher{patches: patches, scheme: scheme}
 	} else {
 		return fmt.Errorf("no patch data found")
 	}
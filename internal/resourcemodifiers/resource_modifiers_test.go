@@ -1144,7 +1144,7 @@ func TestResourceModifiers_ApplyResourceModifierRules(t *testing.T) {
 				Version:               tt.fields.Version,
 				ResourceModifierRules: tt.fields.ResourceModifierRules,
 			}
-			got := p.ApplyResourceModifierRules(tt.args.obj, tt.args.groupResource, nil, logrus.New())
+			got := p.ApplyResourceModifierRules(tt.args.obj, tt.args.groupResource, nil, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.args.obj)
@@ -1456,7 +1456,7 @@ func TestResourceModifiers_ApplyResourceModifierRules_StrategicMergePatch(t *tes
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, scheme, logrus.New())
+			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, scheme, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.obj)
@@ -1607,7 +1607,7 @@ func TestResourceModifiers_ApplyResourceModifierRules_JSONMergePatch(t *testing.
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
+			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.obj)
@@ -1683,7 +1683,7 @@ func TestResourceModifiers_wildcard_in_GroupResource(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
+			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.obj)
@@ -1771,7 +1771,7 @@ func TestResourceModifiers_conditional_patches(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
+			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.obj)
@@ -1779,6 +1779,292 @@ func TestResourceModifiers_conditional_patches(t *testing.T) {
 	}
 }
 
+var deployYAMLWithReplicas3 = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: fake
+  labels:
+    app: web
+spec:
+  replicas: 3
+`
+
+func TestResourceModifiers_CELExpressions(t *testing.T) {
+	unstructuredSerializer := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+	o, _, err := unstructuredSerializer.Decode([]byte(deployYAMLWithReplicas3), nil, nil)
+	assert.NoError(t, err)
+	deploy := o.(*unstructured.Unstructured)
+
+	tests := []struct {
+		name        string
+		expressions []string
+		modCtx      *Context
+		wantErr     bool
+		wantReplica int64
+	}{
+		{
+			name:        "expression on object matches",
+			expressions: []string{"object.spec.replicas > 1"},
+			wantReplica: 1,
+		},
+		{
+			name:        "expression on object doesn't match",
+			expressions: []string{"object.spec.replicas > 5"},
+			wantReplica: 3,
+		},
+		{
+			name:        "all expressions should match",
+			expressions: []string{"object.spec.replicas > 1", `object.metadata.labels.app == "db"`},
+			wantReplica: 3,
+		},
+		{
+			name:        "expression on missing field doesn't match",
+			expressions: []string{"object.spec.paused == false"},
+			wantReplica: 3,
+		},
+		{
+			name:        "expression on context matches",
+			expressions: []string{`backupName == "backup-1" && targetNamespace == "new" && sourceNamespace == "fake"`},
+			modCtx:      &Context{BackupName: "backup-1", RestoreName: "restore-1", TargetNamespace: "new"},
+			wantReplica: 1,
+		},
+		{
+			name:        "target namespace defaults to the namespace of the object",
+			expressions: []string{`targetNamespace == "fake"`},
+			wantReplica: 1,
+		},
+		{
+			name:        "invalid expression",
+			expressions: []string{"object.spec.replicas >"},
+			wantErr:     true,
+			wantReplica: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := &ResourceModifiers{
+				Version: "v1",
+				ResourceModifierRules: []ResourceModifierRule{
+					{
+						Conditions: Conditions{
+							GroupResource:  "deployments.apps",
+							CELExpressions: tt.expressions,
+						},
+						Patches: []JSONPatch{
+							{
+								Operation: "replace",
+								Path:      "/spec/replicas",
+								Value:     "1",
+							},
+						},
+					},
+				},
+			}
+			obj := deploy.DeepCopy()
+
+			got := rm.ApplyResourceModifierRules(obj, "deployments.apps", nil, tt.modCtx, logrus.New())
+
+			assert.Equal(t, tt.wantErr, len(got) > 0)
+			replicas, _, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantReplica, replicas)
+		})
+	}
+}
+
+func TestResourceModifiers_templated_values(t *testing.T) {
+	unstructuredSerializer := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+	o, _, err := unstructuredSerializer.Decode([]byte(deployYAMLWithReplicas3), nil, nil)
+	assert.NoError(t, err)
+	deploy := o.(*unstructured.Unstructured)
+	// the value of the annotation tries to break out of the patch data it's rendered into
+	note := "say \"hi\", \"injected\": \"true\"\nbye"
+	deploy.SetAnnotations(map[string]string{"note": note})
+
+	modCtx := &Context{BackupName: "backup-1", RestoreName: "restore-1", TargetNamespace: "new"}
+
+	tests := []struct {
+		name            string
+		rule            ResourceModifierRule
+		wantErr         bool
+		want            map[string]interface{}
+		wantAnnotations map[string]string
+	}{
+		{
+			name: "JSON patch with arithmetic on a field",
+			rule: ResourceModifierRule{
+				Patches: []JSONPatch{
+					{
+						Operation: "replace",
+						Path:      "/spec/replicas",
+						Value:     `{{ sub (field "/spec/replicas") 1 }}`,
+					},
+				},
+			},
+			want: map[string]interface{}{"replicas": int64(2)},
+		},
+		{
+			name: "JSON patch with variables",
+			rule: ResourceModifierRule{
+				Patches: []JSONPatch{
+					{
+						Operation: "add",
+						Path:      "/metadata/labels/restored-by",
+						Value:     `{{ .restoreName }}-{{ upper .targetNamespace }}`,
+					},
+				},
+			},
+			want: map[string]interface{}{"restored-by": "restore-1-NEW"},
+		},
+		{
+			name: "merge patch with object fields",
+			rule: ResourceModifierRule{
+				MergePatches: []JSONMergePatch{
+					{
+						PatchData: `{"metadata":{"labels":{"origin":"{{ .sourceNamespace }}.{{ .object.metadata.name }}","backup":"{{ replace "-" "_" .backupName }}"}}}`,
+					},
+				},
+			},
+			want: map[string]interface{}{"origin": "fake.web", "backup": "backup_1"},
+		},
+		{
+			name: "strategic merge patch with arithmetic",
+			rule: ResourceModifierRule{
+				StrategicPatches: []StrategicMergePatch{
+					{
+						PatchData: `{"spec":{"replicas":{{ mul (field "/spec/replicas") 2 }}}}`,
+					},
+				},
+			},
+			want: map[string]interface{}{"replicas": int64(6)},
+		},
+		{
+			name: "JSON patch with a field with quotes and newlines",
+			rule: ResourceModifierRule{
+				Patches: []JSONPatch{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations/copy",
+						Value:     `{{ field "/metadata/annotations/note" }}`,
+					},
+				},
+			},
+			wantAnnotations: map[string]string{"note": note, "copy": note},
+		},
+		{
+			name: "JSON patch with text and a field with quotes and newlines",
+			rule: ResourceModifierRule{
+				Patches: []JSONPatch{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations/copy",
+						Value:     `note: {{ field "/metadata/annotations/note" }}`,
+					},
+				},
+			},
+			wantAnnotations: map[string]string{"note": note, "copy": "note: " + note},
+		},
+		{
+			name: "quoted JSON patch with a number field is a string",
+			rule: ResourceModifierRule{
+				Patches: []JSONPatch{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations/copy",
+						Value:     `"{{ field "/spec/replicas" }}"`,
+					},
+				},
+			},
+			wantAnnotations: map[string]string{"note": note, "copy": "3"},
+		},
+		{
+			name: "merge patch with a field with quotes and newlines in a string",
+			rule: ResourceModifierRule{
+				MergePatches: []JSONMergePatch{
+					{
+						PatchData: `{"metadata":{"annotations":{"copy":"note: {{ field "/metadata/annotations/note" }}"}}}`,
+					},
+				},
+			},
+			wantAnnotations: map[string]string{"note": note, "copy": "note: " + note},
+		},
+		{
+			name: "YAML strategic merge patch with a field with quotes and newlines",
+			rule: ResourceModifierRule{
+				StrategicPatches: []StrategicMergePatch{
+					{
+						PatchData: "metadata:\n  annotations:\n    copy: {{ field \"/metadata/annotations/note\" }}\n",
+					},
+				},
+			},
+			wantAnnotations: map[string]string{"note": note, "copy": note},
+		},
+		{
+			name: "missing field fails",
+			rule: ResourceModifierRule{
+				Patches: []JSONPatch{
+					{
+						Operation: "replace",
+						Path:      "/spec/replicas",
+						Value:     `{{ field "/spec/paused" }}`,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing variable fails",
+			rule: ResourceModifierRule{
+				Patches: []JSONPatch{
+					{
+						Operation: "add",
+						Path:      "/metadata/labels/a",
+						Value:     `{{ .unknown }}`,
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Conditions = Conditions{GroupResource: "deployments.apps"}
+			rm := &ResourceModifiers{
+				Version:               "v1",
+				ResourceModifierRules: []ResourceModifierRule{tt.rule},
+			}
+			obj := deploy.DeepCopy()
+
+			got := rm.ApplyResourceModifierRules(obj, "deployments.apps", scheme, modCtx, logrus.New())
+
+			assert.Equal(t, tt.wantErr, len(got) > 0)
+			if tt.wantErr {
+				assert.Equal(t, *deploy, *obj)
+				return
+			}
+			for k, v := range tt.want {
+				if k == "replicas" {
+					replicas, _, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+					assert.NoError(t, err)
+					assert.Equal(t, v, replicas)
+					continue
+				}
+				assert.Equal(t, v, obj.GetLabels()[k])
+			}
+			if tt.wantAnnotations != nil {
+				assert.Equal(t, tt.wantAnnotations, obj.GetAnnotations())
+			}
+		})
+	}
+}
+
 func TestJSONPatch_ToString(t *testing.T) {
 	type fields struct {
 		Operation string
@@ -43,6 +43,19 @@ func (r *ResourceModifierRule) Validate() error {
 		if err := patch.Validate(); err != nil {
 			return err
 		}
+		if err := validateTemplate(patch.Value); err != nil {
+			return err
+		}
+	}
+	for _, patch := range r.MergePatches {
+		if err := validateTemplate(patch.PatchData); err != nil {
+			return err
+		}
+	}
+	for _, patch := range r.StrategicPatches {
+		if err := validateTemplate(patch.PatchData); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -80,5 +93,10 @@ func (c *Conditions) Validate() error {
 	if c.GroupResource == "" {
 		return fmt.Errorf("groupkResource cannot be empty")
 	}
+	for _, expression := range c.CELExpressions {
+		if _, err := compileCELExpression(expression); err != nil {
+			return err
+		}
+	}
 	return nil
 }
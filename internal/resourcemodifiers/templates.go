@@ -0,0 +1,316 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	templateDelimiter = "{{"
+
+	jsonFunc       = "_json"
+	jsonStringFunc = "_jsonString"
+)
+
+// isTemplate returns whether the value of a patch should be rendered as a template.
+func isTemplate(value string) bool {
+	return strings.Contains(value, templateDelimiter)
+}
+
+func templateFuncs(obj *unstructured.Unstructured) template.FuncMap {
+	return template.FuncMap{
+		// field returns the value of the object located by the JSON pointer, e.g. "/spec/replicas"
+		"field": func(path string) (interface{}, error) {
+			if obj == nil {
+				return nil, errors.Errorf("no object to get field %s from", path)
+			}
+			return getFieldByPointer(obj.Object, path)
+		},
+		"add":     func(a, b interface{}) (interface{}, error) { return arithmetic(a, b, "add") },
+		"sub":     func(a, b interface{}) (interface{}, error) { return arithmetic(a, b, "sub") },
+		"mul":     func(a, b interface{}) (interface{}, error) { return arithmetic(a, b, "mul") },
+		"lower":   strings.ToLower,
+		"upper":   strings.ToUpper,
+		"replace": func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+		// the escaping functions appended to the actions of the templates
+		jsonFunc:       toJSON,
+		jsonStringFunc: toJSONString,
+	}
+}
+
+func parseTemplate(value string, obj *unstructured.Unstructured) (*template.Template, error) {
+	tmpl, err := template.New("value").Option("missingkey=error").Funcs(templateFuncs(obj)).Parse(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing template %q", value)
+	}
+	return tmpl, nil
+}
+
+// validateTemplate checks the value is a valid template if it is one.
+func validateTemplate(value string) error {
+	if !isTemplate(value) {
+		return nil
+	}
+	_, err := parseTemplate(value, nil)
+	return err
+}
+
+// renderTemplate renders the patch data against the object and the variables when it is a
+// template, otherwise the patch data is returned unchanged. The values of the actions are
+// escaped, so they can't break out of the JSON or YAML patch data: the values in double-quoted
+// strings are escaped as string content, the others are JSON encoded, e.g. a string value is
+// rendered quoted and a number unquoted.
+func renderTemplate(value string, obj *unstructured.Unstructured, vars map[string]interface{}) (string, error) {
+	if !isTemplate(value) {
+		return value, nil
+	}
+
+	tmpl, err := parseTemplate(value, obj)
+	if err != nil {
+		return "", err
+	}
+	escapeActions(tmpl.Tree)
+
+	return executeTemplate(tmpl, value, vars)
+}
+
+// renderJSONValue renders the templated value of a JSON patch into a JSON value. The value
+// which is a single action is the JSON encoding of the result of the action, e.g. a number for
+// `{{ sub (field "/spec/replicas") 1 }}`, any other value, or a value wrapped in quotes, is
+// rendered into a string.
+func renderJSONValue(value string, obj *unstructured.Unstructured, vars map[string]interface{}) (string, error) {
+	quoted := len(value) > 1 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`)
+	if quoted {
+		value = value[1 : len(value)-1]
+	}
+
+	tmpl, err := parseTemplate(value, obj)
+	if err != nil {
+		return "", err
+	}
+
+	if nodes := tmpl.Tree.Root.Nodes; !quoted && len(nodes) == 1 {
+		if action, ok := nodes[0].(*parse.ActionNode); ok && len(action.Pipe.Decl) == 0 {
+			appendEscapeFunc(tmpl.Tree, action.Pipe, jsonFunc)
+			return executeTemplate(tmpl, value, vars)
+		}
+	}
+
+	rendered, err := executeTemplate(tmpl, value, vars)
+	if err != nil {
+		return "", err
+	}
+	return escapeJSON(rendered), nil
+}
+
+func executeTemplate(tmpl *template.Template, value string, vars map[string]interface{}) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, vars); err != nil {
+		return "", errors.Wrapf(err, "error rendering template %q", value)
+	}
+	return buf.String(), nil
+}
+
+// escapeActions appends the escaping function to the pipeline of every action printing a
+// value, depending on whether the action is in a double-quoted string of the text around it.
+func escapeActions(tree *parse.Tree) {
+	inString := false
+	var walk func(list *parse.ListNode)
+	walk = func(list *parse.ListNode) {
+		if list == nil {
+			return
+		}
+		for _, node := range list.Nodes {
+			switch n := node.(type) {
+			case *parse.TextNode:
+				inString = scanQuotes(n.Text, inString)
+			case *parse.ActionNode:
+				// the actions declaring variables print nothing
+				if len(n.Pipe.Decl) > 0 {
+					continue
+				}
+				if inString {
+					appendEscapeFunc(tree, n.Pipe, jsonStringFunc)
+				} else {
+					appendEscapeFunc(tree, n.Pipe, jsonFunc)
+				}
+			case *parse.IfNode:
+				walk(n.List)
+				walk(n.ElseList)
+			case *parse.RangeNode:
+				walk(n.List)
+				walk(n.ElseList)
+			case *parse.WithNode:
+				walk(n.List)
+				walk(n.ElseList)
+			}
+		}
+	}
+	walk(tree.Root)
+}
+
+// scanQuotes returns whether the end of the text is in a double-quoted string.
+func scanQuotes(text []byte, inString bool) bool {
+	for i := 0; i < len(text); i++ {
+		switch {
+		case inString && text[i] == '\\':
+			i++
+		case text[i] == '"':
+			inString = !inString
+		}
+	}
+	return inString
+}
+
+func appendEscapeFunc(tree *parse.Tree, pipe *parse.PipeNode, name string) {
+	pipe.Cmds = append(pipe.Cmds, &parse.CommandNode{
+		NodeType: parse.NodeCommand,
+		Pos:      pipe.Pos,
+		Args:     []parse.Node{parse.NewIdentifier(name).SetTree(tree).SetPos(pipe.Pos)},
+	})
+}
+
+// toJSON returns the JSON encoding of the value.
+func toJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return escapeJSON(fmt.Sprint(value))
+	}
+	return string(data)
+}
+
+// toJSONString returns the value escaped as the content of a JSON string.
+func toJSONString(value interface{}) string {
+	str, ok := value.(string)
+	if !ok {
+		str = fmt.Sprint(value)
+	}
+	escaped := escapeJSON(str)
+	return escaped[1 : len(escaped)-1]
+}
+
+// escapeJSON returns the string encoded as a JSON string.
+func escapeJSON(str string) string {
+	data, _ := json.Marshal(str)
+	return string(data)
+}
+
+// getFieldByPointer returns the value located by the JSON pointer (RFC 6901) in the object.
+func getFieldByPointer(obj map[string]interface{}, pointer string) (interface{}, error) {
+	if pointer == "" || pointer == "/" {
+		return obj, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, errors.Errorf("invalid JSON pointer %s", pointer)
+	}
+
+	var current interface{} = obj
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := current.(type) {
+		case map[string]interface{}:
+			value, ok := v[token]
+			if !ok {
+				return nil, errors.Errorf("field %s not found", pointer)
+			}
+			current = value
+		case []interface{}:
+			var index int
+			if _, err := fmt.Sscanf(token, "%d", &index); err != nil || index < 0 || index >= len(v) {
+				return nil, errors.Errorf("invalid index %s in %s", token, pointer)
+			}
+			current = v[index]
+		default:
+			return nil, errors.Errorf("field %s not found", pointer)
+		}
+	}
+
+	return current, nil
+}
+
+// arithmetic applies the operation on the numbers, the result is an integer if both
+// are integers, otherwise a float.
+func arithmetic(a, b interface{}, op string) (interface{}, error) {
+	ai, aIsInt := toInt64(a)
+	bi, bIsInt := toInt64(b)
+	if aIsInt && bIsInt {
+		switch op {
+		case "add":
+			return ai + bi, nil
+		case "sub":
+			return ai - bi, nil
+		default:
+			return ai * bi, nil
+		}
+	}
+
+	af, ok := toFloat64(a)
+	if !ok {
+		return nil, errors.Errorf("%v is not a number", a)
+	}
+	bf, ok := toFloat64(b)
+	if !ok {
+		return nil, errors.Errorf("%v is not a number", b)
+	}
+	switch op {
+	case "add":
+		return af + bf, nil
+	case "sub":
+		return af - bf, nil
+	default:
+		return af * bf, nil
+	}
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case float64:
+		if n == float64(int64(n)) {
+			return int64(n), true
+		}
+	}
+	return 0, false
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
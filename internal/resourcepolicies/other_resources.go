@@ -0,0 +1,237 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+)
+
+type ResourceActionType string
+
+const (
+	// ResourceSkip implies the matched resources would be skipped from the backup
+	ResourceSkip ResourceActionType = "skip"
+	// ResourceInclude implies the matched resources would be backed up, regardless of the later policies
+	ResourceInclude ResourceActionType = "include"
+)
+
+// ResourceAction defined the action for the matched resources
+type ResourceAction struct {
+	Type ResourceActionType `yaml:"type"`
+}
+
+// ResourcePolicy defined policy to conditions to match non-volume resources and related action to handle matched resources
+type ResourcePolicy struct {
+	// Conditions defined the conditions to match resources, in the same format as the conditions of resource modifiers,
+	// plus the optional exceptNewest condition
+	Conditions map[string]interface{} `yaml:"conditions"`
+	Action     ResourceAction         `yaml:"action"`
+}
+
+// resourceConditions defined the current format of resource conditions we parsed
+type resourceConditions struct {
+	resourcemodifiers.Conditions
+	ExceptNewest *exceptNewest `json:"exceptNewest,omitempty"`
+}
+
+// exceptNewest excludes the newest items by creation timestamp from the matched items
+type exceptNewest struct {
+	// Count is the number of the newest items excluded
+	Count int `json:"count"`
+	// GroupByLabel ranks the items by groups of the same value of the label, e.g. the
+	// "name" label of the Helm release Secrets, otherwise by namespace
+	GroupByLabel string `json:"groupByLabel,omitempty"`
+}
+
+type resPolicy struct {
+	action     ResourceAction
+	conditions resourceConditions
+}
+
+// unmarshalResourceConditions parse map[string]interface{} into resourceConditions format
+// and validate key fields of the map.
+func unmarshalResourceConditions(con map[string]interface{}) (*resourceConditions, error) {
+	data, err := json.Marshal(con)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode resource conditions")
+	}
+
+	conditions := &resourceConditions{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(conditions); err != nil {
+		return nil, errors.Wrap(err, "failed to decode resource conditions")
+	}
+	return conditions, nil
+}
+
+func (r *resPolicy) validate() error {
+	if r.action.Type != ResourceSkip && r.action.Type != ResourceInclude {
+		return fmt.Errorf("invalid resource action type %s", r.action.Type)
+	}
+
+	if err := r.conditions.Validate(); err != nil {
+		return err
+	}
+	if _, err := glob.Compile(r.conditions.GroupResource, '.'); err != nil {
+		return errors.Wrapf(err, "invalid groupResource %s", r.conditions.GroupResource)
+	}
+	if r.conditions.ResourceNameRegex != "" {
+		if _, err := regexp.Compile(r.conditions.ResourceNameRegex); err != nil {
+			return errors.Wrapf(err, "invalid resourceNameRegex %s", r.conditions.ResourceNameRegex)
+		}
+	}
+	if r.conditions.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(r.conditions.LabelSelector); err != nil {
+			return errors.Wrap(err, "invalid labelSelector")
+		}
+	}
+	for _, match := range r.conditions.Matches {
+		if match.Path == "" {
+			return errors.New("path is required for match rule")
+		}
+	}
+
+	if r.conditions.ExceptNewest != nil {
+		if r.conditions.ExceptNewest.Count <= 0 {
+			return errors.Errorf("invalid count %d of exceptNewest, it must be positive", r.conditions.ExceptNewest.Count)
+		}
+		if label := r.conditions.ExceptNewest.GroupByLabel; label != "" {
+			if errs := validation.IsQualifiedName(label); len(errs) > 0 {
+				return errors.Errorf("invalid groupByLabel %s of exceptNewest: %v", label, errs)
+			}
+		}
+	}
+
+	return nil
+}
+
+// match returns the indexes of the items matching the policy
+func (r *resPolicy) match(groupResource string, items []unstructured.Unstructured, candidates []int, log logrus.FieldLogger) ([]int, error) {
+	var matched []int
+	for _, i := range candidates {
+		ok, err := r.conditions.Match(&items[i], groupResource, nil, log)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, i)
+		}
+	}
+
+	if r.conditions.ExceptNewest == nil {
+		return matched, nil
+	}
+
+	groups := make(map[string][]int)
+	var keys []string
+	for _, i := range matched {
+		key := items[i].GetNamespace()
+		if label := r.conditions.ExceptNewest.GroupByLabel; label != "" {
+			key += "/" + items[i].GetLabels()[label]
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	var result []int
+	for _, key := range keys {
+		group := groups[key]
+		// sort from the newest to the oldest
+		sort.SliceStable(group, func(a, b int) bool {
+			ta, tb := items[group[a]].GetCreationTimestamp(), items[group[b]].GetCreationTimestamp()
+			if !ta.Equal(&tb) {
+				return tb.Before(&ta)
+			}
+			return items[group[a]].GetName() > items[group[b]].GetName()
+		})
+		if len(group) > r.conditions.ExceptNewest.Count {
+			result = append(result, group[r.conditions.ExceptNewest.Count:]...)
+		}
+	}
+	sort.Ints(result)
+	return result, nil
+}
+
+// FilterResources returns the items of the group resource to back up. Each item is handled by the action
+// of the first resource policy matching it, and the items not matching any policy are backed up.
+func (p *Policies) FilterResources(groupResource string, items []unstructured.Unstructured, log logrus.FieldLogger) ([]unstructured.Unstructured, error) {
+	if len(p.resourcePolicies) == 0 || len(items) == 0 {
+		return items, nil
+	}
+
+	undecided := make([]int, len(items))
+	for i := range items {
+		undecided[i] = i
+	}
+	skipped := make(map[int]bool)
+
+	for _, policy := range p.resourcePolicies {
+		matched, err := policy.match(groupResource, items, undecided, log)
+		if err != nil {
+			return nil, err
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		decided := make(map[int]bool, len(matched))
+		for _, i := range matched {
+			decided[i] = true
+			if policy.action.Type == ResourceSkip {
+				skipped[i] = true
+			}
+		}
+
+		remaining := undecided[:0]
+		for _, i := range undecided {
+			if !decided[i] {
+				remaining = append(remaining, i)
+			}
+		}
+		undecided = remaining
+	}
+
+	if len(skipped) == 0 {
+		return items, nil
+	}
+
+	var result []unstructured.Unstructured
+	for i := range items {
+		if skipped[i] {
+			log.Infof("Skipping item %s/%s of %s because of the matched resource policy", items[i].GetNamespace(), items[i].GetName(), groupResource)
+			continue
+		}
+		result = append(result, items[i])
+	}
+	return result, nil
+}
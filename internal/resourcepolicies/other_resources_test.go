@@ -0,0 +1,249 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newItem(apiVersion, kind, namespace, name string, labels map[string]string, created time.Time, fields map[string]interface{}) unstructured.Unstructured {
+	item := unstructured.Unstructured{Object: map[string]interface{}{}}
+	for k, v := range fields {
+		item.Object[k] = v
+	}
+	item.SetAPIVersion(apiVersion)
+	item.SetKind(kind)
+	item.SetNamespace(namespace)
+	item.SetName(name)
+	item.SetLabels(labels)
+	item.SetCreationTimestamp(metav1.NewTime(created))
+	return item
+}
+
+func itemNames(items []unstructured.Unstructured) []string {
+	var names []string
+	for _, item := range items {
+		names = append(names, item.GetNamespace()+"/"+item.GetName())
+	}
+	return names
+}
+
+func TestFilterResources(t *testing.T) {
+	now := time.Now()
+	helmSecret := func(namespace, release string, version int) unstructured.Unstructured {
+		return newItem("v1", "Secret", namespace, fmt.Sprintf("sh.helm.release.v1.%s.v%d", release, version),
+			map[string]string{"owner": "helm", "name": release}, now.Add(time.Duration(version)*time.Hour),
+			map[string]interface{}{"type": "helm.sh/release.v1"})
+	}
+	secrets := []unstructured.Unstructured{
+		helmSecret("ns-1", "app", 1),
+		helmSecret("ns-1", "app", 2),
+		helmSecret("ns-1", "app", 3),
+		helmSecret("ns-1", "app", 4),
+		helmSecret("ns-1", "db", 1),
+		helmSecret("ns-2", "app", 1),
+		newItem("v1", "Secret", "ns-1", "opaque", nil, now, map[string]interface{}{"type": "Opaque"}),
+	}
+	jobs := []unstructured.Unstructured{
+		newItem("batch/v1", "Job", "ns-1", "completed", nil, now, map[string]interface{}{"status": map[string]interface{}{"succeeded": int64(1)}}),
+		newItem("batch/v1", "Job", "ns-1", "running", nil, now, map[string]interface{}{"status": map[string]interface{}{"active": int64(1)}}),
+		newItem("batch/v1", "Job", "ns-1", "keep", map[string]string{"keep": "true"}, now, map[string]interface{}{"status": map[string]interface{}{"succeeded": int64(1)}}),
+	}
+
+	yamlData := `version: v1
+volumePolicies: []
+resourcePolicies:
+- conditions:
+    groupResource: secrets
+    labelSelector:
+      matchLabels:
+        owner: helm
+    matches:
+    - path: /type
+      value: helm.sh/release.v1
+    exceptNewest:
+      count: 2
+      groupByLabel: name
+  action:
+    type: skip
+- conditions:
+    groupResource: jobs.batch
+    labelSelector:
+      matchLabels:
+        keep: "true"
+  action:
+    type: include
+- conditions:
+    groupResource: "*.batch"
+    matches:
+    - path: /status/succeeded
+      value: "1"
+  action:
+    type: skip`
+
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	require.NoError(t, err)
+	policies := &Policies{}
+	require.NoError(t, policies.BuildPolicy(resPolicies))
+	require.NoError(t, policies.Validate())
+
+	log := logrus.StandardLogger()
+
+	filtered, err := policies.FilterResources("secrets", secrets, log)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"ns-1/sh.helm.release.v1.app.v3",
+		"ns-1/sh.helm.release.v1.app.v4",
+		"ns-1/sh.helm.release.v1.db.v1",
+		"ns-2/sh.helm.release.v1.app.v1",
+		"ns-1/opaque",
+	}, itemNames(filtered))
+
+	filtered, err = policies.FilterResources("jobs.batch", jobs, log)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1/running", "ns-1/keep"}, itemNames(filtered))
+
+	filtered, err = policies.FilterResources("configmaps", []unstructured.Unstructured{newItem("v1", "ConfigMap", "ns-1", "cm", nil, now, nil)}, log)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1/cm"}, itemNames(filtered))
+
+	filtered, err = (&Policies{}).FilterResources("jobs.batch", jobs, log)
+	require.NoError(t, err)
+	assert.Len(t, filtered, 3)
+}
+
+func TestResourcePoliciesValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		yamlData string
+		wantErr  bool
+	}{
+		{
+			name: "valid resource policies",
+			yamlData: `version: v1
+volumePolicies: []
+resourcePolicies:
+- conditions:
+    groupResource: secrets
+    namespaces:
+    - ns-1
+    resourceNameRegex: "^sh\\.helm\\."
+    exceptNewest:
+      count: 3
+  action:
+    type: skip`,
+		},
+		{
+			name: "unknown condition",
+			yamlData: `version: v1
+volumePolicies: []
+resourcePolicies:
+- conditions:
+    groupResource: secrets
+    unknown: value
+  action:
+    type: skip`,
+			wantErr: true,
+		},
+		{
+			name: "invalid action",
+			yamlData: `version: v1
+volumePolicies: []
+resourcePolicies:
+- conditions:
+    groupResource: secrets
+  action:
+    type: snapshot`,
+			wantErr: true,
+		},
+		{
+			name: "empty groupResource",
+			yamlData: `version: v1
+volumePolicies: []
+resourcePolicies:
+- conditions:
+    namespaces:
+    - ns-1
+  action:
+    type: skip`,
+			wantErr: true,
+		},
+		{
+			name: "invalid regex",
+			yamlData: `version: v1
+volumePolicies: []
+resourcePolicies:
+- conditions:
+    groupResource: secrets
+    resourceNameRegex: "(["
+  action:
+    type: skip`,
+			wantErr: true,
+		},
+		{
+			name: "empty match path",
+			yamlData: `version: v1
+volumePolicies: []
+resourcePolicies:
+- conditions:
+    groupResource: secrets
+    matches:
+    - value: Opaque
+  action:
+    type: skip`,
+			wantErr: true,
+		},
+		{
+			name: "invalid exceptNewest count",
+			yamlData: `version: v1
+volumePolicies: []
+resourcePolicies:
+- conditions:
+    groupResource: secrets
+    exceptNewest:
+      count: 0
+  action:
+    type: skip`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resPolicies, err := unmarshalResourcePolicies(&tc.yamlData)
+			require.NoError(t, err)
+			policies := &Policies{}
+			err = policies.BuildPolicy(resPolicies)
+			if err == nil {
+				err = policies.Validate()
+			}
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
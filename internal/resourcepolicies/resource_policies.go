@@ -44,6 +44,64 @@ type Action struct {
 	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
 }
 
+const (
+	// VolumeSnapshotClassParameter is the name of the VolumeSnapshotClass used for the CSI snapshot of the volume
+	VolumeSnapshotClassParameter = "volumeSnapshotClass"
+	// SnapshotMoveDataParameter decides whether the data of the CSI snapshot is moved, overriding the backup's snapshotMoveData
+	SnapshotMoveDataParameter = "snapshotMoveData"
+	// UploaderTypeParameter is the uploader(kopia/restic) used for the fs-backup of the volume
+	UploaderTypeParameter = "uploaderType"
+	// ParallelFilesUploadParameter is the number of files uploaded in parallel for the fs-backup of the volume
+	ParallelFilesUploadParameter = "parallelFilesUpload"
+	// DataMoverParameter is the data mover handling the data of the volume, overriding the backup's datamover
+	DataMoverParameter = "dataMover"
+)
+
+// VolumeSnapshotClass returns the VolumeSnapshotClass parameter of the action, or "" if it isn't set
+func (a *Action) VolumeSnapshotClass() string {
+	return a.stringParameter(VolumeSnapshotClassParameter)
+}
+
+// SnapshotMoveData returns the snapshotMoveData parameter of the action, or nil if it isn't set
+func (a *Action) SnapshotMoveData() *bool {
+	if a == nil {
+		return nil
+	}
+	if v, ok := a.Parameters[SnapshotMoveDataParameter].(bool); ok {
+		return &v
+	}
+	return nil
+}
+
+// UploaderType returns the uploaderType parameter of the action, or "" if it isn't set
+func (a *Action) UploaderType() string {
+	return a.stringParameter(UploaderTypeParameter)
+}
+
+// ParallelFilesUpload returns the parallelFilesUpload parameter of the action, or 0 if it isn't set
+func (a *Action) ParallelFilesUpload() int {
+	if a == nil {
+		return 0
+	}
+	if v, ok := a.Parameters[ParallelFilesUploadParameter].(int); ok {
+		return v
+	}
+	return 0
+}
+
+// DataMover returns the dataMover parameter of the action, or "" if it isn't set
+func (a *Action) DataMover() string {
+	return a.stringParameter(DataMoverParameter)
+}
+
+func (a *Action) stringParameter(key string) string {
+	if a == nil {
+		return ""
+	}
+	v, _ := a.Parameters[key].(string)
+	return strings.TrimSpace(v)
+}
+
 // volumePolicy defined policy to conditions to match Volumes and related action to handle matched Volumes
 type VolumePolicy struct {
 	// Conditions defined list of conditions to match Volumes
@@ -51,18 +109,17 @@ type VolumePolicy struct {
 	Action     Action                 `yaml:"action"`
 }
 
-// resourcePolicies currently defined slice of volume policies to handle backup
+// resourcePolicies defined slice of volume policies and non-volume resource policies to handle backup
 type ResourcePolicies struct {
-	Version        string         `yaml:"version"`
-	VolumePolicies []VolumePolicy `yaml:"volumePolicies"`
-	// we may support other resource policies in the future, and they could be added separately
-	// OtherResourcePolicies []OtherResourcePolicy
+	Version          string           `yaml:"version"`
+	VolumePolicies   []VolumePolicy   `yaml:"volumePolicies"`
+	ResourcePolicies []ResourcePolicy `yaml:"resourcePolicies,omitempty"`
 }
 
 type Policies struct {
-	version        string
-	volumePolicies []volPolicy
-	// OtherPolicies
+	version          string
+	volumePolicies   []volPolicy
+	resourcePolicies []resPolicy
 }
 
 func unmarshalResourcePolicies(yamlData *string) (*ResourcePolicies, error) {
@@ -91,10 +148,20 @@ func (p *Policies) BuildPolicy(resPolicies *ResourcePolicies) error {
 		volP.conditions = append(volP.conditions, &nfsCondition{nfs: con.NFS})
 		volP.conditions = append(volP.conditions, &csiCondition{csi: con.CSI})
 		volP.conditions = append(volP.conditions, &volumeTypeCondition{volumeTypes: con.VolumeTypes})
+		volP.conditions = append(volP.conditions, &pvcLabelsCondition{labels: con.PVCLabels})
+		volP.conditions = append(volP.conditions, &pvcAnnotationsCondition{annotations: con.PVCAnnotations})
+		volP.conditions = append(volP.conditions, &namespacesCondition{namespaces: con.Namespaces})
+		volP.conditions = append(volP.conditions, &pvcPhaseCondition{phases: con.PVCPhase})
 		p.volumePolicies = append(p.volumePolicies, volP)
 	}
 
-	// Other resource policies
+	for _, rp := range resPolicies.ResourcePolicies {
+		con, err := unmarshalResourceConditions(rp.Conditions)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		p.resourcePolicies = append(p.resourcePolicies, resPolicy{action: rp.Action, conditions: *con})
+	}
 
 	p.version = resPolicies.Version
 	return nil
@@ -117,6 +184,20 @@ func (p *Policies) match(res *structuredVolume) *Action {
 	return nil
 }
 
+// VolumeFilterData is the data of a volume to match the volume policies against.
+// PersistentVolumeClaim is used by the PVC conditions and is optional, without it
+// the PVC conditions only match when they are not specified.
+type VolumeFilterData struct {
+	PersistentVolume      *v1.PersistentVolume
+	PodVolume             *v1.Volume
+	PersistentVolumeClaim *v1.PersistentVolumeClaim
+	// PodNamespace is the namespace of the pod of PodVolume, it is used by the
+	// namespaces condition when there is no PersistentVolumeClaim.
+	PodNamespace string
+}
+
+// GetMatchAction returns the action of the first volume policy matching the volume,
+// which is a *v1.PersistentVolume, a *v1.Volume or a VolumeFilterData.
 func (p *Policies) GetMatchAction(res interface{}) (*Action, error) {
 	volume := &structuredVolume{}
 	switch obj := res.(type) {
@@ -124,6 +205,19 @@ func (p *Policies) GetMatchAction(res interface{}) (*Action, error) {
 		volume.parsePV(obj)
 	case *v1.Volume:
 		volume.parsePodVolume(obj)
+	case VolumeFilterData:
+		switch {
+		case obj.PersistentVolume != nil:
+			volume.parsePV(obj.PersistentVolume)
+		case obj.PodVolume != nil:
+			volume.parsePodVolume(obj.PodVolume)
+			volume.namespace = obj.PodNamespace
+		default:
+			return nil, errors.New("failed to convert object")
+		}
+		if obj.PersistentVolumeClaim != nil {
+			volume.parsePVC(obj.PersistentVolumeClaim)
+		}
 	default:
 		return nil, errors.New("failed to convert object")
 	}
@@ -145,6 +239,12 @@ func (p *Policies) Validate() error {
 			}
 		}
 	}
+
+	for _, policy := range p.resourcePolicies {
+		if err := policy.validate(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
 	return nil
 }
 
@@ -439,3 +439,165 @@ volumePolicies:
 		})
 	}
 }
+
+func TestGetMatchActionWithVolumeFilterData(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+- conditions:
+    pvcLabels:
+      backup-tier: skip
+  action:
+    type: skip
+- conditions:
+    pvcAnnotations:
+      example.com/backup: fs
+    pvcPhase:
+      - Bound
+  action:
+    type: fs-backup
+- conditions:
+    namespaces:
+      - ns-snapshot
+  action:
+    type: snapshot`
+
+	pv := &v1.PersistentVolume{
+		Spec: v1.PersistentVolumeSpec{
+			Capacity: v1.ResourceList{
+				v1.ResourceStorage: resource.MustParse("1Gi"),
+			},
+			ClaimRef: &v1.ObjectReference{Namespace: "ns-snapshot", Name: "pvc-1"},
+		},
+	}
+	pvc := func(namespace string, labels, annotations map[string]string, phase v1.PersistentVolumeClaimPhase) *v1.PersistentVolumeClaim {
+		return &v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "pvc-1", Labels: labels, Annotations: annotations},
+			Status:     v1.PersistentVolumeClaimStatus{Phase: phase},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		data     interface{}
+		expected *Action
+	}{
+		{
+			name:     "pvc labels match",
+			data:     VolumeFilterData{PersistentVolume: pv, PersistentVolumeClaim: pvc("ns-1", map[string]string{"backup-tier": "skip", "app": "app"}, nil, v1.ClaimBound)},
+			expected: &Action{Type: Skip},
+		},
+		{
+			name:     "pvc labels mismatch",
+			data:     VolumeFilterData{PersistentVolume: pv, PersistentVolumeClaim: pvc("ns-1", map[string]string{"backup-tier": "gold"}, nil, v1.ClaimBound)},
+			expected: nil,
+		},
+		{
+			name:     "pvc annotations and phase match",
+			data:     VolumeFilterData{PersistentVolume: pv, PersistentVolumeClaim: pvc("ns-1", nil, map[string]string{"example.com/backup": "fs"}, v1.ClaimBound)},
+			expected: &Action{Type: FSBackup},
+		},
+		{
+			name:     "pvc annotations match but phase mismatch",
+			data:     VolumeFilterData{PersistentVolume: pv, PersistentVolumeClaim: pvc("ns-1", nil, map[string]string{"example.com/backup": "fs"}, v1.ClaimPending)},
+			expected: nil,
+		},
+		{
+			name:     "namespace of pvc matches",
+			data:     VolumeFilterData{PodVolume: &v1.Volume{Name: "vol-1"}, PersistentVolumeClaim: pvc("ns-snapshot", nil, nil, v1.ClaimBound)},
+			expected: &Action{Type: Snapshot},
+		},
+		{
+			name:     "namespace of pod matches",
+			data:     VolumeFilterData{PodVolume: &v1.Volume{Name: "vol-1"}, PodNamespace: "ns-snapshot"},
+			expected: &Action{Type: Snapshot},
+		},
+		{
+			name:     "namespace of pv claim matches",
+			data:     pv,
+			expected: &Action{Type: Snapshot},
+		},
+		{
+			name:     "pvc conditions don't match without pvc",
+			data:     VolumeFilterData{PodVolume: &v1.Volume{Name: "vol-1"}, PodNamespace: "ns-1"},
+			expected: nil,
+		},
+	}
+
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	assert.NoError(t, err)
+	policies := &Policies{}
+	assert.NoError(t, policies.BuildPolicy(resPolicies))
+	assert.NoError(t, policies.Validate())
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			action, err := policies.GetMatchAction(tc.data)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, action)
+		})
+	}
+
+	_, err = policies.GetMatchAction(VolumeFilterData{})
+	assert.Error(t, err)
+}
+
+func TestActionParameters(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+- conditions:
+    storageClass:
+    - gold
+  action:
+    type: snapshot
+    parameters:
+      volumeSnapshotClass: gold-vsc
+      snapshotMoveData: true
+      dataMover: velero
+- conditions:
+    storageClass:
+    - silver
+  action:
+    type: fs-backup
+    parameters:
+      uploaderType: restic
+      parallelFilesUpload: 4
+- conditions:
+    storageClass:
+    - scratch
+  action:
+    type: snapshot`
+
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	assert.NoError(t, err)
+	policies := &Policies{}
+	assert.NoError(t, policies.BuildPolicy(resPolicies))
+	assert.NoError(t, policies.Validate())
+
+	getAction := func(storageClass string) *Action {
+		action, err := policies.GetMatchAction(&v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{StorageClassName: storageClass}})
+		assert.NoError(t, err)
+		return action
+	}
+
+	gold := getAction("gold")
+	assert.Equal(t, "gold-vsc", gold.VolumeSnapshotClass())
+	assert.Equal(t, true, *gold.SnapshotMoveData())
+	assert.Equal(t, "velero", gold.DataMover())
+	assert.Equal(t, "", gold.UploaderType())
+	assert.Equal(t, 0, gold.ParallelFilesUpload())
+
+	silver := getAction("silver")
+	assert.Equal(t, "restic", silver.UploaderType())
+	assert.Equal(t, 4, silver.ParallelFilesUpload())
+	assert.Nil(t, silver.SnapshotMoveData())
+
+	scratch := getAction("scratch")
+	assert.Equal(t, "", scratch.VolumeSnapshotClass())
+	assert.Nil(t, scratch.SnapshotMoveData())
+	assert.Equal(t, "", scratch.DataMover())
+
+	var unmatched *Action
+	assert.Equal(t, "", unmatched.VolumeSnapshotClass())
+	assert.Nil(t, unmatched.SnapshotMoveData())
+	assert.Equal(t, 0, unmatched.ParallelFilesUpload())
+}
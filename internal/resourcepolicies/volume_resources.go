@@ -43,16 +43,23 @@ type capacity struct {
 }
 
 type structuredVolume struct {
-	capacity     resource.Quantity
-	storageClass string
-	nfs          *nFSVolumeSource
-	csi          *csiVolumeSource
-	volumeType   SupportedVolume
+	capacity       resource.Quantity
+	storageClass   string
+	nfs            *nFSVolumeSource
+	csi            *csiVolumeSource
+	volumeType     SupportedVolume
+	namespace      string
+	pvcLabels      map[string]string
+	pvcAnnotations map[string]string
+	pvcPhase       string
 }
 
 func (s *structuredVolume) parsePV(pv *corev1api.PersistentVolume) {
 	s.capacity = *pv.Spec.Capacity.Storage()
 	s.storageClass = pv.Spec.StorageClassName
+	if pv.Spec.ClaimRef != nil {
+		s.namespace = pv.Spec.ClaimRef.Namespace
+	}
 	nfs := pv.Spec.NFS
 	if nfs != nil {
 		s.nfs = &nFSVolumeSource{Server: nfs.Server, Path: nfs.Path}
@@ -80,6 +87,13 @@ func (s *structuredVolume) parsePodVolume(vol *corev1api.Volume) {
 	s.volumeType = getVolumeTypeFromVolume(vol)
 }
 
+func (s *structuredVolume) parsePVC(pvc *corev1api.PersistentVolumeClaim) {
+	s.namespace = pvc.Namespace
+	s.pvcLabels = pvc.Labels
+	s.pvcAnnotations = pvc.Annotations
+	s.pvcPhase = string(pvc.Status.Phase)
+}
+
 type capacityCondition struct {
 	capacity capacity
 }
@@ -163,6 +177,66 @@ func (c *csiCondition) match(v *structuredVolume) bool {
 	return c.csi.Driver == v.csi.Driver
 }
 
+type pvcLabelsCondition struct {
+	labels map[string]string
+}
+
+func (c *pvcLabelsCondition) match(v *structuredVolume) bool {
+	return matchAll(c.labels, v.pvcLabels)
+}
+
+type pvcAnnotationsCondition struct {
+	annotations map[string]string
+}
+
+func (c *pvcAnnotationsCondition) match(v *structuredVolume) bool {
+	return matchAll(c.annotations, v.pvcAnnotations)
+}
+
+// matchAll returns true if all the expected key/value pairs are in the actual map
+func matchAll(expected, actual map[string]string) bool {
+	for k, v := range expected {
+		if value, ok := actual[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+type namespacesCondition struct {
+	namespaces []string
+}
+
+func (c *namespacesCondition) match(v *structuredVolume) bool {
+	if len(c.namespaces) == 0 {
+		return true
+	}
+
+	for _, ns := range c.namespaces {
+		if v.namespace == ns {
+			return true
+		}
+	}
+	return false
+}
+
+type pvcPhaseCondition struct {
+	phases []string
+}
+
+func (c *pvcPhaseCondition) match(v *structuredVolume) bool {
+	if len(c.phases) == 0 {
+		return true
+	}
+
+	for _, phase := range c.phases {
+		if v.pvcPhase == phase {
+			return true
+		}
+	}
+	return false
+}
+
 // parseCapacity parse string into capacity format
 func parseCapacity(cap string) (*capacity, error) {
 	if cap == "" {
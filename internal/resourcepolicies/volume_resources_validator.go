@@ -18,9 +18,14 @@ package resourcepolicies
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
 const currentSupportDataVersion = "v1"
@@ -38,11 +43,15 @@ type nFSVolumeSource struct {
 
 // volumeConditions defined the current format of conditions we parsed
 type volumeConditions struct {
-	Capacity     string            `yaml:"capacity,omitempty"`
-	StorageClass []string          `yaml:"storageClass,omitempty"`
-	NFS          *nFSVolumeSource  `yaml:"nfs,omitempty"`
-	CSI          *csiVolumeSource  `yaml:"csi,omitempty"`
-	VolumeTypes  []SupportedVolume `yaml:"volumeTypes,omitempty"`
+	Capacity       string            `yaml:"capacity,omitempty"`
+	StorageClass   []string          `yaml:"storageClass,omitempty"`
+	NFS            *nFSVolumeSource  `yaml:"nfs,omitempty"`
+	CSI            *csiVolumeSource  `yaml:"csi,omitempty"`
+	VolumeTypes    []SupportedVolume `yaml:"volumeTypes,omitempty"`
+	PVCLabels      map[string]string `yaml:"pvcLabels,omitempty"`
+	PVCAnnotations map[string]string `yaml:"pvcAnnotations,omitempty"`
+	Namespaces     []string          `yaml:"namespaces,omitempty"`
+	PVCPhase       []string          `yaml:"pvcPhase,omitempty"`
 }
 
 func (c *capacityCondition) validate() error {
@@ -72,6 +81,47 @@ func (c *csiCondition) validate() error {
 	return nil
 }
 
+func (c *pvcLabelsCondition) validate() error {
+	for k, v := range c.labels {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return errors.Errorf("invalid key %s in pvcLabels: %s", k, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return errors.Errorf("invalid value %s of key %s in pvcLabels: %s", v, k, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+func (c *pvcAnnotationsCondition) validate() error {
+	for k := range c.annotations {
+		if errs := validation.IsQualifiedName(strings.ToLower(k)); len(errs) > 0 {
+			return errors.Errorf("invalid key %s in pvcAnnotations: %s", k, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+func (c *namespacesCondition) validate() error {
+	for _, ns := range c.namespaces {
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return errors.Errorf("invalid namespace %s: %s", ns, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+func (c *pvcPhaseCondition) validate() error {
+	for _, phase := range c.phases {
+		switch corev1api.PersistentVolumeClaimPhase(phase) {
+		case corev1api.ClaimPending, corev1api.ClaimBound, corev1api.ClaimLost:
+		default:
+			return errors.Errorf("invalid pvcPhase %s, it accepts only %s, %s, %s as value", phase, corev1api.ClaimPending, corev1api.ClaimBound, corev1api.ClaimLost)
+		}
+	}
+	return nil
+}
+
 // decodeStruct restric validate the keys in decoded mappings to exist as fields in the struct being decoded into
 func decodeStruct(r io.Reader, s interface{}) error {
 	dec := yaml.NewDecoder(r)
@@ -90,6 +140,63 @@ func (a *Action) validate() error {
 		return fmt.Errorf("invalid action type %s", a.Type)
 	}
 
-	// TODO validate parameters
+	return a.validateParameters()
+}
+
+// validateParameters checks the parameters are known to the action type and have the right types
+func (a *Action) validateParameters() error {
+	for key, value := range a.Parameters {
+		switch key {
+		case VolumeSnapshotClassParameter:
+			if a.Type != Snapshot {
+				return fmt.Errorf("parameter %s is only supported by action %s", key, Snapshot)
+			}
+			if v, ok := value.(string); !ok || strings.TrimSpace(v) == "" {
+				return fmt.Errorf("parameter %s should be a non-empty string", key)
+			}
+		case SnapshotMoveDataParameter:
+			if a.Type != Snapshot {
+				return fmt.Errorf("parameter %s is only supported by action %s", key, Snapshot)
+			}
+			if _, ok := value.(bool); !ok {
+				return fmt.Errorf("parameter %s should be a boolean", key)
+			}
+		case UploaderTypeParameter:
+			if a.Type != FSBackup {
+				return fmt.Errorf("parameter %s is only supported by action %s", key, FSBackup)
+			}
+			v, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("parameter %s should be a string", key)
+			}
+			if err := uploader.ValidateUploaderType(v); err != nil {
+				return errors.Wrapf(err, "invalid parameter %s", key)
+			}
+		case ParallelFilesUploadParameter:
+			if a.Type != FSBackup {
+				return fmt.Errorf("parameter %s is only supported by action %s", key, FSBackup)
+			}
+			if v, ok := value.(int); !ok || v <= 0 {
+				return fmt.Errorf("parameter %s should be a positive integer", key)
+			}
+		case DataMoverParameter:
+			v, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("parameter %s should be a string", key)
+			}
+			switch a.Type {
+			case Snapshot:
+			case FSBackup:
+				// pod volume backups are always handled by the node-agent
+				if v = strings.TrimSpace(v); v != "" && v != "velero" {
+					return fmt.Errorf("parameter %s of action %s only supports the built-in data mover velero", key, FSBackup)
+				}
+			default:
+				return fmt.Errorf("parameter %s is only supported by actions %s and %s", key, Snapshot, FSBackup)
+			}
+		default:
+			return fmt.Errorf("unknown parameter %s of action %s", key, a.Type)
+		}
+	}
 	return nil
 }
@@ -320,6 +320,114 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "supported format of pvc conditions",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: "skip"},
+						Conditions: map[string]interface{}{
+							"pvcLabels":      map[string]string{"backup-tier": "skip"},
+							"pvcAnnotations": map[string]string{"example.com/backup": "false"},
+							"namespaces":     []string{"ns-1", "ns-2"},
+							"pvcPhase":       []string{"Bound", "Pending"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error format of pvcLabels",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: "skip"},
+						Conditions: map[string]interface{}{
+							"pvcLabels": []string{"backup-tier=skip"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid key of pvcLabels",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: "skip"},
+						Conditions: map[string]interface{}{
+							"pvcLabels": map[string]string{"backup tier": "skip"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid value of pvcLabels",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: "skip"},
+						Conditions: map[string]interface{}{
+							"pvcLabels": map[string]string{"backup-tier": "skip tier"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid key of pvcAnnotations",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: "skip"},
+						Conditions: map[string]interface{}{
+							"pvcAnnotations": map[string]string{"example.com/backup/tier": "skip"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid namespace",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: "skip"},
+						Conditions: map[string]interface{}{
+							"namespaces": []string{"NS_1"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid pvcPhase",
+			res: &ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []VolumePolicy{
+					{
+						Action: Action{Type: "skip"},
+						Conditions: map[string]interface{}{
+							"pvcPhase": []string{"Released"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -339,3 +447,82 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestActionValidateParameters(t *testing.T) {
+	testCases := []struct {
+		name    string
+		action  Action
+		wantErr bool
+	}{
+		{
+			name: "snapshot parameters",
+			action: Action{Type: Snapshot, Parameters: map[string]interface{}{
+				"volumeSnapshotClass": "gold-vsc",
+				"snapshotMoveData":    true,
+				"dataMover":           "velero",
+			}},
+		},
+		{
+			name: "fs-backup parameters",
+			action: Action{Type: FSBackup, Parameters: map[string]interface{}{
+				"uploaderType":        "kopia",
+				"parallelFilesUpload": 8,
+				"dataMover":           "velero",
+			}},
+		},
+		{
+			name:    "unknown parameter",
+			action:  Action{Type: Snapshot, Parameters: map[string]interface{}{"unknown": "value"}},
+			wantErr: true,
+		},
+		{
+			name:    "empty volumeSnapshotClass",
+			action:  Action{Type: Snapshot, Parameters: map[string]interface{}{"volumeSnapshotClass": ""}},
+			wantErr: true,
+		},
+		{
+			name:    "volumeSnapshotClass of fs-backup",
+			action:  Action{Type: FSBackup, Parameters: map[string]interface{}{"volumeSnapshotClass": "gold-vsc"}},
+			wantErr: true,
+		},
+		{
+			name:    "snapshotMoveData isn't a boolean",
+			action:  Action{Type: Snapshot, Parameters: map[string]interface{}{"snapshotMoveData": "yes"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid uploaderType",
+			action:  Action{Type: FSBackup, Parameters: map[string]interface{}{"uploaderType": "rsync"}},
+			wantErr: true,
+		},
+		{
+			name:    "uploaderType of snapshot",
+			action:  Action{Type: Snapshot, Parameters: map[string]interface{}{"uploaderType": "kopia"}},
+			wantErr: true,
+		},
+		{
+			name:    "non-positive parallelFilesUpload",
+			action:  Action{Type: FSBackup, Parameters: map[string]interface{}{"parallelFilesUpload": 0}},
+			wantErr: true,
+		},
+		{
+			name:    "third party data mover of fs-backup",
+			action:  Action{Type: FSBackup, Parameters: map[string]interface{}{"dataMover": "custom"}},
+			wantErr: true,
+		},
+		{
+			name:    "dataMover of skip",
+			action:  Action{Type: Skip, Parameters: map[string]interface{}{"dataMover": "velero"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.action.validate()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -37,7 +37,7 @@ func UpdateVolumeSnapshotLocationWithCredentialConfig(location *velerov1api.Volu
 			return errors.Wrap(err, "unable to get credentials")
 		}
 
-		location.Spec.Config["credentialsFile"] = credsFile
+		location.Spec.Config[credentials.FileConfigKey] = credsFile
 	}
 	return nil
 }
@@ -260,6 +260,8 @@ type BackupVolumesInformation struct {
 	// A map contains the backup-included PV detail content. The key is PV name.
 	pvMap       *pvcPvMap
 	volumeInfos []*BackupVolumeInfo
+	// pvMapLock guards pvMap, which is inserted into concurrently during the backup.
+	pvMapLock sync.Mutex
 
 	logger                 logrus.FieldLogger
 	crClient               kbclient.Client
@@ -287,6 +289,8 @@ func (v *BackupVolumesInformation) Init() {
 }
 
 func (v *BackupVolumesInformation) InsertPVMap(pv corev1api.PersistentVolume, pvcName, pvcNamespace string) {
+	v.pvMapLock.Lock()
+	defer v.pvMapLock.Unlock()
 	if v.pvMap == nil {
 		v.Init()
 	}
@@ -56,12 +56,13 @@ func NewVolumeHelperImpl(
 func (v *volumeHelperImpl) ShouldPerformSnapshot(obj runtime.Unstructured, groupResource schema.GroupResource) (bool, error) {
 	// check if volume policy exists and also check if the object(pv/pvc) fits a volume policy criteria and see if the associated action is snapshot
 	// if it is not snapshot then skip the code path for snapshotting the PV/PVC
-	pvc := new(corev1api.PersistentVolumeClaim)
+	var pvc *corev1api.PersistentVolumeClaim
 	pv := new(corev1api.PersistentVolume)
 	var err error
 
 	if groupResource == kuberesource.PersistentVolumeClaims {
-		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &pvc); err != nil {
+		pvc = new(corev1api.PersistentVolumeClaim)
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
 			v.logger.WithError(err).Error("fail to convert unstructured into PVC")
 			return false, err
 		}
@@ -81,7 +82,19 @@ func (v *volumeHelperImpl) ShouldPerformSnapshot(obj runtime.Unstructured, group
 	}
 
 	if v.volumePolicy != nil {
-		action, err := v.volumePolicy.GetMatchAction(pv)
+		if pvc == nil {
+			// resolve the PVC for the PVC conditions of the volume policy
+			pvc, err = kubeutil.GetPVCForPV(pv, v.client)
+			if err != nil {
+				v.logger.WithError(err).Errorf("fail to get PVC for PV %s", pv.Name)
+				return false, err
+			}
+		}
+
+		action, err := v.volumePolicy.GetMatchAction(resourcepolicies.VolumeFilterData{
+			PersistentVolume:      pv,
+			PersistentVolumeClaim: pvc,
+		})
 		if err != nil {
 			v.logger.WithError(err).Errorf("fail to get VolumePolicy match action for PV %s", pv.Name)
 			return false, err
@@ -143,20 +156,28 @@ func (v volumeHelperImpl) ShouldPerformFSBackup(volume corev1api.Volume, pod cor
 	}
 
 	if v.volumePolicy != nil {
-		pvc, err := kubeutil.GetPVCForPodVolume(&volume, &pod, v.client)
-		if err != nil {
-			v.logger.WithError(err).Errorf("fail to get PVC for pod %s", pod.Namespace+"/"+pod.Name)
-			return false, err
+		filterData := resourcepolicies.VolumeFilterData{
+			PodVolume:    &volume,
+			PodNamespace: pod.Namespace,
 		}
-		pv, err := kubeutil.GetPVForPVC(pvc, v.client)
-		if err != nil {
-			v.logger.WithError(err).Errorf("fail to get PV for PVC %s", pvc.Namespace+"/"+pvc.Name)
-			return false, err
+		if volume.PersistentVolumeClaim != nil {
+			pvc, err := kubeutil.GetPVCForPodVolume(&volume, &pod, v.client)
+			if err != nil {
+				v.logger.WithError(err).Errorf("fail to get PVC for pod %s", pod.Namespace+"/"+pod.Name)
+				return false, err
+			}
+			pv, err := kubeutil.GetPVForPVC(pvc, v.client)
+			if err != nil {
+				v.logger.WithError(err).Errorf("fail to get PV for PVC %s", pvc.Namespace+"/"+pvc.Name)
+				return false, err
+			}
+			filterData.PersistentVolume = pv
+			filterData.PersistentVolumeClaim = pvc
 		}
 
-		action, err := v.volumePolicy.GetMatchAction(pv)
+		action, err := v.volumePolicy.GetMatchAction(filterData)
 		if err != nil {
-			v.logger.WithError(err).Errorf("fail to get VolumePolicy match action for PV %s", pv.Name)
+			v.logger.WithError(err).Errorf("fail to get VolumePolicy match action for volume %s of pod %s", volume.Name, pod.Namespace+"/"+pod.Name)
 			return false, err
 		}
 
@@ -70,6 +70,48 @@ func TestVolumeHelperImpl_ShouldPerformSnapshot(t *testing.T) {
 			shouldSnapshot:      true,
 			expectedErr:         false,
 		},
+		{
+			name:          "VolumePolicy match on labels of the PVC claiming the PV, return false and no error",
+			inputObj:      builder.ForPersistentVolume("example-pv").StorageClass("gp2-csi").ClaimRef("ns", "pvc-2").Result(),
+			groupResource: kuberesource.PersistentVolumes,
+			resourcePolicies: &resourcepolicies.ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []resourcepolicies.VolumePolicy{
+					{
+						Conditions: map[string]interface{}{
+							"pvcLabels": map[string]string{"backup-tier": "skip"},
+						},
+						Action: resourcepolicies.Action{
+							Type: resourcepolicies.Skip,
+						},
+					},
+				},
+			},
+			snapshotVolumesFlag: ptr.To(true),
+			shouldSnapshot:      false,
+			expectedErr:         false,
+		},
+		{
+			name:          "VolumePolicy not match on labels of the PVC claiming the PV, return true and no error",
+			inputObj:      builder.ForPersistentVolume("example-pv").StorageClass("gp2-csi").ClaimRef("ns", "pvc-1").Result(),
+			groupResource: kuberesource.PersistentVolumes,
+			resourcePolicies: &resourcepolicies.ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []resourcepolicies.VolumePolicy{
+					{
+						Conditions: map[string]interface{}{
+							"pvcLabels": map[string]string{"backup-tier": "skip"},
+						},
+						Action: resourcepolicies.Action{
+							Type: resourcepolicies.Skip,
+						},
+					},
+				},
+			},
+			snapshotVolumesFlag: ptr.To(true),
+			shouldSnapshot:      true,
+			expectedErr:         false,
+		},
 		{
 			name:          "VolumePolicy match, snapshotVolumes is false, return true and no error",
 			inputObj:      builder.ForPersistentVolume("example-pv").StorageClass("gp2-csi").ClaimRef("ns", "pvc-1").Result(),
@@ -305,6 +347,13 @@ func TestVolumeHelperImpl_ShouldPerformSnapshot(t *testing.T) {
 				Name:      "pvc-1",
 			},
 		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "pvc-2",
+				Labels:    map[string]string{"backup-tier": "skip"},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -552,6 +601,68 @@ func TestVolumeHelperImpl_ShouldPerformFSBackup(t *testing.T) {
 			shouldFSBackup: true,
 			expectedErr:    false,
 		},
+		{
+			name: "VolumePolicy match on PVC labels, return true and no error",
+			pod: builder.ForPod("ns", "pod-1").
+				Volumes(
+					&corev1.Volume{
+						Name: "",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "pvc-1",
+							},
+						},
+					}).Result(),
+			resources: []runtime.Object{
+				builder.ForPersistentVolumeClaim("ns", "pvc-1").
+					ObjectMeta(builder.WithLabels("backup-tier", "fs")).
+					VolumeName("pv-1").
+					StorageClass("gp2-csi").Phase(corev1.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-1").StorageClass("gp2-csi").Result(),
+			},
+			resourcePolicies: &resourcepolicies.ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []resourcepolicies.VolumePolicy{
+					{
+						Conditions: map[string]interface{}{
+							"pvcLabels": map[string]string{"backup-tier": "fs"},
+							"pvcPhase":  []string{"Bound"},
+						},
+						Action: resourcepolicies.Action{
+							Type: resourcepolicies.FSBackup,
+						},
+					},
+				},
+			},
+			shouldFSBackup: true,
+			expectedErr:    false,
+		},
+		{
+			name: "VolumePolicy match on namespace of the pod for a volume without PVC, return true and no error",
+			pod: builder.ForPod("ns", "pod-1").
+				Volumes(
+					&corev1.Volume{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							EmptyDir: &corev1.EmptyDirVolumeSource{},
+						},
+					}).Result(),
+			resourcePolicies: &resourcepolicies.ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []resourcepolicies.VolumePolicy{
+					{
+						Conditions: map[string]interface{}{
+							"namespaces": []string{"ns"},
+						},
+						Action: resourcepolicies.Action{
+							Type: resourcepolicies.FSBackup,
+						},
+					},
+				},
+			},
+			shouldFSBackup: true,
+			expectedErr:    false,
+		},
 		{
 			name: "VolumePolicy match, action type is not fs-backup, return false and no error",
 			pod: builder.ForPod("ns", "pod-1").
@@ -41,6 +41,12 @@ type BackupRepositorySpec struct {
 
 	// MaintenanceFrequency is how often maintenance should be run.
 	MaintenanceFrequency metav1.Duration `json:"maintenanceFrequency"`
+
+	// RepositoryConfig is for repository-specific configuration fields,
+	// e.g. cache size, hashing, encryption, splitter and compression.
+	// +optional
+	// +nullable
+	RepositoryConfig map[string]string `json:"repositoryConfig,omitempty"`
 }
 
 // BackupRepositoryPhase represents the lifecycle phase of a BackupRepository.
@@ -70,6 +76,56 @@ type BackupRepositoryStatus struct {
 	// +optional
 	// +nullable
 	LastMaintenanceTime *metav1.Time `json:"lastMaintenanceTime,omitempty"`
+
+	// RecentMaintenance is status of the recent repo maintenance.
+	// +optional
+	RecentMaintenance []BackupRepositoryMaintenanceStatus `json:"recentMaintenance,omitempty"`
+
+	// EffectiveRepositoryConfig is the repository-specific configuration
+	// applied to the repository, including the defaults of the fields that
+	// are not set in RepositoryConfig. The fields fixed when the repository
+	// was created, e.g. the hash algorithm, are read from the repository.
+	// +optional
+	// +nullable
+	EffectiveRepositoryConfig map[string]string `json:"effectiveRepositoryConfig,omitempty"`
+
+	// RepositoryConfigWarnings are the warnings about RepositoryConfig, e.g.
+	// the fields set to values different from the ones the repository was
+	// created with, which don't take effect, or the error reading the
+	// repository config map, in which case the last applied RepositoryConfig
+	// is kept.
+	// +optional
+	// +nullable
+	RepositoryConfigWarnings []string `json:"repositoryConfigWarnings,omitempty"`
+}
+
+// BackupRepositoryMaintenanceResult represents the result of a repo maintenance.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type BackupRepositoryMaintenanceResult string
+
+const (
+	BackupRepositoryMaintenanceSucceeded BackupRepositoryMaintenanceResult = "Succeeded"
+	BackupRepositoryMaintenanceFailed    BackupRepositoryMaintenanceResult = "Failed"
+)
+
+type BackupRepositoryMaintenanceStatus struct {
+	// Result is the result of the repo maintenance.
+	// +optional
+	Result BackupRepositoryMaintenanceResult `json:"result,omitempty"`
+
+	// StartTimestamp is the start time of the repo maintenance.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompleteTimestamp is the completion time of the repo maintenance.
+	// +optional
+	// +nullable
+	CompleteTimestamp *metav1.Time `json:"completeTimestamp,omitempty"`
+
+	// Message is a message about the current status of the repo maintenance.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
@@ -166,6 +166,12 @@ type BackupSpec struct {
 	// +optional
 	ResourcePolicy *v1.TypedLocalObjectReference `json:"resourcePolicy,omitempty"`
 
+	// ResourceModifier specifies the reference to JSON resource patches that should be applied
+	// to resources before they are written to the backup.
+	// +optional
+	// +nullable
+	ResourceModifier *v1.TypedLocalObjectReference `json:"resourceModifier,omitempty"`
+
 	// SnapshotMoveData specifies whether snapshot data should be moved
 	// +optional
 	// +nullable
@@ -180,6 +186,12 @@ type BackupSpec struct {
 	// +optional
 	// +nullable
 	UploaderConfig *UploaderConfigForBackup `json:"uploaderConfig,omitempty"`
+
+	// ItemBlockWorkerCount is the number of item blocks which are backed up concurrently.
+	// If it's not set, the server's default item block worker count is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ItemBlockWorkerCount int `json:"itemBlockWorkerCount,omitempty"`
 }
 
 // UploaderConfigForBackup defines the configuration for the uploader when doing backup.
@@ -451,6 +463,81 @@ type BackupStatus struct {
 	// +optional
 	// +nullable
 	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+
+	// Replicas are the statuses of the copies of the backup in the replica
+	// locations of its backup storage location.
+	// +optional
+	// +nullable
+	Replicas []BackupReplicaStatus `json:"replicas,omitempty"`
+
+	// ResourceModifiers records the resource modifier rules applied to the items of
+	// the backup, the items in the backup differ from the ones in the cluster if any
+	// rule was applied.
+	// +optional
+	// +nullable
+	ResourceModifiers *BackupResourceModifiersStatus `json:"resourceModifiers,omitempty"`
+}
+
+// BackupResourceModifiersStatus records the resource modifiers applied to the items of a backup.
+type BackupResourceModifiersStatus struct {
+	// ConfigMap is the name of the resource modifier configmap referenced by the backup.
+	ConfigMap string `json:"configMap"`
+
+	// AppliedRules are the rules of the resource modifiers applied to at least one item.
+	// +optional
+	// +nullable
+	AppliedRules []AppliedResourceModifierRule `json:"appliedRules,omitempty"`
+}
+
+// AppliedResourceModifierRule records a resource modifier rule applied to the items of a backup.
+type AppliedResourceModifierRule struct {
+	// Index is the index of the rule in the resource modifier rules.
+	Index int `json:"index"`
+
+	// GroupResource is the group resource condition of the rule.
+	GroupResource string `json:"groupResource"`
+
+	// ItemsModified is the number of items the rule was applied to.
+	ItemsModified int `json:"itemsModified"`
+}
+
+// BackupReplicaPhase is the state of the copy of a backup in a replica location.
+// +kubebuilder:validation:Enum=InProgress;Completed;Failed
+type BackupReplicaPhase string
+
+const (
+	BackupReplicaPhaseInProgress BackupReplicaPhase = "InProgress"
+	BackupReplicaPhaseCompleted  BackupReplicaPhase = "Completed"
+	BackupReplicaPhaseFailed     BackupReplicaPhase = "Failed"
+)
+
+// BackupReplicaStatus is the status of the copy of a backup in a replica location.
+type BackupReplicaStatus struct {
+	// Location is the name of the BackupStorageLocation the backup is copied to.
+	Location string `json:"location"`
+
+	// Phase is the current state of the copy.
+	// +optional
+	Phase BackupReplicaPhase `json:"phase,omitempty"`
+
+	// StartTimestamp records the time the latest attempt to copy the backup started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the latest attempt to copy the backup ended.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// FilesCopied is the number of the files of the backup and of its backup
+	// repositories which were copied to the location.
+	// +optional
+	FilesCopied int `json:"filesCopied,omitempty"`
+
+	// Message is a message about the status of the copy, e.g. why it failed.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
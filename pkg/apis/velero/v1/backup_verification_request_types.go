@@ -0,0 +1,115 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// BackupVerificationRequestSpec is the specification for a BackupVerificationRequest.
+type BackupVerificationRequestSpec struct {
+	// BackupName is the name of the backup to verify.
+	BackupName string `json:"backupName"`
+
+	// ReadContent specifies whether to read all the content of the volume
+	// snapshots in the backup repository, instead of only checking the
+	// snapshots exist. Only takes effect for the kopia repositories.
+	// +optional
+	ReadContent bool `json:"readContent,omitempty"`
+}
+
+// BackupVerificationRequestPhase represents the lifecycle phase of a BackupVerificationRequest.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;Failed
+type BackupVerificationRequestPhase string
+
+const (
+	BackupVerificationRequestPhaseNew        BackupVerificationRequestPhase = "New"
+	BackupVerificationRequestPhaseInProgress BackupVerificationRequestPhase = "InProgress"
+	BackupVerificationRequestPhaseCompleted  BackupVerificationRequestPhase = "Completed"
+	BackupVerificationRequestPhaseFailed     BackupVerificationRequestPhase = "Failed"
+)
+
+// BackupVerificationRequestStatus is the current status of a BackupVerificationRequest.
+type BackupVerificationRequestStatus struct {
+	// Phase is the current state of the BackupVerificationRequest.
+	// It is Completed if every item and volume snapshot in the backup
+	// is verified, otherwise it is Failed.
+	// +optional
+	Phase BackupVerificationRequestPhase `json:"phase,omitempty"`
+
+	// StartTimestamp records the time the verification was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the verification was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// ItemsVerified is the number of items in the backup tarball that
+	// are decoded successfully.
+	// +optional
+	ItemsVerified int `json:"itemsVerified,omitempty"`
+
+	// VolumeSnapshotsVerified is the number of pod volume backup and data
+	// mover snapshots that are found in the backup repository.
+	// +optional
+	VolumeSnapshotsVerified int `json:"volumeSnapshotsVerified,omitempty"`
+
+	// Errors is a list of problems found during the verification.
+	// +optional
+	// +nullable
+	Errors []string `json:"errors,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="Name of the backup to verify"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Verification status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// BackupVerificationRequest is a request to verify that a backup in backup
+// object storage could be restored, by decoding every item in the backup
+// contents and checking the volume snapshots in the backup repositories.
+type BackupVerificationRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupVerificationRequestSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BackupVerificationRequestStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=backupverificationrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backupverificationrequests/status,verbs=get;update;patch
+
+// BackupVerificationRequestList is a list of BackupVerificationRequests.
+type BackupVerificationRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupVerificationRequest `json:"items"`
+}
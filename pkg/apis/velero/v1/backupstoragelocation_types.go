@@ -50,12 +50,180 @@ type BackupStorageLocationSpec struct {
 	// +nullable
 	BackupSyncPeriod *metav1.Duration `json:"backupSyncPeriod,omitempty"`
 
+	// BackupSyncFilter restricts the backups synced from object storage.
+	// +optional
+	// +nullable
+	BackupSyncFilter *BackupSyncFilter `json:"backupSyncFilter,omitempty"`
+
 	// ValidationFrequency defines how frequently to validate the corresponding object storage. A value of 0 disables validation.
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// ReplicaLocations are the names of the other BackupStorageLocations
+	// which the backups stored in this location, and the content of their
+	// Kopia repositories, are copied to once they finish.
+	// +optional
+	// +nullable
+	ReplicaLocations []string `json:"replicaLocations,omitempty"`
+
+	// Immutability configures the object locks set on the backups stored in
+	// this location and on its Kopia repositories. It requires an object
+	// store plugin implementing the ObjectStore v2 API and a bucket with
+	// object lock enabled.
+	// +optional
+	// +nullable
+	Immutability *BackupStorageLocationImmutability `json:"immutability,omitempty"`
+
+	// Encryption configures the client-side encryption of the files Velero
+	// stores in this location. The content of the Kopia repositories isn't
+	// affected, as Kopia encrypts it on its own.
+	// +optional
+	// +nullable
+	Encryption *BackupStorageLocationEncryption `json:"encryption,omitempty"`
+
+	// BandwidthLimits throttles the data transferred to and from this
+	// location, both by the Kopia repositories and by the backup and restore
+	// files uploaded and downloaded by the Velero server.
+	// +optional
+	// +nullable
+	BandwidthLimits *BandwidthLimits `json:"bandwidthLimits,omitempty"`
+}
+
+// BackupStorageLocationEncryption defines the key encryption key the files
+// stored in a BackupStorageLocation are encrypted with. Exactly one of
+// KeySecret and KMS must be set.
+type BackupStorageLocationEncryption struct {
+	// KeyID is the ID of the key encryption key new files are encrypted with.
+	// The files encrypted with other keys are decrypted with the key recorded
+	// in them, so the previous keys must stay available after a rotation.
+	KeyID string `json:"keyID"`
+
+	// KeySecret is the name of the Secret, in the Velero namespace, holding
+	// the key encryption keys by their IDs. Each key is 32 bytes, raw or
+	// base64 encoded.
+	// +optional
+	KeySecret string `json:"keySecret,omitempty"`
+
+	// KMS is the KeyManager plugin wrapping the keys with a key management service.
+	// +optional
+	// +nullable
+	KMS *KMSConfig `json:"kms,omitempty"`
+
+	// AllowUnencryptedFiles allows reading the files which aren't encrypted, e.g.
+	// because they were stored before the encryption was enabled on the location.
+	// Otherwise reading them fails, so the files can't be swapped for forged
+	// plaintext ones. It's meant for the migration of existing locations only.
+	// +optional
+	AllowUnencryptedFiles bool `json:"allowUnencryptedFiles,omitempty"`
+}
+
+// KMSConfig defines the KeyManager plugin wrapping the encryption keys.
+type KMSConfig struct {
+	// Provider is the name of the KeyManager plugin.
+	Provider string `json:"provider"`
+
+	// Config is for provider-specific configuration fields.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// BackupSyncFilter defines the backups synced from a BackupStorageLocation.
+// The backups already in the cluster aren't affected.
+type BackupSyncFilter struct {
+	// LabelSelector selects the synced backups by their labels.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// MaxAge is the age of the oldest backups synced, by their start time.
+	// +optional
+	// +nullable
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// BandwidthLimits defines the rate limits of the data transferred to and from
+// the object storage, optionally varying by the time of day.
+type BandwidthLimits struct {
+	// BandwidthLimit is the limit applying outside of the scheduled windows.
+	BandwidthLimit `json:",inline"`
+
+	// Schedule defines different limits for windows of the day. The first
+	// window containing the current time applies.
+	// +optional
+	Schedule []ScheduledBandwidthLimit `json:"schedule,omitempty"`
+
+	// TimeZone is the IANA name of the time zone the scheduled windows are
+	// expressed in. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// BandwidthLimit defines the rate limits of the data transferred to and from
+// the object storage. A zero value means unlimited.
+type BandwidthLimit struct {
+	// UploadBytesPerSecond is the maximum rate of the data uploaded.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	UploadBytesPerSecond int64 `json:"uploadBytesPerSecond,omitempty"`
+
+	// DownloadBytesPerSecond is the maximum rate of the data downloaded.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DownloadBytesPerSecond int64 `json:"downloadBytesPerSecond,omitempty"`
+
+	// OperationsPerSecond is the maximum rate of each kind of object
+	// storage operations, i.e. reads, writes and lists.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	OperationsPerSecond int64 `json:"operationsPerSecond,omitempty"`
+}
+
+// ScheduledBandwidthLimit defines the rate limits applying during a window
+// of the day.
+type ScheduledBandwidthLimit struct {
+	// Start is the time of the day the window starts at, in the HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of the day the window ends at, in the HH:MM format.
+	// A window ending before its start spans midnight.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// BandwidthLimit is the limit applying during the window.
+	BandwidthLimit `json:",inline"`
+}
+
+// BackupStorageLocationImmutability defines the object locks set on the
+// content of a BackupStorageLocation.
+type BackupStorageLocationImmutability struct {
+	// Mode is the object lock mode. Objects locked in Compliance mode can't
+	// be unlocked by any user until their retention expires.
+	// +optional
+	Mode ObjectLockMode `json:"mode,omitempty"`
+
+	// RepositoryRetentionPeriod is how long the objects written to the Kopia
+	// repositories of the location stay locked. It's extended by the repository
+	// maintenance as long as the objects are in use, so it must be longer than
+	// the full maintenance interval by at least 24 hours. Defaults to 30 days.
+	// +optional
+	// +nullable
+	RepositoryRetentionPeriod *metav1.Duration `json:"repositoryRetentionPeriod,omitempty"`
 }
 
+// ObjectLockMode represents the mode of the object locks set on the content of a BackupStorageLocation.
+// +kubebuilder:validation:Enum=Governance;Compliance
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance allows users with special permissions to remove or shorten the locks.
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+
+	// ObjectLockModeCompliance prevents any user from removing or shortening the locks.
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
 type BackupStorageLocationStatus struct {
 	// Phase is the current state of the BackupStorageLocation.
@@ -74,6 +242,12 @@ type BackupStorageLocationStatus struct {
 	// +nullable
 	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
 
+	// LastCredentialRotationTime is the last time a change of the location's
+	// credential secret was detected and picked up by the server.
+	// +optional
+	// +nullable
+	LastCredentialRotationTime *metav1.Time `json:"lastCredentialRotationTime,omitempty"`
+
 	// Message is a message about the backup storage location's status.
 	// +optional
 	Message string `json:"message,omitempty"`
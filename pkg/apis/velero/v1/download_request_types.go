@@ -22,10 +22,17 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 type DownloadRequestSpec struct {
 	// Target is what to download (e.g. logs for a backup).
 	Target DownloadTarget `json:"target"`
+
+	// EncryptionPublicKey is the X25519 public key the data key of the target file
+	// is wrapped for, when the file is encrypted by its backup storage location.
+	// The encrypted files are only downloadable by requests setting it.
+	// +optional
+	// +nullable
+	EncryptionPublicKey []byte `json:"encryptionPublicKey,omitempty"`
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupVolumeInfos;RestoreVolumeInfo
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupVolumeInfos;RestoreVolumeInfo;BackupVerification;BackupChecksums;RestoreDryRunResults
 type DownloadTargetKind string
 
 const (
@@ -43,6 +50,9 @@ const (
 	DownloadTargetKindCSIBackupVolumeSnapshotContents DownloadTargetKind = "CSIBackupVolumeSnapshotContents"
 	DownloadTargetKindBackupVolumeInfos               DownloadTargetKind = "BackupVolumeInfos"
 	DownloadTargetKindRestoreVolumeInfo               DownloadTargetKind = "RestoreVolumeInfo"
+	DownloadTargetKindBackupVerification              DownloadTargetKind = "BackupVerification"
+	DownloadTargetKindBackupChecksums                 DownloadTargetKind = "BackupChecksums"
+	DownloadTargetKindRestoreDryRunResults            DownloadTargetKind = "RestoreDryRunResults"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
@@ -79,10 +89,22 @@ type DownloadRequestStatus struct {
 	// +optional
 	DownloadURL string `json:"downloadURL,omitempty"`
 
+	// WrappedEncryptionKey is the data key decrypting the target file, wrapped
+	// for the EncryptionPublicKey of the request. It's set when the file is
+	// encrypted by its backup storage location.
+	// +optional
+	// +nullable
+	WrappedEncryptionKey []byte `json:"wrappedEncryptionKey,omitempty"`
+
 	// Expiration is when this DownloadRequest expires and can be deleted by the system.
 	// +optional
 	// +nullable
 	Expiration *metav1.Time `json:"expiration,omitempty"`
+
+	// Message is a message about why the DownloadRequest was processed without
+	// a DownloadURL.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
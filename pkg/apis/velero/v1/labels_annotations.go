@@ -127,6 +127,16 @@ const (
 	VolumeSnapshotClassDriverBackupAnnotationPrefix = "velero.io/csi-volumesnapshot-class"
 	VolumeSnapshotClassDriverPVCAnnotation          = "velero.io/csi-volumesnapshot-class"
 
+	// VolumeGroupLabel is the label key on PVCs whose value names a group of volumes
+	// that should be snapshotted together with a CSI VolumeGroupSnapshot.
+	VolumeGroupLabel = "velero.io/volume-group"
+	// VolumeGroupSnapshotClassSelectorLabel is the label key on the VolumeGroupSnapshotClass
+	// that Velero should use for a CSI driver.
+	VolumeGroupSnapshotClassSelectorLabel = "velero.io/csi-volumegroupsnapshot-class"
+	// VolumeGroupSnapshotClassDriverBackupAnnotationPrefix is the annotation key prefix on
+	// a backup used to select the VolumeGroupSnapshotClass for a CSI driver.
+	VolumeGroupSnapshotClassDriverBackupAnnotationPrefix = "velero.io/csi-volumegroupsnapshot-class"
+
 	// There is no release w/ these constants exported. Using the strings for now.
 	// CSI Annotation volumesnapshotclass
 	// https://github.com/kubernetes-csi/external-snapshotter/blob/master/pkg/utils/util.go#L59-L60
@@ -143,6 +153,13 @@ const (
 	// find out if the snapshot was skipped b/c the PV is not provisioned via CSI
 	SkippedNoCSIPVAnnotation = "backup.velero.io/skipped-no-csi-pv"
 
+	// VolumePolicySnapshotClassAnnotation, VolumePolicySnapshotMoveDataAnnotation and
+	// VolumePolicyDataMoverAnnotation are set by Velero on the PVC passed to the CSI plugin
+	// to carry the parameters of the matched volume policy, and removed after the plugin returns
+	VolumePolicySnapshotClassAnnotation    = "backup.velero.io/volume-policy-snapshot-class"
+	VolumePolicySnapshotMoveDataAnnotation = "backup.velero.io/volume-policy-snapshot-move-data"
+	VolumePolicyDataMoverAnnotation        = "backup.velero.io/volume-policy-data-mover"
+
 	// DynamicPVRestoreLabel is the label key for dynamic PV restore
 	DynamicPVRestoreLabel = "velero.io/dynamic-pv-restore"
 
@@ -59,6 +59,14 @@ type RestoreSpec struct {
 	// +nullable
 	ExcludedResources []string `json:"excludedResources,omitempty"`
 
+	// IncludedItems is a slice of the items to restore, each in the format
+	// <resource>[.<group>]/<namespace>/<name>, or <resource>[.<group>]/<name>
+	// for cluster-scoped items. If empty, all the items matching the other
+	// filters are restored.
+	// +optional
+	// +nullable
+	IncludedItems []string `json:"includedItems,omitempty"`
+
 	// NamespaceMapping is a map of source namespace names
 	// to target namespace names to restore into. Any source
 	// namespaces not included in the map will be restored into
@@ -115,6 +123,20 @@ type RestoreSpec struct {
 	// +nullable
 	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
 
+	// ExistingResourcePolicyOverrides overrides ExistingResourcePolicy for
+	// specific resources. The keys are resource names, optionally qualified
+	// by their group, e.g. "configmaps" or "jobs.batch".
+	// +optional
+	// +nullable
+	ExistingResourcePolicyOverrides map[string]PolicyType `json:"existingResourcePolicyOverrides,omitempty"`
+
+	// RecreateWaitTimeout specifies how long to wait for a deleted resource to
+	// be removed from the cluster, e.g. until its finalizers have run, before
+	// re-creating it when the recreate policy applies. If zero, velero
+	// re-creates the resource right after deleting it.
+	// +optional
+	RecreateWaitTimeout metav1.Duration `json:"recreateWaitTimeout,omitempty"`
+
 	// ItemOperationTimeout specifies the time used to wait for RestoreItemAction operations
 	// The default value is 4 hour.
 	// +optional
@@ -129,6 +151,12 @@ type RestoreSpec struct {
 	// +optional
 	// +nullable
 	UploaderConfig *UploaderConfigForRestore `json:"uploaderConfig,omitempty"`
+
+	// DryRun specifies whether to only report what the restore would do
+	// without creating or updating any resource or restoring any volume data.
+	// +optional
+	// +nullable
+	DryRun *bool `json:"dryRun,omitempty"`
 }
 
 // UploaderConfigForRestore defines the configuration for the restore.
@@ -313,6 +341,11 @@ const (
 	// PolicyTypeUpdate means velero will try to attempt a patch on
 	// the changed resources.
 	PolicyTypeUpdate PolicyType = "update"
+
+	// PolicyTypeRecreate means velero will delete the changed resources
+	// in cluster and create them again from the backup. ServiceAccounts,
+	// PVCs, PVs and CRDs are updated instead.
+	PolicyTypeRecreate PolicyType = "recreate"
 )
 
 // RestoreStatus captures the current status of a Velero restore
@@ -49,6 +49,160 @@ type ScheduleSpec struct {
 	// If empty, will follow server configuration (default: false).
 	// +optional
 	SkipImmediately *bool `json:"skipImmediately,omitempty"`
+
+	// TimeZone is the IANA time zone name, e.g. "Europe/Berlin", in which
+	// Schedule and BlackoutWindows are evaluated. If empty, the time zone
+	// of the Velero server is used.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// BlackoutWindows are the recurring intervals during which the due
+	// backups are deferred or skipped.
+	// +optional
+	// +nullable
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty"`
+
+	// Jitter is the maximum delay added to the run times of the Schedule.
+	// The delay is derived from the namespace and name of the Schedule, so
+	// the Schedules sharing the same cron expression are spread across the
+	// window. It should be shorter than the interval between the runs.
+	// +optional
+	Jitter metav1.Duration `json:"jitter,omitempty"`
+
+	// ConcurrencyPolicy specifies what to do when a Backup is due while
+	// the previous Backup of this Schedule hasn't finished yet. The default
+	// value is Queue.
+	// +optional
+	ConcurrencyPolicy ScheduleConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// StartingDeadlineSeconds is the deadline in seconds for starting a
+	// Backup which missed its scheduled time, e.g. because the Velero
+	// server was down. A Backup which can't be started before the deadline
+	// is recorded as a missed run. If empty, there is no deadline.
+	// +optional
+	// +nullable
+	// +kubebuilder:validation:Minimum=0
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// Retention specifies which of the backups created by this Schedule
+	// to keep. The others are deleted even if they haven't expired yet.
+	// If empty, the backups are only deleted when they expire.
+	// +optional
+	// +nullable
+	Retention *ScheduleRetention `json:"retention,omitempty"`
+}
+
+// BlackoutWindow is a recurring interval during which the due backups of a
+// Schedule are not run.
+type BlackoutWindow struct {
+	// Name is the name of the window, which is shown in the reason of the
+	// deferred or skipped backups.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Start is a Cron expression defining when the window starts.
+	Start string `json:"start"`
+
+	// Duration is how long the window lasts.
+	Duration metav1.Duration `json:"duration"`
+
+	// Action specifies what to do with the backups due during the window.
+	// Defer runs them when the window ends, Skip doesn't run them. The
+	// default value is Defer.
+	// +optional
+	Action BlackoutWindowAction `json:"action,omitempty"`
+}
+
+// BlackoutWindowAction is what to do with a backup which is due during a
+// blackout window.
+// +kubebuilder:validation:Enum=Defer;Skip
+type BlackoutWindowAction string
+
+const (
+	// BlackoutWindowActionDefer means the backup is run when the window ends.
+	BlackoutWindowActionDefer BlackoutWindowAction = "Defer"
+
+	// BlackoutWindowActionSkip means the backup is skipped, and the Schedule
+	// runs at the next time after the skipped one.
+	BlackoutWindowActionSkip BlackoutWindowAction = "Skip"
+)
+
+// ScheduleConcurrencyPolicy is what to do with a due Backup of a Schedule
+// while the previous Backup of the Schedule hasn't finished.
+// +kubebuilder:validation:Enum=Forbid;Queue;Replace
+type ScheduleConcurrencyPolicy string
+
+const (
+	// ScheduleConcurrencyPolicyForbid means the due Backup is skipped and
+	// recorded as a missed run.
+	ScheduleConcurrencyPolicyForbid ScheduleConcurrencyPolicy = "Forbid"
+
+	// ScheduleConcurrencyPolicyQueue means the due Backup is run when the
+	// previous Backup finishes.
+	ScheduleConcurrencyPolicyQueue ScheduleConcurrencyPolicy = "Queue"
+
+	// ScheduleConcurrencyPolicyReplace means the previous Backups which
+	// haven't started yet are deleted and recorded as missed runs, and the
+	// due Backup is run. A Backup which is already in progress can't be
+	// interrupted, so the due Backup is processed after it.
+	ScheduleConcurrencyPolicyReplace ScheduleConcurrencyPolicy = "Replace"
+)
+
+// ScheduleRetention is a grandfather-father-son retention policy for the
+// backups created by a Schedule. Completed and PartiallyFailed backups are
+// counted separately, and the backups in other phases are never deleted by it.
+type ScheduleRetention struct {
+	// ScheduleRetentionRules applies to the Completed backups, and to the
+	// PartiallyFailed backups if PartiallyFailed isn't specified or doesn't
+	// keep any backup.
+	ScheduleRetentionRules `json:",inline"`
+
+	// PartiallyFailed specifies the rules for the PartiallyFailed backups.
+	// Rules which don't keep any backup are ignored.
+	// +optional
+	// +nullable
+	PartiallyFailed *ScheduleRetentionRules `json:"partiallyFailed,omitempty"`
+}
+
+// ScheduleRetentionRules specifies how many backups to keep for each period.
+// A backup is kept if any of the rules keeps it. For the periodic rules, the
+// latest backup of each of the latest N periods which have backups is kept.
+type ScheduleRetentionRules struct {
+	// KeepLast is the number of the latest backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast int `json:"keepLast,omitempty"`
+
+	// KeepHourly is the number of hours to keep the latest backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepHourly int `json:"keepHourly,omitempty"`
+
+	// KeepDaily is the number of days to keep the latest backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepDaily int `json:"keepDaily,omitempty"`
+
+	// KeepWeekly is the number of ISO weeks to keep the latest backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepWeekly int `json:"keepWeekly,omitempty"`
+
+	// KeepMonthly is the number of months to keep the latest backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepMonthly int `json:"keepMonthly,omitempty"`
+
+	// KeepYearly is the number of years to keep the latest backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepYearly int `json:"keepYearly,omitempty"`
+}
+
+// IsEmpty returns true if no rule keeps any backup.
+func (r ScheduleRetentionRules) IsEmpty() bool {
+	return r.KeepLast <= 0 && r.KeepHourly <= 0 && r.KeepDaily <= 0 &&
+		r.KeepWeekly <= 0 && r.KeepMonthly <= 0 && r.KeepYearly <= 0
 }
 
 // SchedulePhase is a string representation of the lifecycle phase
@@ -87,6 +241,30 @@ type ScheduleStatus struct {
 	// +nullable
 	LastSkipped *metav1.Time `json:"lastSkipped,omitempty"`
 
+	// NextRunTime is the next time a Backup is due to run for this
+	// Schedule, including the jitter and the deferral by blackout windows.
+	// +optional
+	// +nullable
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+
+	// DeferralReason explains why the due Backup of this Schedule has been
+	// deferred or skipped. It's cleared when a Backup is run.
+	// +optional
+	DeferralReason string `json:"deferralReason,omitempty"`
+
+	// MissedRuns is the total number of the scheduled times of this
+	// Schedule which didn't produce a Backup, because they were skipped by
+	// the concurrency policy or a blackout window, passed the starting
+	// deadline or were replaced.
+	// +optional
+	MissedRuns int64 `json:"missedRuns,omitempty"`
+
+	// LastMissedRun is the latest scheduled time of this Schedule which
+	// didn't produce a Backup.
+	// +optional
+	// +nullable
+	LastMissedRun *metav1.Time `json:"lastMissedRun,omitempty"`
+
 	// ValidationErrors is a slice of all validation errors (if
 	// applicable)
 	// +optional
@@ -10,6 +10,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedResourceModifierRule) DeepCopyInto(out *AppliedResourceModifierRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedResourceModifierRule.
+func (in *AppliedResourceModifierRule) DeepCopy() *AppliedResourceModifierRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedResourceModifierRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
@@ -106,12 +121,35 @@ func (in *BackupProgress) DeepCopy() *BackupProgress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupReplicaStatus) DeepCopyInto(out *BackupReplicaStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupReplicaStatus.
+func (in *BackupReplicaStatus) DeepCopy() *BackupReplicaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupRepository) DeepCopyInto(out *BackupRepository) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -165,10 +203,40 @@ func (in *BackupRepositoryList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryMaintenanceStatus) DeepCopyInto(out *BackupRepositoryMaintenanceStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompleteTimestamp != nil {
+		in, out := &in.CompleteTimestamp, &out.CompleteTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryMaintenanceStatus.
+func (in *BackupRepositoryMaintenanceStatus) DeepCopy() *BackupRepositoryMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupRepositorySpec) DeepCopyInto(out *BackupRepositorySpec) {
 	*out = *in
 	out.MaintenanceFrequency = in.MaintenanceFrequency
+	if in.RepositoryConfig != nil {
+		in, out := &in.RepositoryConfig, &out.RepositoryConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositorySpec.
@@ -188,6 +256,25 @@ func (in *BackupRepositoryStatus) DeepCopyInto(out *BackupRepositoryStatus) {
 		in, out := &in.LastMaintenanceTime, &out.LastMaintenanceTime
 		*out = (*in).DeepCopy()
 	}
+	if in.RecentMaintenance != nil {
+		in, out := &in.RecentMaintenance, &out.RecentMaintenance
+		*out = make([]BackupRepositoryMaintenanceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EffectiveRepositoryConfig != nil {
+		in, out := &in.EffectiveRepositoryConfig, &out.EffectiveRepositoryConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RepositoryConfigWarnings != nil {
+		in, out := &in.RepositoryConfigWarnings, &out.RepositoryConfigWarnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryStatus.
@@ -274,6 +361,26 @@ func (in *BackupResourceHookSpec) DeepCopy() *BackupResourceHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupResourceModifiersStatus) DeepCopyInto(out *BackupResourceModifiersStatus) {
+	*out = *in
+	if in.AppliedRules != nil {
+		in, out := &in.AppliedRules, &out.AppliedRules
+		*out = make([]AppliedResourceModifierRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupResourceModifiersStatus.
+func (in *BackupResourceModifiersStatus) DeepCopy() *BackupResourceModifiersStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupResourceModifiersStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 	*out = *in
@@ -375,6 +482,11 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceModifier != nil {
+		in, out := &in.ResourceModifier, &out.ResourceModifier
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SnapshotMoveData != nil {
 		in, out := &in.SnapshotMoveData, &out.SnapshotMoveData
 		*out = new(bool)
@@ -427,6 +539,18 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = new(HookStatus)
 		**out = **in
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]BackupReplicaStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceModifiers != nil {
+		in, out := &in.ResourceModifiers, &out.ResourceModifiers
+		*out = new(BackupResourceModifiersStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
@@ -466,6 +590,46 @@ func (in *BackupStorageLocation) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationEncryption) DeepCopyInto(out *BackupStorageLocationEncryption) {
+	*out = *in
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationEncryption.
+func (in *BackupStorageLocationEncryption) DeepCopy() *BackupStorageLocationEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationImmutability) DeepCopyInto(out *BackupStorageLocationImmutability) {
+	*out = *in
+	if in.RepositoryRetentionPeriod != nil {
+		in, out := &in.RepositoryRetentionPeriod, &out.RepositoryRetentionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationImmutability.
+func (in *BackupStorageLocationImmutability) DeepCopy() *BackupStorageLocationImmutability {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationImmutability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupStorageLocationList) DeepCopyInto(out *BackupStorageLocationList) {
 	*out = *in
@@ -519,11 +683,36 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.BackupSyncFilter != nil {
+		in, out := &in.BackupSyncFilter, &out.BackupSyncFilter
+		*out = new(BackupSyncFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ValidationFrequency != nil {
 		in, out := &in.ValidationFrequency, &out.ValidationFrequency
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.ReplicaLocations != nil {
+		in, out := &in.ReplicaLocations, &out.ReplicaLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Immutability != nil {
+		in, out := &in.Immutability, &out.Immutability
+		*out = new(BackupStorageLocationImmutability)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BackupStorageLocationEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BandwidthLimits != nil {
+		in, out := &in.BandwidthLimits, &out.BandwidthLimits
+		*out = new(BandwidthLimits)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationSpec.
@@ -547,6 +736,10 @@ func (in *BackupStorageLocationStatus) DeepCopyInto(out *BackupStorageLocationSt
 		in, out := &in.LastValidationTime, &out.LastValidationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastCredentialRotationTime != nil {
+		in, out := &in.LastCredentialRotationTime, &out.LastCredentialRotationTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationStatus.
@@ -559,6 +752,185 @@ func (in *BackupStorageLocationStatus) DeepCopy() *BackupStorageLocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSyncFilter) DeepCopyInto(out *BackupSyncFilter) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSyncFilter.
+func (in *BackupSyncFilter) DeepCopy() *BackupSyncFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSyncFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationRequest) DeepCopyInto(out *BackupVerificationRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationRequest.
+func (in *BackupVerificationRequest) DeepCopy() *BackupVerificationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupVerificationRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationRequestList) DeepCopyInto(out *BackupVerificationRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupVerificationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationRequestList.
+func (in *BackupVerificationRequestList) DeepCopy() *BackupVerificationRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupVerificationRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationRequestSpec) DeepCopyInto(out *BackupVerificationRequestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationRequestSpec.
+func (in *BackupVerificationRequestSpec) DeepCopy() *BackupVerificationRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationRequestStatus) DeepCopyInto(out *BackupVerificationRequestStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationRequestStatus.
+func (in *BackupVerificationRequestStatus) DeepCopy() *BackupVerificationRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimits) DeepCopyInto(out *BandwidthLimits) {
+	*out = *in
+	out.BandwidthLimit = in.BandwidthLimit
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = make([]ScheduledBandwidthLimit, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimits.
+func (in *BandwidthLimits) DeepCopy() *BandwidthLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutWindow) DeepCopyInto(out *BlackoutWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutWindow.
+func (in *BlackoutWindow) DeepCopy() *BlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeleteBackupRequest) DeepCopyInto(out *DeleteBackupRequest) {
 	*out = *in
@@ -658,7 +1030,7 @@ func (in *DownloadRequest) DeepCopyInto(out *DownloadRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -716,6 +1088,11 @@ func (in *DownloadRequestList) DeepCopyObject() runtime.Object {
 func (in *DownloadRequestSpec) DeepCopyInto(out *DownloadRequestSpec) {
 	*out = *in
 	out.Target = in.Target
+	if in.EncryptionPublicKey != nil {
+		in, out := &in.EncryptionPublicKey, &out.EncryptionPublicKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadRequestSpec.
@@ -731,6 +1108,11 @@ func (in *DownloadRequestSpec) DeepCopy() *DownloadRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownloadRequestStatus) DeepCopyInto(out *DownloadRequestStatus) {
 	*out = *in
+	if in.WrappedEncryptionKey != nil {
+		in, out := &in.WrappedEncryptionKey, &out.WrappedEncryptionKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
 	if in.Expiration != nil {
 		in, out := &in.Expiration, &out.Expiration
 		*out = (*in).DeepCopy()
@@ -848,6 +1230,28 @@ func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSConfig) DeepCopyInto(out *KMSConfig) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSConfig.
+func (in *KMSConfig) DeepCopy() *KMSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -1315,6 +1719,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IncludedItems != nil {
+		in, out := &in.IncludedItems, &out.IncludedItems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.NamespaceMapping != nil {
 		in, out := &in.NamespaceMapping, &out.NamespaceMapping
 		*out = make(map[string]string, len(*in))
@@ -1359,6 +1768,14 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		**out = **in
 	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.ExistingResourcePolicyOverrides != nil {
+		in, out := &in.ExistingResourcePolicyOverrides, &out.ExistingResourcePolicyOverrides
+		*out = make(map[string]PolicyType, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.RecreateWaitTimeout = in.RecreateWaitTimeout
 	out.ItemOperationTimeout = in.ItemOperationTimeout
 	if in.ResourceModifier != nil {
 		in, out := &in.ResourceModifier, &out.ResourceModifier
@@ -1370,6 +1787,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(UploaderConfigForRestore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
@@ -1504,6 +1926,42 @@ func (in *ScheduleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleRetention) DeepCopyInto(out *ScheduleRetention) {
+	*out = *in
+	out.ScheduleRetentionRules = in.ScheduleRetentionRules
+	if in.PartiallyFailed != nil {
+		in, out := &in.PartiallyFailed, &out.PartiallyFailed
+		*out = new(ScheduleRetentionRules)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRetention.
+func (in *ScheduleRetention) DeepCopy() *ScheduleRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleRetentionRules) DeepCopyInto(out *ScheduleRetentionRules) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRetentionRules.
+func (in *ScheduleRetentionRules) DeepCopy() *ScheduleRetentionRules {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleRetentionRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 	*out = *in
@@ -1518,6 +1976,22 @@ func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]BlackoutWindow, len(*in))
+		copy(*out, *in)
+	}
+	out.Jitter = in.Jitter
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ScheduleRetention)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSpec.
@@ -1541,6 +2015,14 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		in, out := &in.LastSkipped, &out.LastSkipped
 		*out = (*in).DeepCopy()
 	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastMissedRun != nil {
+		in, out := &in.LastMissedRun, &out.LastMissedRun
+		*out = (*in).DeepCopy()
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -1558,6 +2040,22 @@ func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledBandwidthLimit) DeepCopyInto(out *ScheduledBandwidthLimit) {
+	*out = *in
+	out.BandwidthLimit = in.BandwidthLimit
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledBandwidthLimit.
+func (in *ScheduledBandwidthLimit) DeepCopy() *ScheduledBandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledBandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerStatusRequest) DeepCopyInto(out *ServerStatusRequest) {
 	*out = *in
@@ -0,0 +1,60 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ItemReference identifies a single item in a Velero backup archive.
+type ItemReference struct {
+	// GroupResource is the resource of the item, as <resource>[.<group>].
+	GroupResource string
+	// Namespace is empty for cluster-scoped items.
+	Namespace string
+	Name      string
+}
+
+// ParseItemReference parses an item reference in the format
+// <resource>[.<group>]/<namespace>/<name>, or <resource>[.<group>]/<name>
+// for cluster-scoped items.
+func ParseItemReference(s string) (ItemReference, error) {
+	parts := strings.Split(s, "/")
+	for _, part := range parts {
+		if part == "" {
+			return ItemReference{}, fmt.Errorf("invalid item %q, expected <resource>[.<group>]/[<namespace>/]<name>", s)
+		}
+	}
+
+	switch len(parts) {
+	case 2:
+		return ItemReference{GroupResource: strings.ToLower(parts[0]), Name: parts[1]}, nil
+	case 3:
+		return ItemReference{GroupResource: strings.ToLower(parts[0]), Namespace: parts[1], Name: parts[2]}, nil
+	default:
+		return ItemReference{}, fmt.Errorf("invalid item %q, expected <resource>[.<group>]/[<namespace>/]<name>", s)
+	}
+}
+
+// String returns the item reference in the format accepted by ParseItemReference.
+func (r ItemReference) String() string {
+	if r.Namespace == "" {
+		return r.GroupResource + "/" + r.Name
+	}
+	return r.GroupResource + "/" + r.Namespace + "/" + r.Name
+}
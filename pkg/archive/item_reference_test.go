@@ -0,0 +1,80 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseItemReference(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    ItemReference
+		expectedErr bool
+	}{
+		{
+			name:     "namespaced core item",
+			input:    "configmaps/ns-1/cm-1",
+			expected: ItemReference{GroupResource: "configmaps", Namespace: "ns-1", Name: "cm-1"},
+		},
+		{
+			name:     "namespaced item with group",
+			input:    "Deployments.apps/ns-1/deploy-1",
+			expected: ItemReference{GroupResource: "deployments.apps", Namespace: "ns-1", Name: "deploy-1"},
+		},
+		{
+			name:     "cluster-scoped item",
+			input:    "persistentvolumes/pv-1",
+			expected: ItemReference{GroupResource: "persistentvolumes", Name: "pv-1"},
+		},
+		{
+			name:        "resource only",
+			input:       "configmaps",
+			expectedErr: true,
+		},
+		{
+			name:        "empty name",
+			input:       "configmaps/ns-1/",
+			expectedErr: true,
+		},
+		{
+			name:        "too many parts",
+			input:       "configmaps/ns-1/cm-1/extra",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, err := ParseItemReference(tc.input)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ref)
+
+			roundTrip, err := ParseItemReference(ref.String())
+			require.NoError(t, err)
+			assert.Equal(t, ref, roundTrip)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	groupsnapshotv1alpha1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -46,6 +47,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/csi"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/stringptr"
 )
 
 // pvcBackupItemAction is a backup item action plugin for Velero.
@@ -191,6 +193,158 @@ func (p *pvcBackupItemAction) createVolumeSnapshot(
 	return vs, nil
 }
 
+// getVolumeSnapshotFromGroup returns the VolumeSnapshot of a PVC that belongs to
+// a volume group. The first PVC of the group that is backed up creates a CSI
+// VolumeGroupSnapshot covering all the PVCs of the group in the namespace, the
+// following PVCs pick up their member VolumeSnapshots created by it.
+func (p *pvcBackupItemAction) getVolumeSnapshotFromGroup(
+	pvc corev1api.PersistentVolumeClaim,
+	backup *velerov1api.Backup,
+	group string,
+) (*snapshotv1api.VolumeSnapshot, error) {
+	pv, err := kubeutil.GetPVForPVC(&pvc, p.crClient)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vs, err := p.findGroupMemberVolumeSnapshot(pvc, pv, backup, group)
+	if err != nil || vs != nil {
+		return vs, err
+	}
+
+	if err := p.createVolumeGroupSnapshot(pvc, pv, backup, group); err != nil {
+		return nil, err
+	}
+
+	vs, err = p.findGroupMemberVolumeSnapshot(pvc, pv, backup, group)
+	if err != nil {
+		return nil, err
+	}
+	if vs == nil {
+		return nil, errors.Errorf(
+			"no VolumeSnapshot found for PVC %s/%s in the VolumeGroupSnapshot of volume group %s",
+			pvc.Namespace, pvc.Name, group)
+	}
+
+	return vs, nil
+}
+
+// findGroupMemberVolumeSnapshot looks for the PVC's VolumeSnapshot among the members
+// of the volume group's VolumeGroupSnapshot taken by the backup. Member VolumeSnapshots
+// are matched either by their source PVC or by the CSI volume handle of their
+// VolumeSnapshotContent.
+func (p *pvcBackupItemAction) findGroupMemberVolumeSnapshot(
+	pvc corev1api.PersistentVolumeClaim,
+	pv *corev1api.PersistentVolume,
+	backup *velerov1api.Backup,
+	group string,
+) (*snapshotv1api.VolumeSnapshot, error) {
+	vsList := new(snapshotv1api.VolumeSnapshotList)
+	if err := p.crClient.List(
+		context.TODO(),
+		vsList,
+		crclient.InNamespace(pvc.Namespace),
+		crclient.MatchingLabels(groupMemberLabels(backup, group)),
+	); err != nil {
+		return nil, errors.Wrap(err, "error listing VolumeSnapshots of volume group")
+	}
+
+	for i := range vsList.Items {
+		vs := &vsList.Items[i]
+		if vs.Spec.Source.PersistentVolumeClaimName != nil {
+			if *vs.Spec.Source.PersistentVolumeClaimName == pvc.Name {
+				return vs, nil
+			}
+			continue
+		}
+
+		if vs.Status == nil || vs.Status.BoundVolumeSnapshotContentName == nil {
+			continue
+		}
+		vsc := new(snapshotv1api.VolumeSnapshotContent)
+		if err := p.crClient.Get(
+			context.TODO(),
+			crclient.ObjectKey{Name: *vs.Status.BoundVolumeSnapshotContentName},
+			vsc,
+		); err != nil {
+			return nil, errors.Wrapf(err, "error getting VolumeSnapshotContent %s",
+				*vs.Status.BoundVolumeSnapshotContentName)
+		}
+		if vsc.Spec.Source.VolumeHandle != nil &&
+			*vsc.Spec.Source.VolumeHandle == pv.Spec.CSI.VolumeHandle {
+			return vs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (p *pvcBackupItemAction) createVolumeGroupSnapshot(
+	pvc corev1api.PersistentVolumeClaim,
+	pv *corev1api.PersistentVolume,
+	backup *velerov1api.Backup,
+	group string,
+) error {
+	p.log.Debugf("Fetching VolumeGroupSnapshotClass for %s", pv.Spec.CSI.Driver)
+	groupClass, err := csi.GetVolumeGroupSnapshotClass(pv.Spec.CSI.Driver, backup, p.crClient)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VolumeGroupSnapshotClass for driver %s",
+			pv.Spec.CSI.Driver)
+	}
+	p.log.Infof("VolumeGroupSnapshotClass=%s", groupClass.Name)
+
+	vgs := &groupsnapshotv1alpha1.VolumeGroupSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "velero-group-",
+			Namespace:    pvc.Namespace,
+			Labels:       groupMemberLabels(backup, group),
+		},
+		Spec: groupsnapshotv1alpha1.VolumeGroupSnapshotSpec{
+			Source: groupsnapshotv1alpha1.VolumeGroupSnapshotSource{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						velerov1api.VolumeGroupLabel: group,
+					},
+				},
+			},
+			VolumeGroupSnapshotClassName: &groupClass.Name,
+		},
+	}
+
+	if err := p.crClient.Create(context.TODO(), vgs); err != nil {
+		return errors.Wrap(err, "error creating volume group snapshot")
+	}
+	p.log.Infof("Created VolumeGroupSnapshot %s/%s for volume group %s",
+		vgs.Namespace, vgs.Name, group)
+
+	readyVGS, err := csi.WaitVolumeGroupSnapshotReady(
+		context.Background(),
+		p.crClient,
+		vgs.Name,
+		vgs.Namespace,
+		backup.Spec.CSISnapshotTimeout.Duration,
+		p.log,
+	)
+	if err != nil {
+		if deleteErr := p.crClient.Delete(context.TODO(), vgs); deleteErr != nil &&
+			!apierrors.IsNotFound(deleteErr) {
+			p.log.WithError(deleteErr).Errorf("fail to delete VolumeGroupSnapshot %s/%s",
+				vgs.Namespace, vgs.Name)
+		}
+		return errors.Wrapf(err, "error waiting for VolumeGroupSnapshot %s/%s to be ready",
+			vgs.Namespace, vgs.Name)
+	}
+
+	return csi.ReleaseVolumeGroupSnapshot(readyVGS, groupMemberLabels(backup, group), p.crClient, p.log)
+}
+
+func groupMemberLabels(backup *velerov1api.Backup, group string) map[string]string {
+	return map[string]string{
+		velerov1api.BackupNameLabel:  label.GetValidName(backup.Name),
+		velerov1api.VolumeGroupLabel: group,
+	}
+}
+
 // Execute recognizes PVCs backed by volumes provisioned by CSI drivers
 // with VolumeSnapshotting capability and creates snapshots of the
 // underlying PVs by creating VolumeSnapshot CSI API objects that will
@@ -229,7 +383,13 @@ func (p *pvcBackupItemAction) Execute(
 		return item, nil, "", nil, nil
 	}
 
-	vs, err := p.createVolumeSnapshot(pvc, backup)
+	var vs *snapshotv1api.VolumeSnapshot
+	var err error
+	if group := pvc.Labels[velerov1api.VolumeGroupLabel]; group != "" {
+		vs, err = p.getVolumeSnapshotFromGroup(pvc, backup, group)
+	} else {
+		vs, err = p.createVolumeSnapshot(pvc, backup)
+	}
 	if err != nil {
 		return nil, nil, "", nil, err
 	}
@@ -248,7 +408,13 @@ func (p *pvcBackupItemAction) Execute(
 	operationID := ""
 	var itemToUpdate []velero.ResourceIdentifier
 
-	if boolptr.IsSetToTrue(backup.Spec.SnapshotMoveData) {
+	// the matched volume policy may choose to move the data of this volume or not
+	snapshotMoveData := boolptr.IsSetToTrue(backup.Spec.SnapshotMoveData)
+	if value, ok := pvc.Annotations[velerov1api.VolumePolicySnapshotMoveDataAnnotation]; ok {
+		snapshotMoveData = value == "true"
+	}
+
+	if snapshotMoveData {
 		operationID = label.GetValidName(
 			string(
 				velerov1api.AsyncOperationIDPrefixDataUpload,
@@ -425,6 +591,11 @@ func newDataUpload(
 	pvc *corev1api.PersistentVolumeClaim,
 	operationID string,
 ) *velerov2alpha1.DataUpload {
+	dataMover := backup.Spec.DataMover
+	if value, ok := pvc.Annotations[velerov1api.VolumePolicyDataMoverAnnotation]; ok {
+		dataMover = value
+	}
+
 	dataUpload := &velerov2alpha1.DataUpload{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
@@ -454,10 +625,10 @@ func newDataUpload(
 			CSISnapshot: &velerov2alpha1.CSISnapshotSpec{
 				VolumeSnapshot: vs.Name,
 				StorageClass:   *pvc.Spec.StorageClassName,
-				SnapshotClass:  *vs.Spec.VolumeSnapshotClassName,
+				SnapshotClass:  stringptr.GetString(vs.Spec.VolumeSnapshotClassName),
 			},
 			SourcePVC:             pvc.Name,
-			DataMover:             backup.Spec.DataMover,
+			DataMover:             dataMover,
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			SourceNamespace:       pvc.Namespace,
 			OperationTimeout:      backup.Spec.CSISnapshotTimeout,
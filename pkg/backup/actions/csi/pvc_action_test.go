@@ -24,6 +24,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	groupsnapshotv1alpha1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	v1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	"github.com/sirupsen/logrus"
@@ -36,12 +37,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
@@ -113,6 +116,58 @@ func TestExecute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "Test parameters of volume policy",
+			backup: builder.ForBackup("velero", "test").CSISnapshotTimeout(1 * time.Minute).Result(),
+			pvc: builder.ForPersistentVolumeClaim("velero", "testPVC").VolumeName("testPV").StorageClass("testSC").Phase(corev1.ClaimBound).
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.VolumePolicySnapshotMoveDataAnnotation, "true",
+					velerov1api.VolumePolicySnapshotClassAnnotation, "goldVSClass",
+					velerov1api.VolumePolicyDataMoverAnnotation, "custom",
+				)).Result(),
+			pv:          builder.ForPersistentVolume("testPV").CSI("hostpath", "testVolume").Result(),
+			sc:          builder.ForStorageClass("testSC").Provisioner("hostpath").Result(),
+			vsClass:     builder.ForVolumeSnapshotClass("goldVSClass").Driver("hostpath").Result(),
+			operationID: ".",
+			expectedErr: nil,
+			expectedDataUpload: &velerov2alpha1.DataUpload{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "DataUpload",
+					APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-",
+					Namespace:    "velero",
+					Labels: map[string]string{
+						velerov1api.BackupNameLabel:       "test",
+						velerov1api.BackupUIDLabel:        "",
+						velerov1api.PVCUIDLabel:           "",
+						velerov1api.AsyncOperationIDLabel: "du-.",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "velero.io/v1",
+							Kind:       "Backup",
+							Name:       "test",
+							UID:        "",
+							Controller: &boolTrue,
+						},
+					},
+				},
+				Spec: velerov2alpha1.DataUploadSpec{
+					SnapshotType: velerov2alpha1.SnapshotTypeCSI,
+					CSISnapshot: &velerov2alpha1.CSISnapshotSpec{
+						VolumeSnapshot: "",
+						StorageClass:   "testSC",
+						SnapshotClass:  "goldVSClass",
+					},
+					SourcePVC:        "testPVC",
+					SourceNamespace:  "velero",
+					DataMover:        "custom",
+					OperationTimeout: metav1.Duration{Duration: 1 * time.Minute},
+				},
+			},
+		},
 		{
 			name:        "Verify PVC is modified as expected",
 			backup:      builder.ForBackup("velero", "test").SnapshotMoveData(true).CSISnapshotTimeout(1 * time.Minute).Result(),
@@ -156,7 +211,8 @@ func TestExecute(t *testing.T) {
 			pvcMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.pvc)
 			require.NoError(t, err)
 
-			if boolptr.IsSetToTrue(tc.backup.Spec.SnapshotMoveData) == true {
+			if boolptr.IsSetToTrue(tc.backup.Spec.SnapshotMoveData) ||
+				(tc.pvc != nil && tc.pvc.Annotations[velerov1api.VolumePolicySnapshotMoveDataAnnotation] == "true") {
 				go func() {
 					var vsList v1.VolumeSnapshotList
 					err := wait.PollUntilContextTimeout(context.Background(), 1*time.Second, 10*time.Second, true, func(ctx context.Context) (bool, error) {
@@ -210,6 +266,117 @@ func TestExecute(t *testing.T) {
 	}
 }
 
+func TestExecuteWithVolumeGroup(t *testing.T) {
+	backup := builder.ForBackup("velero", "test").CSISnapshotTimeout(1 * time.Minute).Result()
+	groupLabels := builder.WithLabels(velerov1api.VolumeGroupLabel, "db")
+	pvc1 := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(groupLabels).
+		VolumeName("pv-1").StorageClass("testSC").Phase(corev1.ClaimBound).Result()
+	pvc2 := builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(groupLabels).
+		VolumeName("pv-2").StorageClass("testSC").Phase(corev1.ClaimBound).Result()
+
+	vgsCreated := 0
+	crClient := velerotest.NewFakeControllerRuntimeClientBuilder(t).
+		WithRuntimeObjects(
+			pvc1,
+			pvc2,
+			builder.ForPersistentVolume("pv-1").CSI("hostpath", "vol-1").Result(),
+			builder.ForPersistentVolume("pv-2").CSI("hostpath", "vol-2").Result(),
+			builder.ForStorageClass("testSC").Provisioner("hostpath").Result(),
+			&groupsnapshotv1alpha1.VolumeGroupSnapshotClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "testVGSClass",
+					Labels: map[string]string{velerov1api.VolumeGroupSnapshotClassSelectorLabel: ""},
+				},
+				Driver: "hostpath",
+			},
+		).
+		WithInterceptorFuncs(interceptor.Funcs{
+			// Simulate the CSI snapshot controller, which creates a member
+			// VolumeSnapshot and VolumeSnapshotContent per volume of the group.
+			Create: func(ctx context.Context, client crclient.WithWatch, obj crclient.Object, opts ...crclient.CreateOption) error {
+				vgs, ok := obj.(*groupsnapshotv1alpha1.VolumeGroupSnapshot)
+				if !ok {
+					return client.Create(ctx, obj, opts...)
+				}
+				vgsCreated++
+
+				vgscName := "testVGSC"
+				vgs.Status = &groupsnapshotv1alpha1.VolumeGroupSnapshotStatus{
+					ReadyToUse:                          boolptr.True(),
+					BoundVolumeGroupSnapshotContentName: &vgscName,
+				}
+				if err := client.Create(ctx, vgs, opts...); err != nil {
+					return err
+				}
+				if err := client.Create(ctx, &groupsnapshotv1alpha1.VolumeGroupSnapshotContent{
+					ObjectMeta: metav1.ObjectMeta{Name: vgscName},
+					Spec: groupsnapshotv1alpha1.VolumeGroupSnapshotContentSpec{
+						DeletionPolicy: snapshotv1api.VolumeSnapshotContentDelete,
+					},
+				}); err != nil {
+					return err
+				}
+
+				owner := []metav1.OwnerReference{{Kind: "VolumeGroupSnapshot", Name: vgs.Name}}
+				for _, volume := range []string{"vol-1", "vol-2"} {
+					handle := "snap-" + volume
+					volumeHandle := volume
+					if err := client.Create(ctx, builder.ForVolumeSnapshotContent("vsc-"+volume).
+						Source(snapshotv1api.VolumeSnapshotContentSource{VolumeHandle: &volumeHandle}).
+						Status(&snapshotv1api.VolumeSnapshotContentStatus{SnapshotHandle: &handle}).Result()); err != nil {
+						return err
+					}
+					if err := client.Create(ctx, builder.ForVolumeSnapshot(vgs.Namespace, "vs-"+volume).
+						ObjectMeta(builder.WithOwnerReference(owner)).
+						SourceVolumeSnapshotContentName("vsc-"+volume).
+						Status().BoundVolumeSnapshotContentName("vsc-"+volume).ReadyToUse(true).Result()); err != nil {
+						return err
+					}
+					vgs.Status.VolumeSnapshotRefList = append(vgs.Status.VolumeSnapshotRefList, corev1.ObjectReference{Name: "vs-" + volume})
+				}
+
+				return client.Update(ctx, vgs)
+			},
+		}).Build()
+
+	pvcBIA := pvcBackupItemAction{
+		log:      logrus.New(),
+		crClient: crClient,
+	}
+
+	for _, tc := range []struct {
+		pvc        *corev1.PersistentVolumeClaim
+		expectedVS string
+	}{
+		{pvc: pvc1, expectedVS: "vs-vol-1"},
+		{pvc: pvc2, expectedVS: "vs-vol-2"},
+	} {
+		pvcMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.pvc)
+		require.NoError(t, err)
+
+		_, additionalItems, _, _, err := pvcBIA.Execute(&unstructured.Unstructured{Object: pvcMap}, backup)
+		require.NoError(t, err)
+		require.Equal(t, []velero.ResourceIdentifier{
+			{GroupResource: kuberesource.VolumeSnapshots, Namespace: "ns-1", Name: tc.expectedVS},
+		}, additionalItems)
+
+		vs := new(snapshotv1api.VolumeSnapshot)
+		require.NoError(t, crClient.Get(context.Background(), crclient.ObjectKey{Namespace: "ns-1", Name: tc.expectedVS}, vs))
+		require.Empty(t, vs.OwnerReferences)
+		require.Equal(t, "test", vs.Labels[velerov1api.BackupNameLabel])
+	}
+
+	// Only one VolumeGroupSnapshot is taken for the group, and the group objects
+	// are removed once the member snapshots are released.
+	require.Equal(t, 1, vgsCreated)
+	vgsList := new(groupsnapshotv1alpha1.VolumeGroupSnapshotList)
+	require.NoError(t, crClient.List(context.Background(), vgsList))
+	require.Empty(t, vgsList.Items)
+	vgscList := new(groupsnapshotv1alpha1.VolumeGroupSnapshotContentList)
+	require.NoError(t, crClient.List(context.Background(), vgscList))
+	require.Empty(t, vgscList.Items)
+}
+
 func TestProgress(t *testing.T) {
 	currentTime := time.Now()
 	tests := []struct {
@@ -84,16 +84,14 @@ func (p *volumeSnapshotBackupItemAction) Execute(
 		return nil, nil, "", nil, errors.WithStack(err)
 	}
 
-	volumeSnapshotClassName := ""
+	// VolumeSnapshots created as members of a VolumeGroupSnapshot
+	// don't reference a VolumeSnapshotClass.
+	var additionalItems []velero.ResourceIdentifier
 	if vs.Spec.VolumeSnapshotClassName != nil {
-		volumeSnapshotClassName = *vs.Spec.VolumeSnapshotClassName
-	}
-
-	additionalItems := []velero.ResourceIdentifier{
-		{
+		additionalItems = append(additionalItems, velero.ResourceIdentifier{
 			GroupResource: kuberesource.VolumeSnapshotClasses,
-			Name:          volumeSnapshotClassName,
-		},
+			Name:          *vs.Spec.VolumeSnapshotClassName,
+		})
 	}
 
 	// determine if we are backing up a VolumeSnapshot that was created by velero while
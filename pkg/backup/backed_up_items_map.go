@@ -0,0 +1,110 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// backedUpItemsMap keeps track of the items which have been backed up. It is safe
+// for concurrent use by the item block workers.
+type backedUpItemsMap struct {
+	*sync.RWMutex
+	backedUpItems map[itemKey]struct{}
+}
+
+// NewBackedUpItemsMap returns an empty backedUpItemsMap.
+func NewBackedUpItemsMap() *backedUpItemsMap {
+	return &backedUpItemsMap{
+		RWMutex:       &sync.RWMutex{},
+		backedUpItems: make(map[itemKey]struct{}),
+	}
+}
+
+// ResourceMap returns the backed up items grouped by resource, with the namespace/name
+// entries for each resource sorted.
+func (m *backedUpItemsMap) ResourceMap() map[string][]string {
+	m.RLock()
+	defer m.RUnlock()
+
+	resources := map[string][]string{}
+	for i := range m.backedUpItems {
+		entry := i.name
+		if i.namespace != "" {
+			entry = fmt.Sprintf("%s/%s", i.namespace, i.name)
+		}
+		resources[i.resource] = append(resources[i.resource], entry)
+	}
+
+	// sort namespace/name entries for each GVK
+	for _, v := range resources {
+		sort.Strings(v)
+	}
+
+	return resources
+}
+
+// Keys returns a snapshot of the backed up item keys.
+func (m *backedUpItemsMap) Keys() []itemKey {
+	m.RLock()
+	defer m.RUnlock()
+
+	keys := make([]itemKey, 0, len(m.backedUpItems))
+	for key := range m.backedUpItems {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Len returns the number of backed up items.
+func (m *backedUpItemsMap) Len() int {
+	m.RLock()
+	defer m.RUnlock()
+	return len(m.backedUpItems)
+}
+
+// Has returns true if the item has been backed up.
+func (m *backedUpItemsMap) Has(key itemKey) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	_, exists := m.backedUpItems[key]
+	return exists
+}
+
+// AddItem records the item as backed up.
+func (m *backedUpItemsMap) AddItem(key itemKey) {
+	m.Lock()
+	defer m.Unlock()
+	m.backedUpItems[key] = struct{}{}
+}
+
+// AddItemIfAbsent records the item as backed up, returning false if it had
+// already been recorded. The check and the insert are done atomically so
+// that only one worker claims an item.
+func (m *backedUpItemsMap) AddItemIfAbsent(key itemKey) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, exists := m.backedUpItems[key]; exists {
+		return false
+	}
+	m.backedUpItems[key] = struct{}{}
+	return true
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -46,6 +47,7 @@ import (
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/itemblock"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
@@ -53,6 +55,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	ibav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/itemblockaction/v1"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
@@ -77,6 +80,7 @@ type Backupper interface {
 		backup *Request,
 		backupFile io.Writer,
 		actions []biav2.BackupItemAction,
+		itemBlockActions []ibav1.ItemBlockAction,
 		volumeSnapshotterGetter VolumeSnapshotterGetter,
 	) error
 
@@ -85,6 +89,7 @@ type Backupper interface {
 		backupRequest *Request,
 		backupFile io.Writer,
 		backupItemActionResolver framework.BackupItemActionResolverV2,
+		itemBlockActionResolver framework.ItemBlockActionResolver,
 		volumeSnapshotterGetter VolumeSnapshotterGetter,
 	) error
 
@@ -209,9 +214,10 @@ type VolumeSnapshotterGetter interface {
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
 // to the backup log.
 func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer,
-	actions []biav2.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
+	actions []biav2.BackupItemAction, itemBlockActions []ibav1.ItemBlockAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
 	backupItemActions := framework.NewBackupItemActionResolverV2(actions)
-	return kb.BackupWithResolvers(log, backupRequest, backupFile, backupItemActions, volumeSnapshotterGetter)
+	itemBlockActionResolver := framework.NewItemBlockActionResolver(itemBlockActions)
+	return kb.BackupWithResolvers(log, backupRequest, backupFile, backupItemActions, itemBlockActionResolver, volumeSnapshotterGetter)
 }
 
 func (kb *kubernetesBackupper) BackupWithResolvers(
@@ -219,6 +225,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 	backupRequest *Request,
 	backupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolverV2,
+	itemBlockActionResolver framework.ItemBlockActionResolver,
 	volumeSnapshotterGetter VolumeSnapshotterGetter,
 ) error {
 	gzippedData := gzip.NewWriter(backupFile)
@@ -267,7 +274,13 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		return err
 	}
 
-	backupRequest.BackedUpItems = map[itemKey]struct{}{}
+	backupRequest.ResolvedItemBlockActions, err = itemBlockActionResolver.ResolveActions(kb.discoveryHelper, log)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Errorf("Error from itemBlockActionResolver.ResolveActions")
+		return err
+	}
+
+	backupRequest.BackedUpItems = NewBackedUpItemsMap()
 
 	podVolumeTimeout := kb.podVolumeTimeout
 	if val := backupRequest.Annotations[velerov1api.PodVolumeOperationTimeoutAnnotation]; val != "" {
@@ -401,55 +414,105 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 	}()
 
 	backedUpGroupResources := map[schema.GroupResource]bool{}
+	// Maps items in the item list from GR+NamespacedName to a slice of pointers to kubernetesResources
+	// We need the slice value since if the EnableAPIGroupVersions feature flag is set, there may
+	// be more than one resource to back up for the given item.
+	itemsMap := make(map[velero.ResourceIdentifier][]*kubernetesResource)
+	for i := range items {
+		key := velero.ResourceIdentifier{
+			GroupResource: items[i].groupResource,
+			Namespace:     items[i].namespace,
+			Name:          items[i].name,
+		}
+		itemsMap[key] = append(itemsMap[key], items[i])
+	}
 
-	for i, item := range items {
-		log.WithFields(map[string]interface{}{
-			"progress":  "",
-			"resource":  item.groupResource.String(),
-			"namespace": item.namespace,
-			"name":      item.name,
-		}).Infof("Processing item")
-
-		// use an anonymous func so we can defer-close/remove the file
-		// as soon as we're done with it
-		func() {
-			var unstructured unstructured.Unstructured
+	// The item blocks are backed up concurrently by the worker pool. At most workerCount blocks
+	// are in flight at a time, and the results are collected in submission order so the files
+	// of each block are written to the tarball in the same order regardless of the worker count.
+	workerCount := backupRequest.Spec.ItemBlockWorkerCount
+	if workerCount < 1 {
+		workerCount = 1
+	}
+	workerPool := startItemBlockWorkerPool(workerCount, kb.backupItemBlock, log)
 
-			f, err := os.Open(item.path)
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error opening file containing item")
-				return
-			}
-			defer f.Close()
-			defer os.Remove(f.Name())
+	type pendingItemBlock struct {
+		// index of the item the block was created for
+		index      int
+		returnChan chan itemBlockReturn
+	}
+	var pendingItemBlocks []pendingItemBlock
 
-			if err := json.NewDecoder(f).Decode(&unstructured); err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error decoding JSON from file")
-				return
-			}
+	// collectItemBlock waits for the oldest in-flight item block to be backed up, writes
+	// its files to the tarball and sends a progress update.
+	collectItemBlock := func() {
+		pending := pendingItemBlocks[0]
+		pendingItemBlocks = pendingItemBlocks[1:]
 
-			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
-				backedUpGroupResources[item.groupResource] = true
-			}
-		}()
+		ret := <-pending.returnChan
+		for _, gr := range ret.resources {
+			backedUpGroupResources[gr] = true
+		}
+		if err := ret.itemBlock.tarWriter.flush(tw); err != nil {
+			log.WithError(err).Error("Error writing item block to the backup tarball")
+		}
 
 		// updated total is computed as "how many items we've backed up so far, plus
 		// how many items we know of that are remaining"
-		totalItems := len(backupRequest.BackedUpItems) + (len(items) - (i + 1))
+		backedUpItems := backupRequest.BackedUpItems.Len()
+		totalItems := backedUpItems + (len(items) - (pending.index + 1))
 
 		// send a progress update
 		update <- progressUpdate{
 			totalItems:    totalItems,
-			itemsBackedUp: len(backupRequest.BackedUpItems),
+			itemsBackedUp: backedUpItems,
 		}
 
 		log.WithFields(map[string]interface{}{
 			"progress":  "",
-			"resource":  item.groupResource.String(),
-			"namespace": item.namespace,
-			"name":      item.name,
-		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", len(backupRequest.BackedUpItems), totalItems)
+			"resource":  items[pending.index].groupResource.String(),
+			"namespace": items[pending.index].namespace,
+			"name":      items[pending.index].name,
+		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", backedUpItems, totalItems)
+	}
+
+	for i := range items {
+		log.WithFields(map[string]interface{}{
+			"progress":  "",
+			"resource":  items[i].groupResource.String(),
+			"namespace": items[i].namespace,
+			"name":      items[i].name,
+		}).Infof("Processing item")
+
+		// Skip if this item has already been added to an ItemBlock
+		if items[i].inItemBlock {
+			log.Debugf("Not creating new ItemBlock for %s %s/%s because it's already in an ItemBlock", items[i].groupResource.String(), items[i].namespace, items[i].name)
+			continue
+		}
+
+		itemBlock := NewBackupItemBlock(log, itemBackupper)
+		itemBlock.collectedItems = itemsMap
+		// Add the item to the new ItemBlock, then expand it with the related items
+		// returned by the ItemBlockActions
+		obj := itemBlock.addKubernetesResource(items[i], log)
+		if obj == nil {
+			continue
+		}
+		kb.executeItemBlockActions(log, obj, items[i].groupResource, items[i].name, items[i].namespace, itemsMap, itemBlock)
+
+		if len(pendingItemBlocks) >= workerCount {
+			collectItemBlock()
+		}
+		pendingItemBlocks = append(pendingItemBlocks, pendingItemBlock{
+			index:      i,
+			returnChan: workerPool.submit(itemBlock),
+		})
+	}
+
+	for len(pendingItemBlocks) > 0 {
+		collectItemBlock()
 	}
+	workerPool.stop()
 
 	// no more progress updates will be sent on the 'update' channel
 	quit <- struct{}{}
@@ -474,8 +537,8 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 	if updated.Status.Progress == nil {
 		updated.Status.Progress = &velerov1api.BackupProgress{}
 	}
-	updated.Status.Progress.TotalItems = len(backupRequest.BackedUpItems)
-	updated.Status.Progress.ItemsBackedUp = len(backupRequest.BackedUpItems)
+	updated.Status.Progress.TotalItems = backupRequest.BackedUpItems.Len()
+	updated.Status.Progress.ItemsBackedUp = backupRequest.BackedUpItems.Len()
 
 	// update the hooks execution status
 	if updated.Status.HookStatus == nil {
@@ -484,6 +547,9 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 	updated.Status.HookStatus.HooksAttempted, updated.Status.HookStatus.HooksFailed = itemBackupper.hookTracker.Stat()
 	log.Debugf("hookAttempted: %d, hookFailed: %d", updated.Status.HookStatus.HooksAttempted, updated.Status.HookStatus.HooksFailed)
 
+	// record the resource modifier rules which altered the backed up items
+	updated.Status.ResourceModifiers = backupRequest.ResourceModifiersStatus()
+
 	if err := kube.PatchResource(backupRequest.Backup, updated, kb.kbClient); err != nil {
 		log.WithError(errors.WithStack((err))).Warn("Got error trying to update backup's status.progress and hook status")
 	}
@@ -494,14 +560,184 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		log.Infof("Summary for skipped PVs: %s", skippedPVSummary)
 	}
 
-	backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: len(backupRequest.BackedUpItems), ItemsBackedUp: len(backupRequest.BackedUpItems)}
-	log.WithField("progress", "").Infof("Backed up a total of %d items", len(backupRequest.BackedUpItems))
+	backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: backupRequest.BackedUpItems.Len(), ItemsBackedUp: backupRequest.BackedUpItems.Len()}
+	backupRequest.Status.ResourceModifiers = updated.Status.ResourceModifiers
+	log.WithField("progress", "").Infof("Backed up a total of %d items", backupRequest.BackedUpItems.Len())
 
 	return nil
 }
 
-func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
-	backedUpItem, _, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR, false, false)
+// executeItemBlockActions runs the ItemBlockActions which apply to obj and adds the related items
+// they return to the item block, recursively expanding the block with the items related to those.
+func (kb *kubernetesBackupper) executeItemBlockActions(
+	log logrus.FieldLogger,
+	obj runtime.Unstructured,
+	groupResource schema.GroupResource,
+	name, namespace string,
+	itemsMap map[velero.ResourceIdentifier][]*kubernetesResource,
+	itemBlock *BackupItemBlock,
+) {
+	metadata, err := meta.Accessor(obj)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Warn("Failed to get object metadata.")
+		return
+	}
+	for _, action := range itemBlock.itemBackupper.backupRequest.ResolvedItemBlockActions {
+		if !action.ShouldUse(groupResource, namespace, metadata, log) {
+			continue
+		}
+		log.Info("Executing ItemBlock action")
+
+		relatedItems, err := action.GetRelatedItems(obj, itemBlock.itemBackupper.backupRequest.Backup)
+		if err != nil {
+			log.Error(errors.Wrapf(err, "error executing ItemBlock action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name))
+			continue
+		}
+
+		for _, relatedItem := range relatedItems {
+			var newObj runtime.Unstructured
+			if itemsToAdd, ok := itemsMap[relatedItem]; ok {
+				// the related item was collected for this backup, so add all the versions
+				// of it which aren't in an ItemBlock yet
+				for _, itemToAdd := range itemsToAdd {
+					if obj := itemBlock.addKubernetesResource(itemToAdd, log); obj != nil {
+						newObj = obj
+					}
+				}
+			} else {
+				// the related item is not in the collected item list, so get it from the cluster
+				gvr, resource, err := itemBlock.itemBackupper.discoveryHelper.ResourceFor(relatedItem.GroupResource.WithVersion(""))
+				if err != nil {
+					log.Error(errors.WithStack(err))
+					continue
+				}
+
+				client, err := itemBlock.itemBackupper.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, relatedItem.Namespace)
+				if err != nil {
+					log.Error(errors.WithStack(err))
+					continue
+				}
+
+				item, err := client.Get(relatedItem.Name, metav1.GetOptions{})
+				if apierrors.IsNotFound(err) {
+					log.WithFields(logrus.Fields{
+						"groupResource": relatedItem.GroupResource,
+						"namespace":     relatedItem.Namespace,
+						"name":          relatedItem.Name,
+					}).Warnf("Related item was not found in Kubernetes API, can't add to item block")
+					continue
+				}
+				if err != nil {
+					log.Error(errors.WithStack(err))
+					continue
+				}
+				itemsMap[relatedItem] = append(itemsMap[relatedItem], &kubernetesResource{
+					groupResource: relatedItem.GroupResource,
+					preferredGVR:  gvr,
+					namespace:     relatedItem.Namespace,
+					name:          relatedItem.Name,
+					inItemBlock:   true,
+				})
+				log.Infof("adding %s %s/%s to ItemBlock", relatedItem.GroupResource, relatedItem.Namespace, relatedItem.Name)
+				itemBlock.AddUnstructured(relatedItem.GroupResource, item, gvr)
+				newObj = item
+			}
+
+			// a nil newObj means the related item is already in an ItemBlock
+			if newObj != nil {
+				kb.executeItemBlockActions(log, newObj, relatedItem.GroupResource, relatedItem.Name, relatedItem.Namespace, itemsMap, itemBlock)
+			}
+		}
+	}
+}
+
+// backupItemBlock runs the pre hooks for all the pods in the item block, backs up all
+// of its items, then runs the post hooks for the pods. It returns the group resources
+// of the items which were backed up.
+func (kb *kubernetesBackupper) backupItemBlock(itemBlock BackupItemBlock) []schema.GroupResource {
+	// find the pods in the ItemBlock which still need to be backed up,
+	// this list will be used to run pre/post hooks
+	var preHookPods []itemblock.ItemBlockItem
+	itemBlock.Log.Debug("Executing pre hooks")
+	for _, item := range itemBlock.Items {
+		if item.Gr == kuberesource.Pods {
+			metadata, key, err := kb.itemMetadataAndKey(item)
+			if err != nil {
+				itemBlock.Log.WithError(errors.WithStack(err)).Error("Error accessing pod metadata")
+				continue
+			}
+			// Don't run hooks if the pod is excluded
+			if !itemBlock.itemBackupper.itemInclusionChecks(itemBlock.Log, false, metadata, item.Item, item.Gr) {
+				continue
+			}
+			// Don't run hooks if the pod has already been backed up
+			if !itemBlock.itemBackupper.backupRequest.BackedUpItems.Has(key) {
+				preHookPods = append(preHookPods, item)
+			}
+		}
+	}
+	postHookPods, failedPods, errs := kb.handleItemBlockHooks(itemBlock, preHookPods, hook.PhasePre)
+	for i, pod := range failedPods {
+		itemBlock.Log.WithError(errs[i]).WithField("name", pod.Item.GetName()).Error("Error running pre hooks for pod")
+		// if the pre hook fails, flag the pod as backed up and move on
+		_, key, err := kb.itemMetadataAndKey(pod)
+		if err != nil {
+			itemBlock.Log.WithError(errors.WithStack(err)).Error("Error accessing pod metadata")
+			continue
+		}
+		itemBlock.itemBackupper.backupRequest.BackedUpItems.AddItem(key)
+	}
+
+	itemBlock.Log.Debug("Backing up items in BackupItemBlock")
+	var grList []schema.GroupResource
+	for _, item := range itemBlock.Items {
+		if backedUp := kb.backupItem(itemBlock.Log, item.Gr, itemBlock.itemBackupper, item.Item, item.PreferredGVR, &itemBlock); backedUp {
+			grList = append(grList, item.Gr)
+		}
+	}
+
+	itemBlock.Log.Debug("Executing post hooks")
+	_, failedPods, errs = kb.handleItemBlockHooks(itemBlock, postHookPods, hook.PhasePost)
+	for i, pod := range failedPods {
+		itemBlock.Log.WithError(errs[i]).WithField("name", pod.Item.GetName()).Error("Error running post hooks for pod")
+	}
+
+	return grList
+}
+
+func (kb *kubernetesBackupper) itemMetadataAndKey(item itemblock.ItemBlockItem) (metav1.Object, itemKey, error) {
+	metadata, err := meta.Accessor(item.Item)
+	if err != nil {
+		return nil, itemKey{}, err
+	}
+	key := itemKey{
+		resource:  resourceKey(item.Item),
+		namespace: metadata.GetNamespace(),
+		name:      metadata.GetName(),
+	}
+	return metadata, key, nil
+}
+
+// handleItemBlockHooks runs the hooks of the given phase for the pods, returning the pods
+// the hooks succeeded for, and the pods the hooks failed for along with their errors.
+func (kb *kubernetesBackupper) handleItemBlockHooks(itemBlock BackupItemBlock, hookPods []itemblock.ItemBlockItem, phase hook.HookPhase) ([]itemblock.ItemBlockItem, []itemblock.ItemBlockItem, []error) {
+	var successPods []itemblock.ItemBlockItem
+	var failedPods []itemblock.ItemBlockItem
+	var errs []error
+	for _, pod := range hookPods {
+		err := itemBlock.itemBackupper.itemHookHandler.HandleHooks(itemBlock.Log, pod.Gr, pod.Item, itemBlock.itemBackupper.backupRequest.ResourceHooks, phase, itemBlock.itemBackupper.hookTracker)
+		if err == nil {
+			successPods = append(successPods, pod)
+		} else {
+			failedPods = append(failedPods, pod)
+			errs = append(errs, err)
+		}
+	}
+	return successPods, failedPods, errs
+}
+
+func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource, itemBlock *BackupItemBlock) bool {
+	backedUpItem, _, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR, false, false, itemBlock)
 	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
 		log.WithField("name", unstructured.GetName()).Infof("%d errors encountered backup up item", len(aggregate.Errors()))
 		// log each error separately so we get error location info in the log, and an
@@ -526,7 +762,7 @@ func (kb *kubernetesBackupper) finalizeItem(
 	unstructured *unstructured.Unstructured,
 	preferredGVR schema.GroupVersionResource,
 ) (bool, []FileForArchive) {
-	backedUpItem, updateFiles, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR, true, true)
+	backedUpItem, updateFiles, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR, true, true, nil)
 	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
 		log.WithField("name", unstructured.GetName()).Infof("%d errors encountered backup up item", len(aggregate.Errors()))
 		// log each error separately so we get error location info in the log, and an
@@ -580,7 +816,7 @@ func (kb *kubernetesBackupper) backupCRD(log logrus.FieldLogger, gr schema.Group
 
 	log.Infof("Found associated CRD %s to add to backup", gr.String())
 
-	kb.backupItem(log, gvr.GroupResource(), itemBackupper, unstructured, gvr)
+	kb.backupItem(log, gvr.GroupResource(), itemBackupper, unstructured, gvr, nil)
 }
 
 func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
@@ -630,7 +866,7 @@ func (kb *kubernetesBackupper) FinalizeBackup(
 		return err
 	}
 
-	backupRequest.BackedUpItems = map[itemKey]struct{}{}
+	backupRequest.BackedUpItems = NewBackedUpItemsMap()
 
 	// set up a temp dir for the itemCollector to use to temporarily
 	// store items as they're scraped from the API.
@@ -716,14 +952,14 @@ func (kb *kubernetesBackupper) FinalizeBackup(
 
 		// updated total is computed as "how many items we've backed up so far, plus
 		// how many items we know of that are remaining"
-		totalItems := len(backupRequest.BackedUpItems) + (len(items) - (i + 1))
+		totalItems := backupRequest.BackedUpItems.Len() + (len(items) - (i + 1))
 
 		log.WithFields(map[string]interface{}{
 			"progress":  "",
 			"resource":  item.groupResource.String(),
 			"namespace": item.namespace,
 			"name":      item.name,
-		}).Infof("Updated %d items out of an estimated total of %d (estimate will change throughout the backup finalizer)", len(backupRequest.BackedUpItems), totalItems)
+		}).Infof("Updated %d items out of an estimated total of %d (estimate will change throughout the backup finalizer)", backupRequest.BackedUpItems.Len(), totalItems)
 	}
 
 	backupStore, volumeInfos, err := kb.getVolumeInfos(*backupRequest.Backup, log)
@@ -748,7 +984,7 @@ func (kb *kubernetesBackupper) FinalizeBackup(
 		return err
 	}
 
-	log.WithField("progress", "").Infof("Updated a total of %d items", len(backupRequest.BackedUpItems))
+	log.WithField("progress", "").Infof("Updated a total of %d items", backupRequest.BackedUpItems.Len())
 
 	return nil
 }
@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
@@ -58,6 +59,7 @@ import (
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	ibav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/itemblockaction/v1"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/test"
@@ -99,12 +101,12 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 		h.addItems(t, resource)
 	}
 
-	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 	// go through BackedUpItems after the backup to assemble the list of files we
 	// expect to see in the tarball and compare to see if they match
 	var expectedFiles []string
-	for item := range req.BackedUpItems {
+	for _, item := range req.BackedUpItems.Keys() {
 		file := "resources/" + gvkToResource[item.resource]
 		if item.namespace != "" {
 			file = file + "/namespaces/" + item.namespace
@@ -157,11 +159,11 @@ func TestBackupProgressIsUpdated(t *testing.T) {
 		h.addItems(t, resource)
 	}
 
-	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 	require.NotNil(t, req.Status.Progress)
-	assert.Len(t, req.BackedUpItems, req.Status.Progress.TotalItems)
-	assert.Len(t, req.BackedUpItems, req.Status.Progress.ItemsBackedUp)
+	assert.Equal(t, req.BackedUpItems.Len(), req.Status.Progress.TotalItems)
+	assert.Equal(t, req.BackedUpItems.Len(), req.Status.Progress.ItemsBackedUp)
 }
 
 // TestBackupOldResourceFiltering runs backups with different combinations
@@ -880,7 +882,7 @@ func TestBackupOldResourceFiltering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -1057,7 +1059,7 @@ func TestCRDInclusion(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -1152,7 +1154,7 @@ func TestBackupResourceCohabitation(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -1176,7 +1178,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	h.addItems(t, test.Deployments(builder.ForDeployment("ns-1", "deploy-1").Result()))
 	h.addItems(t, test.ExtensionsDeployments(builder.ForDeployment("ns-1", "deploy-1").Result()))
 
-	h.backupper.Backup(h.log, backup1, backup1File, nil, nil)
+	h.backupper.Backup(h.log, backup1, backup1File, nil, nil, nil)
 
 	assertTarballContents(t, backup1File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 
@@ -1187,7 +1189,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	}
 	backup2File := bytes.NewBuffer([]byte{})
 
-	h.backupper.Backup(h.log, backup2, backup2File, nil, nil)
+	h.backupper.Backup(h.log, backup2, backup2File, nil, nil, nil)
 
 	assertTarballContents(t, backup2File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 }
@@ -1242,7 +1244,7 @@ func TestBackupResourceOrdering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 			assertTarballOrdering(t, backupFile, "pods", "persistentvolumeclaims", "persistentvolumes")
 		})
@@ -1441,7 +1443,7 @@ func TestBackupItemActionsForSkippedPV(t *testing.T) {
 				require.NoError(t, tc.backupReq.ResPolicies.BuildPolicy(tc.resPolicies))
 			}
 
-			err := h.backupper.Backup(h.log, tc.backupReq, backupFile, actions, nil)
+			err := h.backupper.Backup(h.log, tc.backupReq, backupFile, actions, nil, nil)
 			assert.NoError(t, err)
 
 			if tc.expectSkippedPVs != nil {
@@ -1461,6 +1463,133 @@ func TestBackupItemActionsForSkippedPV(t *testing.T) {
 	}
 }
 
+// TestBackupResourcePolicies verifies the items skipped by the resource policies
+// are not written to the backup tarball.
+func TestBackupResourcePolicies(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{
+		Backup:           defaultBackup().Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		ResPolicies:      new(resourcepolicies.Policies),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	require.NoError(t, req.ResPolicies.BuildPolicy(&resourcepolicies.ResourcePolicies{
+		Version: "v1",
+		ResourcePolicies: []resourcepolicies.ResourcePolicy{
+			{
+				Conditions: map[string]interface{}{
+					"groupResource": "secrets",
+					"labelSelector": map[string]interface{}{
+						"matchLabels": map[string]interface{}{"owner": "helm"},
+					},
+					"exceptNewest": map[string]interface{}{"count": 1},
+				},
+				Action: resourcepolicies.ResourceAction{Type: resourcepolicies.ResourceSkip},
+			},
+			{
+				Conditions: map[string]interface{}{
+					"groupResource": "pods",
+					"namespaces":    []string{"zoo"},
+				},
+				Action: resourcepolicies.ResourceAction{Type: resourcepolicies.ResourceSkip},
+			},
+		},
+	}))
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+	h.addItems(t, test.Secrets(
+		builder.ForSecret("foo", "release-v1").ObjectMeta(builder.WithLabels("owner", "helm"), builder.WithCreationTimestamp(time.Now().Add(-time.Hour))).Result(),
+		builder.ForSecret("foo", "release-v2").ObjectMeta(builder.WithLabels("owner", "helm"), builder.WithCreationTimestamp(time.Now())).Result(),
+		builder.ForSecret("foo", "other").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/foo/bar.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+		"resources/secrets/namespaces/foo/release-v2.json",
+		"resources/secrets/v1-preferredversion/namespaces/foo/release-v2.json",
+		"resources/secrets/namespaces/foo/other.json",
+		"resources/secrets/v1-preferredversion/namespaces/foo/other.json",
+	)
+}
+
+// TestBackupResourceModifiers verifies the resource modifiers are applied to the items
+// before they are written to the backup, and the applied rules are recorded in the status.
+func TestBackupResourceModifiers(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{
+		Backup:           defaultBackup().ResourceModifier("modifiers").Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		ResourceModifiers: &resourcemodifiers.ResourceModifiers{
+			Version: "v1",
+			ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{
+				{
+					Conditions: resourcemodifiers.Conditions{
+						GroupResource: "secrets",
+						Namespaces:    []string{"foo"},
+					},
+					Patches: []resourcemodifiers.JSONPatch{
+						{Operation: "remove", Path: "/data/password"},
+					},
+				},
+				{
+					Conditions: resourcemodifiers.Conditions{
+						GroupResource: "pods",
+					},
+					Patches: []resourcemodifiers.JSONPatch{
+						{Operation: "remove", Path: "/status"},
+					},
+				},
+				{
+					Conditions: resourcemodifiers.Conditions{
+						GroupResource: "configmaps",
+					},
+					MergePatches: []resourcemodifiers.JSONMergePatch{
+						{PatchData: `{"data":null}`},
+					},
+				},
+			},
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	pod1 := builder.ForPod("foo", "pod-1").Phase(corev1.PodRunning).Result()
+	pod2 := builder.ForPod("bar", "pod-2").Phase(corev1.PodRunning).Result()
+	fooSecret := builder.ForSecret("foo", "creds").Data(map[string][]byte{"username": []byte("admin"), "password": []byte("secret")}).Result()
+	barSecret := builder.ForSecret("bar", "creds").Data(map[string][]byte{"username": []byte("admin"), "password": []byte("secret")}).Result()
+	h.addItems(t, test.Pods(pod1, pod2))
+	h.addItems(t, test.Secrets(fooSecret, barSecret))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	withoutStatus := func(pod *corev1.Pod) unstructuredObject {
+		obj := toUnstructuredOrFail(t, pod)
+		delete(obj, "status")
+		return obj
+	}
+	assertTarballFileContents(t, backupFile, map[string]unstructuredObject{
+		"resources/pods/namespaces/foo/pod-1.json":    withoutStatus(pod1),
+		"resources/pods/namespaces/bar/pod-2.json":    withoutStatus(pod2),
+		"resources/secrets/namespaces/foo/creds.json": toUnstructuredOrFail(t, builder.ForSecret("foo", "creds").Data(map[string][]byte{"username": []byte("admin")}).Result()),
+		"resources/secrets/namespaces/bar/creds.json": toUnstructuredOrFail(t, barSecret),
+	})
+
+	assert.Equal(t, &velerov1.BackupResourceModifiersStatus{
+		ConfigMap: "modifiers",
+		AppliedRules: []velerov1.AppliedResourceModifierRule{
+			{Index: 0, GroupResource: "secrets", ItemsModified: 1},
+			{Index: 1, GroupResource: "pods", ItemsModified: 2},
+		},
+	}, req.Status.ResourceModifiers)
+}
+
 // TestBackupActionsRunForCorrectItems runs backups with backup item actions, and
 // verifies that each backup item action is run for the correct set of resources based on its
 // AppliesTo() resource selector. Verification is done by using the recordResourcesAction struct,
@@ -1655,7 +1784,7 @@ func TestBackupActionsRunForCorrectItems(t *testing.T) {
 				actions = append(actions, action)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, actions, nil, nil)
 			assert.NoError(t, err)
 
 			for action, want := range tc.actions {
@@ -1731,7 +1860,7 @@ func TestBackupWithInvalidActions(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.Error(t, h.backupper.Backup(h.log, req, backupFile, tc.actions, nil))
+			assert.Error(t, h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil))
 		})
 	}
 }
@@ -1877,7 +2006,7 @@ func TestBackupActionModifications(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil)
 			assert.NoError(t, err)
 
 			assertTarballFileContents(t, backupFile, tc.want)
@@ -2132,7 +2261,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil)
 			assert.NoError(t, err)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
@@ -2140,6 +2269,256 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 	}
 }
 
+// TestItemBlockActionRelatedItems runs backups with ItemBlock actions that return
+// related items and verifies that the related items are included in the backup
+// tarball as appropriate.
+func TestItemBlockActionRelatedItems(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		actions      []ibav1.ItemBlockAction
+		want         []string
+	}{
+		{
+			name:   "related items that are already being backed up are not backed up twice",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				),
+			},
+			actions: []ibav1.ItemBlockAction{
+				&pluggableIBA{
+					selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
+					getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+						return []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.Pods, Namespace: "ns-2", Name: "pod-2"},
+						}, nil
+					},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-2/pod-2.json",
+			},
+		},
+		{
+			name:   "when using a backup namespace filter, related items that are in a non-included namespace are not backed up",
+			backup: defaultBackup().IncludedNamespaces("ns-1").Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				),
+			},
+			actions: []ibav1.ItemBlockAction{
+				&pluggableIBA{
+					getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+						return []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.Pods, Namespace: "ns-2", Name: "pod-2"},
+						}, nil
+					},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+			},
+		},
+		{
+			name:   "related items that don't exist in the cluster are skipped",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+				),
+				test.PVCs(),
+			},
+			actions: []ibav1.ItemBlockAction{
+				&pluggableIBA{
+					getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+						return []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-1"},
+						}, nil
+					},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h   = newHarness(t)
+				req = &Request{
+					Backup:           tc.backup,
+					SkippedPVTracker: NewSkipPVTracker(),
+				}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			err := h.backupper.Backup(h.log, req, backupFile, nil, tc.actions, nil)
+			assert.NoError(t, err)
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+		})
+	}
+}
+
+// TestBackupWithItemBlockWorkers runs the same backup serially and with multiple item
+// block workers, and verifies that the tarballs contain the same files in the same order.
+func TestBackupWithItemBlockWorkers(t *testing.T) {
+	h := newHarness(t)
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).Result(),
+			builder.ForPod("ns-1", "pod-2").Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).Result(),
+			builder.ForPod("ns-2", "pod-3").Result(),
+			builder.ForPod("ns-2", "pod-4").Result(),
+			builder.ForPod("ns-3", "pod-5").Result(),
+		),
+		test.PVCs(
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+			builder.ForPersistentVolumeClaim("ns-2", "pvc-2").VolumeName("pv-2").Result(),
+		),
+		test.PVs(
+			builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+			builder.ForPersistentVolume("pv-2").ClaimRef("ns-2", "pvc-2").Result(),
+		),
+		test.Deployments(
+			builder.ForDeployment("ns-1", "deploy-1").Result(),
+			builder.ForDeployment("ns-3", "deploy-2").Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+	actions := []ibav1.ItemBlockAction{
+		&pluggableIBA{
+			selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+			getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+				pod := new(corev1.Pod)
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pod); err != nil {
+					return nil, err
+				}
+				var relatedItems []velero.ResourceIdentifier
+				for _, volume := range pod.Spec.Volumes {
+					if volume.PersistentVolumeClaim != nil {
+						relatedItems = append(relatedItems, velero.ResourceIdentifier{
+							GroupResource: kuberesource.PersistentVolumeClaims,
+							Namespace:     pod.Namespace,
+							Name:          volume.PersistentVolumeClaim.ClaimName,
+						})
+					}
+				}
+				return relatedItems, nil
+			},
+		},
+	}
+
+	runBackup := func(workers int) ([]string, map[string][]byte) {
+		var (
+			req = &Request{
+				Backup:           defaultBackup().ItemBlockWorkerCount(workers).Result(),
+				SkippedPVTracker: NewSkipPVTracker(),
+			}
+			backupFile = bytes.NewBuffer([]byte{})
+		)
+
+		require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, actions, nil))
+
+		return readTarball(t, backupFile)
+	}
+
+	serialNames, serialContents := runBackup(1)
+	for _, workers := range []int{2, 4, 16} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			names, contents := runBackup(workers)
+			assert.Equal(t, serialNames, names)
+			assert.Equal(t, serialContents, contents)
+		})
+	}
+}
+
+// TestBackupSharedAdditionalItemsWithItemBlockWorkers verifies that the additional items
+// returned by the actions of several item blocks are backed up by the first of those blocks,
+// so the tarball is the same whatever the number of item block workers.
+func TestBackupSharedAdditionalItemsWithItemBlockWorkers(t *testing.T) {
+	h := newHarness(t)
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("ns-1", "pod-1").Result(),
+			builder.ForPod("ns-1", "pod-2").Result(),
+			builder.ForPod("ns-2", "pod-3").Result(),
+			builder.ForPod("ns-2", "pod-4").Result(),
+		),
+		test.PVs(
+			builder.ForPersistentVolume("pv-1").Result(),
+			builder.ForPersistentVolume("pv-2").Result(),
+			builder.ForPersistentVolume("pv-3").Result(),
+			builder.ForPersistentVolume("pv-4").Result(),
+			builder.ForPersistentVolume("pv-shared").Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+	actions := []biav2.BackupItemAction{
+		&pluggableAction{
+			selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+			executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
+				name := item.(*unstructured.Unstructured).GetName()
+				// delay the first pod so the blocks after it are backed up first
+				if name == "pod-1" {
+					time.Sleep(100 * time.Millisecond)
+				}
+
+				additionalItems := []velero.ResourceIdentifier{
+					{GroupResource: kuberesource.PersistentVolumes, Name: "pv-shared"},
+					{GroupResource: kuberesource.PersistentVolumes, Name: strings.Replace(name, "pod", "pv", 1)},
+				}
+				return item, additionalItems, "", nil, nil
+			},
+		},
+	}
+
+	runBackup := func(workers int) ([]string, map[string][]byte) {
+		var (
+			req = &Request{
+				Backup:           defaultBackup().IncludedNamespaces("ns-1", "ns-2").ItemBlockWorkerCount(workers).Result(),
+				SkippedPVTracker: NewSkipPVTracker(),
+			}
+			backupFile = bytes.NewBuffer([]byte{})
+		)
+
+		require.NoError(t, h.backupper.Backup(h.log, req, backupFile, actions, nil, nil))
+
+		return readTarball(t, backupFile)
+	}
+
+	serialNames, serialContents := runBackup(1)
+	assert.Contains(t, serialNames, "resources/persistentvolumes/cluster/pv-shared.json")
+	for _, workers := range []int{2, 4} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			names, contents := runBackup(workers)
+			assert.Equal(t, serialNames, names)
+			assert.Equal(t, serialContents, contents)
+		})
+	}
+}
+
 // volumeSnapshotterGetter is a simple implementation of the VolumeSnapshotterGetter
 // interface that returns vsv1.VolumeSnapshotters from a map if they exist.
 type volumeSnapshotterGetter map[string]vsv1.VolumeSnapshotter
@@ -2587,7 +2966,7 @@ func TestBackupWithSnapshots(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, tc.req, backupFile, nil, tc.snapshotterGetter)
+			err := h.backupper.Backup(h.log, tc.req, backupFile, nil, nil, tc.snapshotterGetter)
 			assert.NoError(t, err)
 
 			assert.Equal(t, tc.want, tc.req.VolumeSnapshots)
@@ -2746,7 +3125,7 @@ func TestBackupWithAsyncOperations(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, tc.req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(h.log, tc.req, backupFile, tc.actions, nil, nil)
 			assert.NoError(t, err)
 
 			resultOper := *tc.req.GetItemOperationsList()
@@ -2812,7 +3191,7 @@ func TestBackupWithInvalidHooks(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.EqualError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil), tc.want.Error())
+			assert.EqualError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil), tc.want.Error())
 		})
 	}
 }
@@ -3078,7 +3457,7 @@ func TestBackupWithHooks(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
 
 			assertTarballContents(t, backupFile, append(tc.wantBackedUp, "metadata/version")...)
 		})
@@ -3269,7 +3648,7 @@ func TestBackupWithPodVolume(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, tc.snapshotterGetter))
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, tc.snapshotterGetter))
 
 			assert.Equal(t, tc.want, req.PodVolumeBackups)
 
@@ -3315,6 +3694,27 @@ func (a *pluggableAction) Name() string {
 	return ""
 }
 
+type pluggableIBA struct {
+	selector            velero.ResourceSelector
+	getRelatedItemsFunc func(runtime.Unstructured, *velerov1.Backup) ([]velero.ResourceIdentifier, error)
+}
+
+func (a *pluggableIBA) GetRelatedItems(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+	if a.getRelatedItemsFunc == nil {
+		return nil, nil
+	}
+
+	return a.getRelatedItemsFunc(item, backup)
+}
+
+func (a *pluggableIBA) AppliesTo() (velero.ResourceSelector, error) {
+	return a.selector, nil
+}
+
+func (a *pluggableIBA) Name() string {
+	return ""
+}
+
 type harness struct {
 	*test.APIServer
 	backupper *kubernetesBackupper
@@ -3418,6 +3818,32 @@ func assertTarballContents(t *testing.T, backupFile io.Reader, items ...string)
 	assert.Equal(t, items, files)
 }
 
+// readTarball returns the names of the files in the gzipped tarball stored in the provided
+// backupFile, in the order they were written, and their contents.
+func readTarball(t *testing.T, backupFile io.Reader) ([]string, map[string][]byte) {
+	t.Helper()
+
+	gzr, err := gzip.NewReader(backupFile)
+	require.NoError(t, err)
+	r := tar.NewReader(gzr)
+
+	var names []string
+	contents := map[string][]byte{}
+	for {
+		hdr, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+		contents[hdr.Name] = data
+	}
+	return names, contents
+}
+
 // unstructuredObject is a type alias to improve readability.
 type unstructuredObject map[string]interface{}
 
@@ -4351,7 +4777,7 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -4512,7 +4938,7 @@ func TestBackupNamespaces(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -21,7 +21,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/vmware-tanzu/velero/internal/volumehelper"
@@ -40,6 +42,7 @@ import (
 	kbClient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -47,6 +50,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/itemblock"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -54,6 +58,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	csiutil "github.com/vmware-tanzu/velero/pkg/util/csi"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 const (
@@ -74,6 +79,7 @@ type itemBackupper struct {
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]vsv1.VolumeSnapshotter
+	volumeSnapshotterLock              sync.Mutex
 	hookTracker                        *hook.HookTracker
 	volumeHelperImpl                   volumehelper.VolumeHelper
 }
@@ -87,27 +93,35 @@ type FileForArchive struct {
 // backupItem backs up an individual item to tarWriter. The item may be excluded based on the
 // namespaces IncludesExcludes list.
 // If finalize is true, then it returns the bytes instead of writing them to the tarWriter
+// If itemBlock is not nil, pre and post hooks for the items in the block are run at the
+// block level rather than for each individual item.
 // In addition to the error return, backupItem also returns a bool indicating whether the item
 // was actually backed up.
-func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource, mustInclude, finalize bool) (bool, []FileForArchive, error) {
-	selectedForBackup, files, err := ib.backupItemInternal(logger, obj, groupResource, preferredGVR, mustInclude, finalize)
+func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource, mustInclude, finalize bool, itemBlock *BackupItemBlock) (bool, []FileForArchive, error) {
+	selectedForBackup, files, err := ib.backupItemInternal(logger, obj, groupResource, preferredGVR, mustInclude, finalize, itemBlock)
 	// return if not selected, an error occurred, there are no files to add, or for finalize
 	if !selectedForBackup || err != nil || len(files) == 0 || finalize {
 		return selectedForBackup, files, err
 	}
+	tw := ib.tarWriter
+	if itemBlock != nil {
+		// the files of an item block are written to the tarball once the
+		// whole block is backed up so the tarball is in a deterministic order
+		tw = itemBlock.tarWriter
+	}
 	for _, file := range files {
-		if err := ib.tarWriter.WriteHeader(file.Header); err != nil {
+		if err := tw.WriteHeader(file.Header); err != nil {
 			return false, []FileForArchive{}, errors.WithStack(err)
 		}
 
-		if _, err := ib.tarWriter.Write(file.FileBytes); err != nil {
+		if _, err := tw.Write(file.FileBytes); err != nil {
 			return false, []FileForArchive{}, errors.WithStack(err)
 		}
 	}
 	return true, []FileForArchive{}, nil
 }
 
-func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource, mustInclude, finalize bool) (bool, []FileForArchive, error) {
+func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource, mustInclude, finalize bool, itemBlock *BackupItemBlock) (bool, []FileForArchive, error) {
 	var itemFiles []FileForArchive
 	metadata, err := meta.Accessor(obj)
 	if err != nil {
@@ -123,41 +137,7 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		"namespace": namespace,
 	})
 
-	if mustInclude {
-		log.Infof("Skipping the exclusion checks for this resource")
-	} else {
-		if metadata.GetLabels()[velerov1api.ExcludeFromBackupLabel] == "true" {
-			log.Infof("Excluding item because it has label %s=true", velerov1api.ExcludeFromBackupLabel)
-			ib.trackSkippedPV(obj, groupResource, "", fmt.Sprintf("item has label %s=true", velerov1api.ExcludeFromBackupLabel), log)
-			return false, itemFiles, nil
-		}
-		// NOTE: we have to re-check namespace & resource includes/excludes because it's possible that
-		// backupItem can be invoked by a custom action.
-		if namespace != "" && !ib.backupRequest.NamespaceIncludesExcludes.ShouldInclude(namespace) {
-			log.Info("Excluding item because namespace is excluded")
-			return false, itemFiles, nil
-		}
-
-		// NOTE: we specifically allow namespaces to be backed up even if it's excluded.
-		// This check is more permissive for cluster resources to let those passed in by
-		// plugins' additional items to get involved.
-		// Only expel cluster resource when it's specifically listed in the excluded list here.
-		if namespace == "" && groupResource != kuberesource.Namespaces &&
-			ib.backupRequest.ResourceIncludesExcludes.ShouldExclude(groupResource.String()) {
-			log.Info("Excluding item because resource is cluster-scoped and is excluded by cluster filter.")
-			return false, itemFiles, nil
-		}
-
-		// Only check namespace-scoped resource to avoid expelling cluster resources
-		// are not specified in included list.
-		if namespace != "" && !ib.backupRequest.ResourceIncludesExcludes.ShouldInclude(groupResource.String()) {
-			log.Info("Excluding item because resource is excluded")
-			return false, itemFiles, nil
-		}
-	}
-
-	if metadata.GetDeletionTimestamp() != nil {
-		log.Info("Skipping item because it's being deleted.")
+	if !ib.itemInclusionChecks(log, mustInclude, metadata, obj, groupResource) {
 		return false, itemFiles, nil
 	}
 
@@ -167,12 +147,16 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		name:      name,
 	}
 
-	if _, exists := ib.backupRequest.BackedUpItems[key]; exists {
+	// pre and post hooks of the items in the current item block are run
+	// for the whole block by the caller, so only run them here for items
+	// which are backed up outside of the block (e.g. additional items).
+	runHooks := itemBlock == nil || len(itemBlock.FindItem(groupResource, namespace, name)) == 0
+
+	if !ib.backupRequest.BackedUpItems.AddItemIfAbsent(key) {
 		log.Info("Skipping item because it's already been backed up.")
 		// returning true since this item *is* in the backup, even though we're not backing it up here
 		return true, itemFiles, nil
 	}
-	ib.backupRequest.BackedUpItems[key] = struct{}{}
 	log.Info("Backing up item")
 
 	var (
@@ -181,9 +165,11 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		pvbVolumes []string
 	)
 
-	log.Debug("Executing pre hooks")
-	if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePre, ib.hookTracker); err != nil {
-		return false, itemFiles, err
+	if runHooks {
+		log.Debug("Executing pre hooks")
+		if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePre, ib.hookTracker); err != nil {
+			return false, itemFiles, err
+		}
 	}
 	if optedOut, podName := ib.podVolumeSnapshotTracker.OptedoutByPod(namespace, name); optedOut {
 		ib.trackSkippedPV(obj, groupResource, podVolumeApproach, fmt.Sprintf("opted out due to annotation in pod %s", podName), log)
@@ -232,14 +218,16 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 	// the group version of the object.
 	versionPath := resourceVersion(obj)
 
-	updatedObj, additionalItemFiles, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata, finalize)
+	updatedObj, additionalItemFiles, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata, finalize, itemBlock)
 	if err != nil {
 		backupErrs = append(backupErrs, err)
 
 		// if there was an error running actions, execute post hooks and return
-		log.Debug("Executing post hooks")
-		if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost, ib.hookTracker); err != nil {
-			backupErrs = append(backupErrs, err)
+		if runHooks {
+			log.Debug("Executing post hooks")
+			if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost, ib.hookTracker); err != nil {
+				backupErrs = append(backupErrs, err)
+			}
 		}
 		return false, itemFiles, kubeerrs.NewAggregate(backupErrs)
 	}
@@ -295,15 +283,21 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		}
 	}
 
-	log.Debug("Executing post hooks")
-	if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost, ib.hookTracker); err != nil {
-		backupErrs = append(backupErrs, err)
+	if runHooks {
+		log.Debug("Executing post hooks")
+		if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost, ib.hookTracker); err != nil {
+			backupErrs = append(backupErrs, err)
+		}
 	}
 
 	if len(backupErrs) != 0 {
 		return false, itemFiles, kubeerrs.NewAggregate(backupErrs)
 	}
 
+	if err := ib.applyResourceModifiers(log, obj, groupResource, finalize); err != nil {
+		return false, itemFiles, err
+	}
+
 	itemBytes, err := json.Marshal(obj.UnstructuredContent())
 	if err != nil {
 		return false, itemFiles, errors.WithStack(err)
@@ -320,6 +314,75 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 	return true, itemFiles, nil
 }
 
+// applyResourceModifiers applies the resource modifiers of the backup to the item before it's
+// written to the backup. The item isn't backed up if any rule fails to apply, so that the data
+// which should be scrubbed never reaches the backup storage.
+func (ib *itemBackupper) applyResourceModifiers(log logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, finalize bool) error {
+	if ib.backupRequest.ResourceModifiers == nil {
+		return nil
+	}
+
+	u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	modCtx := &resourcemodifiers.Context{BackupName: ib.backupRequest.Name}
+	applied, errs := ib.backupRequest.ResourceModifiers.ApplyResourceModifierRulesAndGetApplied(u, groupResource.String(), ib.kbClient.Scheme(), modCtx, log)
+	if len(errs) > 0 {
+		return errors.Wrap(kubeerrs.NewAggregate(errs), "error applying resource modifiers")
+	}
+	obj.SetUnstructuredContent(u.Object)
+
+	// the items finalized were counted when they were backed up at the first time
+	if !finalize {
+		ib.backupRequest.recordAppliedResourceModifierRules(applied)
+	}
+	return nil
+}
+
+// itemInclusionChecks returns true if the item should be backed up, checking the exclusion label,
+// the namespace and resource includes/excludes of the backup, and whether the item is being deleted.
+func (ib *itemBackupper) itemInclusionChecks(log logrus.FieldLogger, mustInclude bool, metadata metav1.Object, obj runtime.Unstructured, groupResource schema.GroupResource) bool {
+	namespace := metadata.GetNamespace()
+
+	if mustInclude {
+		log.Infof("Skipping the exclusion checks for this resource")
+	} else {
+		if metadata.GetLabels()[velerov1api.ExcludeFromBackupLabel] == "true" {
+			log.Infof("Excluding item because it has label %s=true", velerov1api.ExcludeFromBackupLabel)
+			ib.trackSkippedPV(obj, groupResource, "", fmt.Sprintf("item has label %s=true", velerov1api.ExcludeFromBackupLabel), log)
+			return false
+		}
+		// NOTE: we have to re-check namespace & resource includes/excludes because it's possible that
+		// backupItem can be invoked by a custom action.
+		if namespace != "" && !ib.backupRequest.NamespaceIncludesExcludes.ShouldInclude(namespace) {
+			log.Info("Excluding item because namespace is excluded")
+			return false
+		}
+
+		// NOTE: we specifically allow namespaces to be backed up even if it's excluded.
+		// This check is more permissive for cluster resources to let those passed in by
+		// plugins' additional items to get involved.
+		// Only expel cluster resource when it's specifically listed in the excluded list here.
+		if namespace == "" && groupResource != kuberesource.Namespaces &&
+			ib.backupRequest.ResourceIncludesExcludes.ShouldExclude(groupResource.String()) {
+			log.Info("Excluding item because resource is cluster-scoped and is excluded by cluster filter.")
+			return false
+		}
+
+		// Only check namespace-scoped resource to avoid expelling cluster resources
+		// are not specified in included list.
+		if namespace != "" && !ib.backupRequest.ResourceIncludesExcludes.ShouldInclude(groupResource.String()) {
+			log.Info("Excluding item because resource is excluded")
+			return false
+		}
+	}
+
+	if metadata.GetDeletionTimestamp() != nil {
+		log.Info("Skipping item because it's being deleted.")
+		return false
+	}
+
+	return true
+}
+
 func getFileForArchive(namespace, name, groupResource, versionPath string, itemBytes []byte) FileForArchive {
 	filePath := archive.GetVersionedItemFilePath("", groupResource, namespace, name, versionPath)
 	hdr := &tar.Header{
@@ -354,6 +417,7 @@ func (ib *itemBackupper) executeActions(
 	name, namespace string,
 	metadata metav1.Object,
 	finalize bool,
+	itemBlock *BackupItemBlock,
 ) (runtime.Unstructured, []FileForArchive, error) {
 	var itemFiles []FileForArchive
 	for _, action := range ib.backupRequest.ResolvedActions {
@@ -362,7 +426,8 @@ func (ib *itemBackupper) executeActions(
 		}
 		log.Info("Executing custom action")
 		actionName := action.Name()
-		if act, err := ib.getMatchAction(obj, groupResource, actionName); err != nil {
+		act, err := ib.getMatchAction(obj, groupResource, actionName)
+		if err != nil {
 			return nil, itemFiles, errors.WithStack(err)
 		} else if act != nil && act.Type == resourcepolicies.Skip {
 			log.Infof("Skip executing Backup Item Action: %s of resource %s: %s/%s for the matched resource policies", actionName, groupResource, namespace, name)
@@ -394,6 +459,15 @@ func (ib *itemBackupper) executeActions(
 				)
 				continue
 			}
+
+			if group := metadata.GetLabels()[velerov1api.VolumeGroupLabel]; group != "" {
+				if err := ib.checkVolumeGroup(namespace, group); err != nil {
+					return nil, itemFiles, err
+				}
+			}
+
+			// pass the parameters of the matched volume policy to the CSI plugin
+			obj = addVolumePolicyAnnotations(obj, act)
 		}
 
 		updatedItem, additionalItemIdentifiers, operationID, postOperationItems, err := action.Execute(obj, ib.backupRequest.Backup)
@@ -416,9 +490,12 @@ func (ib *itemBackupper) executeActions(
 		}
 
 		mustInclude := u.GetAnnotations()[velerov1api.MustIncludeAdditionalItemAnnotation] == "true" || finalize
-		// remove the annotation as it's for communication between BIA and velero server,
-		// we don't want the resource be restored with this annotation.
+		// remove the annotations as they're for communication between BIA and velero server,
+		// we don't want the resource be restored with these annotations.
 		delete(u.GetAnnotations(), velerov1api.MustIncludeAdditionalItemAnnotation)
+		delete(u.GetAnnotations(), velerov1api.VolumePolicySnapshotClassAnnotation)
+		delete(u.GetAnnotations(), velerov1api.VolumePolicySnapshotMoveDataAnnotation)
+		delete(u.GetAnnotations(), velerov1api.VolumePolicyDataMoverAnnotation)
 		obj = u
 
 		// If async plugin started async operation, add it to the ItemOperations list
@@ -447,40 +524,65 @@ func (ib *itemBackupper) executeActions(
 				},
 			}
 			newOperation.Spec.PostOperationItems = postOperationItems
-			itemOperList := ib.backupRequest.GetItemOperationsList()
-			*itemOperList = append(*itemOperList, &newOperation)
+			ib.backupRequest.addItemOperation(&newOperation)
 		}
 
 		for _, additionalItem := range additionalItemIdentifiers {
-			gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
-			if err != nil {
-				return nil, itemFiles, err
+			var itemList []itemblock.ItemBlockItem
+
+			// get the item content from the item block if it's there to avoid an additional API server call.
+			// There could be multiple versions to back up if EnableAPIGroupVersions is set.
+			if itemBlock != nil {
+				itemList = itemBlock.FindItem(additionalItem.GroupResource, additionalItem.Namespace, additionalItem.Name)
+				if len(itemList) == 0 && !itemBlock.prepareAdditionalItem(additionalItem) {
+					log.WithFields(logrus.Fields{
+						"groupResource": additionalItem.GroupResource,
+						"namespace":     additionalItem.Namespace,
+						"name":          additionalItem.Name,
+					}).Debug("Additional item is backed up with the item block it's in")
+					continue
+				}
 			}
 
-			client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
-			if err != nil {
-				return nil, itemFiles, err
-			}
+			// if the item is not in the item block, get it from the cluster
+			if len(itemList) == 0 {
+				gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
+				if err != nil {
+					return nil, itemFiles, err
+				}
 
-			item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
+				client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
+				if err != nil {
+					return nil, itemFiles, err
+				}
 
-			if apierrors.IsNotFound(err) {
-				log.WithFields(logrus.Fields{
-					"groupResource": additionalItem.GroupResource,
-					"namespace":     additionalItem.Namespace,
-					"name":          additionalItem.Name,
-				}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
-				continue
-			}
-			if err != nil {
-				return nil, itemFiles, errors.WithStack(err)
+				item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
+
+				if apierrors.IsNotFound(err) {
+					log.WithFields(logrus.Fields{
+						"groupResource": additionalItem.GroupResource,
+						"namespace":     additionalItem.Namespace,
+						"name":          additionalItem.Name,
+					}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
+					continue
+				}
+				if err != nil {
+					return nil, itemFiles, errors.WithStack(err)
+				}
+				itemList = append(itemList, itemblock.ItemBlockItem{
+					Gr:           gvr.GroupResource(),
+					Item:         item,
+					PreferredGVR: gvr,
+				})
 			}
 
-			_, additionalItemFiles, err := ib.backupItem(log, item, gvr.GroupResource(), gvr, mustInclude, finalize)
-			if err != nil {
-				return nil, itemFiles, err
+			for _, item := range itemList {
+				_, additionalItemFiles, err := ib.backupItem(log, item.Item, item.Gr, item.PreferredGVR, mustInclude, finalize, itemBlock)
+				if err != nil {
+					return nil, itemFiles, err
+				}
+				itemFiles = append(itemFiles, additionalItemFiles...)
 			}
-			itemFiles = append(itemFiles, additionalItemFiles...)
 		}
 	}
 	return obj, itemFiles, nil
@@ -489,6 +591,9 @@ func (ib *itemBackupper) executeActions(
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
 // or returns an existing one if one's already been initialized for the location.
 func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeSnapshotLocation) (vsv1.VolumeSnapshotter, error) {
+	ib.volumeSnapshotterLock.Lock()
+	defer ib.volumeSnapshotterLock.Unlock()
+
 	if bs, ok := ib.snapshotLocationVolumeSnapshotters[snapshotLocation.Name]; ok {
 		return bs, nil
 	}
@@ -577,7 +682,15 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	}
 
 	if ib.backupRequest.ResPolicies != nil {
-		if action, err := ib.backupRequest.ResPolicies.GetMatchAction(pv); err != nil {
+		pvc, err := kubeutil.GetPVCForPV(pv, ib.kbClient)
+		if err != nil {
+			log.WithError(err).Errorf("Error getting the PVC of pv %s for resource policies", pv.Name)
+			return nil
+		}
+		if action, err := ib.backupRequest.ResPolicies.GetMatchAction(resourcepolicies.VolumeFilterData{
+			PersistentVolume:      pv,
+			PersistentVolumeClaim: pvc,
+		}); err != nil {
 			log.WithError(err).Errorf("Error getting matched resource policies for pv %s", pv.Name)
 			return nil
 		} else if action != nil && action.Type == resourcepolicies.Skip {
@@ -672,7 +785,7 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
 		snapshot.Status.ProviderSnapshotID = snapshotID
 	}
-	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+	ib.backupRequest.addVolumeSnapshot(snapshot)
 
 	// nil errors are automatically removed
 	return kubeerrs.NewAggregate(errs)
@@ -694,12 +807,99 @@ func (ib *itemBackupper) getMatchAction(obj runtime.Unstructured, groupResource
 		if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Name: pvName}, pv); err != nil {
 			return nil, errors.WithStack(err)
 		}
-		return ib.backupRequest.ResPolicies.GetMatchAction(pv)
+		return ib.backupRequest.ResPolicies.GetMatchAction(resourcepolicies.VolumeFilterData{
+			PersistentVolume:      pv,
+			PersistentVolumeClaim: &pvc,
+		})
 	}
 
 	return nil, nil
 }
 
+// checkVolumeGroup returns an error if any PVC of the volume group isn't backed up with a CSI
+// snapshot by the backup. The VolumeGroupSnapshot of the group snapshots all the PVCs of the
+// group, the member snapshots of the PVCs which aren't backed up with CSI snapshots would never
+// be consumed by the backup and would be leaked.
+func (ib *itemBackupper) checkVolumeGroup(namespace, group string) error {
+	pvcs := new(corev1api.PersistentVolumeClaimList)
+	if err := ib.kbClient.List(context.Background(), pvcs, kbClient.InNamespace(namespace),
+		kbClient.MatchingLabels{velerov1api.VolumeGroupLabel: group}); err != nil {
+		return errors.Wrapf(err, "error listing the PVCs of volume group %s", group)
+	}
+
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+
+		reason := ""
+		switch {
+		case pvc.Labels[velerov1api.ExcludeFromBackupLabel] == "true":
+			reason = fmt.Sprintf("it has label %s=true", velerov1api.ExcludeFromBackupLabel)
+		case pvc.DeletionTimestamp != nil:
+			reason = "it's being deleted"
+		default:
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			obj := &unstructured.Unstructured{Object: content}
+
+			act, err := ib.getMatchAction(obj, kuberesource.PersistentVolumeClaims, csiBIAPluginName)
+			if err != nil {
+				return errors.Wrapf(err, "error checking the volume policies of PVC %s/%s of volume group %s", pvc.Namespace, pvc.Name, group)
+			}
+			if act != nil && act.Type == resourcepolicies.Skip {
+				reason = "it's skipped by the resource policies"
+				break
+			}
+
+			snapshotVolume, err := ib.volumeHelperImpl.ShouldPerformSnapshot(obj, kuberesource.PersistentVolumeClaims)
+			if err != nil {
+				return errors.Wrapf(err, "error checking the volume policies of PVC %s/%s of volume group %s", pvc.Namespace, pvc.Name, group)
+			}
+			if !snapshotVolume {
+				reason = "it doesn't satisfy the criteria for VolumePolicy or the legacy snapshot way"
+			}
+		}
+
+		if reason != "" {
+			return errors.Errorf("volume group %s can't be snapshotted, PVC %s/%s of the group isn't backed up with a CSI snapshot: %s",
+				group, pvc.Namespace, pvc.Name, reason)
+		}
+	}
+
+	return nil
+}
+
+// addVolumePolicyAnnotations sets the snapshot parameters of the matched volume policy
+// as annotations on the PVC, for the CSI plugin to pick them up.
+func addVolumePolicyAnnotations(obj runtime.Unstructured, action *resourcepolicies.Action) runtime.Unstructured {
+	annotations := make(map[string]string)
+	if class := action.VolumeSnapshotClass(); class != "" {
+		annotations[velerov1api.VolumePolicySnapshotClassAnnotation] = class
+	}
+	if moveData := action.SnapshotMoveData(); moveData != nil {
+		annotations[velerov1api.VolumePolicySnapshotMoveDataAnnotation] = strconv.FormatBool(*moveData)
+	}
+	if dataMover := action.DataMover(); dataMover != "" {
+		annotations[velerov1api.VolumePolicyDataMoverAnnotation] = dataMover
+	}
+	if len(annotations) == 0 {
+		return obj
+	}
+
+	u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	existing := u.GetAnnotations()
+	if existing == nil {
+		existing = make(map[string]string)
+	}
+	for k, v := range annotations {
+		existing[k] = v
+	}
+	u.SetAnnotations(existing)
+
+	return u
+}
+
 // trackSkippedPV tracks the skipped PV based on the object and the given approach and reason
 // this function will be called throughout the process of backup, it needs to handle any object
 func (ib *itemBackupper) trackSkippedPV(obj runtime.Unstructured, groupResource schema.GroupResource, approach string, reason string, log logrus.FieldLogger) {
@@ -31,7 +31,11 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	"github.com/vmware-tanzu/velero/internal/volumehelper"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func Test_resourceKey(t *testing.T) {
@@ -229,6 +233,57 @@ func TestGetPVName(t *testing.T) {
 	}
 }
 
+func TestAddVolumePolicyAnnotations(t *testing.T) {
+	testcases := []struct {
+		name        string
+		action      *resourcepolicies.Action
+		annotations map[string]string
+		expected    map[string]string
+	}{
+		{
+			name: "no matched action",
+		},
+		{
+			name:   "action without parameters",
+			action: &resourcepolicies.Action{Type: resourcepolicies.Snapshot},
+		},
+		{
+			name: "snapshot parameters are added to existing annotations",
+			action: &resourcepolicies.Action{
+				Type: resourcepolicies.Snapshot,
+				Parameters: map[string]interface{}{
+					resourcepolicies.VolumeSnapshotClassParameter: "gold-vsc",
+					resourcepolicies.SnapshotMoveDataParameter:    false,
+					resourcepolicies.DataMoverParameter:           "velero",
+				},
+			},
+			annotations: map[string]string{"foo": "bar"},
+			expected: map[string]string{
+				"foo": "bar",
+				velerov1api.VolumePolicySnapshotClassAnnotation:    "gold-vsc",
+				velerov1api.VolumePolicySnapshotMoveDataAnnotation: "false",
+				velerov1api.VolumePolicyDataMoverAnnotation:        "velero",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			pvc := builder.ForPersistentVolumeClaim("ns", "pvc-1").Result()
+			pvc.Annotations = tc.annotations
+			data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+			require.NoError(t, err)
+
+			obj := addVolumePolicyAnnotations(&unstructured.Unstructured{Object: data}, tc.action)
+			u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+			if tc.expected == nil {
+				assert.Equal(t, tc.annotations, u.GetAnnotations())
+			} else {
+				assert.Equal(t, tc.expected, u.GetAnnotations())
+			}
+		})
+	}
+}
+
 func TestRandom(t *testing.T) {
 	pv := new(corev1api.PersistentVolume)
 	pvc := new(corev1api.PersistentVolumeClaim)
@@ -269,3 +324,75 @@ func TestAddVolumeInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckVolumeGroup(t *testing.T) {
+	groupLabels := builder.WithLabels(velerov1api.VolumeGroupLabel, "group-1")
+
+	tests := []struct {
+		name                     string
+		objs                     []runtime.Object
+		defaultVolumesToFsBackup bool
+		expectedErr              string
+	}{
+		{
+			name: "all the PVCs of the group are snapshotted",
+			objs: []runtime.Object{
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(groupLabels).VolumeName("pv-1").Phase(corev1api.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-1").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(groupLabels).VolumeName("pv-2").Phase(corev1api.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-2").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-3").VolumeName("pv-3").Phase(corev1api.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-3").Result(),
+				builder.ForPersistentVolumeClaim("ns-2", "pvc-4").ObjectMeta(builder.WithLabels(velerov1api.VolumeGroupLabel, "group-1", velerov1api.ExcludeFromBackupLabel, "true")).VolumeName("pv-4").Phase(corev1api.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-4").Result(),
+			},
+		},
+		{
+			name: "a PVC of the group is excluded from the backup",
+			objs: []runtime.Object{
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(groupLabels).VolumeName("pv-1").Phase(corev1api.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-1").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(builder.WithLabels(velerov1api.VolumeGroupLabel, "group-1", velerov1api.ExcludeFromBackupLabel, "true")).VolumeName("pv-2").Phase(corev1api.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-2").Result(),
+			},
+			expectedErr: "volume group group-1 can't be snapshotted, PVC ns-1/pvc-2 of the group isn't backed up with a CSI snapshot: it has label velero.io/exclude-from-backup=true",
+		},
+		{
+			name: "a PVC of the group is backed up by fs-backup",
+			objs: []runtime.Object{
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(groupLabels).VolumeName("pv-1").Phase(corev1api.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-1").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(groupLabels).VolumeName("pv-2").Phase(corev1api.ClaimBound).Result(),
+				builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").Result(),
+				builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("volume-1").PersistentVolumeClaimSource("pvc-2").Result()).Result(),
+			},
+			defaultVolumesToFsBackup: true,
+			expectedErr:              "volume group group-1 can't be snapshotted, PVC ns-1/pvc-2 of the group isn't backed up with a CSI snapshot: it doesn't satisfy the criteria for VolumePolicy or the legacy snapshot way",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, tc.objs...)
+			ib := itemBackupper{
+				backupRequest: new(Request),
+				kbClient:      client,
+				volumeHelperImpl: volumehelper.NewVolumeHelperImpl(
+					nil,
+					nil,
+					logrus.StandardLogger(),
+					client,
+					tc.defaultVolumesToFsBackup,
+					false,
+				),
+			}
+
+			err := ib.checkVolumeGroup("ns-1", "group-1")
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// itemBlockInput is an item block to be backed up by an item block worker.
+type itemBlockInput struct {
+	itemBlock  *BackupItemBlock
+	returnChan chan itemBlockReturn
+}
+
+// itemBlockReturn is the result of backing up an item block.
+type itemBlockReturn struct {
+	itemBlock *BackupItemBlock
+	resources []schema.GroupResource
+}
+
+// itemBlockWorkerPool is a bounded pool of workers which back up the item blocks
+// of a backup concurrently.
+type itemBlockWorkerPool struct {
+	inputChannel chan itemBlockInput
+	wg           *sync.WaitGroup
+	sequence     *itemBlockSequence
+	// submitted is the number of item blocks submitted so far
+	submitted int
+}
+
+// itemBlockSequence tracks the item blocks backed up, numbered in submission order, so an
+// item block can wait for the ones submitted before it to be backed up.
+type itemBlockSequence struct {
+	lock sync.Mutex
+	cond *sync.Cond
+	// next is the number of the first item block which isn't backed up, all the ones
+	// before it are
+	next int
+	// finished are the item blocks after next which are backed up
+	finished map[int]bool
+}
+
+func newItemBlockSequence() *itemBlockSequence {
+	s := &itemBlockSequence{finished: map[int]bool{}}
+	s.cond = sync.NewCond(&s.lock)
+	return s
+}
+
+// finish records the item block as backed up.
+func (s *itemBlockSequence) finish(number int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.finished[number] = true
+	for s.finished[s.next] {
+		delete(s.finished, s.next)
+		s.next++
+	}
+	s.cond.Broadcast()
+}
+
+// waitForPrevious waits for all the item blocks before the given one to be backed up.
+func (s *itemBlockSequence) waitForPrevious(number int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for s.next < number {
+		s.cond.Wait()
+	}
+}
+
+// startItemBlockWorkerPool starts the given number of workers, each of which backs up
+// the item blocks it receives with backupFunc.
+func startItemBlockWorkerPool(workers int, backupFunc func(BackupItemBlock) []schema.GroupResource, log logrus.FieldLogger) *itemBlockWorkerPool {
+	if workers < 1 {
+		workers = 1
+	}
+	pool := &itemBlockWorkerPool{
+		inputChannel: make(chan itemBlockInput, workers),
+		wg:           &sync.WaitGroup{},
+		sequence:     newItemBlockSequence(),
+	}
+	log.Debugf("Starting %d item block workers", workers)
+	for i := 0; i < workers; i++ {
+		pool.wg.Add(1)
+		go func() {
+			defer pool.wg.Done()
+			for input := range pool.inputChannel {
+				resources := backupFunc(*input.itemBlock)
+				pool.sequence.finish(input.itemBlock.number)
+				input.returnChan <- itemBlockReturn{
+					itemBlock: input.itemBlock,
+					resources: resources,
+				}
+			}
+		}()
+	}
+	return pool
+}
+
+// submit queues the item block for backup and returns the channel its result is sent on.
+// The item blocks must be submitted from a single goroutine.
+func (p *itemBlockWorkerPool) submit(itemBlock *BackupItemBlock) chan itemBlockReturn {
+	number := p.submitted
+	p.submitted++
+	itemBlock.number = number
+	itemBlock.waitForPreviousBlocks = func() {
+		p.sequence.waitForPrevious(number)
+	}
+
+	returnChan := make(chan itemBlockReturn, 1)
+	p.inputChannel <- itemBlockInput{
+		itemBlock:  itemBlock,
+		returnChan: returnChan,
+	}
+	return returnChan
+}
+
+// stop waits for the workers to finish the submitted item blocks and exit.
+func (p *itemBlockWorkerPool) stop() {
+	close(p.inputChannel)
+	p.wg.Wait()
+}
@@ -178,6 +178,8 @@ type kubernetesResource struct {
 	groupResource         schema.GroupResource
 	preferredGVR          schema.GroupVersionResource
 	namespace, name, path string
+	// set to true during backup processing when added to an ItemBlock
+	inItemBlock bool
 }
 
 // getItemsFromResourceIdentifiers get the kubernetesResources
@@ -462,6 +464,15 @@ func (r *itemCollector) getResourceItems(
 			continue
 		}
 
+		if r.backupRequest.ResPolicies != nil {
+			filtered, err := r.backupRequest.ResPolicies.FilterResources(gr.String(), unstructuredItems, log)
+			if err != nil {
+				log.WithError(err).Error("Error filtering items by resource policies")
+			} else {
+				unstructuredItems = filtered
+			}
+		}
+
 		// Collect items in included Namespaces
 		for i := range unstructuredItems {
 			item := &unstructuredItems[i]
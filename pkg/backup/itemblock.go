@@ -0,0 +1,138 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/itemblock"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupItemBlock is an ItemBlock which is backed up by the shared itemBackupper of a backup.
+type BackupItemBlock struct {
+	itemblock.ItemBlock
+	// This is a reference to the shared itemBackupper for the backup
+	itemBackupper *itemBackupper
+	// tarWriter buffers the files of the items in the block until they
+	// are written to the backup tarball
+	tarWriter *itemBlockTarWriter
+	// number is the position of the block in the submission order of the worker pool
+	number int
+	// waitForPreviousBlocks waits for the blocks submitted before this one to be backed
+	// up, it's nil if the block isn't backed up by the worker pool
+	waitForPreviousBlocks func()
+	// collectedItems are the items collected for the backup, each of them is backed up
+	// by the item block it's added to
+	collectedItems map[velero.ResourceIdentifier][]*kubernetesResource
+}
+
+// NewBackupItemBlock returns an empty BackupItemBlock.
+func NewBackupItemBlock(log logrus.FieldLogger, itemBackupper *itemBackupper) *BackupItemBlock {
+	return &BackupItemBlock{
+		ItemBlock:     itemblock.ItemBlock{Log: log},
+		itemBackupper: itemBackupper,
+		tarWriter:     &itemBlockTarWriter{},
+	}
+}
+
+// prepareAdditionalItem decides which item block backs up an additional item which isn't
+// in this block, so the item ends up in the same block whatever the number of workers.
+// It returns false if the item is collected for the backup, so it's backed up by the block
+// it's added to. Otherwise the item is backed up by the first block, in submission order,
+// which refers to it, so prepareAdditionalItem waits for the blocks before this one.
+func (b *BackupItemBlock) prepareAdditionalItem(id velero.ResourceIdentifier) bool {
+	if _, collected := b.collectedItems[id]; collected {
+		return false
+	}
+	if b.waitForPreviousBlocks != nil {
+		b.waitForPreviousBlocks()
+	}
+	return true
+}
+
+// addKubernetesResource reads the item collected from the API server from disk and adds it
+// to the item block. It returns nil if the item is already in an item block or it can't be read.
+func (b *BackupItemBlock) addKubernetesResource(item *kubernetesResource, log logrus.FieldLogger) *unstructured.Unstructured {
+	// no-op if the item is already in an item block
+	if item.inItemBlock {
+		return nil
+	}
+	item.inItemBlock = true
+
+	var unstructured unstructured.Unstructured
+
+	f, err := os.Open(item.path)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error opening file containing item")
+		return nil
+	}
+	defer f.Close()
+	defer os.Remove(f.Name())
+
+	if err := json.NewDecoder(f).Decode(&unstructured); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error decoding JSON from file")
+		return nil
+	}
+	log.Infof("adding %s %s/%s to ItemBlock", item.groupResource, item.namespace, item.name)
+	b.AddUnstructured(item.groupResource, &unstructured, item.preferredGVR)
+	return &unstructured
+}
+
+// itemBlockTarWriter is a tarWriter which keeps the files written to it in memory
+// so they can be written to the backup tarball in item block order.
+type itemBlockTarWriter struct {
+	files []FileForArchive
+}
+
+func (w *itemBlockTarWriter) WriteHeader(header *tar.Header) error {
+	w.files = append(w.files, FileForArchive{FilePath: header.Name, Header: header})
+	return nil
+}
+
+func (w *itemBlockTarWriter) Write(b []byte) (int, error) {
+	if len(w.files) == 0 {
+		return 0, errors.New("write called before WriteHeader")
+	}
+	last := &w.files[len(w.files)-1]
+	last.FileBytes = append(last.FileBytes, b...)
+	return len(b), nil
+}
+
+func (w *itemBlockTarWriter) Close() error {
+	return nil
+}
+
+// flush writes the buffered files to tw.
+func (w *itemBlockTarWriter) flush(tw tarWriter) error {
+	for _, file := range w.files {
+		if err := tw.WriteHeader(file.Header); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := tw.Write(file.FileBytes); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	w.files = nil
+	return nil
+}
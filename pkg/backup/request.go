@@ -17,10 +17,10 @@ limitations under the License.
 package backup
 
 import (
-	"fmt"
-	"sort"
+	"sync"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -46,13 +46,23 @@ type Request struct {
 	ResourceIncludesExcludes  collections.IncludesExcludesInterface
 	ResourceHooks             []hook.ResourceHook
 	ResolvedActions           []framework.BackupItemResolvedActionV2
+	ResolvedItemBlockActions  []framework.ItemBlockResolvedAction
 	VolumeSnapshots           []*volume.Snapshot
 	PodVolumeBackups          []*velerov1api.PodVolumeBackup
-	BackedUpItems             map[itemKey]struct{}
+	BackedUpItems             *backedUpItemsMap
 	itemOperationsList        *[]*itemoperation.BackupOperation
 	ResPolicies               *resourcepolicies.Policies
+	ResourceModifiers         *resourcemodifiers.ResourceModifiers
 	SkippedPVTracker          *skipPVTracker
 	VolumesInformation        volume.BackupVolumesInformation
+
+	// appliedResourceModifierRules is the number of items modified by each
+	// resource modifier rule, keyed by the index of the rule
+	appliedResourceModifierRules map[int]int
+
+	// lock guards VolumeSnapshots, itemOperationsList and appliedResourceModifierRules,
+	// which are updated by concurrent item block workers
+	lock sync.Mutex
 }
 
 // BackupVolumesInformation contains the information needs by generating
@@ -60,6 +70,8 @@ type Request struct {
 
 // GetItemOperationsList returns ItemOperationsList, initializing it if necessary
 func (r *Request) GetItemOperationsList() *[]*itemoperation.BackupOperation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
 	if r.itemOperationsList == nil {
 		list := []*itemoperation.BackupOperation{}
 		r.itemOperationsList = &list
@@ -67,24 +79,62 @@ func (r *Request) GetItemOperationsList() *[]*itemoperation.BackupOperation {
 	return r.itemOperationsList
 }
 
-// BackupResourceList returns the list of backed up resources grouped by the API
-// Version and Kind
-func (r *Request) BackupResourceList() map[string][]string {
-	resources := map[string][]string{}
-	for i := range r.BackedUpItems {
-		entry := i.name
-		if i.namespace != "" {
-			entry = fmt.Sprintf("%s/%s", i.namespace, i.name)
-		}
-		resources[i.resource] = append(resources[i.resource], entry)
+// addItemOperation appends the operation to the ItemOperationsList
+func (r *Request) addItemOperation(operation *itemoperation.BackupOperation) {
+	itemOperList := r.GetItemOperationsList()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	*itemOperList = append(*itemOperList, operation)
+}
+
+// addVolumeSnapshot appends the snapshot to VolumeSnapshots
+func (r *Request) addVolumeSnapshot(snapshot *volume.Snapshot) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.VolumeSnapshots = append(r.VolumeSnapshots, snapshot)
+}
+
+// recordAppliedResourceModifierRules counts an item modified by the resource modifier rules
+func (r *Request) recordAppliedResourceModifierRules(rules []int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.appliedResourceModifierRules == nil {
+		r.appliedResourceModifierRules = make(map[int]int)
 	}
+	for _, rule := range rules {
+		r.appliedResourceModifierRules[rule]++
+	}
+}
 
-	// sort namespace/name entries for each GVK
-	for _, v := range resources {
-		sort.Strings(v)
+// ResourceModifiersStatus returns the status of the resource modifiers applied to the
+// items of the backup, it's nil if the backup doesn't reference resource modifiers.
+func (r *Request) ResourceModifiersStatus() *velerov1api.BackupResourceModifiersStatus {
+	if r.ResourceModifiers == nil || r.Spec.ResourceModifier == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	status := &velerov1api.BackupResourceModifiersStatus{ConfigMap: r.Spec.ResourceModifier.Name}
+	for i, rule := range r.ResourceModifiers.ResourceModifierRules {
+		if count := r.appliedResourceModifierRules[i]; count > 0 {
+			status.AppliedRules = append(status.AppliedRules, velerov1api.AppliedResourceModifierRule{
+				Index:         i,
+				GroupResource: rule.Conditions.GroupResource,
+				ItemsModified: count,
+			})
+		}
 	}
+	return status
+}
 
-	return resources
+// BackupResourceList returns the list of backed up resources grouped by the API
+// Version and Kind
+func (r *Request) BackupResourceList() map[string][]string {
+	if r.BackedUpItems == nil {
+		return map[string][]string{}
+	}
+	return r.BackedUpItems.ResourceMap()
 }
 
 func (r *Request) FillVolumesInformation() {
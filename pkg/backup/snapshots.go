@@ -25,9 +25,9 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/label"
-	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 // GetBackupCSIResources is used to get CSI snapshot related resources.
@@ -42,9 +42,11 @@ func GetBackupCSIResources(
 	volumeSnapshotContents []snapshotv1api.VolumeSnapshotContent,
 	volumeSnapshotClasses []snapshotv1api.VolumeSnapshotClass,
 ) {
-	if boolptr.IsSetToTrue(backup.Spec.SnapshotMoveData) {
-		backupLog.Info("backup SnapshotMoveData is set to true, skip VolumeSnapshot resource persistence.")
-	} else if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+	if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		// the data of the snapshots is moved by the DataUploads either when the backup sets
+		// SnapshotMoveData or when the volume policies choose so, such VolumeSnapshots are not persisted
+		movedSnapshots := getMovedVolumeSnapshots(client, backup, backupLog)
+
 		selector := label.NewSelectorForBackup(backup.Name)
 		vscList := &snapshotv1api.VolumeSnapshotContentList{}
 
@@ -55,13 +57,21 @@ func GetBackupCSIResources(
 		if err != nil {
 			backupLog.Error(err)
 		}
-		volumeSnapshots = append(volumeSnapshots, vsList.Items...)
+		for _, vs := range vsList.Items {
+			if movedSnapshots.Has(vs.Namespace + "/" + vs.Name) {
+				continue
+			}
+			volumeSnapshots = append(volumeSnapshots, vs)
+		}
 
 		if err := client.List(context.Background(), vscList, &kbclient.ListOptions{LabelSelector: selector}); err != nil {
 			backupLog.Error(err)
 		}
-		if len(vscList.Items) >= 0 {
-			volumeSnapshotContents = vscList.Items
+		for _, vsc := range vscList.Items {
+			if movedSnapshots.Has(vsc.Spec.VolumeSnapshotRef.Namespace + "/" + vsc.Spec.VolumeSnapshotRef.Name) {
+				continue
+			}
+			volumeSnapshotContents = append(volumeSnapshotContents, vsc)
 		}
 
 		vsClassSet := sets.NewString()
@@ -82,3 +92,26 @@ func GetBackupCSIResources(
 
 	return volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses
 }
+
+// getMovedVolumeSnapshots returns the namespaced names of the VolumeSnapshots
+// whose data is moved by the DataUploads of the backup.
+func getMovedVolumeSnapshots(client kbclient.Client, backup *velerov1api.Backup, backupLog logrus.FieldLogger) sets.Set[string] {
+	movedSnapshots := sets.New[string]()
+
+	duList := new(velerov2alpha1.DataUploadList)
+	if err := client.List(context.TODO(), duList, &kbclient.ListOptions{
+		Namespace:     backup.Namespace,
+		LabelSelector: label.NewSelectorForBackup(backup.Name),
+	}); err != nil {
+		backupLog.WithError(err).Error("Error listing DataUploads of the backup")
+		return movedSnapshots
+	}
+
+	for _, du := range duList.Items {
+		if du.Spec.CSISnapshot != nil {
+			movedSnapshots.Insert(du.Spec.SourceNamespace + "/" + du.Spec.CSISnapshot.VolumeSnapshot)
+		}
+	}
+
+	return movedSnapshots
+}
@@ -0,0 +1,59 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetBackupCSIResources(t *testing.T) {
+	features.NewFeatureFlagSet(velerov1api.CSIFeatureFlag)
+	defer features.NewFeatureFlagSet()
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	backupLabel := builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")
+
+	objs := []runtime.Object{
+		builder.ForVolumeSnapshot("ns-1", "vs-1").ObjectMeta(backupLabel).Result(),
+		builder.ForVolumeSnapshot("ns-1", "vs-2").ObjectMeta(backupLabel).Result(),
+		builder.ForVolumeSnapshotContent("vsc-1").ObjectMeta(backupLabel).VolumeSnapshotRef("ns-1", "vs-1").Result(),
+		builder.ForVolumeSnapshotContent("vsc-2").ObjectMeta(backupLabel).VolumeSnapshotRef("ns-1", "vs-2").Result(),
+		// the data of vs-2 is moved, as chosen by a volume policy
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").
+			Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1"}).
+			SourceNamespace("ns-1").
+			CSISnapshot(&velerov2alpha1.CSISnapshotSpec{VolumeSnapshot: "vs-2"}).Result(),
+	}
+	client := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+
+	vss, vscs, _ := GetBackupCSIResources(client, client, backup, velerotest.NewLogger())
+	require.Len(t, vss, 1)
+	assert.Equal(t, "vs-1", vss[0].Name)
+	require.Len(t, vscs, 1)
+	assert.Equal(t, "vsc-1", vscs[0].Name)
+	assert.Equal(t, 1, backup.Status.CSIVolumeSnapshotsAttempted)
+}
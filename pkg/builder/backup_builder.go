@@ -23,7 +23,6 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 
 	"github.com/sirupsen/logrus"
@@ -283,8 +282,16 @@ func (b *BackupBuilder) ItemOperationTimeout(timeout time.Duration) *BackupBuild
 }
 
 // ResourcePolicies sets the Backup's resource polices.
+// The kind is spelled out to avoid an import cycle through the resourcepolicies package.
 func (b *BackupBuilder) ResourcePolicies(name string) *BackupBuilder {
-	b.object.Spec.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: name}
+	b.object.Spec.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: "configmap", Name: name}
+	return b
+}
+
+// ResourceModifier sets the Backup's resource modifiers.
+// The kind is spelled out to avoid an import cycle through the resourcemodifiers package.
+func (b *BackupBuilder) ResourceModifier(name string) *BackupBuilder {
+	b.object.Spec.ResourceModifier = &v1.TypedLocalObjectReference{Kind: "configmap", Name: name}
 	return b
 }
 
@@ -309,6 +316,12 @@ func (b *BackupBuilder) ParallelFilesUpload(parallel int) *BackupBuilder {
 	return b
 }
 
+// ItemBlockWorkerCount sets the Backup's item block worker count
+func (b *BackupBuilder) ItemBlockWorkerCount(count int) *BackupBuilder {
+	b.object.Spec.ItemBlockWorkerCount = count
+	return b
+}
+
 // WithStatus sets the Backup's status.
 func (b *BackupBuilder) WithStatus(status velerov1api.BackupStatus) *BackupBuilder {
 	b.object.Status = status
@@ -128,3 +128,24 @@ func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeyS
 	b.object.Spec.Credential = selector
 	return b
 }
+
+// ReplicaLocations sets the BackupStorageLocation's replica locations.
+func (b *BackupStorageLocationBuilder) ReplicaLocations(names ...string) *BackupStorageLocationBuilder {
+	b.object.Spec.ReplicaLocations = append(b.object.Spec.ReplicaLocations, names...)
+	return b
+}
+
+// Immutability sets the BackupStorageLocation's object lock mode and repository retention period.
+func (b *BackupStorageLocationBuilder) Immutability(mode velerov1api.ObjectLockMode, repositoryRetentionPeriod time.Duration) *BackupStorageLocationBuilder {
+	b.object.Spec.Immutability = &velerov1api.BackupStorageLocationImmutability{
+		Mode:                      mode,
+		RepositoryRetentionPeriod: &metav1.Duration{Duration: repositoryRetentionPeriod},
+	}
+	return b
+}
+
+// Encryption sets the BackupStorageLocation's encryption.
+func (b *BackupStorageLocationBuilder) Encryption(encryption *velerov1api.BackupStorageLocationEncryption) *BackupStorageLocationBuilder {
+	b.object.Spec.Encryption = encryption
+	return b
+}
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupVerificationRequestBuilder builds BackupVerificationRequest objects.
+type BackupVerificationRequestBuilder struct {
+	object *velerov1api.BackupVerificationRequest
+}
+
+// ForBackupVerificationRequest is the constructor for a BackupVerificationRequestBuilder.
+func ForBackupVerificationRequest(ns, name string) *BackupVerificationRequestBuilder {
+	return &BackupVerificationRequestBuilder{
+		object: &velerov1api.BackupVerificationRequest{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "BackupVerificationRequest",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built BackupVerificationRequest.
+func (b *BackupVerificationRequestBuilder) Result() *velerov1api.BackupVerificationRequest {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the BackupVerificationRequest's ObjectMeta.
+func (b *BackupVerificationRequestBuilder) ObjectMeta(opts ...ObjectMetaOpt) *BackupVerificationRequestBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// BackupName sets the name of the backup to verify.
+func (b *BackupVerificationRequestBuilder) BackupName(name string) *BackupVerificationRequestBuilder {
+	b.object.Spec.BackupName = name
+	return b
+}
+
+// ReadContent sets whether to read all the content of the volume snapshots.
+func (b *BackupVerificationRequestBuilder) ReadContent(readContent bool) *BackupVerificationRequestBuilder {
+	b.object.Spec.ReadContent = readContent
+	return b
+}
+
+// Phase sets the BackupVerificationRequest's phase.
+func (b *BackupVerificationRequestBuilder) Phase(phase velerov1api.BackupVerificationRequestPhase) *BackupVerificationRequestBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
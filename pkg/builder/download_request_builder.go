@@ -60,3 +60,9 @@ func (b *DownloadRequestBuilder) Target(targetKind velerov1api.DownloadTargetKin
 	b.object.Spec.Target.Name = targetName
 	return b
 }
+
+// EncryptionPublicKey sets the public key the data key of the DownloadRequest's target is wrapped for.
+func (b *DownloadRequestBuilder) EncryptionPublicKey(key []byte) *DownloadRequestBuilder {
+	b.object.Spec.EncryptionPublicKey = key
+	return b
+}
@@ -95,12 +95,27 @@ func (b *RestoreBuilder) ExcludedResources(resources ...string) *RestoreBuilder
 	return b
 }
 
+// IncludedItems appends to the Restore's included items.
+func (b *RestoreBuilder) IncludedItems(items ...string) *RestoreBuilder {
+	b.object.Spec.IncludedItems = append(b.object.Spec.IncludedItems, items...)
+	return b
+}
+
 // ExistingResourcePolicy sets the Restore's resource policy.
 func (b *RestoreBuilder) ExistingResourcePolicy(policy string) *RestoreBuilder {
 	b.object.Spec.ExistingResourcePolicy = velerov1api.PolicyType(policy)
 	return b
 }
 
+// ExistingResourcePolicyOverride sets the Restore's resource policy for a resource.
+func (b *RestoreBuilder) ExistingResourcePolicyOverride(resource, policy string) *RestoreBuilder {
+	if b.object.Spec.ExistingResourcePolicyOverrides == nil {
+		b.object.Spec.ExistingResourcePolicyOverrides = map[string]velerov1api.PolicyType{}
+	}
+	b.object.Spec.ExistingResourcePolicyOverrides[resource] = velerov1api.PolicyType(policy)
+	return b
+}
+
 // IncludeClusterResources sets the Restore's "include cluster resources" flag.
 func (b *RestoreBuilder) IncludeClusterResources(val bool) *RestoreBuilder {
 	b.object.Spec.IncludeClusterResources = &val
@@ -171,3 +186,9 @@ func (b *RestoreBuilder) ItemOperationTimeout(timeout time.Duration) *RestoreBui
 	b.object.Spec.ItemOperationTimeout.Duration = timeout
 	return b
 }
+
+// DryRun sets the Restore's dry run.
+func (b *RestoreBuilder) DryRun(val bool) *RestoreBuilder {
+	b.object.Spec.DryRun = &val
+	return b
+}
@@ -95,3 +95,39 @@ func (b *ScheduleBuilder) SkipImmediately(skip *bool) *ScheduleBuilder {
 	b.object.Spec.SkipImmediately = skip
 	return b
 }
+
+// TimeZone sets the Schedule's time zone.
+func (b *ScheduleBuilder) TimeZone(timeZone string) *ScheduleBuilder {
+	b.object.Spec.TimeZone = timeZone
+	return b
+}
+
+// BlackoutWindows appends to the Schedule's blackout windows.
+func (b *ScheduleBuilder) BlackoutWindows(windows ...velerov1api.BlackoutWindow) *ScheduleBuilder {
+	b.object.Spec.BlackoutWindows = append(b.object.Spec.BlackoutWindows, windows...)
+	return b
+}
+
+// Jitter sets the Schedule's jitter.
+func (b *ScheduleBuilder) Jitter(jitter time.Duration) *ScheduleBuilder {
+	b.object.Spec.Jitter = metav1.Duration{Duration: jitter}
+	return b
+}
+
+// ConcurrencyPolicy sets the Schedule's concurrency policy.
+func (b *ScheduleBuilder) ConcurrencyPolicy(policy velerov1api.ScheduleConcurrencyPolicy) *ScheduleBuilder {
+	b.object.Spec.ConcurrencyPolicy = policy
+	return b
+}
+
+// StartingDeadlineSeconds sets the Schedule's starting deadline in seconds.
+func (b *ScheduleBuilder) StartingDeadlineSeconds(seconds int64) *ScheduleBuilder {
+	b.object.Spec.StartingDeadlineSeconds = &seconds
+	return b
+}
+
+// Retention sets the Schedule's retention policy.
+func (b *ScheduleBuilder) Retention(retention *velerov1api.ScheduleRetention) *ScheduleBuilder {
+	b.object.Spec.Retention = retention
+	return b
+}
@@ -36,6 +36,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewVerifyCommand(f, "verify"),
+		NewSearchCommand(f, "search"),
 	)
 
 	return c
@@ -105,8 +105,10 @@ type CreateOptions struct {
 	CSISnapshotTimeout              time.Duration
 	ItemOperationTimeout            time.Duration
 	ResPoliciesConfigmap            string
+	ResourceModifierConfigMap       string
 	client                          kbclient.WithWatch
 	ParallelFilesUpload             int
+	ItemBlockWorkerCount            int
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -151,8 +153,10 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = cmd.TRUE
 
 	flags.StringVar(&o.ResPoliciesConfigmap, "resource-policies-configmap", "", "Reference to the resource policies configmap that backup using")
+	flags.StringVar(&o.ResourceModifierConfigMap, "resource-modifier-configmap", "", "Reference to the resource modifier configmap that backup will apply to the items before they are written to the backup")
 	flags.StringVar(&o.DataMover, "data-mover", "", "Specify the data mover to be used by the backup. If the parameter is not set or set as 'velero', the built-in data mover will be used")
 	flags.IntVar(&o.ParallelFilesUpload, "parallel-files-upload", 0, "Number of files uploads simultaneously when running a backup. This is only applicable for the kopia uploader")
+	flags.IntVar(&o.ItemBlockWorkerCount, "item-block-worker-count", 0, "Number of item blocks backed up concurrently. If not set, the server's default item block worker count is used")
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -176,6 +180,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return fmt.Errorf("either a 'selector' or an 'or-selector' can be specified, but not both")
 	}
 
+	if o.ItemBlockWorkerCount < 0 {
+		return fmt.Errorf("item-block-worker-count must not be negative")
+	}
+
 	// Ensure that unless FromSchedule is set, args contains a backup name
 	if o.FromSchedule == "" && len(args) != 1 {
 		return fmt.Errorf("a backup name is required, unless you are creating based on a schedule")
@@ -398,9 +406,15 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.ResPoliciesConfigmap != "" {
 			backupBuilder.ResourcePolicies(o.ResPoliciesConfigmap)
 		}
+		if o.ResourceModifierConfigMap != "" {
+			backupBuilder.ResourceModifier(o.ResourceModifierConfigMap)
+		}
 		if o.ParallelFilesUpload > 0 {
 			backupBuilder.ParallelFilesUpload(o.ParallelFilesUpload)
 		}
+		if o.ItemBlockWorkerCount > 0 {
+			backupBuilder.ItemBlockWorkerCount(o.ItemBlockWorkerCount)
+		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
@@ -182,8 +182,10 @@ func TestCreateCommand(t *testing.T) {
 		includeClusterResources := "true"
 		defaultVolumesToFsBackup := "true"
 		resPoliciesConfigmap := "cm-name-2"
+		resourceModifierConfigMap := "cm-name-3"
 		dataMover := "velero"
 		parallelFilesUpload := 10
+		itemBlockWorkerCount := 4
 		flags := new(flag.FlagSet)
 		o := NewCreateOptions()
 		o.BindFlags(flags)
@@ -210,8 +212,10 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--include-cluster-resources", includeClusterResources})
 		flags.Parse([]string{"--default-volumes-to-fs-backup", defaultVolumesToFsBackup})
 		flags.Parse([]string{"--resource-policies-configmap", resPoliciesConfigmap})
+		flags.Parse([]string{"--resource-modifier-configmap", resourceModifierConfigMap})
 		flags.Parse([]string{"--data-mover", dataMover})
 		flags.Parse([]string{"--parallel-files-upload", fmt.Sprintf("%d", parallelFilesUpload)})
+		flags.Parse([]string{"--item-block-worker-count", fmt.Sprintf("%d", itemBlockWorkerCount)})
 		//flags.Parse([]string{"--wait"})
 
 		client := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
@@ -259,8 +263,10 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, includeClusterResources, o.IncludeClusterResources.String())
 		require.Equal(t, defaultVolumesToFsBackup, o.DefaultVolumesToFsBackup.String())
 		require.Equal(t, resPoliciesConfigmap, o.ResPoliciesConfigmap)
+		require.Equal(t, resourceModifierConfigMap, o.ResourceModifierConfigMap)
 		require.Equal(t, dataMover, o.DataMover)
 		require.Equal(t, parallelFilesUpload, o.ParallelFilesUpload)
+		require.Equal(t, itemBlockWorkerCount, o.ItemBlockWorkerCount)
 		//assert.Equal(t, true, o.Wait)
 
 		// verify oldAndNewFilterParametersUsedTogether
@@ -17,8 +17,11 @@ limitations under the License.
 package backup
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -32,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 )
 
 func NewDownloadCommand(f client.Factory) *cobra.Command {
@@ -124,7 +128,23 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	}
 	defer backupDest.Close()
 
-	err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), o.Name, velerov1api.DownloadTargetKindBackupContents, backupDest, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	verifier, err := o.getChecksumVerifier(kbClient, f.Namespace())
+	if err != nil {
+		os.Remove(o.Output)
+		cmd.CheckError(err)
+	}
+
+	// the checksum is of the stored content, which is encrypted if the
+	// location is configured with encryption
+	var raw io.Writer
+	if verifier != nil {
+		raw = verifier
+	}
+
+	err = downloadrequest.StreamWithRawContent(context.Background(), kbClient, f.Namespace(), o.Name, velerov1api.DownloadTargetKindBackupContents, backupDest, raw, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	if err == nil && verifier != nil {
+		err = verifier.Verify()
+	}
 	if err != nil {
 		os.Remove(o.Output)
 		cmd.CheckError(err)
@@ -133,3 +153,33 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Backup %s has been successfully downloaded to %s\n", o.Name, backupDest.Name())
 	return nil
 }
+
+// getChecksumVerifier returns a verifier for the checksum of the backup contents
+// recorded in the backup storage, or nil if the backup has no checksums recorded.
+func (o *DownloadOptions) getChecksumVerifier(kbClient controllerclient.Client, namespace string) (*persistence.ChecksumVerifier, error) {
+	buf := new(bytes.Buffer)
+	err := downloadrequest.Stream(context.Background(), kbClient, namespace, o.Name, velerov1api.DownloadTargetKindBackupChecksums, buf, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	if err == downloadrequest.ErrNotFound {
+		// backups created by older versions have no checksums recorded
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error downloading backup checksums")
+	}
+
+	checksums := new(persistence.BackupChecksums)
+	if err := json.NewDecoder(buf).Decode(checksums); err != nil {
+		return nil, errors.Wrap(err, "error decoding backup checksums")
+	}
+	if checksums.Algorithm != persistence.ChecksumAlgorithmSHA256 {
+		return nil, errors.Errorf("unsupported backup checksum algorithm %q", checksums.Algorithm)
+	}
+
+	file := o.Name + ".tar.gz"
+	expected, ok := checksums.Checksums[file]
+	if !ok {
+		return nil, nil
+	}
+
+	return persistence.NewChecksumVerifier(file, expected), nil
+}
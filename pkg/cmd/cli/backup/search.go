@@ -0,0 +1,385 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
+)
+
+const (
+	itemContentFirst     = "first"
+	itemContentChanged   = "changed"
+	itemContentUnchanged = "unchanged"
+)
+
+func NewSearchCommand(f client.Factory, use string) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewSearchOptions()
+	o.CACertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Search the backups containing an item",
+		Long: `Search the backups containing an item, and show in which backups its content changed.
+
+The resource lists of the completed and partially failed backups are checked for the item,
+then the item is read from the contents of every backup containing it and compared with
+the item in the previous backup. The item could then be restored from one of the backups
+with "velero restore create --from-backup <BACKUP> --include-item <ITEM>".`,
+		Example: `  # Search the backups containing the configmap "app-config" in the namespace "app".
+  velero backup search --resource configmaps/app/app-config
+
+  # Search the backups of the schedule "daily" containing the cluster role "app", and print the changes.
+  velero backup search --resource clusterroles.rbac.authorization.k8s.io/app --selector velero.io/schedule-name=daily --diff`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type SearchOptions struct {
+	Resource              string
+	Selector              string
+	Diff                  bool
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CACertFile            string
+
+	item     archive.ItemReference
+	client   kbclient.Client
+	resolver func(schema.GroupResource) (schema.GroupKind, error)
+	out      io.Writer
+}
+
+func NewSearchOptions() *SearchOptions {
+	return &SearchOptions{
+		Timeout: time.Minute,
+		out:     os.Stdout,
+	}
+}
+
+func (o *SearchOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Resource, "resource", o.Resource, "Item to search, formatted as resource.group/namespace/name, or resource.group/name for cluster-scoped items, such as configmaps/app/app-config.")
+	flags.StringVarP(&o.Selector, "selector", "l", o.Selector, "Only search the backups matching this label selector.")
+	flags.BoolVar(&o.Diff, "diff", o.Diff, "Print the changes of the item between the backups.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process the download request of each backup file.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *SearchOptions) Complete(args []string, f client.Factory) error {
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	if o.resolver == nil {
+		o.resolver = func(groupResource schema.GroupResource) (schema.GroupKind, error) {
+			kubeClient, err := f.KubeClient()
+			if err != nil {
+				return schema.GroupKind{}, err
+			}
+			helper, err := velerodiscovery.NewHelper(kubeClient.Discovery(), logrus.New())
+			if err != nil {
+				return schema.GroupKind{}, err
+			}
+			gvr, resource, err := helper.ResourceFor(groupResource.WithVersion(""))
+			if err != nil {
+				return schema.GroupKind{}, errors.Wrapf(err, "error resolving resource %s", groupResource)
+			}
+			return schema.GroupKind{Group: gvr.Group, Kind: resource.Kind}, nil
+		}
+	}
+
+	return nil
+}
+
+func (o *SearchOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if o.Resource == "" {
+		return errors.New("--resource is required")
+	}
+
+	item, err := archive.ParseItemReference(o.Resource)
+	if err != nil {
+		return err
+	}
+	o.item = item
+
+	return nil
+}
+
+func (o *SearchOptions) Run(c *cobra.Command, f client.Factory) error {
+	groupKind, err := o.resolver(schema.ParseGroupResource(o.item.GroupResource))
+	if err != nil {
+		return err
+	}
+
+	backups := new(velerov1api.BackupList)
+	listOptions := &kbclient.ListOptions{Namespace: f.Namespace()}
+	if o.Selector != "" {
+		selector, err := labels.Parse(o.Selector)
+		if err != nil {
+			return err
+		}
+		listOptions.LabelSelector = selector
+	}
+	if err := o.client.List(context.TODO(), backups, listOptions); err != nil {
+		return err
+	}
+
+	var candidates []velerov1api.Backup
+	for _, backup := range backups.Items {
+		if backup.Status.Phase == velerov1api.BackupPhaseCompleted || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed {
+			candidates = append(candidates, backup)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return backupStartTime(&candidates[i]).Before(backupStartTime(&candidates[j]))
+	})
+
+	w := tabwriter.NewWriter(o.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "BACKUP\tSTARTED\tCONTENT")
+
+	var found string
+	var previous []byte
+	var diffs []string
+	for i := range candidates {
+		backup := &candidates[i]
+
+		resourceList, err := o.getResourceList(backup)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "WARNING: Skipping backup %s: error getting its resource list: %v\n", backup.Name, err)
+			continue
+		}
+		if !resourceListContains(resourceList, groupKind, o.item) {
+			continue
+		}
+
+		content, err := o.getItem(backup)
+		if err != nil {
+			return errors.Wrapf(err, "error getting item %s from backup %s", o.item, backup.Name)
+		}
+		if content == nil {
+			fmt.Fprintf(os.Stderr, "WARNING: Item %s is in the resource list of backup %s but not in its contents\n", o.item, backup.Name)
+			continue
+		}
+
+		state := itemContentFirst
+		if previous != nil {
+			state = itemContentUnchanged
+			if !bytes.Equal(previous, content) {
+				state = itemContentChanged
+				if o.Diff {
+					diff, err := itemDiff(previous, content, found, backup.Name)
+					if err != nil {
+						return err
+					}
+					diffs = append(diffs, diff)
+				}
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", backup.Name, backupStartTime(backup).Format(time.RFC3339), state)
+
+		previous = content
+		found = backup.Name
+	}
+	w.Flush()
+
+	if found == "" {
+		fmt.Fprintf(o.out, "\nItem %s not found in any backup.\n", o.item)
+		return nil
+	}
+
+	for _, diff := range diffs {
+		fmt.Fprintf(o.out, "\n%s", diff)
+	}
+
+	fmt.Fprintf(o.out, "\nRun `velero restore create --from-backup %s --include-item %s` to restore the item from a backup.\n", found, o.item)
+
+	return nil
+}
+
+func (o *SearchOptions) getResourceList(backup *velerov1api.Backup) (map[string][]string, error) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(context.TODO(), o.client, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, o.Timeout, o.InsecureSkipTLSVerify, o.CACertFile); err != nil {
+		return nil, err
+	}
+
+	var resourceList map[string][]string
+	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
+		return nil, errors.Wrap(err, "error decoding the resource list")
+	}
+	return resourceList, nil
+}
+
+// getItem streams the contents of the backup and returns the normalized item, or nil
+// if it is not in the contents. The download stops once the item is found.
+func (o *SearchOptions) getItem(backup *velerov1api.Backup) ([]byte, error) {
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	go func() {
+		writer.CloseWithError(downloadrequest.Stream(context.TODO(), o.client, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupContents, writer, o.Timeout, o.InsecureSkipTLSVerify, o.CACertFile))
+	}()
+
+	content, err := findItemInArchive(reader, o.item)
+	if err != nil || content == nil {
+		return nil, err
+	}
+	return normalizeItem(content)
+}
+
+func backupStartTime(backup *velerov1api.Backup) time.Time {
+	if backup.Status.StartTimestamp != nil {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
+
+// resourceListContains returns whether the item is in the resource list of a backup, whose
+// keys are formatted as group/version/Kind, or version/Kind for the core group.
+func resourceListContains(resourceList map[string][]string, groupKind schema.GroupKind, item archive.ItemReference) bool {
+	name := item.Name
+	if item.Namespace != "" {
+		name = item.Namespace + "/" + item.Name
+	}
+
+	for key, items := range resourceList {
+		var group, kind string
+		parts := strings.Split(key, "/")
+		switch len(parts) {
+		case 2:
+			kind = parts[1]
+		case 3:
+			group, kind = parts[0], parts[2]
+		default:
+			continue
+		}
+		if group != groupKind.Group || kind != groupKind.Kind {
+			continue
+		}
+
+		for _, i := range items {
+			if i == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findItemInArchive returns the content of the item in the preferred version
+// from a backup tarball, or nil if it is not in the tarball.
+func findItemInArchive(r io.Reader, item archive.ItemReference) ([]byte, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading the backup contents")
+	}
+	defer gzr.Close()
+
+	itemPath := archive.GetItemFilePath("", item.GroupResource, item.Namespace, item.Name)
+	tarRdr := tar.NewReader(gzr)
+	for {
+		header, err := tarRdr.Next()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading the backup contents")
+		}
+		if header.Typeflag != tar.TypeReg || header.Name != itemPath {
+			continue
+		}
+		return io.ReadAll(tarRdr)
+	}
+}
+
+// normalizeItem removes the fields of an item that change without its content
+// changing, so that the items in different backups could be compared.
+func normalizeItem(content []byte) ([]byte, error) {
+	obj := new(unstructured.Unstructured)
+	if err := json.Unmarshal(content, obj); err != nil {
+		return nil, errors.Wrap(err, "error decoding the item")
+	}
+
+	for _, field := range []string{"resourceVersion", "uid", "creationTimestamp", "generation", "managedFields", "selfLink"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	// json.Marshal sorts the keys of the maps
+	return json.Marshal(obj.Object)
+}
+
+func itemDiff(before, after []byte, beforeName, afterName string) (string, error) {
+	beforeYAML, err := yaml.JSONToYAML(before)
+	if err != nil {
+		return "", err
+	}
+	afterYAML, err := yaml.JSONToYAML(after)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(beforeYAML)),
+		B:        difflib.SplitLines(string(afterYAML)),
+		FromFile: beforeName,
+		ToFile:   afterName,
+		Context:  3,
+	})
+}
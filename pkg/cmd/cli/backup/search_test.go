@@ -0,0 +1,170 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestSearchCommand(t *testing.T) {
+	f := &factorymocks.Factory{}
+
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result(),
+	)
+
+	f.On("KubebuilderClient").Return(client, nil)
+	f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+
+	c := NewSearchCommand(f, "search")
+	assert.Equal(t, "Search the backups containing an item", c.Short)
+
+	o := NewSearchOptions()
+	flags := new(flag.FlagSet)
+	o.BindFlags(flags)
+
+	require.NoError(t, o.Complete(nil, f))
+	require.EqualError(t, o.Validate(c, nil, f), "--resource is required")
+
+	require.NoError(t, flags.Parse([]string{"--resource", "configmaps"}))
+	require.Error(t, o.Validate(c, nil, f))
+
+	require.NoError(t, flags.Parse([]string{"--resource", "configmaps/app/app-config", "--selector", "foo=bar", "--diff"}))
+	require.NoError(t, o.Validate(c, nil, f))
+	assert.Equal(t, archive.ItemReference{GroupResource: "configmaps", Namespace: "app", Name: "app-config"}, o.item)
+	assert.Equal(t, "foo=bar", o.Selector)
+	assert.True(t, o.Diff)
+
+	o.Selector = ""
+	o.resolver = func(schema.GroupResource) (schema.GroupKind, error) {
+		return schema.GroupKind{Kind: "ConfigMap"}, nil
+	}
+	out := new(bytes.Buffer)
+	o.out = out
+	require.NoError(t, o.Run(c, f))
+	assert.Contains(t, out.String(), "Item configmaps/app/app-config not found in any backup.")
+}
+
+func TestResourceListContains(t *testing.T) {
+	resourceList := map[string][]string{
+		"v1/ConfigMap":       {"app/app-config", "app/other"},
+		"apps/v1/Deployment": {"app/app"},
+		"rbac.authorization.k8s.io/v1/ClusterRole": {"app"},
+	}
+
+	tests := []struct {
+		name      string
+		groupKind schema.GroupKind
+		item      archive.ItemReference
+		expected  bool
+	}{
+		{
+			name:      "namespaced core item",
+			groupKind: schema.GroupKind{Kind: "ConfigMap"},
+			item:      archive.ItemReference{GroupResource: "configmaps", Namespace: "app", Name: "app-config"},
+			expected:  true,
+		},
+		{
+			name:      "namespaced item with group",
+			groupKind: schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			item:      archive.ItemReference{GroupResource: "deployments.apps", Namespace: "app", Name: "app"},
+			expected:  true,
+		},
+		{
+			name:      "cluster-scoped item",
+			groupKind: schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+			item:      archive.ItemReference{GroupResource: "clusterroles.rbac.authorization.k8s.io", Name: "app"},
+			expected:  true,
+		},
+		{
+			name:      "item in another namespace",
+			groupKind: schema.GroupKind{Kind: "ConfigMap"},
+			item:      archive.ItemReference{GroupResource: "configmaps", Namespace: "other", Name: "app-config"},
+			expected:  false,
+		},
+		{
+			name:      "item of another group",
+			groupKind: schema.GroupKind{Group: "extensions", Kind: "Deployment"},
+			item:      archive.ItemReference{GroupResource: "deployments.extensions", Namespace: "app", Name: "app"},
+			expected:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resourceListContains(resourceList, tc.groupKind, tc.item))
+		})
+	}
+}
+
+func TestFindItemInArchive(t *testing.T) {
+	configMap := builder.ForConfigMap("app", "app-config").Data("key", "value").Result()
+	tarball := velerotest.NewTarWriter(t).
+		AddItems("configmaps", builder.ForConfigMap("app", "other").Result()).
+		Add("resources/configmaps/v1-preferredversion/namespaces/app/app-config.json", configMap).
+		AddItems("configmaps", configMap).
+		Done().Bytes()
+
+	content, err := findItemInArchive(bytes.NewReader(tarball), archive.ItemReference{GroupResource: "configmaps", Namespace: "app", Name: "app-config"})
+	require.NoError(t, err)
+	obj := make(map[string]any)
+	require.NoError(t, json.Unmarshal(content, &obj))
+	assert.Equal(t, map[string]any{"key": "value"}, obj["data"])
+
+	content, err = findItemInArchive(bytes.NewReader(tarball), archive.ItemReference{GroupResource: "configmaps", Namespace: "app", Name: "missing"})
+	require.NoError(t, err)
+	assert.Nil(t, content)
+
+	_, err = findItemInArchive(bytes.NewReader([]byte("not a tarball")), archive.ItemReference{GroupResource: "configmaps", Name: "app-config"})
+	require.Error(t, err)
+}
+
+func TestNormalizeItem(t *testing.T) {
+	before, err := normalizeItem([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"app-config","namespace":"app","resourceVersion":"1","uid":"uid-1","creationTimestamp":"2024-01-01T00:00:00Z"},"data":{"key":"value"}}`))
+	require.NoError(t, err)
+	after, err := normalizeItem([]byte(`{"kind":"ConfigMap","apiVersion":"v1","metadata":{"namespace":"app","name":"app-config","resourceVersion":"2","uid":"uid-2","creationTimestamp":"2024-01-02T00:00:00Z"},"data":{"key":"value"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	changed, err := normalizeItem([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"app-config","namespace":"app"},"data":{"key":"new-value"}}`))
+	require.NoError(t, err)
+	assert.NotEqual(t, before, changed)
+
+	diff, err := itemDiff(before, changed, "backup-1", "backup-2")
+	require.NoError(t, err)
+	assert.Contains(t, diff, "--- backup-1")
+	assert.Contains(t, diff, "+++ backup-2")
+	assert.Contains(t, diff, "-  key: value")
+	assert.Contains(t, diff, "+  key: new-value")
+
+	_, err = normalizeItem([]byte("not json"))
+	require.Error(t, err)
+}
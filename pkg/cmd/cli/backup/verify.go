@@ -0,0 +1,171 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+func NewVerifyCommand(f client.Factory, use string) *cobra.Command {
+	o := NewVerifyOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Verify that a backup could be restored",
+		Long: `Verify that a backup could be restored, without running a restore.
+
+The Velero server downloads the backup contents and checks every item in it could be decoded,
+and checks every pod volume backup and data mover snapshot of the backup exists in the backup repository.
+The result of the verification is stored along with the backup in the backup storage location.`,
+		Example: `  # Verify the backup named "backup-1".
+  velero backup verify backup-1
+
+  # Verify the backup named "backup-1" and read all the data of its volume snapshots, then wait for the result.
+  velero backup verify backup-1 --read-content --wait`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type VerifyOptions struct {
+	BackupName  string
+	ReadContent bool
+	Wait        bool
+	Timeout     time.Duration
+
+	client kbclient.Client
+}
+
+func NewVerifyOptions() *VerifyOptions {
+	return &VerifyOptions{
+		Timeout: time.Hour,
+	}
+}
+
+func (o *VerifyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.ReadContent, "read-content", o.ReadContent, "Read all the data of the volume snapshots in the backup repository instead of only checking the snapshots exist. Only supported by the kopia repositories.")
+	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the verification to complete.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the verification to complete. Only takes effect with --wait.")
+}
+
+func (o *VerifyOptions) Complete(args []string, f client.Factory) error {
+	o.BackupName = args[0]
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *VerifyOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	backup := new(velerov1api.Backup)
+	if err := o.client.Get(context.TODO(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.BackupName}, backup); err != nil {
+		return err
+	}
+
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+		return fmt.Errorf("backup %s is %s, only completed or partially failed backups could be verified", o.BackupName, backup.Status.Phase)
+	}
+
+	return nil
+}
+
+func (o *VerifyOptions) Run(c *cobra.Command, f client.Factory) error {
+	request := builder.ForBackupVerificationRequest(f.Namespace(), "").
+		ObjectMeta(
+			builder.WithLabels(velerov1api.BackupNameLabel, label.GetValidName(o.BackupName)),
+			builder.WithGenerateName(o.BackupName+"-"),
+		).
+		BackupName(o.BackupName).
+		ReadContent(o.ReadContent).
+		Result()
+
+	if err := client.CreateRetryGenerateName(o.client, context.TODO(), request); err != nil {
+		return err
+	}
+
+	fmt.Printf("Backup verification request %q submitted successfully.\n", request.Name)
+
+	if !o.Wait {
+		fmt.Printf("Run `kubectl -n %s get backupverificationrequests %s -o yaml` for the result.\n", request.Namespace, request.Name)
+		return nil
+	}
+
+	fmt.Println("Waiting for the verification to complete. You may safely press ctrl-c to stop waiting - your verification will continue in the background.")
+
+	ctx, cancel := context.WithTimeout(context.Background(), o.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for backup verification request %s to complete", request.Name)
+		case <-ticker.C:
+			fmt.Print(".")
+
+			if err := o.client.Get(ctx, kbclient.ObjectKeyFromObject(request), request); err != nil {
+				return err
+			}
+
+			if request.Status.Phase == velerov1api.BackupVerificationRequestPhaseCompleted ||
+				request.Status.Phase == velerov1api.BackupVerificationRequestPhaseFailed {
+				fmt.Println()
+				printBackupVerificationStatus(request)
+				return nil
+			}
+		}
+	}
+}
+
+func printBackupVerificationStatus(request *velerov1api.BackupVerificationRequest) {
+	fmt.Printf("Backup verification completed with status: %s.\n", request.Status.Phase)
+	fmt.Printf("Items verified: %d\n", request.Status.ItemsVerified)
+	fmt.Printf("Volume snapshots verified: %d\n", request.Status.VolumeSnapshotsVerified)
+
+	if len(request.Status.Errors) > 0 {
+		fmt.Println("Errors:")
+		for _, err := range request.Status.Errors {
+			fmt.Printf("  %s\n", err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestVerifyCommand(t *testing.T) {
+	f := &factorymocks.Factory{}
+
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result(),
+		builder.ForBackup(cmdtest.VeleroNameSpace, "backup-2").Phase(velerov1api.BackupPhaseInProgress).Result(),
+	)
+
+	f.On("KubebuilderClient").Return(client, nil)
+	f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+
+	c := NewVerifyCommand(f, "verify")
+	assert.Equal(t, "Verify that a backup could be restored", c.Short)
+
+	o := NewVerifyOptions()
+	flags := new(flag.FlagSet)
+	o.BindFlags(flags)
+	require.NoError(t, flags.Parse([]string{"--read-content", "--timeout", "10m"}))
+
+	require.NoError(t, o.Complete([]string{"backup-2"}, f))
+	require.EqualError(t, o.Validate(c, []string{"backup-2"}, f), "backup backup-2 is InProgress, only completed or partially failed backups could be verified")
+
+	require.NoError(t, o.Complete([]string{"backup-1"}, f))
+	require.NoError(t, o.Validate(c, []string{"backup-1"}, f))
+	require.NoError(t, o.Run(c, f))
+
+	requests := new(velerov1api.BackupVerificationRequestList)
+	require.NoError(t, client.List(context.Background(), requests, &controllerclient.ListOptions{Namespace: cmdtest.VeleroNameSpace}))
+	require.Len(t, requests.Items, 1)
+	assert.Equal(t, "backup-1", requests.Items[0].Spec.BackupName)
+	assert.True(t, requests.Items[0].Spec.ReadContent)
+	assert.Equal(t, "backup-1", requests.Items[0].Labels[velerov1api.BackupNameLabel])
+}
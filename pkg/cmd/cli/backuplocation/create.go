@@ -69,10 +69,22 @@ type CreateOptions struct {
 	DefaultBackupStorageLocation          bool
 	Prefix                                string
 	BackupSyncPeriod, ValidationFrequency time.Duration
+	BackupSyncSelector                    flag.LabelSelector
+	BackupSyncMaxAge                      time.Duration
 	Config                                flag.Map
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	ReplicaLocations                      flag.StringArray
+	ImmutabilityMode                      *flag.Enum
+	RepositoryRetentionPeriod             time.Duration
+	EncryptionKeyID                       string
+	EncryptionKeySecret                   string
+	KMSProvider                           string
+	KMSConfig                             flag.Map
+	UploadBytesPerSecond                  int64
+	DownloadBytesPerSecond                int64
+	OperationsPerSecond                   int64
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -80,11 +92,17 @@ func NewCreateOptions() *CreateOptions {
 		Credential: flag.NewMap(),
 		Config:     flag.NewMap(),
 		Labels:     flag.NewMap(),
+		KMSConfig:  flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
 		),
+		ImmutabilityMode: flag.NewEnum(
+			"",
+			string(velerov1api.ObjectLockModeGovernance),
+			string(velerov1api.ObjectLockModeCompliance),
+		),
 	}
 }
 
@@ -95,6 +113,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "Prefix under which all Velero data should be stored within the bucket. Optional.")
 	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. Optional. Set this to `0s` to disable sync. Default: 1 minute.")
+	flags.Var(&o.BackupSyncSelector, "backup-sync-selector", "Only sync the backups matching this label selector from object storage. Optional.")
+	flags.DurationVar(&o.BackupSyncMaxAge, "backup-sync-max-age", o.BackupSyncMaxAge, "Only sync the backups started within this duration from object storage. Optional.")
 	flags.DurationVar(&o.ValidationFrequency, "validation-frequency", o.ValidationFrequency, "How often to verify if the backup storage location is valid. Optional. Set this to `0s` to disable sync. Default 1 minute.")
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup storage location.")
@@ -104,6 +124,20 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.Var(&o.ReplicaLocations, "replica-locations", "Names of the backup storage locations the finished backups of this location are copied to, e.g. in another region. Optional.")
+	flags.Var(
+		o.ImmutabilityMode,
+		"immutability-mode",
+		fmt.Sprintf("Object lock mode protecting the backups of the location from being deleted or overwritten before they expire. Requires an object store plugin and a bucket supporting object locks. Valid values are %s. Optional.", strings.Join(o.ImmutabilityMode.AllowedValues(), ",")),
+	)
+	flags.DurationVar(&o.RepositoryRetentionPeriod, "repository-retention-period", o.RepositoryRetentionPeriod, "How long the objects written to the Kopia repositories of the location stay locked, when the location has an immutability mode. Optional. Default: 720h.")
+	flags.StringVar(&o.EncryptionKeyID, "encryption-key-id", o.EncryptionKeyID, "ID of the key the files Velero stores in the location are encrypted with. Requires --encryption-key-secret or --kms-provider. Optional.")
+	flags.StringVar(&o.EncryptionKeySecret, "encryption-key-secret", o.EncryptionKeySecret, "Name of the Secret holding the encryption keys by their IDs. Optional.")
+	flags.StringVar(&o.KMSProvider, "kms-provider", o.KMSProvider, "Name of the KeyManager plugin wrapping the encryption keys. Optional.")
+	flags.Var(&o.KMSConfig, "kms-config", "Configuration key-value pairs of the KeyManager plugin. Optional.")
+	flags.Int64Var(&o.UploadBytesPerSecond, "upload-bytes-per-second", o.UploadBytesPerSecond, "Maximum rate of the data uploaded to the location, in bytes per second. Optional.")
+	flags.Int64Var(&o.DownloadBytesPerSecond, "download-bytes-per-second", o.DownloadBytesPerSecond, "Maximum rate of the data downloaded from the location, in bytes per second. Optional.")
+	flags.Int64Var(&o.OperationsPerSecond, "operations-per-second", o.OperationsPerSecond, "Maximum rate of each kind of operations (reads, writes and lists) on the location, per second. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -123,10 +157,38 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--backup-sync-period must be non-negative")
 	}
 
+	if o.BackupSyncMaxAge < 0 {
+		return errors.New("--backup-sync-max-age must be non-negative")
+	}
+
 	if len(o.Credential.Data()) > 1 {
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.RepositoryRetentionPeriod != 0 && o.ImmutabilityMode.String() == "" {
+		return errors.New("--repository-retention-period requires --immutability-mode")
+	}
+
+	if o.RepositoryRetentionPeriod < 0 {
+		return errors.New("--repository-retention-period must be non-negative")
+	}
+
+	if o.EncryptionKeyID == "" && (o.EncryptionKeySecret != "" || o.KMSProvider != "") {
+		return errors.New("--encryption-key-secret and --kms-provider require --encryption-key-id")
+	}
+
+	if o.EncryptionKeyID != "" && (o.EncryptionKeySecret == "") == (o.KMSProvider == "") {
+		return errors.New("--encryption-key-id requires exactly one of --encryption-key-secret and --kms-provider")
+	}
+
+	if len(o.KMSConfig.Data()) > 0 && o.KMSProvider == "" {
+		return errors.New("--kms-config requires --kms-provider")
+	}
+
+	if o.UploadBytesPerSecond < 0 || o.DownloadBytesPerSecond < 0 || o.OperationsPerSecond < 0 {
+		return errors.New("--upload-bytes-per-second, --download-bytes-per-second and --operations-per-second must be non-negative")
+	}
+
 	return nil
 }
 
@@ -163,9 +225,10 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 					CACert: caCertData,
 				},
 			},
-			Config:     o.Config.Data(),
-			Default:    o.DefaultBackupStorageLocation,
-			AccessMode: velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Config:           o.Config.Data(),
+			Default:          o.DefaultBackupStorageLocation,
+			AccessMode:       velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			ReplicaLocations: o.ReplicaLocations,
 		},
 	}
 
@@ -173,10 +236,51 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 		backupStorageLocation.Spec.BackupSyncPeriod = &metav1.Duration{Duration: o.BackupSyncPeriod}
 	}
 
+	if o.BackupSyncSelector.LabelSelector != nil || o.BackupSyncMaxAge > 0 {
+		backupStorageLocation.Spec.BackupSyncFilter = &velerov1api.BackupSyncFilter{
+			LabelSelector: o.BackupSyncSelector.LabelSelector,
+		}
+		if o.BackupSyncMaxAge > 0 {
+			backupStorageLocation.Spec.BackupSyncFilter.MaxAge = &metav1.Duration{Duration: o.BackupSyncMaxAge}
+		}
+	}
+
 	if setValidationFrequency {
 		backupStorageLocation.Spec.ValidationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
 	}
 
+	if o.ImmutabilityMode.String() != "" {
+		backupStorageLocation.Spec.Immutability = &velerov1api.BackupStorageLocationImmutability{
+			Mode: velerov1api.ObjectLockMode(o.ImmutabilityMode.String()),
+		}
+		if o.RepositoryRetentionPeriod > 0 {
+			backupStorageLocation.Spec.Immutability.RepositoryRetentionPeriod = &metav1.Duration{Duration: o.RepositoryRetentionPeriod}
+		}
+	}
+
+	if o.EncryptionKeyID != "" {
+		backupStorageLocation.Spec.Encryption = &velerov1api.BackupStorageLocationEncryption{
+			KeyID:     o.EncryptionKeyID,
+			KeySecret: o.EncryptionKeySecret,
+		}
+		if o.KMSProvider != "" {
+			backupStorageLocation.Spec.Encryption.KMS = &velerov1api.KMSConfig{
+				Provider: o.KMSProvider,
+				Config:   o.KMSConfig.Data(),
+			}
+		}
+	}
+
+	if o.UploadBytesPerSecond > 0 || o.DownloadBytesPerSecond > 0 || o.OperationsPerSecond > 0 {
+		backupStorageLocation.Spec.BandwidthLimits = &velerov1api.BandwidthLimits{
+			BandwidthLimit: velerov1api.BandwidthLimit{
+				UploadBytesPerSecond:   o.UploadBytesPerSecond,
+				DownloadBytesPerSecond: o.DownloadBytesPerSecond,
+				OperationsPerSecond:    o.OperationsPerSecond,
+			},
+		}
+	}
+
 	for secretName, secretKey := range o.Credential.Data() {
 		backupStorageLocation.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
 		break
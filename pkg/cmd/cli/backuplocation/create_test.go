@@ -29,6 +29,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -97,6 +98,86 @@ func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	assert.Equal(t, map[string]string{"key": "value"}, bsl.Labels)
 }
 
+func TestBuildBackupStorageLocationSetsImmutability(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Immutability)
+
+	assert.NoError(t, o.ImmutabilityMode.Set("Governance"))
+	o.RepositoryRetentionPeriod = 72 * time.Hour
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.BackupStorageLocationImmutability{
+		Mode:                      velerov1api.ObjectLockModeGovernance,
+		RepositoryRetentionPeriod: &metav1.Duration{Duration: 72 * time.Hour},
+	}, bsl.Spec.Immutability)
+
+	assert.Error(t, o.ImmutabilityMode.Set("Legal"))
+}
+
+func TestBuildBackupStorageLocationSetsBackupSyncFilter(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.BackupSyncFilter)
+
+	assert.NoError(t, o.BackupSyncSelector.Set("app=nginx"))
+	o.BackupSyncMaxAge = 720 * time.Hour
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.BackupSyncFilter{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}, MatchExpressions: []metav1.LabelSelectorRequirement{}},
+		MaxAge:        &metav1.Duration{Duration: 720 * time.Hour},
+	}, bsl.Spec.BackupSyncFilter)
+}
+
+func TestBuildBackupStorageLocationSetsEncryption(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Encryption)
+
+	o.EncryptionKeyID = "key-1"
+	o.KMSProvider = "velero.io/kms"
+	assert.NoError(t, o.KMSConfig.Set("region=us-east-1"))
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.BackupStorageLocationEncryption{
+		KeyID: "key-1",
+		KMS: &velerov1api.KMSConfig{
+			Provider: "velero.io/kms",
+			Config:   map[string]string{"region": "us-east-1"},
+		},
+	}, bsl.Spec.Encryption)
+}
+
+func TestBuildBackupStorageLocationSetsBandwidthLimits(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.BandwidthLimits)
+
+	o.UploadBytesPerSecond = 1000
+	o.OperationsPerSecond = 10
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.BandwidthLimits{
+		BandwidthLimit: velerov1api.BandwidthLimit{
+			UploadBytesPerSecond: 1000,
+			OperationsPerSecond:  10,
+		},
+	}, bsl.Spec.BandwidthLimits)
+}
+
 func TestCreateCommand_Run(t *testing.T) {
 	// create a factory
 	f := &factorymocks.Factory{}
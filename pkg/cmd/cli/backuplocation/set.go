@@ -61,6 +61,7 @@ type SetOptions struct {
 	CACertFile                   string
 	Credential                   flag.Map
 	DefaultBackupStorageLocation flag.OptionalBool
+	ReplicaLocations             flag.StringArray
 }
 
 func NewSetOptions() *SetOptions {
@@ -74,6 +75,7 @@ func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
 	f := flags.VarPF(&o.DefaultBackupStorageLocation, "default", "", "Sets this new location to be the new default backup storage location. Optional.")
 	f.NoOptDefVal = cmd.TRUE
+	flags.Var(&o.ReplicaLocations, "replica-locations", "Sets the names of the backup storage locations the finished backups of this location are copied to. Set it to \"\" to stop copying the backups. Optional.")
 }
 
 func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -148,6 +150,15 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 		break
 	}
 
+	if c.Flags().Changed("replica-locations") {
+		location.Spec.ReplicaLocations = nil
+		for _, name := range o.ReplicaLocations {
+			if name != "" {
+				location.Spec.ReplicaLocations = append(location.Spec.ReplicaLocations, name)
+			}
+		}
+	}
+
 	if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -86,6 +86,7 @@ type Options struct {
 	DisableInformerCache            bool
 	ScheduleSkipImmediately         bool
 	MaintenanceCfg                  repository.MaintenanceConfig
+	BackupRepoConfig                string
 }
 
 // BindFlags adds command line values to the options struct.
@@ -135,6 +136,8 @@ func (o *Options) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.MaintenanceCfg.MemRequest, "maintenance-job-mem-request", o.MaintenanceCfg.MemRequest, "Memory request for maintenance jobs. Default is no limit.")
 	flags.StringVar(&o.MaintenanceCfg.CPULimit, "maintenance-job-cpu-limit", o.MaintenanceCfg.CPULimit, "CPU limit for maintenance jobs. Default is no limit.")
 	flags.StringVar(&o.MaintenanceCfg.MemLimit, "maintenance-job-mem-limit", o.MaintenanceCfg.MemLimit, "Memory limit for maintenance jobs. Default is no limit.")
+	flags.StringVar(&o.MaintenanceCfg.JobConfigName, "repo-maintenance-job-configmap", o.MaintenanceCfg.JobConfigName, "The name of the ConfigMap holding the maintenance job configurations per repository. Optional.")
+	flags.StringVar(&o.BackupRepoConfig, "backup-repository-configmap", o.BackupRepoConfig, "The name of the ConfigMap holding the configurations of the backup repositories per repository type and BackupStorageLocation. Optional.")
 }
 
 // NewInstallOptions instantiates a new, default InstallOptions struct.
@@ -232,6 +235,7 @@ func (o *Options) AsVeleroOptions() (*install.VeleroOptions, error) {
 		DisableInformerCache:            o.DisableInformerCache,
 		ScheduleSkipImmediately:         o.ScheduleSkipImmediately,
 		MaintenanceCfg:                  o.MaintenanceCfg,
+		BackupRepoConfig:                o.BackupRepoConfig,
 	}, nil
 }
 
@@ -33,6 +33,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
@@ -61,7 +62,10 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero restore create --from-schedule schedule-1 --allow-partially-failed
 
   # Create a restore for only persistentvolumeclaims and persistentvolumes within a backup.
-  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes`,
+  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # Create a restore for only the configmap "app-config" in the namespace "app" within a backup.
+  velero restore create --from-backup backup-2 --include-item configmaps/app/app-config`,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -87,8 +91,11 @@ type CreateOptions struct {
 	IncludeNamespaces         flag.StringArray
 	ExcludeNamespaces         flag.StringArray
 	ExistingResourcePolicy    string
+	ExistingResourcePolicies  flag.Map
+	RecreateWaitTimeout       time.Duration
 	IncludeResources          flag.StringArray
 	ExcludeResources          flag.StringArray
+	IncludeItems              flag.StringArray
 	StatusIncludeResources    flag.StringArray
 	StatusExcludeResources    flag.StringArray
 	NamespaceMappings         flag.Map
@@ -101,18 +108,20 @@ type CreateOptions struct {
 	ResourceModifierConfigMap string
 	WriteSparseFiles          flag.OptionalBool
 	ParallelFilesDownload     int
+	DryRun                    bool
 	client                    kbclient.WithWatch
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Labels:                  flag.NewMap(),
-		IncludeNamespaces:       flag.NewStringArray("*"),
-		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
-		RestoreVolumes:          flag.NewOptionalBool(nil),
-		PreserveNodePorts:       flag.NewOptionalBool(nil),
-		IncludeClusterResources: flag.NewOptionalBool(nil),
-		WriteSparseFiles:        flag.NewOptionalBool(nil),
+		Labels:                   flag.NewMap(),
+		IncludeNamespaces:        flag.NewStringArray("*"),
+		NamespaceMappings:        flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		ExistingResourcePolicies: flag.NewMap(),
+		RestoreVolumes:           flag.NewOptionalBool(nil),
+		PreserveNodePorts:        flag.NewOptionalBool(nil),
+		IncludeClusterResources:  flag.NewOptionalBool(nil),
+		WriteSparseFiles:         flag.NewOptionalBool(nil),
 	}
 }
 
@@ -125,7 +134,10 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Labels, "labels", "Labels to apply to the restore.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
-	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none or update")
+	flags.Var(&o.IncludeItems, "include-item", "Items to restore, formatted as resource.group/namespace/name, or resource.group/name for cluster-scoped items, such as configmaps/app/app-config. If specified, only these items are restored.")
+	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none, update or recreate")
+	flags.Var(&o.ExistingResourcePolicies, "existing-resource-policy-overrides", "Restore Policies overriding existing-resource-policy for specific resources, formatted as resource.group=policy, such as configmaps=update,jobs.batch=recreate.")
+	flags.DurationVar(&o.RecreateWaitTimeout, "recreate-wait-timeout", o.RecreateWaitTimeout, "How long to wait for a resource deleted by the recreate policy to be removed before creating it again.")
 	flags.Var(&o.StatusIncludeResources, "status-include-resources", "Resources to include in the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.Var(&o.StatusExcludeResources, "status-exclude-resources", "Resources to exclude from the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
@@ -155,6 +167,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = cmd.TRUE
 
 	flags.IntVar(&o.ParallelFilesDownload, "parallel-files-download", 0, "The number of restore operations to run in parallel. If set to 0, the default parallelism will be the number of CPUs for the node that node agent pod is running.")
+
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only report what the restore would create, update or skip, without changing anything in the cluster. Use 'velero restore describe --details' to see the result.")
 }
 
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
@@ -201,7 +215,19 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	}
 
 	if len(o.ExistingResourcePolicy) > 0 && !restore.IsResourcePolicyValid(o.ExistingResourcePolicy) {
-		return errors.New("existing-resource-policy has invalid value, it accepts only none, update as value")
+		return errors.New("existing-resource-policy has invalid value, it accepts only none, update, recreate as value")
+	}
+
+	for resource, policy := range o.ExistingResourcePolicies.Data() {
+		if !restore.IsResourcePolicyValid(policy) {
+			return errors.Errorf("existing-resource-policy-overrides has invalid value %q for %s, it accepts only none, update, recreate as value", policy, resource)
+		}
+	}
+
+	for _, item := range o.IncludeItems {
+		if _, err := archive.ParseItemReference(item); err != nil {
+			return err
+		}
 	}
 
 	if o.ParallelFilesDownload < 0 {
@@ -320,6 +346,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			ExcludedNamespaces:      o.ExcludeNamespaces,
 			IncludedResources:       o.IncludeResources,
 			ExcludedResources:       o.ExcludeResources,
+			IncludedItems:           o.IncludeItems,
 			ExistingResourcePolicy:  api.PolicyType(o.ExistingResourcePolicy),
 			NamespaceMapping:        o.NamespaceMappings.Data(),
 			LabelSelector:           o.Selector.LabelSelector,
@@ -338,6 +365,21 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 	}
 
+	if o.DryRun {
+		restore.Spec.DryRun = boolptr.True()
+	}
+
+	if policies := o.ExistingResourcePolicies.Data(); len(policies) > 0 {
+		restore.Spec.ExistingResourcePolicyOverrides = make(map[string]api.PolicyType, len(policies))
+		for resource, policy := range policies {
+			restore.Spec.ExistingResourcePolicyOverrides[resource] = api.PolicyType(policy)
+		}
+	}
+
+	if o.RecreateWaitTimeout > 0 {
+		restore.Spec.RecreateWaitTimeout = metav1.Duration{Duration: o.RecreateWaitTimeout}
+	}
+
 	if len([]string(o.StatusIncludeResources)) > 0 {
 		restore.Spec.RestoreStatus = &api.RestoreStatusSpec{
 			IncludedResources: o.StatusIncludeResources,
@@ -68,8 +68,11 @@ func TestCreateCommand(t *testing.T) {
 		includeNamespaces := "app1,app2"
 		excludeNamespaces := "pod1,pod2,pod3"
 		existingResourcePolicy := "none"
+		existingResourcePolicies := "jobs.batch=recreate"
+		recreateWaitTimeout := "1m0s"
 		includeResources := "sc,sts"
 		excludeResources := "job"
+		includeItems := "configmaps/app/app-config,persistentvolumes/pv-1"
 		statusIncludeResources := "sc,sts"
 		statusExcludeResources := "job"
 		namespaceMappings := "a:b"
@@ -89,10 +92,13 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--preserve-nodeports", preserveNodePorts})
 		flags.Parse([]string{"--labels", labels})
 		flags.Parse([]string{"--existing-resource-policy", existingResourcePolicy})
+		flags.Parse([]string{"--existing-resource-policy-overrides", existingResourcePolicies})
+		flags.Parse([]string{"--recreate-wait-timeout", recreateWaitTimeout})
 		flags.Parse([]string{"--include-namespaces", includeNamespaces})
 		flags.Parse([]string{"--exclude-namespaces", excludeNamespaces})
 		flags.Parse([]string{"--include-resources", includeResources})
 		flags.Parse([]string{"--exclude-resources", excludeResources})
+		flags.Parse([]string{"--include-item", includeItems})
 		flags.Parse([]string{"--status-include-resources", statusIncludeResources})
 		flags.Parse([]string{"--status-exclude-resources", statusExcludeResources})
 		flags.Parse([]string{"--namespace-mappings", namespaceMappings})
@@ -102,6 +108,7 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--item-operation-timeout", itemOperationTimeout})
 		flags.Parse([]string{"--write-sparse-files", writeSparseFiles})
 		flags.Parse([]string{"--parallel-files-download", "2"})
+		flags.Parse([]string{"--dry-run"})
 		client := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
 
 		f.On("Namespace").Return(mock.Anything)
@@ -127,8 +134,11 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, includeNamespaces, o.IncludeNamespaces.String())
 		require.Equal(t, excludeNamespaces, o.ExcludeNamespaces.String())
 		require.Equal(t, existingResourcePolicy, o.ExistingResourcePolicy)
+		require.Equal(t, existingResourcePolicies, o.ExistingResourcePolicies.String())
+		require.Equal(t, recreateWaitTimeout, o.RecreateWaitTimeout.String())
 		require.Equal(t, includeResources, o.IncludeResources.String())
 		require.Equal(t, excludeResources, o.ExcludeResources.String())
+		require.Equal(t, includeItems, o.IncludeItems.String())
 
 		require.Equal(t, statusIncludeResources, o.StatusIncludeResources.String())
 		require.Equal(t, statusExcludeResources, o.StatusExcludeResources.String())
@@ -139,6 +149,7 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, itemOperationTimeout, o.ItemOperationTimeout.String())
 		require.Equal(t, writeSparseFiles, o.WriteSparseFiles.String())
 		require.Equal(t, parallel, o.ParallelFilesDownload)
+		require.True(t, o.DryRun)
 	})
 
 	t.Run("create a restore from schedule", func(t *testing.T) {
@@ -19,6 +19,7 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -26,6 +27,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -64,7 +66,16 @@ example: "@every 2h30m".`,
   velero create schedule NAME --schedule="@every 24h" --include-namespaces web
 
   # Create a weekly backup, each living for 90 days (2160 hours).
-  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s`,
+  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s
+
+  # Create a daily backup at 2am in Berlin, spread across 30 minutes.
+  velero create schedule NAME --schedule="0 2 * * *" --time-zone Europe/Berlin --jitter 30m
+
+  # Create an hourly backup, skipping the runs due while the previous backup is running, or missed for more than 10 minutes.
+  velero create schedule NAME --schedule="@every 1h" --concurrency-policy Forbid --starting-deadline 10m
+
+  # Create an hourly backup, keeping the latest backups of the last 24 hours, 7 days and 12 months.
+  velero create schedule NAME --schedule="@every 1h" --keep-hourly 24 --keep-daily 7 --keep-monthly 12`,
 		Args: cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -86,6 +97,11 @@ type CreateOptions struct {
 	Schedule                   string
 	UseOwnerReferencesInBackup bool
 	Paused                     bool
+	Retention                  api.ScheduleRetentionRules
+	TimeZone                   string
+	Jitter                     time.Duration
+	ConcurrencyPolicy          string
+	StartingDeadline           time.Duration
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -101,6 +117,16 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule. Notice: if set to true, when schedule is deleted, backups will be deleted too.")
 	flags.BoolVar(&o.Paused, "paused", o.Paused, "Specifies whether the newly created schedule is paused or not.")
+	flags.StringVar(&o.TimeZone, "time-zone", o.TimeZone, "IANA time zone name, such as Europe/Berlin, in which the schedule is evaluated. If empty, the time zone of the Velero server is used.")
+	flags.DurationVar(&o.Jitter, "jitter", o.Jitter, "Maximum delay added to the run times of the schedule, which spreads the schedules with the same cron expression across the window.")
+	flags.StringVar(&o.ConcurrencyPolicy, "concurrency-policy", o.ConcurrencyPolicy, "What to do when a backup is due while the previous backup of the schedule hasn't finished. Valid values are Forbid, Queue, Replace. Default is Queue.")
+	flags.DurationVar(&o.StartingDeadline, "starting-deadline", o.StartingDeadline, "Deadline for starting a backup which missed its scheduled time. The backups which can't be started before the deadline are recorded as missed runs. If zero, there is no deadline.")
+	flags.IntVar(&o.Retention.KeepLast, "keep-last", o.Retention.KeepLast, "Number of the latest backups created by this schedule to keep. The backups kept by none of the keep flags are deleted.")
+	flags.IntVar(&o.Retention.KeepHourly, "keep-hourly", o.Retention.KeepHourly, "Number of hours to keep the latest backup for.")
+	flags.IntVar(&o.Retention.KeepDaily, "keep-daily", o.Retention.KeepDaily, "Number of days to keep the latest backup for.")
+	flags.IntVar(&o.Retention.KeepWeekly, "keep-weekly", o.Retention.KeepWeekly, "Number of weeks to keep the latest backup for.")
+	flags.IntVar(&o.Retention.KeepMonthly, "keep-monthly", o.Retention.KeepMonthly, "Number of months to keep the latest backup for.")
+	flags.IntVar(&o.Retention.KeepYearly, "keep-yearly", o.Retention.KeepYearly, "Number of years to keep the latest backup for.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -108,6 +134,32 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	if o.Retention.KeepLast < 0 || o.Retention.KeepHourly < 0 || o.Retention.KeepDaily < 0 ||
+		o.Retention.KeepWeekly < 0 || o.Retention.KeepMonthly < 0 || o.Retention.KeepYearly < 0 {
+		return errors.New("the keep flags cannot be negative")
+	}
+
+	if o.TimeZone != "" {
+		if _, err := time.LoadLocation(o.TimeZone); err != nil {
+			return errors.Wrap(err, "invalid time zone")
+		}
+	}
+
+	if o.Jitter < 0 {
+		return errors.New("jitter cannot be negative")
+	}
+
+	switch api.ScheduleConcurrencyPolicy(o.ConcurrencyPolicy) {
+	case "", api.ScheduleConcurrencyPolicyForbid, api.ScheduleConcurrencyPolicyQueue, api.ScheduleConcurrencyPolicyReplace:
+	default:
+		return errors.Errorf("invalid concurrency policy %s, valid values are %s, %s, %s", o.ConcurrencyPolicy,
+			api.ScheduleConcurrencyPolicyForbid, api.ScheduleConcurrencyPolicyQueue, api.ScheduleConcurrencyPolicyReplace)
+	}
+
+	if o.StartingDeadline < 0 {
+		return errors.New("starting deadline cannot be negative")
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -164,19 +216,39 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
 			Paused:                     o.Paused,
 			SkipImmediately:            o.SkipOptions.SkipImmediately.Value,
+			TimeZone:                   o.TimeZone,
+			Jitter:                     metav1.Duration{Duration: o.Jitter},
+			ConcurrencyPolicy:          api.ScheduleConcurrencyPolicy(o.ConcurrencyPolicy),
 		},
 	}
 
+	if o.StartingDeadline > 0 {
+		seconds := int64(o.StartingDeadline / time.Second)
+		schedule.Spec.StartingDeadlineSeconds = &seconds
+	}
+
 	if o.BackupOptions.ResPoliciesConfigmap != "" {
 		schedule.Spec.Template.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: o.BackupOptions.ResPoliciesConfigmap}
 	}
 
+	if o.BackupOptions.ResourceModifierConfigMap != "" {
+		schedule.Spec.Template.ResourceModifier = &v1.TypedLocalObjectReference{Kind: resourcemodifiers.ConfigmapRefType, Name: o.BackupOptions.ResourceModifierConfigMap}
+	}
+
 	if o.BackupOptions.ParallelFilesUpload > 0 {
 		schedule.Spec.Template.UploaderConfig = &api.UploaderConfigForBackup{
 			ParallelFilesUpload: o.BackupOptions.ParallelFilesUpload,
 		}
 	}
 
+	if o.BackupOptions.ItemBlockWorkerCount > 0 {
+		schedule.Spec.Template.ItemBlockWorkerCount = o.BackupOptions.ItemBlockWorkerCount
+	}
+
+	if !o.Retention.IsEmpty() {
+		schedule.Spec.Retention = &api.ScheduleRetention{ScheduleRetentionRules: o.Retention}
+	}
+
 	if printed, err := output.PrintWithFormat(c, schedule); printed || err != nil {
 		return err
 	}
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	logrusr "github.com/bombsimon/logrusr/v3"
+	groupsnapshotv1alpha1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -113,6 +114,7 @@ const (
 
 	defaultMaxConcurrentK8SConnections = 30
 	defaultDisableInformerCache        = false
+	defaultItemBlockWorkerCount        = 1
 )
 
 type serverConfig struct {
@@ -140,6 +142,8 @@ type serverConfig struct {
 	disableInformerCache                                                    bool
 	scheduleSkipImmediately                                                 bool
 	maintenanceCfg                                                          repository.MaintenanceConfig
+	itemBlockWorkerCount                                                    int
+	backupRepoConfig                                                        string
 }
 
 func NewCommand(f client.Factory) *cobra.Command {
@@ -174,6 +178,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 			maintenanceCfg: repository.MaintenanceConfig{
 				KeepLatestMaitenanceJobs: repository.DefaultKeepLatestMaitenanceJobs,
 			},
+			itemBlockWorkerCount: defaultItemBlockWorkerCount,
 		}
 	)
 
@@ -252,6 +257,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().StringVar(&config.maintenanceCfg.MemRequest, "maintenance-job-mem-request", config.maintenanceCfg.MemRequest, "Memory request for maintenance job. Default is no limit.")
 	command.Flags().StringVar(&config.maintenanceCfg.CPULimit, "maintenance-job-cpu-limit", config.maintenanceCfg.CPULimit, "CPU limit for maintenance job. Default is no limit.")
 	command.Flags().StringVar(&config.maintenanceCfg.MemLimit, "maintenance-job-mem-limit", config.maintenanceCfg.MemLimit, "Memory limit for maintenance job. Default is no limit.")
+	command.Flags().StringVar(&config.maintenanceCfg.JobConfigName, "repo-maintenance-job-configmap", config.maintenanceCfg.JobConfigName, "The name of the ConfigMap in the Velero namespace that holds the maintenance job configurations per repository. Optional.")
+	command.Flags().StringVar(&config.backupRepoConfig, "backup-repository-configmap", config.backupRepoConfig, "The name of the ConfigMap in the Velero namespace that holds the configurations of the backup repositories per repository type and BackupStorageLocation. Optional.")
+	command.Flags().IntVar(&config.itemBlockWorkerCount, "item-block-worker-count", config.itemBlockWorkerCount, "Number of item blocks backed up concurrently by default when the backup doesn't specify its own worker count. Default is 1 (back up item blocks serially).")
 
 	// maintenance job log setting inherited from velero server
 	config.maintenanceCfg.FormatFlag = config.formatFlag
@@ -355,6 +363,10 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		cancelFunc()
 		return nil, err
 	}
+	if err := groupsnapshotv1alpha1.AddToScheme(scheme); err != nil {
+		cancelFunc()
+		return nil, err
+	}
 	if err := batchv1api.AddToScheme(scheme); err != nil {
 		cancelFunc()
 		return nil, err
@@ -707,12 +719,16 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		controller.BackupFinalizer:     {},
 		controller.BackupOperations:    {},
 		controller.BackupRepo:          {},
+		controller.BackupReplication:   {},
 		controller.BackupSync:          {},
+		controller.BackupVerification:  {},
+		controller.CredentialRotation:  {},
 		controller.DownloadRequest:     {},
 		controller.GarbageCollection:   {},
 		controller.Restore:             {},
 		controller.RestoreOperations:   {},
 		controller.Schedule:            {},
+		controller.ScheduleRetention:   {},
 		controller.ServerStatusRequest: {},
 		controller.RestoreFinalizer:    {},
 	}
@@ -724,8 +740,10 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			controller.BackupDeletion,
 			controller.BackupFinalizer,
 			controller.BackupOperations,
+			controller.BackupReplication,
 			controller.GarbageCollection,
 			controller.Schedule,
+			controller.ScheduleRetention,
 		)
 	}
 
@@ -799,6 +817,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.credentialFileStore,
 			s.config.maxConcurrentK8SConnections,
 			s.config.defaultSnapshotMoveData,
+			s.config.itemBlockWorkerCount,
 			s.crClient,
 		).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.Backup)
@@ -876,7 +895,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.BackupRepo]; ok {
-		if err := controller.NewBackupRepoReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.config.repoMaintenanceFrequency, s.repoManager).SetupWithManager(s.mgr); err != nil {
+		if err := controller.NewBackupRepoReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.config.repoMaintenanceFrequency, s.repoManager, s.config.backupRepoConfig).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupRepo)
 		}
 	}
@@ -932,6 +951,46 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.BackupVerification]; ok {
+		r := controller.NewBackupVerificationRequestReconciler(
+			s.mgr.GetClient(),
+			clock.RealClock{},
+			newPluginManager,
+			backupStoreGetter,
+			s.repoManager,
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupVerification)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.BackupReplication]; ok {
+		r := controller.NewBackupReplicationReconciler(
+			s.mgr.GetClient(),
+			clock.RealClock{},
+			newPluginManager,
+			backupStoreGetter,
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupReplication)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.CredentialRotation]; ok {
+		r := controller.NewCredentialRotationReconciler(
+			s.mgr.GetClient(),
+			s.namespace,
+			s.credentialFileStore,
+			clock.RealClock{},
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.CredentialRotation)
+		}
+	}
+
 	if _, ok := enabledRuntimeControllers[controller.GarbageCollection]; ok {
 		r := controller.NewGCReconciler(s.logger, s.mgr.GetClient(), s.config.garbageCollectionFrequency)
 		if err := r.SetupWithManager(s.mgr); err != nil {
@@ -939,6 +998,13 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.ScheduleRetention]; ok {
+		r := controller.NewScheduleRetentionReconciler(s.logger, s.mgr.GetClient(), s.config.garbageCollectionFrequency)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.ScheduleRetention)
+		}
+	}
+
 	pvrInformer, err := s.mgr.GetCache().GetInformer(s.ctx, &velerov1api.PodVolumeRestore{})
 	if err != nil {
 		s.logger.Fatal(err, "fail to get controller-runtime informer from manager for PVR")
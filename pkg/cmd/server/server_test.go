@@ -284,6 +284,7 @@ func Test_veleroResourcesExist(t *testing.T) {
 				{Kind: "BackupStorageLocation"},
 				{Kind: "VolumeSnapshotLocation"},
 				{Kind: "ServerStatusRequest"},
+				{Kind: "BackupVerificationRequest"},
 			},
 		},
 		{
@@ -34,6 +34,7 @@ import (
 
 	veleroV1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
 )
 
 // ErrNotFound is exported for external packages to check for when a file is
@@ -50,16 +51,46 @@ func Stream(
 	timeout time.Duration,
 	insecureSkipTLSVerify bool,
 	caCertFile string,
+) error {
+	return StreamWithRawContent(ctx, kbClient, namespace, name, kind, w, nil, timeout, insecureSkipTLSVerify, caCertFile)
+}
+
+// StreamWithRawContent is like Stream, and also writes the file as it's stored in
+// the object storage, i.e. before it's decrypted and decompressed, to raw if it's
+// not nil. The checksums of the backup files are of the stored content.
+func StreamWithRawContent(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	namespace, name string,
+	kind veleroV1api.DownloadTargetKind,
+	w io.Writer,
+	raw io.Writer,
+	timeout time.Duration,
+	insecureSkipTLSVerify bool,
+	caCertFile string,
 ) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	downloadURL, err := getDownloadURL(ctx, kbClient, namespace, name, kind)
+	// the data key of an encrypted file is handed out wrapped for this key only
+	recipientKey, err := encryption.GenerateRecipientKey()
+	if err != nil {
+		return err
+	}
+
+	downloadURL, wrappedKey, err := getDownloadURL(ctx, kbClient, namespace, name, kind, recipientKey.PublicKey().Bytes())
 	if err != nil {
 		return err
 	}
 
-	if err := download(ctx, downloadURL, kind, w, insecureSkipTLSVerify, caCertFile); err != nil {
+	var dataKey []byte
+	if len(wrappedKey) > 0 {
+		if dataKey, err = encryption.UnwrapKeyForRecipient(recipientKey, wrappedKey); err != nil {
+			return errors.Wrap(err, "error unwrapping the data key of the downloaded file")
+		}
+	}
+
+	if err := download(ctx, downloadURL, dataKey, kind, w, raw, insecureSkipTLSVerify, caCertFile); err != nil {
 		return err
 	}
 
@@ -71,32 +102,37 @@ func getDownloadURL(
 	kbClient kbclient.Client,
 	namespace, name string,
 	kind veleroV1api.DownloadTargetKind,
-) (string, error) {
+	publicKey []byte,
+) (string, []byte, error) {
 	uuid, err := uuid.NewRandom()
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	reqName := fmt.Sprintf("%s-%s", name, uuid.String())
-	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).Result()
+	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).EncryptionPublicKey(publicKey).Result()
 
 	if err := kbClient.Create(ctx, created, &kbclient.CreateOptions{}); err != nil {
-		return "", errors.WithStack(err)
+		return "", nil, errors.WithStack(err)
 	}
 
 	for {
 		select {
 		case <-ctx.Done():
-			return "", ErrDownloadRequestDownloadURLTimeout
+			return "", nil, ErrDownloadRequestDownloadURLTimeout
 
 		case <-time.After(25 * time.Millisecond):
 			updated := &veleroV1api.DownloadRequest{}
 			if err := kbClient.Get(ctx, kbclient.ObjectKey{Name: created.Name, Namespace: namespace}, updated); err != nil {
-				return "", errors.WithStack(err)
+				return "", nil, errors.WithStack(err)
 			}
 
 			if updated.Status.DownloadURL != "" {
-				return updated.Status.DownloadURL, nil
+				return updated.Status.DownloadURL, updated.Status.WrappedEncryptionKey, nil
+			}
+
+			if updated.Status.Phase == veleroV1api.DownloadRequestPhaseProcessed && updated.Status.Message != "" {
+				return "", nil, errors.Errorf("download request %s failed: %s", updated.Name, updated.Status.Message)
 			}
 		}
 	}
@@ -105,8 +141,10 @@ func getDownloadURL(
 func download(
 	ctx context.Context,
 	downloadURL string,
+	dataKey []byte,
 	kind veleroV1api.DownloadTargetKind,
 	w io.Writer,
+	raw io.Writer,
 	insecureSkipTLSVerify bool,
 	caCertFile string,
 ) error {
@@ -172,10 +210,20 @@ func download(
 		return errors.Errorf("request failed: %v", string(body))
 	}
 
-	reader := resp.Body
+	var reader io.Reader = resp.Body
+	if raw != nil {
+		reader = io.TeeReader(reader, raw)
+	}
+	if len(dataKey) > 0 {
+		// the file is encrypted by its backup storage location
+		if reader, err = encryption.DecryptWithDataKey(reader, dataKey); err != nil {
+			return errors.Wrap(err, "error decrypting the downloaded file")
+		}
+	}
+
 	if kind != veleroV1api.DownloadTargetKindBackupContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		gzipReader, err := gzip.NewReader(reader)
 		if err != nil {
 			return err
 		}
@@ -183,6 +231,17 @@ func download(
 		reader = gzipReader
 	}
 
-	_, err = io.Copy(w, reader)
-	return err
+	if _, err = io.Copy(w, reader); err != nil {
+		return err
+	}
+
+	if raw != nil {
+		// make sure all the stored content is written, e.g. what follows the
+		// end of the compressed content
+		if _, err := io.Copy(raw, resp.Body); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,109 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downloadrequest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestStreamEncryptedBackupContents(t *testing.T) {
+	wrapper := encryption.NewAESKeyWrapper(func(string) ([]byte, error) {
+		return bytes.Repeat([]byte{1}, encryption.DataKeySize), nil
+	})
+	encrypted, err := encryption.Encrypt(bytes.NewReader([]byte("contents")), "key-1", wrapper)
+	require.NoError(t, err)
+	ciphertext, err := io.ReadAll(encrypted)
+	require.NoError(t, err)
+
+	// the checksums manifest records the checksum of the stored, encrypted, content
+	hash := sha256.Sum256(ciphertext)
+	checksum := hex.EncodeToString(hash[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(ciphertext)
+	}))
+	defer server.Close()
+
+	// process the download requests like the DownloadRequest controller does, the
+	// data key is only handed out wrapped for the public key of the request
+	kbClient := velerotest.NewFakeControllerRuntimeClientBuilder(t).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, client kbclient.WithWatch, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+			request := obj.(*velerov1api.DownloadRequest)
+			dataKey, err := encryption.ReadDataKey(bytes.NewReader(ciphertext), wrapper)
+			if err != nil {
+				return err
+			}
+			if request.Status.WrappedEncryptionKey, err = encryption.WrapKeyForRecipient(request.Spec.EncryptionPublicKey, dataKey); err != nil {
+				return err
+			}
+			request.Status.DownloadURL = server.URL
+			return client.Create(ctx, request, opts...)
+		},
+	}).Build()
+
+	tests := []struct {
+		name        string
+		checksum    string
+		expectedErr string
+	}{
+		{
+			name:     "the stored content matches the checksum",
+			checksum: checksum,
+		},
+		{
+			name:        "the stored content doesn't match the checksum",
+			checksum:    hex.EncodeToString(make([]byte, sha256.Size)),
+			expectedErr: "checksum mismatch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier := persistence.NewChecksumVerifier("backup-1.tar.gz", test.checksum)
+			out := new(bytes.Buffer)
+
+			require.NoError(t, StreamWithRawContent(context.Background(), kbClient, velerov1api.DefaultNamespace, "backup-1",
+				velerov1api.DownloadTargetKindBackupContents, out, verifier, time.Minute, false, ""))
+			assert.Equal(t, "contents", out.String())
+
+			err := verifier.Verify()
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -88,6 +88,11 @@ func DescribeBackup(
 			DescribeResourcePolicies(d, backup.Spec.ResourcePolicy)
 		}
 
+		if backup.Spec.ResourceModifier != nil {
+			d.Println()
+			DescribeResourceModifier(d, backup.Spec.ResourceModifier)
+		}
+
 		if backup.Spec.UploaderConfig != nil && backup.Spec.UploaderConfig.ParallelFilesUpload > 0 {
 			d.Println()
 			DescribeUploaderConfigForBackup(d, backup.Spec)
@@ -125,6 +130,26 @@ func DescribeResourcePolicies(d *Describer, resPolicies *v1.TypedLocalObjectRefe
 	d.Printf("\tName:\t%s\n", resPolicies.Name)
 }
 
+// DescribeResourceModifier describes resource modifier reference in human-readable format
+func DescribeResourceModifier(d *Describer, resModifier *v1.TypedLocalObjectReference) {
+	d.Printf("Resource modifier:\n")
+	d.Printf("\tType:\t%s\n", resModifier.Kind)
+	d.Printf("\tName:\t%s\n", resModifier.Name)
+}
+
+// describeBackupResourceModifiersStatus describes the resource modifier rules applied to the items of the backup
+func describeBackupResourceModifiersStatus(d *Describer, status *velerov1api.BackupResourceModifiersStatus) {
+	if len(status.AppliedRules) == 0 {
+		d.Printf("Resource modifiers applied:\t<none>\n")
+		return
+	}
+
+	d.Printf("Resource modifiers applied (items in the backup differ from the ones in the cluster):\n")
+	for _, rule := range status.AppliedRules {
+		d.Printf("\tRule %d (%s):\t%d items modified\n", rule.Index, rule.GroupResource, rule.ItemsModified)
+	}
+}
+
 // DescribeUploaderConfigForBackup describes uploader config in human-readable format
 func DescribeUploaderConfigForBackup(d *Describer, spec velerov1api.BackupSpec) {
 	d.Printf("Uploader config:\n")
@@ -364,6 +389,29 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 		d.Printf("HooksAttempted:\t%d\n", status.HookStatus.HooksAttempted)
 		d.Printf("HooksFailed:\t%d\n", status.HookStatus.HooksFailed)
 	}
+
+	if status.ResourceModifiers != nil {
+		d.Println()
+		describeBackupResourceModifiersStatus(d, status.ResourceModifiers)
+	}
+
+	if len(status.Replicas) > 0 {
+		d.Println()
+		describeBackupReplicas(d, status.Replicas)
+	}
+}
+
+func describeBackupReplicas(d *Describer, replicas []velerov1api.BackupReplicaStatus) {
+	d.Printf("Replicas:\n")
+	for _, replica := range replicas {
+		d.Printf("\t%s: %s (%d files copied)\n", replica.Location, replica.Phase, replica.FilesCopied)
+		if replica.CompletionTimestamp != nil && !replica.CompletionTimestamp.Time.IsZero() {
+			d.Printf("\t\tCompleted: %s\n", replica.CompletionTimestamp.Time)
+		}
+		if replica.Message != "" {
+			d.Printf("\t\tMessage: %s\n", replica.Message)
+		}
+	}
 }
 
 func describeBackupItemOperations(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, insecureSkipTLSVerify bool, caCertPath string) {
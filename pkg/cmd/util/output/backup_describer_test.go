@@ -29,6 +29,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
 
@@ -71,6 +72,69 @@ func TestDescribeResourcePolicies(t *testing.T) {
 	assert.Equal(t, expect, d.buf.String())
 }
 
+func TestDescribeResourceModifier(t *testing.T) {
+	input := &v1.TypedLocalObjectReference{
+		Kind: "configmap",
+		Name: "test-resource-modifier",
+	}
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	DescribeResourceModifier(d, input)
+	d.out.Flush()
+	expect := `Resource modifier:
+  Type:  configmap
+  Name:  test-resource-modifier
+`
+	assert.Equal(t, expect, d.buf.String())
+}
+
+func TestDescribeBackupResourceModifiersStatus(t *testing.T) {
+	testcases := []struct {
+		name   string
+		input  *velerov1api.BackupResourceModifiersStatus
+		expect string
+	}{
+		{
+			name:  "no rule applied",
+			input: &velerov1api.BackupResourceModifiersStatus{ConfigMap: "cm"},
+			expect: `Resource modifiers applied:  <none>
+`,
+		},
+		{
+			name: "rules applied",
+			input: &velerov1api.BackupResourceModifiersStatus{
+				ConfigMap: "cm",
+				AppliedRules: []velerov1api.AppliedResourceModifierRule{
+					{Index: 0, GroupResource: "secrets", ItemsModified: 2},
+					{Index: 2, GroupResource: "pods", ItemsModified: 10},
+				},
+			},
+			expect: `Resource modifiers applied (items in the backup differ from the ones in the cluster):
+  Rule 0 (secrets):  2 items modified
+  Rule 2 (pods):     10 items modified
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			describeBackupResourceModifiersStatus(d, tc.input)
+			d.out.Flush()
+			assert.Equal(tt, tc.expect, d.buf.String())
+		})
+	}
+}
+
 func TestDescribeBackupSpec(t *testing.T) {
 	input1 := builder.ForBackup("test-ns", "test-backup-1").
 		IncludedNamespaces("inc-ns-1", "inc-ns-2").
@@ -667,6 +731,39 @@ func TestDescribeDeleteBackupRequests(t *testing.T) {
 	}
 }
 
+func TestDescribeBackupReplicas(t *testing.T) {
+	t1, err := time.Parse("2006-Jan-02", "2023-Jun-26")
+	require.NoError(t, err)
+	replicas := []velerov1api.BackupReplicaStatus{
+		{
+			Location:            "dr-1",
+			Phase:               velerov1api.BackupReplicaPhaseCompleted,
+			CompletionTimestamp: &metav1.Time{Time: t1},
+			FilesCopied:         12,
+		},
+		{
+			Location: "dr-2",
+			Phase:    velerov1api.BackupReplicaPhaseFailed,
+			Message:  "backup storage location dr-2 is read-only",
+		},
+	}
+
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	describeBackupReplicas(d, replicas)
+	d.out.Flush()
+	assert.Equal(t, `Replicas:
+  dr-1: Completed (12 files copied)
+    Completed: 2023-06-26 00:00:00 +0000 UTC
+  dr-2: Failed (0 files copied)
+    Message: backup storage location dr-2 is read-only
+`, d.buf.String())
+}
+
 func TestDescribeBackupItemOperation(t *testing.T) {
 	t1, err1 := time.Parse("2006-Jan-02", "2023-Jun-26")
 	require.Nil(t, err1)
@@ -55,6 +55,10 @@ func DescribeBackupInSF(
 			DescribeResourcePoliciesInSF(d, backup.Spec.ResourcePolicy)
 		}
 
+		if backup.Spec.ResourceModifier != nil {
+			DescribeResourceModifierInSF(d, backup.Spec.ResourceModifier)
+		}
+
 		status := backup.Status
 		if len(status.ValidationErrors) > 0 {
 			d.Describe("validationErrors", status.ValidationErrors)
@@ -269,6 +273,14 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 		backupStatusInfo["hooksAttempted"] = status.HookStatus.HooksAttempted
 		backupStatusInfo["hooksFailed"] = status.HookStatus.HooksFailed
 	}
+
+	if status.ResourceModifiers != nil {
+		backupStatusInfo["resourceModifiers"] = status.ResourceModifiers
+	}
+
+	if len(status.Replicas) > 0 {
+		backupStatusInfo["replicas"] = status.Replicas
+	}
 }
 
 func describeBackupResourceListInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
@@ -575,6 +587,13 @@ func DescribeResourcePoliciesInSF(d *StructuredDescriber, resPolicies *v1.TypedL
 	d.Describe("resourcePolicies", policiesInfo)
 }
 
+func DescribeResourceModifierInSF(d *StructuredDescriber, resModifier *v1.TypedLocalObjectReference) {
+	modifierInfo := make(map[string]interface{})
+	modifierInfo["type"] = resModifier.Kind
+	modifierInfo["name"] = resModifier.Name
+	d.Describe("resourceModifier", modifierInfo)
+}
+
 func describeResultInSF(m map[string]interface{}, result results.Result) {
 	m["velero"], m["cluster"], m["namespace"] = []string{}, []string{}, []string{}
 
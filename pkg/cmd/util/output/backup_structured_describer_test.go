@@ -536,6 +536,25 @@ func TestDescribeResourcePoliciesInSF(t *testing.T) {
 	assert.True(t, reflect.DeepEqual(sd.output, expect))
 }
 
+func TestDescribeResourceModifierInSF(t *testing.T) {
+	input := &v1.TypedLocalObjectReference{
+		Kind: "configmap",
+		Name: "resource-modifier-1",
+	}
+	expect := map[string]interface{}{
+		"resourceModifier": map[string]interface{}{
+			"type": "configmap",
+			"name": "resource-modifier-1",
+		},
+	}
+	sd := &StructuredDescriber{
+		output: make(map[string]interface{}),
+		format: "",
+	}
+	DescribeResourceModifierInSF(sd, input)
+	assert.True(t, reflect.DeepEqual(sd.output, expect))
+}
+
 func TestDescribeBackupResultInSF(t *testing.T) {
 	input := results.Result{
 		Velero:  []string{"msg-1", "msg-2"},
@@ -35,6 +35,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/restore/dryrun"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
@@ -55,6 +56,11 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			phaseString += " (Deleting)"
 		}
 
+		dryRun := boolptr.IsSetToTrue(restore.Spec.DryRun)
+		if dryRun {
+			phaseString += " (Dry Run)"
+		}
+
 		switch phase {
 		case velerov1api.RestorePhaseCompleted:
 			phaseString = color.GreenString(phaseString)
@@ -102,6 +108,11 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 
 		describeRestoreResults(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
 
+		if dryRun && (phase == velerov1api.RestorePhaseCompleted || phase == velerov1api.RestorePhasePartiallyFailed) {
+			d.Println()
+			describeRestoreDryRunResults(ctx, kbClient, d, restore, details, insecureSkipTLSVerify, caCertFile)
+		}
+
 		d.Println()
 		d.Printf("Backup:\t%s\n", restore.Spec.BackupName)
 
@@ -140,6 +151,14 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 
 		d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(restore.Spec.IncludeClusterResources, "excluded", "included", "auto"))
 
+		if len(restore.Spec.IncludedItems) > 0 {
+			d.Println()
+			d.Printf("Items:\n")
+			for _, item := range restore.Spec.IncludedItems {
+				d.Printf("\t%s\n", item)
+			}
+		}
+
 		d.Println()
 		d.DescribeMap("Namespace mappings", restore.Spec.NamespaceMapping)
 
@@ -191,6 +210,20 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			s = string(restore.Spec.ExistingResourcePolicy)
 		}
 		d.Printf("Existing Resource Policy: \t%s\n", s)
+		if len(restore.Spec.ExistingResourcePolicyOverrides) > 0 {
+			resources := make([]string, 0, len(restore.Spec.ExistingResourcePolicyOverrides))
+			for resource := range restore.Spec.ExistingResourcePolicyOverrides {
+				resources = append(resources, resource)
+			}
+			sort.Strings(resources)
+			d.Printf("Existing Resource Policy Overrides:\n")
+			for _, resource := range resources {
+				d.Printf("\t%s:\t%s\n", resource, restore.Spec.ExistingResourcePolicyOverrides[resource])
+			}
+		}
+		if restore.Spec.RecreateWaitTimeout.Duration > 0 {
+			d.Printf("Recreate Wait Timeout:\t%s\n", restore.Spec.RecreateWaitTimeout.Duration)
+		}
 		d.Printf("ItemOperationTimeout:\t%s\n", restore.Spec.ItemOperationTimeout.Duration)
 
 		d.Println()
@@ -207,7 +240,9 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			d.Printf("HooksFailed: \t%d\n", restore.Status.HookStatus.HooksFailed)
 		}
 
-		if details {
+		// nothing is restored by a dry-run restore, and its result of every item
+		// is described along with the dry-run results
+		if details && !dryRun {
 			d.Println()
 			describeRestoreResourceList(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
 		}
@@ -472,3 +507,73 @@ func describeRestoreResourceList(ctx context.Context, kbClient kbclient.Client,
 		d.Printf("\t%s:\n\t\t- %s\n", gvk, strings.Join(resourceList[gvk], "\n\t\t- "))
 	}
 }
+
+func describeRestoreDryRunResults(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreDryRunResults, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Dry Run Results:\t<dry-run results not found>")
+		} else {
+			d.Printf("Dry Run Results:\t<error getting dry-run results: %v>\n", err)
+		}
+		return
+	}
+
+	var items []dryrun.ItemResult
+	if err := json.NewDecoder(buf).Decode(&items); err != nil {
+		d.Printf("Dry Run Results:\t<error reading dry-run results: %v>\n", err)
+		return
+	}
+
+	summary := dryrun.Summarize(items)
+	d.Println("Dry Run Results:")
+	d.Printf("\tWould create:\t%d\n", summary[dryrun.ActionCreate])
+	d.Printf("\tWould update:\t%d\n", summary[dryrun.ActionUpdate])
+	d.Printf("\tWould recreate:\t%d\n", summary[dryrun.ActionRecreate])
+	d.Printf("\tWould skip:\t%d\n", summary[dryrun.ActionSkip])
+	d.Printf("\tExcluded:\t%d\n", summary[dryrun.ActionExclude])
+	d.Printf("\tFailed:\t%d\n", summary[dryrun.ActionFail])
+
+	if !details {
+		d.Printf("\n(use --details to see the result of every item)\n")
+		return
+	}
+
+	// the items are sorted by resource, namespace and name
+	resource := ""
+	for _, item := range items {
+		if item.Resource != resource {
+			resource = item.Resource
+			d.Printf("\t%s:\n", resource)
+		}
+
+		name := item.Name
+		if item.Namespace != "" {
+			name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+		}
+
+		var outcome string
+		switch item.Action {
+		case dryrun.ActionCreate:
+			outcome = "would create"
+		case dryrun.ActionUpdate:
+			outcome = "would update"
+		case dryrun.ActionRecreate:
+			outcome = "would recreate"
+		case dryrun.ActionSkip:
+			outcome = "would skip"
+		case dryrun.ActionExclude:
+			outcome = "excluded"
+		default:
+			outcome = "failed"
+		}
+		if item.Reason != "" {
+			outcome = fmt.Sprintf("%s: %s", outcome, item.Reason)
+		}
+
+		d.Printf("\t\t- %s (%s)\n", name, outcome)
+		if item.Diff != "" {
+			d.Printf("\t\t  Diff:\t%s\n", item.Diff)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package output
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -103,6 +105,89 @@ Backup Template:
   Hooks:  <none>
 
 Last Backup:  2023-06-25 15:04:05 +0000 UTC
+`
+
+	input3 := builder.ForSchedule("velero", "schedule-3").
+		Phase(velerov1api.SchedulePhaseEnabled).
+		CronSchedule("0 * * * *").
+		TimeZone("Europe/Berlin").
+		Jitter(10 * time.Minute).
+		ConcurrencyPolicy(velerov1api.ScheduleConcurrencyPolicyForbid).
+		StartingDeadlineSeconds(600).
+		BlackoutWindows(velerov1api.BlackoutWindow{Name: "freeze", Start: "0 9 * * 1-5", Duration: metav1.Duration{Duration: 8 * time.Hour}}).
+		Retention(&velerov1api.ScheduleRetention{
+			ScheduleRetentionRules: velerov1api.ScheduleRetentionRules{KeepHourly: 24, KeepDaily: 7},
+			PartiallyFailed:        &velerov1api.ScheduleRetentionRules{KeepLast: 1},
+		}).Result()
+	input3.Status.NextRunTime = &metav1.Time{Time: time.Date(2024, 1, 8, 16, 10, 0, 0, time.UTC)}
+	input3.Status.DeferralReason = "the backup due at 2024-01-08T09:00:00Z is deferred by blackout window freeze until 2024-01-08T16:00:00Z"
+	input3.Status.MissedRuns = 3
+	input3.Status.LastMissedRun = &metav1.Time{Time: time.Date(2024, 1, 5, 12, 10, 0, 0, time.UTC)}
+	expect3 := `Name:         schedule-3
+Namespace:    velero
+Labels:       <none>
+Annotations:  <none>
+
+Phase:  Enabled
+
+Paused:  false
+
+Schedule:            0 * * * *
+Time Zone:           Europe/Berlin
+Jitter:              10m0s
+Concurrency Policy:  Forbid
+Starting Deadline:   10m0s
+
+Blackout Windows:
+  freeze:  start "0 9 * * 1-5" for 8h0m0s, Defer
+
+Retention:
+  Keep Last:     0
+  Keep Hourly:   24
+  Keep Daily:    7
+  Keep Weekly:   0
+  Keep Monthly:  0
+  Keep Yearly:   0
+  Partially Failed:
+    Keep Last:     1
+    Keep Hourly:   0
+    Keep Daily:    0
+    Keep Weekly:   0
+    Keep Monthly:  0
+    Keep Yearly:   0
+
+Backup Template:
+  Namespaces:
+    Included:  *
+    Excluded:  <none>
+  
+  Resources:
+    Included:        *
+    Excluded:        <none>
+    Cluster-scoped:  auto
+  
+  Label selector:  <none>
+  
+  Or label selector:  <none>
+  
+  Storage Location:  
+  
+  Velero-Native Snapshot PVs:  auto
+  Snapshot Move Data:          auto
+  Data Mover:                  velero
+  
+  TTL:  0s
+  
+  CSISnapshotTimeout:    0s
+  ItemOperationTimeout:  0s
+  
+  Hooks:  <none>
+
+Last Backup:      <never>
+Next Run:         2024-01-08 16:10:00 +0000 UTC
+Deferral Reason:  the backup due at 2024-01-08T09:00:00Z is deferred by blackout window freeze until 2024-01-08T16:00:00Z
+Missed Runs:      3
+Last Missed Run:  2024-01-05 12:10:00 +0000 UTC
 `
 
 	testcases := []struct {
@@ -120,6 +205,11 @@ Last Backup:  2023-06-25 15:04:05 +0000 UTC
 			input:  input2,
 			expect: expect2,
 		},
+		{
+			name:   "schedule with retention",
+			input:  input3,
+			expect: expect3,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -18,6 +18,7 @@ package output
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/fatih/color"
 
@@ -48,6 +49,11 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 			DescribeResourcePolicies(d, schedule.Spec.Template.ResourcePolicy)
 		}
 
+		if schedule.Spec.Template.ResourceModifier != nil {
+			d.Println()
+			DescribeResourceModifier(d, schedule.Spec.Template.ResourceModifier)
+		}
+
 		if schedule.Spec.Template.UploaderConfig != nil && schedule.Spec.Template.UploaderConfig.ParallelFilesUpload > 0 {
 			d.Println()
 			DescribeUploaderConfigForBackup(d, schedule.Spec.Template)
@@ -75,6 +81,44 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	if spec.TimeZone != "" {
+		d.Printf("Time Zone:\t%s\n", spec.TimeZone)
+	}
+	if spec.Jitter.Duration > 0 {
+		d.Printf("Jitter:\t%s\n", spec.Jitter.Duration)
+	}
+	if spec.ConcurrencyPolicy != "" {
+		d.Printf("Concurrency Policy:\t%s\n", spec.ConcurrencyPolicy)
+	}
+	if spec.StartingDeadlineSeconds != nil {
+		d.Printf("Starting Deadline:\t%s\n", time.Duration(*spec.StartingDeadlineSeconds)*time.Second)
+	}
+
+	if len(spec.BlackoutWindows) > 0 {
+		d.Println()
+		d.Println("Blackout Windows:")
+		for i, window := range spec.BlackoutWindows {
+			name := window.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", i)
+			}
+			action := window.Action
+			if action == "" {
+				action = v1.BlackoutWindowActionDefer
+			}
+			d.Printf("\t%s:\tstart %q for %s, %s\n", name, window.Start, window.Duration.Duration, action)
+		}
+	}
+
+	if spec.Retention != nil {
+		d.Println()
+		d.Println("Retention:")
+		describeScheduleRetentionRules(d, "\t", spec.Retention.ScheduleRetentionRules)
+		if spec.Retention.PartiallyFailed != nil {
+			d.Println("\tPartially Failed:")
+			describeScheduleRetentionRules(d, "\t\t", *spec.Retention.PartiallyFailed)
+		}
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
@@ -83,10 +127,32 @@ func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Prefix = ""
 }
 
+func describeScheduleRetentionRules(d *Describer, indent string, rules v1.ScheduleRetentionRules) {
+	d.Printf("%sKeep Last:\t%d\n", indent, rules.KeepLast)
+	d.Printf("%sKeep Hourly:\t%d\n", indent, rules.KeepHourly)
+	d.Printf("%sKeep Daily:\t%d\n", indent, rules.KeepDaily)
+	d.Printf("%sKeep Weekly:\t%d\n", indent, rules.KeepWeekly)
+	d.Printf("%sKeep Monthly:\t%d\n", indent, rules.KeepMonthly)
+	d.Printf("%sKeep Yearly:\t%d\n", indent, rules.KeepYearly)
+}
+
 func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 	lastBackup := "<never>"
 	if status.LastBackup != nil && !status.LastBackup.Time.IsZero() {
 		lastBackup = fmt.Sprintf("%v", status.LastBackup.Time)
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
+
+	if status.NextRunTime != nil && !status.NextRunTime.Time.IsZero() {
+		d.Printf("Next Run:\t%v\n", status.NextRunTime.Time)
+	}
+	if status.DeferralReason != "" {
+		d.Printf("Deferral Reason:\t%s\n", status.DeferralReason)
+	}
+	if status.MissedRuns > 0 {
+		d.Printf("Missed Runs:\t%d\n", status.MissedRuns)
+	}
+	if status.LastMissedRun != nil && !status.LastMissedRun.Time.IsZero() {
+		d.Printf("Last Missed Run:\t%v\n", status.LastMissedRun.Time)
+	}
 }
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -38,6 +39,7 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	"github.com/vmware-tanzu/velero/internal/volume"
@@ -85,6 +87,7 @@ type backupReconciler struct {
 	credentialFileStore         credentials.FileStore
 	maxConcurrentK8SConnections int
 	defaultSnapshotMoveData     bool
+	defaultItemBlockWorkerCount int
 	globalCRClient              kbclient.Client
 }
 
@@ -110,6 +113,7 @@ func NewBackupReconciler(
 	credentialStore credentials.FileStore,
 	maxConcurrentK8SConnections int,
 	defaultSnapshotMoveData bool,
+	defaultItemBlockWorkerCount int,
 	globalCRClient kbclient.Client,
 ) *backupReconciler {
 	b := &backupReconciler{
@@ -135,6 +139,7 @@ func NewBackupReconciler(
 		credentialFileStore:         credentialStore,
 		maxConcurrentK8SConnections: maxConcurrentK8SConnections,
 		defaultSnapshotMoveData:     defaultSnapshotMoveData,
+		defaultItemBlockWorkerCount: defaultItemBlockWorkerCount,
 		globalCRClient:              globalCRClient,
 	}
 	b.updateTotalBackupMetric()
@@ -357,6 +362,10 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.Spec.SnapshotMoveData = &b.defaultSnapshotMoveData
 	}
 
+	if request.Spec.ItemBlockWorkerCount == 0 {
+		request.Spec.ItemBlockWorkerCount = b.defaultItemBlockWorkerCount
+	}
+
 	// find which storage location to use
 	var serverSpecified bool
 	if request.Spec.StorageLocation == "" {
@@ -488,9 +497,36 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.ResPolicies = res
 	}
 
+	if request.Spec.ResourceModifier != nil && strings.EqualFold(request.Spec.ResourceModifier.Kind, resourcemodifiers.ConfigmapRefType) {
+		resourceModifiers, err := getResourceModifiers(b.kbClient, request.Namespace, request.Spec.ResourceModifier.Name)
+		if err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+		} else {
+			request.ResourceModifiers = resourceModifiers
+		}
+	}
+
 	return request
 }
 
+// getResourceModifiers gets and validates the resource modifiers in the configmap.
+func getResourceModifiers(client kbclient.Client, namespace, name string) (*resourcemodifiers.ResourceModifiers, error) {
+	configmap := &corev1api.ConfigMap{}
+	if err := client.Get(context.Background(), kbclient.ObjectKey{Namespace: namespace, Name: name}, configmap); err != nil {
+		return nil, errors.Wrapf(err, "failed to get resource modifiers configmap %s/%s", namespace, name)
+	}
+
+	resourceModifiers, err := resourcemodifiers.GetResourceModifiersFromConfig(configmap)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error in parsing resource modifiers provided in configmap %s/%s", namespace, name)
+	}
+	if err := resourceModifiers.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "validation error in resource modifiers provided in configmap %s/%s", namespace, name)
+	}
+
+	return resourceModifiers, nil
+}
+
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
 //   - each location name in .spec.volumeSnapshotLocations exists as a location
@@ -646,6 +682,11 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	if err != nil {
 		return err
 	}
+	backupLog.Info("Getting ItemBlock actions")
+	ibActions, err := pluginManager.GetItemBlockActions()
+	if err != nil {
+		return err
+	}
 	backupLog.Info("Setting up backup store to check for backup existence")
 	backupStore, err := b.backupStoreGetter.Get(backup.StorageLocation, pluginManager, backupLog)
 	if err != nil {
@@ -663,9 +704,10 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	}
 
 	backupItemActionsResolver := framework.NewBackupItemActionResolverV2(actions)
+	itemBlockActionResolver := framework.NewItemBlockActionResolver(ibActions)
 
 	var fatalErrs []error
-	if err := b.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver, pluginManager); err != nil {
+	if err := b.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver, itemBlockActionResolver, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
 
@@ -873,6 +915,18 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	// the resource modifiers are stored with the backup, so the items updated by async
+	// operations are modified by the same rules when the backup is finalized
+	var resourceModifiers io.Reader
+	if backup.ResourceModifiers != nil {
+		resourceModifiersJSON, errs := encode.ToJSONGzip(backup.ResourceModifiers, "resource modifiers")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		} else {
+			resourceModifiers = resourceModifiersJSON
+		}
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
@@ -885,6 +939,7 @@ func persistBackup(backup *pkgbackup.Request,
 		csiSnapshotClassesJSON = nil
 		backupResult = nil
 		volumeInfoJSON = nil
+		resourceModifiers = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -901,6 +956,7 @@ func persistBackup(backup *pkgbackup.Request,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
 		CSIVolumeSnapshotClasses:  csiSnapshotClassesJSON,
 		BackupVolumeInfo:          volumeInfoJSON,
+		ResourceModifiers:         resourceModifiers,
 	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
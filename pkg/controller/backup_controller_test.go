@@ -45,6 +45,7 @@ import (
 	fakeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
@@ -57,6 +58,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	ibav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/itemblockaction/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -67,14 +69,14 @@ type fakeBackupper struct {
 	mock.Mock
 }
 
-func (b *fakeBackupper) Backup(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []biav2.BackupItemAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
-	args := b.Called(logger, backup, backupFile, actions, volumeSnapshotterGetter)
+func (b *fakeBackupper) Backup(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []biav2.BackupItemAction, itemBlockActions []ibav1.ItemBlockAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
+	args := b.Called(logger, backup, backupFile, actions, itemBlockActions, volumeSnapshotterGetter)
 	return args.Error(0)
 }
 
 func (b *fakeBackupper) BackupWithResolvers(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer,
-	backupItemActionResolver framework.BackupItemActionResolverV2, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
-	args := b.Called(logger, backup, backupFile, backupItemActionResolver, volumeSnapshotterGetter)
+	backupItemActionResolver framework.BackupItemActionResolverV2, itemBlockActionResolver framework.ItemBlockActionResolver, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
+	args := b.Called(logger, backup, backupFile, backupItemActionResolver, itemBlockActionResolver, volumeSnapshotterGetter)
 	return args.Error(0)
 }
 
@@ -200,6 +202,12 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: defaultBackupLocation,
 			expectedErrs:   []string{"Invalid included/excluded namespace lists: namespaces \"non-existing\" not found"},
 		},
+		{
+			name:           "non-existent resource modifier configmap fails validation",
+			backup:         defaultBackup().ResourceModifier("nonexistent").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"failed to get resource modifiers configmap velero/nonexistent: configmaps \"nonexistent\" not found"},
+		},
 	}
 
 	for _, test := range tests {
@@ -597,11 +605,13 @@ func TestProcessBackupCompletions(t *testing.T) {
 		backupLocation           *velerov1api.BackupStorageLocation
 		defaultVolumesToFsBackup bool
 		defaultSnapshotMoveData  bool
+		defaultItemBlockWorkers  int
 		enableCSI                bool
 		expectedResult           *velerov1api.Backup
 		backupExists             bool
 		existenceCheckError      error
 		volumeSnapshot           *snapshotv1api.VolumeSnapshot
+		dataUpload               *velerov2alpha1api.DataUpload
 	}{
 		// Finalizing
 		{
@@ -1072,6 +1082,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 			},
 			volumeSnapshot: builder.ForVolumeSnapshot("velero", "testVS").VolumeSnapshotClass("testClass").Status().BoundVolumeSnapshotContentName("testVSC").RestoreSize("10G").SourcePVC("testPVC").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).Result(),
+			dataUpload: builder.ForDataUpload("velero", "testDU").Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1"}).
+				SourceNamespace("velero").CSISnapshot(&velerov2alpha1api.CSISnapshotSpec{VolumeSnapshot: "testVS"}).Result(),
 		},
 		{
 			name:                     "backup with snapshot data movement set to false when CSI feature is enabled",
@@ -1279,6 +1291,90 @@ func TestProcessBackupCompletions(t *testing.T) {
 			},
 			volumeSnapshot: builder.ForVolumeSnapshot("velero", "testVS").VolumeSnapshotClass("testClass").Status().BoundVolumeSnapshotContentName("testVSC").RestoreSize("10G").SourcePVC("testPVC").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).Result(),
 		},
+		{
+			name:                     "backup with item block worker count not set and default item block worker count set",
+			backup:                   defaultBackup().Result(),
+			backupLocation:           defaultBackupLocation,
+			defaultVolumesToFsBackup: false,
+			defaultItemBlockWorkers:  4,
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Annotations: map[string]string{
+						"velero.io/source-cluster-k8s-major-version": "1",
+						"velero.io/source-cluster-k8s-minor-version": "16",
+						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/resource-timeout":                 "0s",
+					},
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToFsBackup: boolptr.False(),
+					SnapshotMoveData:         boolptr.False(),
+					ItemBlockWorkerCount:     4,
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:                       velerov1api.BackupPhaseFinalizing,
+					Version:                     1,
+					FormatVersion:               "1.1.0",
+					StartTimestamp:              &timestamp,
+					Expiration:                  &timestamp,
+					CSIVolumeSnapshotsAttempted: 0,
+					CSIVolumeSnapshotsCompleted: 0,
+				},
+			},
+			volumeSnapshot: builder.ForVolumeSnapshot("velero", "testVS").VolumeSnapshotClass("testClass").Status().BoundVolumeSnapshotContentName("testVSC").RestoreSize("10G").SourcePVC("testPVC").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).Result(),
+		},
+		{
+			name:                     "backup with item block worker count set overrides the default item block worker count",
+			backup:                   defaultBackup().ItemBlockWorkerCount(2).Result(),
+			backupLocation:           defaultBackupLocation,
+			defaultVolumesToFsBackup: false,
+			defaultItemBlockWorkers:  4,
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Annotations: map[string]string{
+						"velero.io/source-cluster-k8s-major-version": "1",
+						"velero.io/source-cluster-k8s-minor-version": "16",
+						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/resource-timeout":                 "0s",
+					},
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToFsBackup: boolptr.False(),
+					SnapshotMoveData:         boolptr.False(),
+					ItemBlockWorkerCount:     2,
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:                       velerov1api.BackupPhaseFinalizing,
+					Version:                     1,
+					FormatVersion:               "1.1.0",
+					StartTimestamp:              &timestamp,
+					Expiration:                  &timestamp,
+					CSIVolumeSnapshotsAttempted: 0,
+					CSIVolumeSnapshotsCompleted: 0,
+				},
+			},
+			volumeSnapshot: builder.ForVolumeSnapshot("velero", "testVS").VolumeSnapshotClass("testClass").Status().BoundVolumeSnapshotContentName("testVSC").RestoreSize("10G").SourcePVC("testPVC").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).Result(),
+		},
 	}
 
 	snapshotHandle := "testSnapshotID"
@@ -1315,6 +1411,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 			if test.volumeSnapshot != nil {
 				require.NoError(t, fakeGlobalClient.Create(context.TODO(), test.volumeSnapshot))
 			}
+			if test.dataUpload != nil {
+				require.NoError(t, fakeClient.Create(context.TODO(), test.dataUpload))
+			}
 
 			apiServer := velerotest.NewAPIServer(t)
 
@@ -1334,26 +1433,28 @@ func TestProcessBackupCompletions(t *testing.T) {
 			require.NoError(t, err)
 
 			c := &backupReconciler{
-				logger:                   logger,
-				discoveryHelper:          discoveryHelper,
-				kbClient:                 fakeClient,
-				defaultBackupLocation:    defaultBackupLocation.Name,
-				defaultVolumesToFsBackup: test.defaultVolumesToFsBackup,
-				defaultSnapshotMoveData:  test.defaultSnapshotMoveData,
-				backupTracker:            NewBackupTracker(),
-				metrics:                  metrics.NewServerMetrics(),
-				clock:                    testclocks.NewFakeClock(now),
-				newPluginManager:         func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
-				backupStoreGetter:        NewFakeSingleObjectBackupStoreGetter(backupStore),
-				backupper:                backupper,
-				formatFlag:               formatFlag,
-				globalCRClient:           fakeGlobalClient,
+				logger:                      logger,
+				discoveryHelper:             discoveryHelper,
+				kbClient:                    fakeClient,
+				defaultBackupLocation:       defaultBackupLocation.Name,
+				defaultVolumesToFsBackup:    test.defaultVolumesToFsBackup,
+				defaultSnapshotMoveData:     test.defaultSnapshotMoveData,
+				defaultItemBlockWorkerCount: test.defaultItemBlockWorkers,
+				backupTracker:               NewBackupTracker(),
+				metrics:                     metrics.NewServerMetrics(),
+				clock:                       testclocks.NewFakeClock(now),
+				newPluginManager:            func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				backupStoreGetter:           NewFakeSingleObjectBackupStoreGetter(backupStore),
+				backupper:                   backupper,
+				formatFlag:                  formatFlag,
+				globalCRClient:              fakeGlobalClient,
 			}
 
 			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
+			pluginManager.On("GetItemBlockActions").Return(nil, nil)
 			pluginManager.On("CleanupClients").Return(nil)
 			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []biav2.BackupItemAction(nil), pluginManager).Return(nil)
-			backupper.On("BackupWithResolvers", mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolverV2{}, pluginManager).Return(nil)
+			backupper.On("BackupWithResolvers", mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolverV2{}, framework.ItemBlockActionResolver{}, pluginManager).Return(nil)
 			backupStore.On("BackupExists", test.backupLocation.Spec.StorageType.ObjectStorage.Bucket, test.backup.Name).Return(test.backupExists, test.existenceCheckError)
 
 			// Ensure we have a CompletionTimestamp when uploading and that the backup name matches the backup in the object store.
@@ -48,13 +48,16 @@ import (
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/repository"
-	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 const (
 	deleteBackupRequestMaxAge = 24 * time.Hour
+
+	// deleteBackupReplicatingRequeueInterval is how long to wait before checking
+	// again whether the copies of a backup being deleted are still in progress.
+	deleteBackupReplicatingRequeueInterval = 30 * time.Second
 )
 
 type backupDeletionReconciler struct {
@@ -210,13 +213,46 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
+	// wait for the copies of the backup in progress to end, otherwise they'd
+	// keep uploading to the replica locations after the backup is removed from them
+	if replicaLocation := replicatingBackupLocation(backup, location); replicaLocation != "" {
+		log.Infof("The backup is being copied to replica location %s, waiting for the copy to end before deleting it", replicaLocation)
+		return ctrl.Result{RequeueAfter: deleteBackupReplicatingRequeueInterval}, nil
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error getting the backup store")
+	}
+
+	// refuse to delete the backup while its files are locked in the object storage,
+	// otherwise the deletion would fail halfway and leave a broken backup behind
+	lock, err := backupStore.GetBackupLock(backup.Name)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error getting the backup's object locks")
+	}
+	if lock.Locked(r.clock.Now()) {
+		msg := fmt.Sprintf("cannot delete backup because it is locked in backup storage location %s until %s", location.Name, lock.RetainUntil.UTC().Format(time.RFC3339))
+		if lock.LegalHold {
+			msg = fmt.Sprintf("cannot delete backup because it is under legal hold in backup storage location %s", location.Name)
+		}
+		_, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+			r.Status.Errors = append(r.Status.Errors, msg)
+		})
+		return ctrl.Result{}, err
+	}
+
 	// if the request object has no labels defined, initialize an empty map since
 	// we will be updating labels
 	if dbr.Labels == nil {
 		dbr.Labels = map[string]string{}
 	}
 	// Update status to InProgress and set backup-name and backup-uid label if needed
-	dbr, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+	dbr, err = r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
 		r.Status.Phase = velerov1api.DeleteBackupRequestPhaseInProgress
 
 		if r.Labels[velerov1api.BackupNameLabel] == "" {
@@ -243,14 +279,6 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	r.metrics.RegisterBackupDeletionAttempt(backupScheduleName)
 
-	pluginManager := r.newPluginManager(log)
-	defer pluginManager.CleanupClients()
-
-	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
-	if err != nil {
-		return ctrl.Result{}, errors.Wrap(err, "error getting the backup store")
-	}
-
 	actions, err := pluginManager.GetDeleteItemActions()
 	log.Debugf("%d actions before invoking actions", len(actions))
 	if err != nil {
@@ -319,29 +347,29 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	if boolptr.IsSetToTrue(backup.Spec.SnapshotMoveData) {
-		log.Info("Removing snapshot data by data mover")
-		if deleteErrs := r.deleteMovedSnapshots(ctx, backup); len(deleteErrs) > 0 {
-			for _, err := range deleteErrs {
-				errs = append(errs, err.Error())
-			}
-		}
-		duList := &velerov2alpha1.DataUploadList{}
-		log.Info("Removing local datauploads")
-		if err := r.Client.List(ctx, duList, &client.ListOptions{
-			Namespace: backup.Namespace,
-			LabelSelector: labels.SelectorFromSet(map[string]string{
-				velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
-			}),
-		}); err != nil {
-			log.WithError(err).Error("Error listing datauploads")
+	// the data of the snapshots may be moved even if the backup doesn't set snapshotMoveData,
+	// when it is chosen by the volume policies of the backup
+	log.Info("Removing snapshot data by data mover")
+	if deleteErrs := r.deleteMovedSnapshots(ctx, backup); len(deleteErrs) > 0 {
+		for _, err := range deleteErrs {
 			errs = append(errs, err.Error())
-		} else {
-			for i := range duList.Items {
-				du := duList.Items[i]
-				if err := r.Delete(ctx, &du); err != nil {
-					errs = append(errs, err.Error())
-				}
+		}
+	}
+	duList := &velerov2alpha1.DataUploadList{}
+	log.Info("Removing local datauploads")
+	if err := r.Client.List(ctx, duList, &client.ListOptions{
+		Namespace: backup.Namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+		}),
+	}); err != nil {
+		log.WithError(err).Error("Error listing datauploads")
+		errs = append(errs, err.Error())
+	} else {
+		for i := range duList.Items {
+			du := duList.Items[i]
+			if err := r.Delete(ctx, &du); err != nil {
+				errs = append(errs, err.Error())
 			}
 		}
 	}
@@ -351,6 +379,12 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		if err := backupStore.DeleteBackup(backup.Name); err != nil {
 			errs = append(errs, err.Error())
 		}
+
+		for _, replica := range backup.Status.Replicas {
+			if err := r.deleteBackupReplica(ctx, backup, replica.Location, pluginManager, log); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
 	}
 
 	log.Info("Removing restores")
@@ -580,6 +614,42 @@ func (r *backupDeletionReconciler) deleteMovedSnapshots(ctx context.Context, bac
 	return errs
 }
 
+// replicatingBackupLocation returns the replica location of the backup's storage location
+// the backup is being copied to, or "" if none. A copy left in progress in a location
+// which isn't a replica location anymore is never resumed, so it isn't waited for.
+func replicatingBackupLocation(backup *velerov1api.Backup, location *velerov1api.BackupStorageLocation) string {
+	for _, name := range location.Spec.ReplicaLocations {
+		if replica := getBackupReplicaStatus(backup, name); replica != nil && replica.Phase == velerov1api.BackupReplicaPhaseInProgress {
+			return name
+		}
+	}
+	return ""
+}
+
+// deleteBackupReplica removes the copy of the backup from the replica location.
+func (r *backupDeletionReconciler) deleteBackupReplica(ctx context.Context, backup *velerov1api.Backup, locationName string,
+	pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: locationName}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Warnf("Replica location %s is not found, the copy of the backup in it is not removed", locationName)
+			return nil
+		}
+		return errors.Wrapf(err, "error getting replica location %s", locationName)
+	}
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("cannot remove the copy of the backup from replica location %s because it is in read-only mode", locationName)
+	}
+
+	replicaStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting the backup store of replica location %s", locationName)
+	}
+
+	log.Infof("Removing backup from replica location %s", locationName)
+	return replicaStore.DeleteBackup(backup.Name)
+}
+
 func (r *backupDeletionReconciler) patchDeleteBackupRequest(ctx context.Context, req *velerov1api.DeleteBackupRequest, mutate func(*velerov1api.DeleteBackupRequest)) (*velerov1api.DeleteBackupRequest, error) {
 	original := req.DeepCopy()
 	mutate(req)
@@ -49,6 +49,7 @@ import (
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -102,6 +103,7 @@ func setupBackupDeletionControllerTest(t *testing.T, req *velerov1api.DeleteBack
 	}
 
 	pluginManager.On("CleanupClients").Return(nil)
+	backupStore.On("GetBackupLock", mock.Anything).Return(nil, nil).Maybe()
 	return data
 }
 
@@ -258,6 +260,51 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		assert.Len(t, res.Status.Errors, 1)
 		assert.Equal(t, "cannot delete backup because backup storage location default is currently in read-only mode", res.Status.Errors[0])
 	})
+
+	t.Run("backup is locked in the backup storage location", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Provider("objStoreProvider").Bucket("bucket").Result()
+		retainUntil := time.Now().Add(24 * time.Hour)
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+		td.backupStore.ExpectedCalls = nil
+		td.backupStore.On("GetBackupLock", "foo").Return(&persistence.BackupLock{RetainUntil: retainUntil}, nil)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Equal(t, "Processed", string(res.Status.Phase))
+		assert.Len(t, res.Status.Errors, 1)
+		assert.Equal(t, "cannot delete backup because it is locked in backup storage location default until "+retainUntil.UTC().Format(time.RFC3339), res.Status.Errors[0])
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+
+		// the backup must be left untouched
+		res2 := &velerov1api.Backup{}
+		require.NoError(t, td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, res2))
+		assert.NotEqual(t, velerov1api.BackupPhaseDeleting, res2.Status.Phase)
+	})
+
+	t.Run("backup is under legal hold in the backup storage location", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Provider("objStoreProvider").Bucket("bucket").Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+		td.backupStore.ExpectedCalls = nil
+		td.backupStore.On("GetBackupLock", "foo").Return(&persistence.BackupLock{LegalHold: true}, nil)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Equal(t, "Processed", string(res.Status.Phase))
+		assert.Len(t, res.Status.Errors, 1)
+		assert.Equal(t, "cannot delete backup because it is under legal hold in backup storage location default", res.Status.Errors[0])
+	})
 	t.Run("full delete, no errors", func(t *testing.T) {
 		input := defaultTestDbr()
 
@@ -649,6 +696,103 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		// Make sure snapshot was deleted
 		assert.Equal(t, 0, td.volumeSnapshotter.SnapshotsTaken.Len())
 	})
+	t.Run("backup is removed from its replica locations", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("primary").
+			WithStatus(velerov1api.BackupStatus{
+				Replicas: []velerov1api.BackupReplicaStatus{
+					{Location: "dr", Phase: velerov1api.BackupReplicaPhaseCompleted},
+					{Location: "removed", Phase: velerov1api.BackupReplicaPhaseFailed},
+				},
+			}).Result()
+		backup.UID = "uid"
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Provider("objStoreProvider").Bucket("bucket").ReplicaLocations("dr").Result()
+		replicaLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "dr").Provider("objStoreProvider").Bucket("dr-bucket").Result()
+
+		input := defaultTestDbr()
+		td := setupBackupDeletionControllerTest(t, input, backup, location, replicaLocation)
+
+		replicaStore := &persistencemocks.BackupStore{}
+		td.controller.backupStoreGetter = NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{
+			"primary": td.backupStore,
+			"dr":      replicaStore,
+		})
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return([]velero.DeleteItemAction{}, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+		td.backupStore.On("GetBackupVolumeSnapshots", input.Spec.BackupName).Return(nil, nil)
+		td.backupStore.On("DeleteBackup", input.Spec.BackupName).Return(nil)
+		replicaStore.On("DeleteBackup", input.Spec.BackupName).Return(nil)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		td.backupStore.AssertCalled(t, "DeleteBackup", input.Spec.BackupName)
+		replicaStore.AssertCalled(t, "DeleteBackup", input.Spec.BackupName)
+
+		// the dbr should be deleted, i.e. the deletion has no errors
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		assert.True(t, apierrors.IsNotFound(err), "Expected not found error, but actual value of error: %v", err)
+	})
+
+	t.Run("backup being copied to a replica location is deleted once the copy ends", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("primary").
+			WithStatus(velerov1api.BackupStatus{
+				Phase: velerov1api.BackupPhaseCompleted,
+				Replicas: []velerov1api.BackupReplicaStatus{
+					{Location: "dr", Phase: velerov1api.BackupReplicaPhaseInProgress},
+					{Location: "removed", Phase: velerov1api.BackupReplicaPhaseInProgress},
+				},
+			}).Result()
+		backup.UID = "uid"
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Provider("objStoreProvider").Bucket("bucket").ReplicaLocations("dr").Result()
+		replicaLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "dr").Provider("objStoreProvider").Bucket("dr-bucket").Result()
+
+		input := defaultTestDbr()
+		td := setupBackupDeletionControllerTest(t, input, backup, location, replicaLocation)
+
+		replicaStore := &persistencemocks.BackupStore{}
+		td.controller.backupStoreGetter = NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{
+			"primary": td.backupStore,
+			"dr":      replicaStore,
+		})
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return([]velero.DeleteItemAction{}, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+		// the copy to the replica location is in progress, the deletion is requeued
+		result, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+		assert.Equal(t, deleteBackupReplicatingRequeueInterval, result.RequeueAfter)
+		replicaStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		require.NoError(t, td.fakeClient.Get(ctx, td.req.NamespacedName, res))
+		assert.NotEqual(t, velerov1api.DeleteBackupRequestPhaseInProgress, res.Status.Phase)
+
+		require.NoError(t, td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, backup))
+		assert.Equal(t, velerov1api.BackupPhaseCompleted, backup.Status.Phase)
+
+		// the copy ends, the backup is removed from the replica location, the copy left
+		// in progress in a location which isn't a replica location anymore isn't waited for
+		backup.Status.Replicas[0].Phase = velerov1api.BackupReplicaPhaseCompleted
+		require.NoError(t, td.fakeClient.Update(ctx, backup))
+
+		td.backupStore.On("GetBackupVolumeSnapshots", input.Spec.BackupName).Return(nil, nil)
+		td.backupStore.On("DeleteBackup", input.Spec.BackupName).Return(nil)
+		replicaStore.On("DeleteBackup", input.Spec.BackupName).Return(nil)
+
+		result, err = td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+		assert.Zero(t, result.RequeueAfter)
+		replicaStore.AssertCalled(t, "DeleteBackup", input.Spec.BackupName)
+	})
+
 	t.Run("Expired request will be deleted if the status is processed", func(t *testing.T) {
 		expired := time.Date(2018, 4, 3, 12, 0, 0, 0, time.UTC)
 		input := defaultTestDbr()
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -29,6 +30,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
@@ -154,7 +156,29 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		SkippedPVTracker: pkgbackup.NewSkipPVTracker(),
 	}
 	var outBackupFile *os.File
+	resourceModifiersErr := false
 	if len(operations) > 0 {
+		// the items updated by async operations are written to the backup again,
+		// so the resource modifiers applied at backup time, which are stored with
+		// the backup, should be applied on them again
+		if backup.Spec.ResourceModifier != nil && strings.EqualFold(backup.Spec.ResourceModifier.Kind, resourcemodifiers.ConfigmapRefType) {
+			backupRequest.ResourceModifiers, err = backupStore.GetBackupResourceModifiers(backup.Name)
+			if err != nil {
+				log.WithError(err).Error("Error getting resource modifiers of the backup")
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+			// the backups created before the resource modifiers were stored with them
+			// fall back to the configmap, if it can't be read the backup can't be
+			// finalized as it was taken, so it's partially failed
+			if backupRequest.ResourceModifiers == nil {
+				backupRequest.ResourceModifiers, err = getResourceModifiers(r.client, backup.Namespace, backup.Spec.ResourceModifier.Name)
+				if err != nil {
+					log.WithError(err).Error("Error getting resource modifiers, the items updated by async operations aren't modified")
+					resourceModifiersErr = true
+				}
+			}
+		}
+
 		log.Info("Setting up finalized backup temp file")
 		inBackupFile, err := downloadToTempFile(backup.Name, backupStore, log)
 		if err != nil {
@@ -190,39 +214,66 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 	}
+	// the backup is moved to its final phase only once its final files are uploaded
+	// and locked, if any of that fails it's kept finalizing so it's retried
+	finalizingPhase := backup.Status.Phase
+	switch {
+	case resourceModifiersErr:
+		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+		backup.Status.Errors++
+	case finalizingPhase == velerov1api.BackupPhaseFinalizing:
+		backup.Status.Phase = velerov1api.BackupPhaseCompleted
+	case finalizingPhase == velerov1api.BackupPhaseFinalizingPartiallyFailed:
+		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+	}
+	backup.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	backup.Status.CSIVolumeSnapshotsCompleted = updateCSIVolumeSnapshotsCompleted(operations)
+
+	if err := uploadFinalizedBackup(backup, backupStore, len(operations) > 0, outBackupFile); err != nil {
+		backup.Status.Phase = finalizingPhase
+		backup.Status.Errors = original.Status.Errors
+		backup.Status.CompletionTimestamp = original.Status.CompletionTimestamp
+		return ctrl.Result{}, err
+	}
+
 	backupScheduleName := backupRequest.GetLabels()[velerov1api.ScheduleNameLabel]
 	switch backup.Status.Phase {
-	case velerov1api.BackupPhaseFinalizing:
-		backup.Status.Phase = velerov1api.BackupPhaseCompleted
+	case velerov1api.BackupPhaseCompleted:
 		r.metrics.RegisterBackupSuccess(backupScheduleName)
 		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusSucc)
-	case velerov1api.BackupPhaseFinalizingPartiallyFailed:
-		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+	case velerov1api.BackupPhasePartiallyFailed:
 		r.metrics.RegisterBackupPartialFailure(backupScheduleName)
 		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
 	}
 
-	backup.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
-	backup.Status.CSIVolumeSnapshotsCompleted = updateCSIVolumeSnapshotsCompleted(operations)
-
 	recordBackupMetrics(log, backup, outBackupFile, r.metrics, true)
 
-	// update backup metadata in object store
+	return ctrl.Result{}, nil
+}
+
+// uploadFinalizedBackup uploads the final metadata, and the final contents if they're
+// updated, of the backup and locks its files.
+func uploadFinalizedBackup(backup *velerov1api.Backup, backupStore persistence.BackupStore, contentsUpdated bool, contents *os.File) error {
 	backupJSON := new(bytes.Buffer)
 	if err := encode.To(backup, "json", backupJSON); err != nil {
-		return ctrl.Result{}, errors.Wrap(err, "error encoding backup json")
+		return errors.Wrap(err, "error encoding backup json")
 	}
-	err = backupStore.PutBackupMetadata(backup.Name, backupJSON)
-	if err != nil {
-		return ctrl.Result{}, errors.Wrap(err, "error uploading backup json")
+	if err := backupStore.PutBackupMetadata(backup.Name, backupJSON); err != nil {
+		return errors.Wrap(err, "error uploading backup json")
 	}
-	if len(operations) > 0 {
-		err = backupStore.PutBackupContents(backup.Name, outBackupFile)
-		if err != nil {
-			return ctrl.Result{}, errors.Wrap(err, "error uploading backup final contents")
+	if contentsUpdated {
+		if err := backupStore.PutBackupContents(backup.Name, contents); err != nil {
+			return errors.Wrap(err, "error uploading backup final contents")
 		}
 	}
-	return ctrl.Result{}, nil
+	// lock the backup files once they are final, so they can't be deleted or
+	// overwritten before the backup expires
+	if backup.Status.Expiration != nil {
+		if err := backupStore.LockBackup(backup.Name, backup.Status.Expiration.Time); err != nil {
+			return errors.Wrap(err, "error locking backup files")
+		}
+	}
+	return nil
 }
 
 func (r *backupFinalizerReconciler) SetupWithManager(mgr ctrl.Manager) error {
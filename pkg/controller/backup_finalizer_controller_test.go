@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -34,14 +35,18 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -72,6 +77,7 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 		backupOperations    []*itemoperation.BackupOperation
 		backupLocation      *velerov1api.BackupStorageLocation
 		enableCSI           bool
+		lockErr             error
 		expectError         bool
 		expectPhase         velerov1api.BackupPhase
 		expectedCompletedVS int
@@ -112,6 +118,19 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Finalizing backup whose files fail to be locked stays finalizing",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-4").
+				StorageLocation("default").
+				ObjectMeta(builder.WithUID("foo")).
+				StartTimestamp(fakeClock.Now()).
+				Expiration(fakeClock.Now().Add(time.Hour)).
+				Phase(velerov1api.BackupPhaseFinalizing).Result(),
+			backupLocation: defaultBackupLocation,
+			lockErr:        errors.New("lock error"),
+			expectError:    true,
+			expectPhase:    velerov1api.BackupPhaseFinalizing,
+		},
 		{
 			name: "FinalizingPartiallyFailed backup is partially failed",
 			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").
@@ -224,6 +243,7 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 			backupStore.On("PutBackupMetadata", mock.Anything, mock.Anything).Return(nil)
 			backupStore.On("GetBackupVolumeInfos", mock.Anything).Return(nil, nil)
 			backupStore.On("PutBackupVolumeInfos", mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("LockBackup", test.backup.Name, mock.Anything).Return(test.lockErr).Maybe()
 			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
 			backupper.On("FinalizeBackup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolverV2{}, mock.Anything).Return(nil)
 			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}})
@@ -242,3 +262,95 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestBackupFinalizerReconcileResourceModifiers(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	storedModifiers := &resourcemodifiers.ResourceModifiers{
+		Version: "v1",
+		ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{
+			{Conditions: resourcemodifiers.Conditions{GroupResource: "secrets"}},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		storedModifiers *resourcemodifiers.ResourceModifiers
+		expectModifiers *resourcemodifiers.ResourceModifiers
+		expectPhase     velerov1api.BackupPhase
+		expectErrors    int
+	}{
+		{
+			name:            "the resource modifiers stored with the backup are applied",
+			storedModifiers: storedModifiers,
+			expectModifiers: storedModifiers,
+			expectPhase:     velerov1api.BackupPhaseCompleted,
+		},
+		{
+			name:         "the backup without stored resource modifiers whose configmap is missing is partially failed",
+			expectPhase:  velerov1api.BackupPhasePartiallyFailed,
+			expectErrors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				StorageLocation("default").
+				ResourceModifier("modifiers").
+				StartTimestamp(fakeClock.Now()).
+				Phase(velerov1api.BackupPhaseFinalizing).Result()
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+				backup,
+				builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			)
+
+			operations := []*itemoperation.BackupOperation{
+				{
+					Spec: itemoperation.BackupOperationSpec{
+						BackupName: "backup-1",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+					},
+					Status: itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseCompleted},
+				},
+			}
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetBackupItemOperations", "backup-1").Return(operations, nil)
+			backupStore.On("GetBackupResourceModifiers", "backup-1").Return(test.storedModifiers, nil)
+			backupStore.On("GetBackupContents", "backup-1").Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+			backupStore.On("PutBackupContents", "backup-1", mock.Anything).Return(nil)
+			backupStore.On("PutBackupMetadata", "backup-1", mock.Anything).Return(nil)
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
+			backupper := new(fakeBackupper)
+			backupper.On("FinalizeBackup", mock.Anything, mock.MatchedBy(func(request *pkgbackup.Request) bool {
+				return assert.ObjectsAreEqual(test.expectModifiers, request.ResourceModifiers)
+			}), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			r := NewBackupFinalizerReconciler(
+				fakeClient,
+				fakeClient,
+				fakeClock,
+				backupper,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewBackupTracker(),
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+				logrus.StandardLogger(),
+				metrics.NewServerMetrics(),
+			)
+
+			_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: kbclient.ObjectKeyFromObject(backup)})
+			require.NoError(t, err)
+			backupper.AssertExpectations(t)
+
+			backupAfter := velerov1api.Backup{}
+			require.NoError(t, fakeClient.Get(context.TODO(), kbclient.ObjectKeyFromObject(backup), &backupAfter))
+			assert.Equal(t, test.expectPhase, backupAfter.Status.Phase)
+			assert.Equal(t, test.expectErrors, backupAfter.Status.Errors)
+		})
+	}
+}
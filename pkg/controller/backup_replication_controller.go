@@ -0,0 +1,409 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// defaultBackupReplicationFrequency is how often the backups are checked
+	// for the copies to replicate.
+	defaultBackupReplicationFrequency = 10 * time.Minute
+
+	// backupReplicationRetryInterval is how long to wait before retrying a
+	// failed copy of a backup.
+	backupReplicationRetryInterval = time.Hour
+
+	// backupReplicationProgressInterval is how often the number of the files
+	// copied so far is updated in the replica status of a backup.
+	backupReplicationProgressInterval = 10 * time.Second
+)
+
+// backupReplicationReconciler copies the finished backups, and the content of
+// their Kopia repositories, from their backup storage locations to the replica
+// locations of those.
+type backupReplicationReconciler struct {
+	client            kbclient.Client
+	clock             clocks.Clock
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	frequency         time.Duration
+	log               logrus.FieldLogger
+
+	// replicating are the backups being copied by the goroutines started by
+	// Reconcile, their replica status is only updated by those goroutines.
+	replicating     sets.Set[types.NamespacedName]
+	replicatingLock sync.Mutex
+}
+
+// NewBackupReplicationReconciler initializes and returns backupReplicationReconciler struct.
+func NewBackupReplicationReconciler(
+	client kbclient.Client,
+	clock clocks.Clock,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	log logrus.FieldLogger,
+) *backupReplicationReconciler {
+	return &backupReplicationReconciler{
+		client:            client,
+		clock:             clock,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		frequency:         defaultBackupReplicationFrequency,
+		log:               log,
+		replicating:       sets.New[types.NamespacedName](),
+	}
+}
+
+func (r *backupReplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.log, mgr.GetClient(), &velerov1api.BackupList{}, r.frequency, kube.PeriodicalEnqueueSourceOption{})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(BackupReplication).
+		For(&velerov1api.Backup{}, builder.WithPredicates(kube.NewAllEventPredicate(func(obj kbclient.Object) bool {
+			return isBackupFinished(obj.(*velerov1api.Backup))
+		}))).
+		WatchesRawSource(s, nil).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch
+
+func (r *backupReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.log.WithFields(logrus.Fields{
+		"controller": BackupReplication,
+		"backup":     req.NamespacedName,
+	})
+
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, req.NamespacedName, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find Backup")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup %s", req.String())
+	}
+
+	if !isBackupFinished(backup) {
+		return ctrl.Result{}, nil
+	}
+
+	if r.isReplicating(req.NamespacedName) {
+		log.Debug("Backup is being replicated")
+		return ctrl.Result{}, nil
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.StorageLocation}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debugf("Backup storage location %s is not found", backup.Spec.StorageLocation)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup storage location %s", backup.Spec.StorageLocation)
+	}
+
+	// the replicas to copy, and the earliest time a failed copy can be retried
+	var pending []string
+	var retryAfter time.Duration
+	for _, name := range location.Spec.ReplicaLocations {
+		replica := getBackupReplicaStatus(backup, name)
+		if replica == nil || replica.Phase == velerov1api.BackupReplicaPhaseInProgress {
+			// a copy found in progress while the backup isn't being replicated was
+			// interrupted, e.g. by a restart of the server
+			pending = append(pending, name)
+			continue
+		}
+		if replica.Phase != velerov1api.BackupReplicaPhaseFailed {
+			continue
+		}
+		wait := backupReplicationRetryInterval
+		if replica.CompletionTimestamp != nil {
+			wait -= r.clock.Since(replica.CompletionTimestamp.Time)
+		}
+		if wait <= 0 {
+			pending = append(pending, name)
+		} else if retryAfter == 0 || wait < retryAfter {
+			retryAfter = wait
+		}
+	}
+
+	var replicaLocations []*velerov1api.BackupStorageLocation
+	for _, name := range pending {
+		replicaLocation, err := r.getReplicaLocation(ctx, location, name)
+		if err == nil {
+			replicaLocations = append(replicaLocations, replicaLocation)
+			continue
+		}
+
+		log.WithField("replicaLocation", name).WithError(err).Error("Backup can't be replicated")
+		now := &metav1.Time{Time: r.clock.Now()}
+		original := backup.DeepCopy()
+		setBackupReplicaStatus(backup, velerov1api.BackupReplicaStatus{
+			Location:            name,
+			Phase:               velerov1api.BackupReplicaPhaseFailed,
+			StartTimestamp:      now,
+			CompletionTimestamp: now,
+			Message:             err.Error(),
+		})
+		if err := r.client.Patch(ctx, backup, kbclient.MergeFrom(original)); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating the replica status of backup %s", req.String())
+		}
+		if retryAfter == 0 || backupReplicationRetryInterval < retryAfter {
+			retryAfter = backupReplicationRetryInterval
+		}
+	}
+
+	if len(replicaLocations) > 0 {
+		// the copies can take long, so they run in the background, and a failed
+		// one is retried by the periodic reconciliation of the backups
+		r.startReplicating(req.NamespacedName)
+		go r.replicateBackup(ctx, backup, location, replicaLocations, log)
+	}
+
+	return ctrl.Result{RequeueAfter: retryAfter}, nil
+}
+
+// getReplicaLocation returns the replica location of the location, or an error if
+// the backups of the location can't be copied to it.
+func (r *backupReplicationReconciler) getReplicaLocation(ctx context.Context, location *velerov1api.BackupStorageLocation, replicaName string) (*velerov1api.BackupStorageLocation, error) {
+	if replicaName == location.Name {
+		return nil, errors.Errorf("backup storage location %s can't be a replica of itself", replicaName)
+	}
+
+	replicaLocation := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{Namespace: location.Namespace, Name: replicaName}, replicaLocation); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", replicaName)
+	}
+	if replicaLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return nil, errors.Errorf("backup storage location %s is read-only", replicaName)
+	}
+	if err := checkReplicaEncryption(location, replicaLocation); err != nil {
+		return nil, err
+	}
+
+	return replicaLocation, nil
+}
+
+// checkReplicaEncryption returns an error if the files of the location can't be read
+// from the replica location. The files are copied as they are stored, so the replica
+// location must decrypt them with the same keys, and accept the unencrypted ones if
+// the location may have some.
+func checkReplicaEncryption(location, replicaLocation *velerov1api.BackupStorageLocation) error {
+	source, replica := location.Spec.Encryption, replicaLocation.Spec.Encryption
+	switch {
+	case source == nil && replica == nil:
+		return nil
+	case source == nil:
+		if !replica.AllowUnencryptedFiles {
+			return errors.Errorf("backup storage location %s is encrypted and doesn't allow the unencrypted files of backup storage location %s", replicaLocation.Name, location.Name)
+		}
+		return nil
+	case replica == nil:
+		return errors.Errorf("backup storage location %s isn't encrypted with the keys of backup storage location %s", replicaLocation.Name, location.Name)
+	}
+
+	if source.KeySecret != replica.KeySecret || !reflect.DeepEqual(source.KMS, replica.KMS) {
+		return errors.Errorf("backup storage location %s isn't encrypted with the keys of backup storage location %s", replicaLocation.Name, location.Name)
+	}
+	if source.AllowUnencryptedFiles && !replica.AllowUnencryptedFiles {
+		return errors.Errorf("backup storage location %s doesn't allow the unencrypted files of backup storage location %s", replicaLocation.Name, location.Name)
+	}
+	return nil
+}
+
+// replicateBackup copies the backup to the replica locations one after another, and
+// updates the replica status of the backup with the progress of the copies.
+func (r *backupReplicationReconciler) replicateBackup(ctx context.Context, backup *velerov1api.Backup, location *velerov1api.BackupStorageLocation,
+	replicaLocations []*velerov1api.BackupStorageLocation, log logrus.FieldLogger) {
+	defer r.stopReplicating(kbclient.ObjectKeyFromObject(backup))
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, storeErr := r.backupStoreGetter.Get(location, pluginManager, log)
+	if storeErr != nil {
+		storeErr = errors.Wrapf(storeErr, "error getting the backup store of location %s", location.Name)
+	}
+
+	for _, replicaLocation := range replicaLocations {
+		replicaLog := log.WithField("replicaLocation", replicaLocation.Name)
+
+		original := backup.DeepCopy()
+		setBackupReplicaStatus(backup, velerov1api.BackupReplicaStatus{
+			Location:       replicaLocation.Name,
+			Phase:          velerov1api.BackupReplicaPhaseInProgress,
+			StartTimestamp: &metav1.Time{Time: r.clock.Now()},
+		})
+		if err := r.client.Patch(ctx, backup, kbclient.MergeFrom(original)); err != nil {
+			replicaLog.WithError(err).Error("Error updating the replica status of backup")
+			return
+		}
+
+		replicaLog.Info("Replicating backup")
+		filesCopied, err := 0, storeErr
+		if err == nil {
+			filesCopied, err = r.replicate(ctx, backup, backupStore, replicaLocation, pluginManager, replicaLog)
+		}
+
+		original = backup.DeepCopy()
+		replica := getBackupReplicaStatus(backup, replicaLocation.Name)
+		replica.FilesCopied = filesCopied
+		replica.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		if err != nil {
+			replicaLog.WithError(err).Error("Error replicating backup")
+			replica.Phase = velerov1api.BackupReplicaPhaseFailed
+			replica.Message = err.Error()
+		} else {
+			replicaLog.Infof("Replicated backup, %d files are copied", filesCopied)
+			replica.Phase = velerov1api.BackupReplicaPhaseCompleted
+		}
+		if err := r.client.Patch(ctx, backup, kbclient.MergeFrom(original)); err != nil {
+			replicaLog.WithError(err).Error("Error updating the replica status of backup")
+			return
+		}
+	}
+}
+
+// replicate copies the content of the Kopia repositories the backup stores volume
+// data in, and then the files of the backup, to the replica location. It returns
+// the number of the copied files, which is also updated in the replica status of
+// the backup while the files are copied.
+func (r *backupReplicationReconciler) replicate(ctx context.Context, backup *velerov1api.Backup, backupStore persistence.BackupStore,
+	replicaLocation *velerov1api.BackupStorageLocation, pluginManager clientmgmt.Manager, log logrus.FieldLogger) (int, error) {
+	replicaStore, err := r.backupStoreGetter.Get(replicaLocation, pluginManager, log)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error getting the backup store of location %s", replicaLocation.Name)
+	}
+
+	snapshots, err := getVolumeSnapshotsToVerify(backup, backupStore)
+	if err != nil {
+		return 0, err
+	}
+	namespaces := sets.New[string]()
+	for _, snapshot := range snapshots {
+		if snapshot.repoKey.RepositoryType == velerov1api.BackupRepositoryTypeKopia {
+			namespaces.Insert(snapshot.repoKey.VolumeNamespace)
+		}
+	}
+
+	lastUpdate := r.clock.Now()
+	progress := func() {
+		replica := getBackupReplicaStatus(backup, replicaLocation.Name)
+		if r.clock.Since(lastUpdate) < backupReplicationProgressInterval {
+			replica.FilesCopied++
+			return
+		}
+		original := backup.DeepCopy()
+		replica.FilesCopied++
+		lastUpdate = r.clock.Now()
+		if err := r.client.Patch(ctx, backup, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(err).Warn("Error updating the replication progress of backup")
+		}
+	}
+
+	// copy the repositories first, so the backup is only visible in the
+	// replica location once the volume data it refers to is there
+	filesCopied := 0
+	for _, namespace := range sets.List(namespaces) {
+		copied, err := backupStore.ReplicateRepository(ctx, velerov1api.BackupRepositoryTypeKopia, namespace, replicaStore, progress)
+		filesCopied += copied
+		if err != nil {
+			return filesCopied, errors.Wrapf(err, "error replicating the repository of namespace %s", namespace)
+		}
+	}
+
+	copied, err := backupStore.ReplicateBackup(ctx, backup.Name, replicaStore, progress)
+	filesCopied += copied
+	if err != nil {
+		return filesCopied, errors.Wrap(err, "error replicating the backup files")
+	}
+
+	if backup.Status.Expiration != nil {
+		if err := replicaStore.LockBackup(backup.Name, backup.Status.Expiration.Time); err != nil {
+			return filesCopied, errors.Wrap(err, "error locking the replicated backup files")
+		}
+	}
+
+	return filesCopied, nil
+}
+
+func (r *backupReplicationReconciler) isReplicating(key types.NamespacedName) bool {
+	r.replicatingLock.Lock()
+	defer r.replicatingLock.Unlock()
+	return r.replicating.Has(key)
+}
+
+func (r *backupReplicationReconciler) startReplicating(key types.NamespacedName) {
+	r.replicatingLock.Lock()
+	defer r.replicatingLock.Unlock()
+	r.replicating.Insert(key)
+}
+
+func (r *backupReplicationReconciler) stopReplicating(key types.NamespacedName) {
+	r.replicatingLock.Lock()
+	defer r.replicatingLock.Unlock()
+	r.replicating.Delete(key)
+}
+
+// isBackupFinished returns true if the backup is completed or partially failed,
+// i.e. its files won't change anymore.
+func isBackupFinished(backup *velerov1api.Backup) bool {
+	return backup.Status.Phase == velerov1api.BackupPhaseCompleted || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed
+}
+
+// getBackupReplicaStatus returns the status of the copy of the backup in the location,
+// or nil if the backup isn't copied to the location yet.
+func getBackupReplicaStatus(backup *velerov1api.Backup, location string) *velerov1api.BackupReplicaStatus {
+	for i := range backup.Status.Replicas {
+		if backup.Status.Replicas[i].Location == location {
+			return &backup.Status.Replicas[i]
+		}
+	}
+	return nil
+}
+
+// setBackupReplicaStatus replaces the status of the copy of the backup in the
+// location of the status, and returns the status in the backup.
+func setBackupReplicaStatus(backup *velerov1api.Backup, status velerov1api.BackupReplicaStatus) *velerov1api.BackupReplicaStatus {
+	if replica := getBackupReplicaStatus(backup, status.Location); replica != nil {
+		*replica = status
+		return replica
+	}
+	backup.Status.Replicas = append(backup.Status.Replicas, status)
+	return &backup.Status.Replicas[len(backup.Status.Replicas)-1]
+}
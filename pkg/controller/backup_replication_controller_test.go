@@ -0,0 +1,322 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/volume"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupReplicationReconcile(t *testing.T) {
+	now, err := time.Parse(time.RFC1123, time.RFC1123)
+	require.NoError(t, err)
+
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").ReplicaLocations("dr").Result()
+	replicaLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "dr").Result()
+	readOnlyReplicaLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "dr").
+		AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result()
+	encryption := &velerov1api.BackupStorageLocationEncryption{KeyID: "key-1", KeySecret: "velero-encryption-keys"}
+	encryptedLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").ReplicaLocations("dr").Encryption(encryption).Result()
+	encryptedReplicaLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "dr").
+		Encryption(&velerov1api.BackupStorageLocationEncryption{KeyID: "key-2", KeySecret: "velero-encryption-keys"}).Result()
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").PodName("pod-1").
+		Volume("vol-1").UploaderType("kopia").SnapshotID("pvb-snapshot").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result()
+
+	newBackup := func(phase velerov1api.BackupPhase, replicas ...velerov1api.BackupReplicaStatus) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+			WithStatus(velerov1api.BackupStatus{Phase: phase, Replicas: replicas}).Result()
+	}
+	failedReplica := func(completed time.Time) velerov1api.BackupReplicaStatus {
+		return velerov1api.BackupReplicaStatus{
+			Location:            "dr",
+			Phase:               velerov1api.BackupReplicaPhaseFailed,
+			CompletionTimestamp: &metav1.Time{Time: completed},
+			Message:             "fake-error",
+		}
+	}
+
+	tests := []struct {
+		name               string
+		backup             *velerov1api.Backup
+		objects            []runtime.Object
+		expectedReplicas   []velerov1api.BackupReplicaStatus
+		expectedRequeue    time.Duration
+		expectReplicateRun bool
+		replicating        bool
+	}{
+		{
+			name:    "backup isn't finished",
+			backup:  newBackup(velerov1api.BackupPhaseInProgress),
+			objects: []runtime.Object{location, replicaLocation},
+		},
+		{
+			name:    "location has no replica locations",
+			backup:  newBackup(velerov1api.BackupPhaseCompleted),
+			objects: []runtime.Object{builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()},
+		},
+		{
+			name:    "backup is replicated",
+			backup:  newBackup(velerov1api.BackupPhaseCompleted),
+			objects: []runtime.Object{location, replicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				{
+					Location:            "dr",
+					Phase:               velerov1api.BackupReplicaPhaseCompleted,
+					StartTimestamp:      &metav1.Time{Time: now},
+					CompletionTimestamp: &metav1.Time{Time: now},
+					FilesCopied:         15,
+				},
+			},
+			expectReplicateRun: true,
+		},
+		{
+			name:    "interrupted replication is restarted",
+			backup:  newBackup(velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupReplicaStatus{Location: "dr", Phase: velerov1api.BackupReplicaPhaseInProgress}),
+			objects: []runtime.Object{location, replicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				{
+					Location:            "dr",
+					Phase:               velerov1api.BackupReplicaPhaseCompleted,
+					StartTimestamp:      &metav1.Time{Time: now},
+					CompletionTimestamp: &metav1.Time{Time: now},
+					FilesCopied:         15,
+				},
+			},
+			expectReplicateRun: true,
+		},
+		{
+			name:    "read-only replica location fails the replication",
+			backup:  newBackup(velerov1api.BackupPhaseCompleted),
+			objects: []runtime.Object{location, readOnlyReplicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				{
+					Location:            "dr",
+					Phase:               velerov1api.BackupReplicaPhaseFailed,
+					StartTimestamp:      &metav1.Time{Time: now},
+					CompletionTimestamp: &metav1.Time{Time: now},
+					Message:             "backup storage location dr is read-only",
+				},
+			},
+			expectedRequeue: backupReplicationRetryInterval,
+		},
+		{
+			name:    "replica location not encrypted with the keys of the location fails the replication",
+			backup:  newBackup(velerov1api.BackupPhaseCompleted),
+			objects: []runtime.Object{encryptedLocation, replicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				{
+					Location:            "dr",
+					Phase:               velerov1api.BackupReplicaPhaseFailed,
+					StartTimestamp:      &metav1.Time{Time: now},
+					CompletionTimestamp: &metav1.Time{Time: now},
+					Message:             "backup storage location dr isn't encrypted with the keys of backup storage location default",
+				},
+			},
+			expectedRequeue: backupReplicationRetryInterval,
+		},
+		{
+			name:    "replica location encrypted with the keys of the location",
+			backup:  newBackup(velerov1api.BackupPhaseCompleted),
+			objects: []runtime.Object{encryptedLocation, encryptedReplicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				{
+					Location:            "dr",
+					Phase:               velerov1api.BackupReplicaPhaseCompleted,
+					StartTimestamp:      &metav1.Time{Time: now},
+					CompletionTimestamp: &metav1.Time{Time: now},
+					FilesCopied:         15,
+				},
+			},
+			expectReplicateRun: true,
+		},
+		{
+			name:             "backup being replicated is skipped",
+			backup:           newBackup(velerov1api.BackupPhaseCompleted, velerov1api.BackupReplicaStatus{Location: "dr", Phase: velerov1api.BackupReplicaPhaseInProgress}),
+			objects:          []runtime.Object{location, replicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{{Location: "dr", Phase: velerov1api.BackupReplicaPhaseInProgress}},
+			replicating:      true,
+		},
+		{
+			name:             "recently failed replication isn't retried",
+			backup:           newBackup(velerov1api.BackupPhaseCompleted, failedReplica(now.Add(-10*time.Minute))),
+			objects:          []runtime.Object{location, replicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{failedReplica(now.Add(-10 * time.Minute))},
+			expectedRequeue:  50 * time.Minute,
+		},
+		{
+			name:    "failed replication is retried",
+			backup:  newBackup(velerov1api.BackupPhaseCompleted, failedReplica(now.Add(-2*time.Hour))),
+			objects: []runtime.Object{location, replicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				{
+					Location:            "dr",
+					Phase:               velerov1api.BackupReplicaPhaseCompleted,
+					StartTimestamp:      &metav1.Time{Time: now},
+					CompletionTimestamp: &metav1.Time{Time: now},
+					FilesCopied:         15,
+				},
+			},
+			expectReplicateRun: true,
+		},
+		{
+			name:             "completed replication is skipped",
+			backup:           newBackup(velerov1api.BackupPhaseCompleted, velerov1api.BackupReplicaStatus{Location: "dr", Phase: velerov1api.BackupReplicaPhaseCompleted, FilesCopied: 3}),
+			objects:          []runtime.Object{location, replicaLocation},
+			expectedReplicas: []velerov1api.BackupReplicaStatus{{Location: "dr", Phase: velerov1api.BackupReplicaPhaseCompleted, FilesCopied: 3}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, append(test.objects, test.backup)...)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			backupStore := &persistencemocks.BackupStore{}
+			replicaStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetPodVolumeBackups", "backup-1").Return([]*velerov1api.PodVolumeBackup{pvb}, nil)
+			backupStore.On("GetBackupVolumeInfos", "backup-1").Return([]*volume.BackupVolumeInfo{}, nil)
+			backupStore.On("ReplicateRepository", mock.Anything, velerov1api.BackupRepositoryTypeKopia, "ns-1", replicaStore, mock.Anything).Return(5, nil)
+			backupStore.On("ReplicateBackup", mock.Anything, "backup-1", replicaStore, mock.Anything).Return(10, nil)
+			replicaStore.On("LockBackup", "backup-1", mock.Anything).Return(nil).Maybe()
+
+			r := NewBackupReplicationReconciler(
+				client,
+				testclocks.NewFakeClock(now),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore, "dr": replicaStore}),
+				velerotest.NewLogger(),
+			)
+
+			key := kbclient.ObjectKeyFromObject(test.backup)
+			if test.replicating {
+				r.startReplicating(key)
+			}
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRequeue, result.RequeueAfter)
+
+			if !test.replicating {
+				// wait for the copies running in the background
+				require.Eventually(t, func() bool { return !r.isReplicating(key) }, 10*time.Second, 10*time.Millisecond)
+			}
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKeyFromObject(test.backup), backup))
+			require.Len(t, backup.Status.Replicas, len(test.expectedReplicas))
+			for i := range test.expectedReplicas {
+				expected, actual := test.expectedReplicas[i], backup.Status.Replicas[i]
+				assert.Equal(t, expected.Location, actual.Location)
+				assert.Equal(t, expected.Phase, actual.Phase)
+				assert.Equal(t, expected.FilesCopied, actual.FilesCopied)
+				assert.Equal(t, expected.Message, actual.Message)
+				if expected.StartTimestamp != nil {
+					require.NotNil(t, actual.StartTimestamp)
+					assert.True(t, expected.StartTimestamp.Equal(actual.StartTimestamp))
+				}
+				if expected.CompletionTimestamp != nil {
+					require.NotNil(t, actual.CompletionTimestamp)
+					assert.True(t, expected.CompletionTimestamp.Equal(actual.CompletionTimestamp))
+				}
+			}
+
+			if test.expectReplicateRun {
+				backupStore.AssertCalled(t, "ReplicateBackup", mock.Anything, "backup-1", replicaStore, mock.Anything)
+			} else {
+				backupStore.AssertNotCalled(t, "ReplicateBackup", mock.Anything, "backup-1", replicaStore, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestBackupReplicationProgress(t *testing.T) {
+	now, err := time.Parse(time.RFC1123, time.RFC1123)
+	require.NoError(t, err)
+	clock := testclocks.NewFakeClock(now)
+
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").ReplicaLocations("dr").Result()
+	replicaLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "dr").Result()
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+		WithStatus(velerov1api.BackupStatus{Phase: velerov1api.BackupPhaseCompleted}).Result()
+	client := velerotest.NewFakeControllerRuntimeClient(t, location, replicaLocation, backup)
+
+	getReplicaStatus := func() *velerov1api.BackupReplicaStatus {
+		current := &velerov1api.Backup{}
+		require.NoError(t, client.Get(context.Background(), kbclient.ObjectKeyFromObject(backup), current))
+		return getBackupReplicaStatus(current, "dr")
+	}
+
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	backupStore := &persistencemocks.BackupStore{}
+	replicaStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetPodVolumeBackups", "backup-1").Return([]*velerov1api.PodVolumeBackup{}, nil)
+	backupStore.On("GetBackupVolumeInfos", "backup-1").Return([]*volume.BackupVolumeInfo{}, nil)
+	backupStore.On("ReplicateBackup", mock.Anything, "backup-1", replicaStore, mock.Anything).Run(func(args mock.Arguments) {
+		progress := args.Get(3).(func())
+
+		// the progress isn't updated more often than the progress interval
+		progress()
+		assert.Equal(t, velerov1api.BackupReplicaPhaseInProgress, getReplicaStatus().Phase)
+		assert.Equal(t, 0, getReplicaStatus().FilesCopied)
+
+		clock.Step(backupReplicationProgressInterval)
+		progress()
+		assert.Equal(t, 2, getReplicaStatus().FilesCopied)
+	}).Return(3, errors.New("fake-error"))
+
+	r := NewBackupReplicationReconciler(
+		client,
+		clock,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore, "dr": replicaStore}),
+		velerotest.NewLogger(),
+	)
+
+	key := kbclient.ObjectKeyFromObject(backup)
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return !r.isReplicating(key) }, 10*time.Second, 10*time.Millisecond)
+
+	replica := getReplicaStatus()
+	assert.Equal(t, velerov1api.BackupReplicaPhaseFailed, replica.Phase)
+	assert.Equal(t, 3, replica.FilesCopied)
+	assert.Equal(t, "error replicating the backup files: fake-error", replica.Message)
+}
@@ -27,6 +27,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -41,8 +42,9 @@ import (
 )
 
 const (
-	repoSyncPeriod           = 5 * time.Minute
-	defaultMaintainFrequency = 7 * 24 * time.Hour
+	repoSyncPeriod                      = 5 * time.Minute
+	defaultMaintainFrequency            = 7 * 24 * time.Hour
+	defaultMaintenanceStatusQueueLength = 3
 )
 
 type BackupRepoReconciler struct {
@@ -52,10 +54,13 @@ type BackupRepoReconciler struct {
 	clock                clocks.WithTickerAndDelayedExecution
 	maintenanceFrequency time.Duration
 	repositoryManager    repository.Manager
+	repositoryConfig     string
+	// repoFormats caches the format options read from the ready repositories by their UIDs
+	repoFormats map[types.UID]map[string]string
 }
 
 func NewBackupRepoReconciler(namespace string, logger logrus.FieldLogger, client client.Client,
-	maintenanceFrequency time.Duration, repositoryManager repository.Manager) *BackupRepoReconciler {
+	maintenanceFrequency time.Duration, repositoryManager repository.Manager, repositoryConfig string) *BackupRepoReconciler {
 	c := &BackupRepoReconciler{
 		client,
 		namespace,
@@ -63,6 +68,8 @@ func NewBackupRepoReconciler(namespace string, logger logrus.FieldLogger, client
 		clocks.RealClock{},
 		maintenanceFrequency,
 		repositoryManager,
+		repositoryConfig,
+		map[types.UID]map[string]string{},
 	}
 
 	return c
@@ -180,6 +187,11 @@ func (r *BackupRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	if err := r.updateRepositoryConfig(ctx, backupRepo, log); err != nil {
+		log.WithError(err).Error("error updating repository config")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
 	// If the repository is ready or not-ready, check it for stale locks, but if
 	// this fails for any reason, it's non-critical so we still continue on to the
 	// rest of the "process" logic.
@@ -238,6 +250,8 @@ func (r *BackupRepoReconciler) initializeRepo(ctx context.Context, req *velerov1
 		})
 	}
 
+	config, configWarnings := r.getRepositoryConfig(ctx, req, log)
+
 	// defaulting - if the patch fails, return an error so the item is returned to the queue
 	if err := r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
 		rr.Spec.ResticIdentifier = repoIdentifier
@@ -245,6 +259,8 @@ func (r *BackupRepoReconciler) initializeRepo(ctx context.Context, req *velerov1
 		if rr.Spec.MaintenanceFrequency.Duration <= 0 {
 			rr.Spec.MaintenanceFrequency = metav1.Duration{Duration: r.getRepositoryMaintenanceFrequency(req)}
 		}
+
+		setRepositoryConfig(rr, config, nil, configWarnings)
 	}); err != nil {
 		return err
 	}
@@ -259,6 +275,76 @@ func (r *BackupRepoReconciler) initializeRepo(ctx context.Context, req *velerov1
 	})
 }
 
+// getRepositoryConfig returns the repository config of the repository from the repository
+// config map. If it can't be read, e.g. because the config map is malformed, the last applied
+// repository config is returned along with a warning about the error, so an invalid config map
+// doesn't keep the repository from being initialized and maintained.
+func (r *BackupRepoReconciler) getRepositoryConfig(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (map[string]string, []string) {
+	config, err := repoconfig.GetRepositoryConfig(ctx, r.Client, r.namespace, r.repositoryConfig, req.Spec.RepositoryType, req.Spec.BackupStorageLocation)
+	if err != nil {
+		log.WithError(err).Error("Error getting repository config, keeping the last applied one")
+		return req.Spec.RepositoryConfig, []string{errors.Wrap(err, "the last applied repository config is kept").Error()}
+	}
+
+	return config, nil
+}
+
+// updateRepositoryConfig syncs the repository config of an initialized repository with
+// the repository config map, so the changes take effect the next time the repository
+// is connected or opened. The options fixed when the repository was created are read
+// from the repository once it's ready, and a warning is reported for each of them
+// configured with a different value.
+func (r *BackupRepoReconciler) updateRepositoryConfig(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
+	config, configWarnings := r.getRepositoryConfig(ctx, req, log)
+
+	format := r.getRepositoryFormat(req, log)
+	effective := repoconfig.GetEffectiveRepositoryConfig(req.Spec.RepositoryType, config, format)
+	warnings := append(configWarnings, repoconfig.GetRepositoryConfigConflicts(config, format)...)
+	if reflect.DeepEqual(config, req.Spec.RepositoryConfig) && reflect.DeepEqual(effective, req.Status.EffectiveRepositoryConfig) &&
+		reflect.DeepEqual(warnings, req.Status.RepositoryConfigWarnings) {
+		return nil
+	}
+
+	for _, warning := range warnings {
+		log.Warn(warning)
+	}
+	log.WithField("config", config).Info("Updating repository config")
+
+	return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+		setRepositoryConfig(rr, config, format, configWarnings)
+	})
+}
+
+// getRepositoryFormat returns the format options of the repository, or nil if the repository
+// isn't ready or they can't be read.
+func (r *BackupRepoReconciler) getRepositoryFormat(req *velerov1api.BackupRepository, log logrus.FieldLogger) map[string]string {
+	if req.Status.Phase != velerov1api.BackupRepositoryPhaseReady {
+		// the repository may be re-established, e.g. when its BSL changes
+		delete(r.repoFormats, req.UID)
+		return nil
+	}
+
+	if format, found := r.repoFormats[req.UID]; found {
+		return format
+	}
+
+	format, err := r.repositoryManager.GetRepositoryFormat(req)
+	if err != nil {
+		log.WithError(err).Warn("Failed to read the format of the repository")
+		return nil
+	}
+
+	r.repoFormats[req.UID] = format
+
+	return format
+}
+
+func setRepositoryConfig(rr *velerov1api.BackupRepository, config map[string]string, format map[string]string, configWarnings []string) {
+	rr.Spec.RepositoryConfig = config
+	rr.Status.EffectiveRepositoryConfig = repoconfig.GetEffectiveRepositoryConfig(rr.Spec.RepositoryType, config, format)
+	rr.Status.RepositoryConfigWarnings = append(configWarnings, repoconfig.GetRepositoryConfigConflicts(config, format)...)
+}
+
 func (r *BackupRepoReconciler) getRepositoryMaintenanceFrequency(req *velerov1api.BackupRepository) time.Duration {
 	if r.maintenanceFrequency > 0 {
 		r.logger.WithField("frequency", r.maintenanceFrequency).Info("Set user defined maintenance frequency")
@@ -300,15 +386,35 @@ func (r *BackupRepoReconciler) runMaintenanceIfDue(ctx context.Context, req *vel
 		log.WithError(err).Warn("error pruning repository")
 		return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
 			rr.Status.Message = err.Error()
+			updateRepoMaintenanceHistory(rr, velerov1api.BackupRepositoryMaintenanceFailed, now, r.clock.Now(), err.Error())
 		})
 	}
 
 	return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
 		rr.Status.Message = ""
 		rr.Status.LastMaintenanceTime = &metav1.Time{Time: now}
+		updateRepoMaintenanceHistory(rr, velerov1api.BackupRepositoryMaintenanceSucceeded, now, r.clock.Now(), "")
 	})
 }
 
+// updateRepoMaintenanceHistory appends the result of a maintenance run to the repository's
+// status, only the latest defaultMaintenanceStatusQueueLength runs are kept
+func updateRepoMaintenanceHistory(repo *velerov1api.BackupRepository, result velerov1api.BackupRepositoryMaintenanceResult, startTime time.Time, completionTime time.Time, message string) {
+	latest := velerov1api.BackupRepositoryMaintenanceStatus{
+		Result:            result,
+		StartTimestamp:    &metav1.Time{Time: startTime},
+		CompleteTimestamp: &metav1.Time{Time: completionTime},
+		Message:           message,
+	}
+
+	startingPos := 0
+	if len(repo.Status.RecentMaintenance) >= defaultMaintenanceStatusQueueLength {
+		startingPos = len(repo.Status.RecentMaintenance) - defaultMaintenanceStatusQueueLength + 1
+	}
+
+	repo.Status.RecentMaintenance = append(repo.Status.RecentMaintenance[startingPos:], latest)
+}
+
 func dueForMaintenance(req *velerov1api.BackupRepository, now time.Time) bool {
 	return req.Status.LastMaintenanceTime == nil || req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
 }
@@ -21,9 +21,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/repository"
@@ -32,6 +35,7 @@ import (
 )
 
 const testMaintenanceFrequency = 10 * time.Minute
+const testRepositoryConfig = "repo-config"
 
 func mockBackupRepoReconciler(t *testing.T, rr *velerov1api.BackupRepository, mockOn string, arg interface{}, ret interface{}) *BackupRepoReconciler {
 	mgr := &repomokes.Manager{}
@@ -44,6 +48,7 @@ func mockBackupRepoReconciler(t *testing.T, rr *velerov1api.BackupRepository, mo
 		velerotest.NewFakeControllerRuntimeClient(t),
 		testMaintenanceFrequency,
 		mgr,
+		testRepositoryConfig,
 	)
 }
 
@@ -98,6 +103,108 @@ func TestCheckNotReadyRepo(t *testing.T) {
 	assert.Equal(t, "s3:test.amazonaws.com/bucket/restic/volume-ns-1", rr.Spec.ResticIdentifier)
 }
 
+func TestUpdateRepositoryConfig(t *testing.T) {
+	rr := mockBackupRepositoryCR()
+	rr.Spec.BackupStorageLocation = "default"
+	rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+	reconciler := mockBackupRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+
+	err = reconciler.updateRepositoryConfig(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Nil(t, rr.Spec.RepositoryConfig)
+	assert.Equal(t, "2000", rr.Status.EffectiveRepositoryConfig["cacheLimitMB"])
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      testRepositoryConfig,
+		},
+		Data: map[string]string{
+			"kopia": `{"cacheLimitMB": 4096, "backupStorageLocations": {"default": {"compressionAlgo": "zstd"}}}`,
+		},
+	}
+	err = reconciler.Client.Create(context.TODO(), cm)
+	assert.NoError(t, err)
+
+	err = reconciler.updateRepositoryConfig(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"cacheLimitMB": "4096", "compressionAlgo": "zstd"}, rr.Spec.RepositoryConfig)
+	assert.Equal(t, "4096", rr.Status.EffectiveRepositoryConfig["cacheLimitMB"])
+	assert.Equal(t, "zstd", rr.Status.EffectiveRepositoryConfig["compressionAlgo"])
+
+	updated := &velerov1api.BackupRepository{}
+	err = reconciler.Client.Get(context.TODO(), client.ObjectKeyFromObject(rr), updated)
+	assert.NoError(t, err)
+	assert.Equal(t, rr.Spec.RepositoryConfig, updated.Spec.RepositoryConfig)
+	assert.Equal(t, rr.Status.EffectiveRepositoryConfig, updated.Status.EffectiveRepositoryConfig)
+
+	// a malformed config map keeps the last applied config, and the error is reported as a warning
+	cm.Data["kopia"] = `{"cacheLimitMB": "8192"`
+	err = reconciler.Client.Update(context.TODO(), cm)
+	assert.NoError(t, err)
+
+	err = reconciler.updateRepositoryConfig(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"cacheLimitMB": "4096", "compressionAlgo": "zstd"}, rr.Spec.RepositoryConfig)
+	assert.Equal(t, "4096", rr.Status.EffectiveRepositoryConfig["cacheLimitMB"])
+	require.Len(t, rr.Status.RepositoryConfigWarnings, 1)
+	assert.Contains(t, rr.Status.RepositoryConfigWarnings[0], "the last applied repository config is kept: error to unmarshall kopia config from repo-config")
+
+	// the warning is cleared once the config map is fixed
+	cm.Data["kopia"] = `{"cacheLimitMB": 8192}`
+	err = reconciler.Client.Update(context.TODO(), cm)
+	assert.NoError(t, err)
+
+	err = reconciler.updateRepositoryConfig(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"cacheLimitMB": "8192"}, rr.Spec.RepositoryConfig)
+	assert.Empty(t, rr.Status.RepositoryConfigWarnings)
+}
+
+func TestUpdateRepositoryConfigWithRepositoryFormat(t *testing.T) {
+	rr := mockBackupRepositoryCR()
+	rr.UID = "repo-uid"
+	rr.Spec.BackupStorageLocation = "default"
+	rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+	rr.Status.Phase = velerov1api.BackupRepositoryPhaseReady
+	format := map[string]string{"hashAlgo": "BLAKE2B-256-128", "encryptAlgo": "AES256-GCM-HMAC-SHA256", "splitAlgo": "DYNAMIC-4M-BUZHASH"}
+	reconciler := mockBackupRepoReconciler(t, rr, "", nil, nil)
+	repoManager := reconciler.repositoryManager.(*repomokes.Manager)
+	repoManager.On("GetRepositoryFormat", rr).Return(format, nil).Once()
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      testRepositoryConfig,
+		},
+		Data: map[string]string{
+			"kopia": `{"hashAlgo": "BLAKE3-256", "splitAlgo": "DYNAMIC-4M-BUZHASH"}`,
+		},
+	}
+	err = reconciler.Client.Create(context.TODO(), cm)
+	assert.NoError(t, err)
+
+	// the options fixed at the creation are the ones of the repository, not the configured ones
+	err = reconciler.updateRepositoryConfig(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, "BLAKE2B-256-128", rr.Status.EffectiveRepositoryConfig["hashAlgo"])
+	assert.Equal(t, "AES256-GCM-HMAC-SHA256", rr.Status.EffectiveRepositoryConfig["encryptAlgo"])
+	assert.Equal(t, "DYNAMIC-4M-BUZHASH", rr.Status.EffectiveRepositoryConfig["splitAlgo"])
+	assert.Equal(t, []string{
+		"hashAlgo is configured as BLAKE3-256, but the repository was created with BLAKE2B-256-128, the configured value doesn't take effect",
+	}, rr.Status.RepositoryConfigWarnings)
+
+	// the format is only read once
+	err = reconciler.updateRepositoryConfig(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, "BLAKE2B-256-128", rr.Status.EffectiveRepositoryConfig["hashAlgo"])
+	repoManager.AssertExpectations(t)
+}
+
 func TestRunMaintenanceIfDue(t *testing.T) {
 	rr := mockBackupRepositoryCR()
 	reconciler := mockBackupRepoReconciler(t, rr, "PruneRepo", rr, nil)
@@ -115,6 +222,90 @@ func TestRunMaintenanceIfDue(t *testing.T) {
 	assert.Equal(t, rr.Status.LastMaintenanceTime, lastTm)
 }
 
+func TestRunMaintenanceIfDueWithHistory(t *testing.T) {
+	rr := mockBackupRepositoryCR()
+	reconciler := mockBackupRepoReconciler(t, rr, "PruneRepo", rr, errors.New("fake-prune-error"))
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+
+	err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Nil(t, rr.Status.LastMaintenanceTime)
+	assert.Equal(t, "fake-prune-error", rr.Status.Message)
+	assert.Len(t, rr.Status.RecentMaintenance, 1)
+	assert.Equal(t, velerov1api.BackupRepositoryMaintenanceFailed, rr.Status.RecentMaintenance[0].Result)
+	assert.Equal(t, "fake-prune-error", rr.Status.RecentMaintenance[0].Message)
+	assert.NotNil(t, rr.Status.RecentMaintenance[0].StartTimestamp)
+	assert.NotNil(t, rr.Status.RecentMaintenance[0].CompleteTimestamp)
+}
+
+func TestUpdateRepoMaintenanceHistory(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	history := func(results ...velerov1api.BackupRepositoryMaintenanceResult) []velerov1api.BackupRepositoryMaintenanceStatus {
+		var statuses []velerov1api.BackupRepositoryMaintenanceStatus
+		for i, result := range results {
+			statuses = append(statuses, velerov1api.BackupRepositoryMaintenanceStatus{
+				Result:            result,
+				StartTimestamp:    &metav1.Time{Time: now.Add(time.Duration(i) * time.Hour)},
+				CompleteTimestamp: &metav1.Time{Time: now.Add(time.Duration(i)*time.Hour + time.Minute)},
+			})
+		}
+		return statuses
+	}
+
+	tests := []struct {
+		name     string
+		history  []velerov1api.BackupRepositoryMaintenanceStatus
+		expected []velerov1api.BackupRepositoryMaintenanceResult
+	}{
+		{
+			name:     "empty history",
+			expected: []velerov1api.BackupRepositoryMaintenanceResult{velerov1api.BackupRepositoryMaintenanceFailed},
+		},
+		{
+			name:    "history is not full",
+			history: history(velerov1api.BackupRepositoryMaintenanceSucceeded),
+			expected: []velerov1api.BackupRepositoryMaintenanceResult{
+				velerov1api.BackupRepositoryMaintenanceSucceeded,
+				velerov1api.BackupRepositoryMaintenanceFailed,
+			},
+		},
+		{
+			name: "history is full",
+			history: history(
+				velerov1api.BackupRepositoryMaintenanceSucceeded,
+				velerov1api.BackupRepositoryMaintenanceSucceeded,
+				velerov1api.BackupRepositoryMaintenanceFailed,
+			),
+			expected: []velerov1api.BackupRepositoryMaintenanceResult{
+				velerov1api.BackupRepositoryMaintenanceSucceeded,
+				velerov1api.BackupRepositoryMaintenanceFailed,
+				velerov1api.BackupRepositoryMaintenanceFailed,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := mockBackupRepositoryCR()
+			rr.Status.RecentMaintenance = test.history
+
+			updateRepoMaintenanceHistory(rr, velerov1api.BackupRepositoryMaintenanceFailed, now.Add(5*time.Hour), now.Add(6*time.Hour), "fake-error")
+
+			var results []velerov1api.BackupRepositoryMaintenanceResult
+			for _, status := range rr.Status.RecentMaintenance {
+				results = append(results, status.Result)
+			}
+			assert.Equal(t, test.expected, results)
+
+			latest := rr.Status.RecentMaintenance[len(rr.Status.RecentMaintenance)-1]
+			assert.Equal(t, now.Add(5*time.Hour), latest.StartTimestamp.Time)
+			assert.Equal(t, now.Add(6*time.Hour), latest.CompleteTimestamp.Time)
+			assert.Equal(t, "fake-error", latest.Message)
+		})
+	}
+}
+
 func TestInitializeRepo(t *testing.T) {
 	rr := mockBackupRepositoryCR()
 	rr.Spec.BackupStorageLocation = "default"
@@ -138,6 +329,45 @@ func TestInitializeRepo(t *testing.T) {
 	assert.Equal(t, rr.Status.Phase, velerov1api.BackupRepositoryPhaseReady)
 }
 
+func TestInitializeRepoWithMalformedRepositoryConfig(t *testing.T) {
+	rr := mockBackupRepositoryCR()
+	rr.Spec.BackupStorageLocation = "default"
+	rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+	reconciler := mockBackupRepoReconciler(t, rr, "PrepareRepo", rr, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+	location := &velerov1api.BackupStorageLocation{
+		Spec: velerov1api.BackupStorageLocationSpec{
+			Config: map[string]string{"resticRepoPrefix": "s3:test.amazonaws.com/bucket/restic"},
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      rr.Spec.BackupStorageLocation,
+		},
+	}
+	err = reconciler.Client.Create(context.TODO(), location)
+	assert.NoError(t, err)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      testRepositoryConfig,
+		},
+		Data: map[string]string{
+			"kopia": `{"cacheLimitMB": "4096"}`,
+		},
+	}
+	err = reconciler.Client.Create(context.TODO(), cm)
+	assert.NoError(t, err)
+
+	// the repository is initialized without a config, and the error is reported as a warning
+	err = reconciler.initializeRepo(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupRepositoryPhaseReady, rr.Status.Phase)
+	assert.Nil(t, rr.Spec.RepositoryConfig)
+	require.Len(t, rr.Status.RepositoryConfigWarnings, 1)
+	assert.Contains(t, rr.Status.RepositoryConfigWarnings[0], "error to unmarshall kopia config from repo-config")
+}
+
 func TestBackupRepoReconcile(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -244,6 +474,7 @@ func TestGetRepositoryMaintenanceFrequency(t *testing.T) {
 				velerotest.NewFakeControllerRuntimeClient(t),
 				test.userDefinedFreq,
 				&mgr,
+				"",
 			)
 
 			freq := reconciler.getRepositoryMaintenanceFrequency(test.repo)
@@ -370,7 +601,7 @@ func TestNeedInvalidBackupRepo(t *testing.T) {
 				velerov1api.DefaultNamespace,
 				velerotest.NewLogger(),
 				velerotest.NewFakeControllerRuntimeClient(t),
-				time.Duration(0), nil)
+				time.Duration(0), nil, "")
 
 			need := reconciler.needInvalidBackupRepo(test.oldBSL, test.newBSL)
 			assert.Equal(t, test.expect, need)
@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
@@ -46,6 +47,12 @@ import (
 
 const (
 	backupSyncReconcilePeriod = time.Minute
+
+	// backupIndexRebuildPeriod is how often the index of the backups in a backup
+	// storage location is checked against the listing of the backups, which repairs
+	// the index updates lost by concurrent writers. Only the metadata of the backups
+	// missing from the index is read, so a check costs little more than a listing.
+	backupIndexRebuildPeriod = 10 * time.Minute
 )
 
 type backupSyncReconciler struct {
@@ -55,6 +62,10 @@ type backupSyncReconciler struct {
 	newPluginManager        func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter       persistence.ObjectBackupStoreGetter
 	logger                  logrus.FieldLogger
+
+	// indexRebuildTimes is when the backup index of each location was last rebuilt.
+	indexRebuildTimes     map[string]time.Time
+	indexRebuildTimesLock sync.Mutex
 }
 
 // NewBackupSyncReconciler is used to generate BackupSync reconciler structure.
@@ -72,6 +83,7 @@ func NewBackupSyncReconciler(
 		newPluginManager:        newPluginManager,
 		backupStoreGetter:       backupStoreGetter,
 		logger:                  logger,
+		indexRebuildTimes:       map[string]time.Time{},
 	}
 }
 
@@ -102,13 +114,19 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
-	// get a list of all the backups that are stored in the backup storage location
-	res, err := backupStore.ListBackups()
+	filter, err := newBackupSyncFilter(location.Spec.BackupSyncFilter, time.Now())
+	if err != nil {
+		log.WithError(err).Error("Error parsing the backup sync filter of this location")
+		return ctrl.Result{}, nil
+	}
+
+	// get the index of all the backups that are stored in the backup storage location
+	index, err := b.getBackupIndex(location, backupStore, log)
 	if err != nil {
 		log.WithError(err).Error("Error listing backups in backup store")
 		return ctrl.Result{}, nil
 	}
-	backupStoreBackups := sets.New[string](res...)
+	backupStoreBackups := sets.KeySet(index.Backups)
 	log.WithField("backupCount", len(backupStoreBackups)).Debug("Got backups from backup store")
 
 	// get a list of all the backups that exist as custom resources in the cluster
@@ -132,6 +150,14 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 	backupsToSync := backupStoreBackups.Difference(clusterBackupsSet)
 
+	// skip the backups the location's filter doesn't select without getting their
+	// metadata, they're still considered when deleting the orphaned backups
+	for backupName := range backupsToSync {
+		if !filter.matches(index.Backups[backupName]) {
+			backupsToSync.Delete(backupName)
+		}
+	}
+
 	if count := backupsToSync.Len(); count > 0 {
 		log.Infof("Found %v backups in the backup location that do not exist in the cluster and need to be synced", count)
 	} else {
@@ -287,7 +313,7 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
-	b.deleteOrphanedBackups(ctx, location.Name, backupStoreBackups, log)
+	b.deleteOrphanedBackups(ctx, location.Name, backupStore, backupStoreBackups, log)
 
 	// update the location's last-synced time field
 	statusPatch := client.MergeFrom(location.DeepCopy())
@@ -300,6 +326,92 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{}, nil
 }
 
+// getBackupIndex returns the index of the backups in the location. The index stored
+// in the location is used if it exists and was rebuilt recently by this reconciler.
+// Otherwise the index is rebuilt from the listing of the backups, and written to the
+// location if it has changed, unless the location is read-only.
+func (b *backupSyncReconciler) getBackupIndex(location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) (*persistence.BackupIndex, error) {
+	index, err := backupStore.GetBackupIndex()
+	if err != nil {
+		log.WithError(err).Warn("Error getting backup index from backup store, rebuilding it")
+		index = nil
+	}
+
+	key := kube.NamespaceAndName(location)
+
+	b.indexRebuildTimesLock.Lock()
+	lastRebuild, rebuilt := b.indexRebuildTimes[key]
+	b.indexRebuildTimesLock.Unlock()
+
+	if index != nil && rebuilt && time.Since(lastRebuild) < backupIndexRebuildPeriod {
+		return index, nil
+	}
+
+	log.Debug("Rebuilding backup index from the backups in backup store")
+	stored := index
+	index, err = backupStore.BuildBackupIndex(stored)
+	if err != nil {
+		return nil, err
+	}
+
+	// the entries of the stored index are reused, so the index only changes when backups
+	// are missing from it or were removed from the backup store, e.g. by lost updates
+	changed := stored == nil || !sets.KeySet(stored.Backups).Equal(sets.KeySet(index.Backups))
+	if changed && stored != nil {
+		log.Infof("Backup index is out of date, it has %d backups while the backup store has %d", len(stored.Backups), len(index.Backups))
+	}
+
+	if changed && location.Spec.AccessMode != velerov1api.BackupStorageLocationAccessModeReadOnly {
+		if err := backupStore.PutBackupIndex(index); err != nil {
+			log.WithError(err).Warn("Error writing backup index to backup store")
+		}
+	}
+
+	b.indexRebuildTimesLock.Lock()
+	if b.indexRebuildTimes == nil {
+		b.indexRebuildTimes = map[string]time.Time{}
+	}
+	b.indexRebuildTimes[key] = time.Now()
+	b.indexRebuildTimesLock.Unlock()
+
+	return index, nil
+}
+
+// backupSyncFilter selects the backups synced from a location by their index entries.
+type backupSyncFilter struct {
+	selector     labels.Selector
+	minStartTime time.Time
+}
+
+func newBackupSyncFilter(filter *velerov1api.BackupSyncFilter, now time.Time) (*backupSyncFilter, error) {
+	res := &backupSyncFilter{selector: labels.Everything()}
+	if filter == nil {
+		return res, nil
+	}
+
+	if filter.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(filter.LabelSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid label selector")
+		}
+		res.selector = selector
+	}
+
+	if filter.MaxAge != nil && filter.MaxAge.Duration > 0 {
+		res.minStartTime = now.Add(-filter.MaxAge.Duration)
+	}
+
+	return res, nil
+}
+
+func (f *backupSyncFilter) matches(entry persistence.BackupIndexEntry) bool {
+	if !f.selector.Matches(labels.Set(entry.Labels)) {
+		return false
+	}
+
+	return f.minStartTime.IsZero() || !entry.StartTimestamp.Before(f.minStartTime)
+}
+
 func (b *backupSyncReconciler) filterBackupOwnerReferences(ctx context.Context, backup *velerov1api.Backup, log logrus.FieldLogger) []metav1.OwnerReference {
 	listedReferences := backup.ObjectMeta.OwnerReferences
 	foundReferences := make([]metav1.OwnerReference, 0)
@@ -354,8 +466,10 @@ func (b *backupSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 // deleteOrphanedBackups deletes backup objects (CRDs) from Kubernetes that have the specified location
-// and a phase of Completed, but no corresponding backup in object storage.
-func (b *backupSyncReconciler) deleteOrphanedBackups(ctx context.Context, locationName string, backupStoreBackups sets.Set[string], log logrus.FieldLogger) {
+// and a phase of Completed, but no corresponding backup in object storage. The index of the backups
+// may miss entries, so the backups missing from it are only deleted if they're missing from the
+// listing of the backup store as well.
+func (b *backupSyncReconciler) deleteOrphanedBackups(ctx context.Context, locationName string, backupStore persistence.BackupStore, indexedBackups sets.Set[string], log logrus.FieldLogger) {
 	var backupList velerov1api.BackupList
 	listOption := client.ListOptions{
 		LabelSelector: labels.Set(map[string]string{
@@ -372,13 +486,34 @@ func (b *backupSyncReconciler) deleteOrphanedBackups(ctx context.Context, locati
 		return
 	}
 
+	var orphans []*velerov1api.Backup
 	for i, backup := range backupList.Items {
+		if !(backup.Status.Phase == velerov1api.BackupPhaseCompleted || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed) || indexedBackups.Has(backup.Name) {
+			continue
+		}
+		orphans = append(orphans, &backupList.Items[i])
+	}
+
+	if len(orphans) == 0 {
+		return
+	}
+
+	// confirm the backups are gone with the listing of the backup store before deleting them
+	backupStoreBackups, err := backupStore.ListBackups()
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing backups in backup store, skip deleting orphaned backups")
+		return
+	}
+	listedBackups := sets.New[string](backupStoreBackups...)
+
+	for _, backup := range orphans {
 		log = log.WithField("backup", backup.Name)
-		if !(backup.Status.Phase == velerov1api.BackupPhaseCompleted || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed) || backupStoreBackups.Has(backup.Name) {
+		if listedBackups.Has(backup.Name) {
+			log.Warn("Backup is missing from the backup index but exists in backup store, keeping it")
 			continue
 		}
 
-		if err := b.client.Delete(ctx, &backupList.Items[i], &client.DeleteOptions{}); err != nil {
+		if err := b.client.Delete(ctx, backup, &client.DeleteOptions{}); err != nil {
 			log.WithError(errors.WithStack(err)).Error("Error deleting orphaned backup from cluster")
 		} else {
 			log.Debug("Deleted orphaned backup from cluster")
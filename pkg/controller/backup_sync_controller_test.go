@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -26,6 +27,8 @@ import (
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,10 +44,12 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 func defaultLocation(namespace string) *velerov1api.BackupStorageLocation {
@@ -424,16 +429,19 @@ var _ = Describe("Backup Sync Reconciler", func() {
 				backupStore, ok := backupStores[test.location.Name]
 				Expect(ok).To(BeTrue(), "no mock backup store for location %s", test.location.Name)
 
-				var backupNames []string
+				index := &persistence.BackupIndex{Backups: map[string]persistence.BackupIndexEntry{}}
 				for _, backup := range test.cloudBackups {
-					backupNames = append(backupNames, backup.backup.Name)
+					index.Backups[backup.backup.Name] = persistence.NewBackupIndexEntry(backup.backup)
 					backupStore.On("GetBackupMetadata", backup.backup.Name).Return(backup.backup, nil)
 					backupStore.On("GetPodVolumeBackups", backup.backup.Name).Return(backup.podVolumeBackups, nil)
 					backupStore.On("BackupExists", "bucket-1", backup.backup.Name).Return(true, nil)
 					backupStore.On("GetCSIVolumeSnapshotClasses", backup.backup.Name).Return([]*snapshotv1api.VolumeSnapshotClass{}, nil)
 					backupStore.On("GetCSIVolumeSnapshotContents", backup.backup.Name).Return([]*snapshotv1api.VolumeSnapshotContent{}, nil)
 				}
-				backupStore.On("ListBackups").Return(backupNames, nil)
+				backupStore.On("ListBackups").Return(sets.List(sets.KeySet(index.Backups)), nil).Maybe()
+				backupStore.On("GetBackupIndex").Return(nil, nil)
+				backupStore.On("BuildBackupIndex", (*persistence.BackupIndex)(nil)).Return(index, nil)
+				backupStore.On("PutBackupIndex", index).Return(nil)
 			}
 
 			for _, existingBackup := range test.existingBackups {
@@ -690,7 +698,10 @@ var _ = Describe("Backup Sync Reconciler", func() {
 			if test.useLongBSLName {
 				bslName = longLabelName
 			}
-			r.deleteOrphanedBackups(ctx, bslName, test.cloudBackups, velerotest.NewLogger())
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("ListBackups").Return(sets.List(test.cloudBackups), nil).Maybe()
+
+			r.deleteOrphanedBackups(ctx, bslName, backupStore, test.cloudBackups, velerotest.NewLogger())
 
 			numBackups, err := numBackups(client, r.namespace)
 			Expect(err).ShouldNot(HaveOccurred())
@@ -699,6 +710,15 @@ var _ = Describe("Backup Sync Reconciler", func() {
 
 			expected := len(test.k8sBackups) - len(test.expectedDeletes)
 			Expect(expected).To(BeEquivalentTo(numBackups))
+
+			for _, backup := range test.k8sBackups {
+				err := client.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+				if test.expectedDeletes.Has(backup.Name) {
+					Expect(apierrors.IsNotFound(err)).To(BeTrue(), "backup %s should be deleted", backup.Name)
+				} else {
+					Expect(err).ShouldNot(HaveOccurred(), "backup %s should be kept", backup.Name)
+				}
+			}
 		}
 	})
 
@@ -898,3 +918,162 @@ var _ = Describe("Backup Sync Reconciler", func() {
 		}
 	})
 })
+
+func TestBackupSyncFilter(t *testing.T) {
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	entry := persistence.BackupIndexEntry{
+		Labels:         map[string]string{"app": "a"},
+		StartTimestamp: now.Add(-48 * time.Hour),
+	}
+
+	tests := []struct {
+		name        string
+		filter      *velerov1api.BackupSyncFilter
+		expected    bool
+		expectedErr string
+	}{
+		{
+			name:     "no filter selects all the backups",
+			expected: true,
+		},
+		{
+			name:     "matching label selector",
+			filter:   &velerov1api.BackupSyncFilter{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "a"}}},
+			expected: true,
+		},
+		{
+			name:   "not matching label selector",
+			filter: &velerov1api.BackupSyncFilter{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "b"}}},
+		},
+		{
+			name:     "backup newer than the max age",
+			filter:   &velerov1api.BackupSyncFilter{MaxAge: &metav1.Duration{Duration: 72 * time.Hour}},
+			expected: true,
+		},
+		{
+			name:   "backup older than the max age",
+			filter: &velerov1api.BackupSyncFilter{MaxAge: &metav1.Duration{Duration: 24 * time.Hour}},
+		},
+		{
+			name: "invalid label selector",
+			filter: &velerov1api.BackupSyncFilter{LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: "Unknown"},
+			}}},
+			expectedErr: "invalid label selector",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := newBackupSyncFilter(test.filter, now)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, filter.matches(entry))
+		})
+	}
+}
+
+func TestGetBackupIndex(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").Result()
+	index := &persistence.BackupIndex{Backups: map[string]persistence.BackupIndexEntry{"backup-1": {}}}
+	r := NewBackupSyncReconciler(nil, velerov1api.DefaultNamespace, time.Minute, nil, nil, velerotest.NewLogger())
+
+	// the index is rebuilt on the first sync, and written as a backup is missing from it
+	stored := &persistence.BackupIndex{Backups: map[string]persistence.BackupIndexEntry{}}
+	backupStore := new(persistencemocks.BackupStore)
+	backupStore.On("GetBackupIndex").Return(stored, nil)
+	backupStore.On("BuildBackupIndex", stored).Return(index, nil)
+	backupStore.On("PutBackupIndex", index).Return(nil)
+	res, err := r.getBackupIndex(location, backupStore, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, index, res)
+	backupStore.AssertExpectations(t)
+
+	// the stored index is used until the rebuild period passes
+	backupStore = new(persistencemocks.BackupStore)
+	backupStore.On("GetBackupIndex").Return(index, nil)
+	res, err = r.getBackupIndex(location, backupStore, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, index, res)
+	backupStore.AssertExpectations(t)
+
+	// the index is rebuilt once the rebuild period passes, but not written if it hasn't changed
+	r.indexRebuildTimes[kube.NamespaceAndName(location)] = time.Now().Add(-backupIndexRebuildPeriod)
+	backupStore = new(persistencemocks.BackupStore)
+	backupStore.On("GetBackupIndex").Return(index, nil)
+	backupStore.On("BuildBackupIndex", index).Return(index, nil)
+	res, err = r.getBackupIndex(location, backupStore, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, index, res)
+	backupStore.AssertExpectations(t)
+
+	// the index of a read-only location isn't written
+	r.indexRebuildTimes[kube.NamespaceAndName(location)] = time.Now().Add(-backupIndexRebuildPeriod)
+	location.Spec.AccessMode = velerov1api.BackupStorageLocationAccessModeReadOnly
+	backupStore = new(persistencemocks.BackupStore)
+	backupStore.On("GetBackupIndex").Return(stored, nil)
+	backupStore.On("BuildBackupIndex", stored).Return(index, nil)
+	_, err = r.getBackupIndex(location, backupStore, velerotest.NewLogger())
+	require.NoError(t, err)
+	backupStore.AssertExpectations(t)
+}
+
+func TestDeleteOrphanedBackupsMissingFromIndex(t *testing.T) {
+	tests := []struct {
+		name          string
+		indexed       sets.Set[string]
+		listed        []string
+		listErr       error
+		expectDeleted sets.Set[string]
+	}{
+		{
+			name:          "backups missing from the index but existing in the backup store are kept",
+			indexed:       sets.New[string]("backup-1"),
+			listed:        []string{"backup-1", "backup-2"},
+			expectDeleted: sets.New[string]("backup-3"),
+		},
+		{
+			name:          "no backup is deleted if the backup store can't be listed",
+			indexed:       sets.New[string]("backup-1"),
+			listErr:       fmt.Errorf("list error"),
+			expectDeleted: sets.New[string](),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var k8sBackups []*velerov1api.Backup
+			for _, name := range []string{"backup-1", "backup-2", "backup-3"} {
+				k8sBackups = append(k8sBackups, builder.ForBackup("ns-1", name).
+					ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "default")).
+					Phase(velerov1api.BackupPhaseCompleted).Result())
+			}
+			client := velerotest.NewFakeControllerRuntimeClient(t)
+			for _, backup := range k8sBackups {
+				require.NoError(t, client.Create(context.TODO(), backup))
+			}
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("ListBackups").Return(test.listed, test.listErr)
+
+			r := backupSyncReconciler{
+				client:    client,
+				namespace: "ns-1",
+				logger:    velerotest.NewLogger(),
+			}
+			r.deleteOrphanedBackups(context.TODO(), "default", backupStore, test.indexed, velerotest.NewLogger())
+
+			for _, backup := range k8sBackups {
+				err := client.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+				if test.expectDeleted.Has(backup.Name) {
+					assert.True(t, apierrors.IsNotFound(err), "backup %s should be deleted", backup.Name)
+				} else {
+					assert.NoError(t, err, "backup %s should be kept", backup.Name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,423 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// maxVerificationErrorsInStatus is the max number of errors recorded in the
+// status of a BackupVerificationRequest, the full list is in the verification
+// result uploaded to the backup storage.
+const maxVerificationErrorsInStatus = 10
+
+// backupVerificationResult is the verification evidence uploaded to the backup
+// storage along with the backup.
+type backupVerificationResult struct {
+	BackupName          string                                     `json:"backupName"`
+	Request             string                                     `json:"request"`
+	ReadContent         bool                                       `json:"readContent"`
+	Phase               velerov1api.BackupVerificationRequestPhase `json:"phase"`
+	StartTimestamp      *metav1.Time                               `json:"startTimestamp,omitempty"`
+	CompletionTimestamp *metav1.Time                               `json:"completionTimestamp,omitempty"`
+	ItemsVerified       int                                        `json:"itemsVerified"`
+	VolumeSnapshots     []verifiedVolumeSnapshot                   `json:"volumeSnapshots,omitempty"`
+	Errors              []string                                   `json:"errors,omitempty"`
+}
+
+type verifiedVolumeSnapshot struct {
+	Source         string `json:"source"`
+	SnapshotID     string `json:"snapshotID"`
+	RepositoryType string `json:"repositoryType"`
+	Verified       bool   `json:"verified"`
+	// ContentRead is true if all the content of the snapshot was read, it's never
+	// read for the restic repositories
+	ContentRead bool   `json:"contentRead"`
+	Error       string `json:"error,omitempty"`
+}
+
+// volumeSnapshotToVerify identifies a volume snapshot in a backup repository.
+type volumeSnapshotToVerify struct {
+	source     string
+	snapshotID string
+	repoKey    repository.BackupRepositoryKey
+}
+
+type backupVerificationRequestReconciler struct {
+	client            kbclient.Client
+	clock             clocks.Clock
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	repoMgr           repository.Manager
+	fileSystem        filesystem.Interface
+	log               logrus.FieldLogger
+}
+
+// NewBackupVerificationRequestReconciler initializes and returns backupVerificationRequestReconciler struct.
+func NewBackupVerificationRequestReconciler(
+	client kbclient.Client,
+	clock clocks.Clock,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	repoMgr repository.Manager,
+	log logrus.FieldLogger,
+) *backupVerificationRequestReconciler {
+	return &backupVerificationRequestReconciler{
+		client:            client,
+		clock:             clock,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		repoMgr:           repoMgr,
+		fileSystem:        filesystem.NewFileSystem(),
+		log:               log,
+	}
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupverificationrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backupverificationrequests/status,verbs=get;update;patch
+
+func (r *backupVerificationRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.log.WithFields(logrus.Fields{
+		"controller":                "backup-verification-request",
+		"backupVerificationRequest": req.NamespacedName,
+	})
+
+	request := &velerov1api.BackupVerificationRequest{}
+	if err := r.client.Get(ctx, req.NamespacedName, request); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find BackupVerificationRequest")
+			return ctrl.Result{}, nil
+		}
+
+		log.WithError(err).Error("Error getting BackupVerificationRequest")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	switch request.Status.Phase {
+	case "", velerov1api.BackupVerificationRequestPhaseNew:
+	case velerov1api.BackupVerificationRequestPhaseInProgress:
+		// The verification runs synchronously in the reconciler, so a request
+		// found in progress was interrupted, e.g. by a restart of the server.
+		original := request.DeepCopy()
+		request.Status.Phase = velerov1api.BackupVerificationRequestPhaseFailed
+		request.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		request.Status.Errors = append(request.Status.Errors, "verification is interrupted")
+		if err := r.client.Patch(ctx, request, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(err).Error("Error updating BackupVerificationRequest")
+			return ctrl.Result{}, errors.WithStack(err)
+		}
+		return ctrl.Result{}, nil
+	default:
+		log.Debugf("BackupVerificationRequest is %s, skip", request.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+
+	original := request.DeepCopy()
+	request.Status.Phase = velerov1api.BackupVerificationRequestPhaseInProgress
+	request.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.client.Patch(ctx, request, kbclient.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Error updating BackupVerificationRequest")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	result := &backupVerificationResult{
+		BackupName:     request.Spec.BackupName,
+		Request:        request.Name,
+		ReadContent:    request.Spec.ReadContent,
+		StartTimestamp: request.Status.StartTimestamp,
+	}
+
+	backupStore := r.verify(ctx, request, result, log)
+
+	result.Phase = velerov1api.BackupVerificationRequestPhaseCompleted
+	if len(result.Errors) > 0 {
+		result.Phase = velerov1api.BackupVerificationRequestPhaseFailed
+	}
+	result.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+	if backupStore != nil {
+		if err := putBackupVerification(result, backupStore); err != nil {
+			log.WithError(err).Error("Error uploading backup verification result")
+			result.Errors = append(result.Errors, fmt.Sprintf("error uploading backup verification result: %v", err))
+			result.Phase = velerov1api.BackupVerificationRequestPhaseFailed
+		}
+	}
+
+	original = request.DeepCopy()
+	request.Status.Phase = result.Phase
+	request.Status.CompletionTimestamp = result.CompletionTimestamp
+	request.Status.ItemsVerified = result.ItemsVerified
+	request.Status.VolumeSnapshotsVerified = 0
+	for _, snapshot := range result.VolumeSnapshots {
+		if snapshot.Verified {
+			request.Status.VolumeSnapshotsVerified++
+		}
+	}
+	request.Status.Errors = result.Errors
+	if len(result.Errors) > maxVerificationErrorsInStatus {
+		request.Status.Errors = append(result.Errors[:maxVerificationErrorsInStatus:maxVerificationErrorsInStatus],
+			fmt.Sprintf("%d more errors, see the verification result in the backup storage", len(result.Errors)-maxVerificationErrorsInStatus))
+	}
+	if err := r.client.Patch(ctx, request, kbclient.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Error updating BackupVerificationRequest")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	log.Infof("Backup verification is %s", result.Phase)
+
+	return ctrl.Result{}, nil
+}
+
+// verify runs the verification of the backup and records the outcome in the result.
+// It returns the backup store of the backup, or nil if the backup store can't be accessed.
+func (r *backupVerificationRequestReconciler) verify(ctx context.Context, request *velerov1api.BackupVerificationRequest,
+	result *backupVerificationResult, log logrus.FieldLogger) persistence.BackupStore {
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{Namespace: request.Namespace, Name: request.Spec.BackupName}, backup); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error getting backup %s: %v", request.Spec.BackupName, err))
+		return nil
+	}
+
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+		result.Errors = append(result.Errors, fmt.Sprintf("backup %s is %s, only completed or partially failed backups could be verified",
+			backup.Name, backup.Status.Phase))
+		return nil
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.StorageLocation}, location); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error getting backup storage location %s: %v", backup.Spec.StorageLocation, err))
+		return nil
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error getting backup store: %v", err))
+		return nil
+	}
+
+	itemsVerified, errs := r.verifyBackupContents(backup.Name, backupStore, log)
+	result.ItemsVerified = itemsVerified
+	result.Errors = append(result.Errors, errs...)
+
+	snapshots, err := getVolumeSnapshotsToVerify(backup, backupStore)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	for _, snapshot := range snapshots {
+		verified := verifiedVolumeSnapshot{
+			Source:         snapshot.source,
+			SnapshotID:     snapshot.snapshotID,
+			RepositoryType: snapshot.repoKey.RepositoryType,
+		}
+
+		// reading the content of a single snapshot isn't supported for restic repositories,
+		// only their existence is checked and the snapshots are recorded as not read
+		readContent := request.Spec.ReadContent && snapshot.repoKey.RepositoryType != velerov1api.BackupRepositoryTypeRestic
+		if request.Spec.ReadContent && !readContent {
+			log.Warnf("The content of snapshot %s of %s isn't read as it's in a restic repository", snapshot.snapshotID, snapshot.source)
+		}
+
+		if err := r.verifyVolumeSnapshot(ctx, backup.Namespace, snapshot, readContent); err != nil {
+			verified.Error = err.Error()
+			result.Errors = append(result.Errors, fmt.Sprintf("error verifying snapshot %s of %s: %v", snapshot.snapshotID, snapshot.source, err))
+		} else {
+			verified.Verified = true
+			verified.ContentRead = readContent
+		}
+
+		result.VolumeSnapshots = append(result.VolumeSnapshots, verified)
+	}
+
+	return backupStore
+}
+
+// verifyBackupContents streams the backup tarball through the extractor and
+// parser used by restores and checks every item in it could be decoded.
+func (r *backupVerificationRequestReconciler) verifyBackupContents(backupName string, backupStore persistence.BackupStore,
+	log logrus.FieldLogger) (int, []string) {
+	contents, err := backupStore.GetBackupContents(backupName)
+	if err != nil {
+		return 0, []string{fmt.Sprintf("error getting backup contents: %v", err)}
+	}
+	defer contents.Close()
+
+	dir, err := archive.NewExtractor(log, r.fileSystem).UnzipAndExtractBackup(contents)
+	if err != nil {
+		return 0, []string{fmt.Sprintf("error extracting backup contents: %v", err)}
+	}
+	defer func() {
+		if err := r.fileSystem.RemoveAll(dir); err != nil {
+			log.WithError(err).Errorf("Error removing temp directory %s", dir)
+		}
+	}()
+
+	// read the remaining data so the checksum of the whole file is verified
+	if _, err := io.Copy(io.Discard, contents); err != nil {
+		return 0, []string{fmt.Sprintf("error reading backup contents: %v", err)}
+	}
+
+	resources, err := archive.NewParser(log, r.fileSystem).Parse(dir)
+	if err != nil {
+		return 0, []string{fmt.Sprintf("error parsing backup contents: %v", err)}
+	}
+
+	verified := 0
+	var errs []string
+	for groupResource, items := range resources {
+		for namespace, names := range items.ItemsByNamespace {
+			for _, name := range names {
+				obj, err := archive.Unmarshal(r.fileSystem, archive.GetItemFilePath(dir, groupResource, namespace, name))
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("error decoding item %s %s: %v", groupResource, itemKey(namespace, name), err))
+					continue
+				}
+
+				if obj.GetName() != name || obj.GetNamespace() != namespace {
+					errs = append(errs, fmt.Sprintf("item %s %s is decoded as %s", groupResource, itemKey(namespace, name),
+						itemKey(obj.GetNamespace(), obj.GetName())))
+					continue
+				}
+
+				verified++
+			}
+		}
+	}
+
+	return verified, errs
+}
+
+func (r *backupVerificationRequestReconciler) verifyVolumeSnapshot(ctx context.Context, namespace string,
+	snapshot volumeSnapshotToVerify, readContent bool) error {
+	repo, err := repository.GetBackupRepository(ctx, r.client, namespace, snapshot.repoKey, true)
+	if err != nil {
+		return err
+	}
+
+	return r.repoMgr.VerifySnapshot(ctx, repo, snapshot.snapshotID, readContent)
+}
+
+// getVolumeSnapshotsToVerify returns the pod volume backup snapshots and the data mover
+// snapshots of the backup.
+func getVolumeSnapshotsToVerify(backup *velerov1api.Backup, backupStore persistence.BackupStore) ([]volumeSnapshotToVerify, error) {
+	var snapshots []volumeSnapshotToVerify
+
+	pvbs, err := backupStore.GetPodVolumeBackups(backup.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting pod volume backups")
+	}
+
+	for _, pvb := range pvbs {
+		if pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted || pvb.Status.SnapshotID == "" {
+			continue
+		}
+
+		snapshots = append(snapshots, volumeSnapshotToVerify{
+			source:     fmt.Sprintf("pod volume %s/%s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name, pvb.Spec.Volume),
+			snapshotID: pvb.Status.SnapshotID,
+			repoKey: repository.BackupRepositoryKey{
+				VolumeNamespace: pvb.Spec.Pod.Namespace,
+				BackupLocation:  backup.Spec.StorageLocation,
+				RepositoryType:  podvolume.GetPvbRepositoryType(pvb),
+			},
+		})
+	}
+
+	volumeInfos, err := backupStore.GetBackupVolumeInfos(backup.Name)
+	if err != nil {
+		return snapshots, errors.Wrap(err, "error getting backup volume infos")
+	}
+
+	for _, info := range volumeInfos {
+		if !info.SnapshotDataMoved || info.SnapshotDataMovementInfo == nil || info.SnapshotDataMovementInfo.SnapshotHandle == "" {
+			continue
+		}
+
+		// only the snapshots moved by the built-in data mover are in the backup repositories
+		if datamover.GetUploaderType(info.SnapshotDataMovementInfo.DataMover) != uploader.KopiaType {
+			continue
+		}
+
+		snapshots = append(snapshots, volumeSnapshotToVerify{
+			source:     fmt.Sprintf("PVC %s/%s", info.PVCNamespace, info.PVCName),
+			snapshotID: info.SnapshotDataMovementInfo.SnapshotHandle,
+			repoKey: repository.BackupRepositoryKey{
+				VolumeNamespace: info.PVCNamespace,
+				BackupLocation:  backup.Spec.StorageLocation,
+				RepositoryType:  velerov1api.BackupRepositoryTypeKopia,
+			},
+		})
+	}
+
+	return snapshots, nil
+}
+
+func putBackupVerification(result *backupVerificationResult, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(result); err != nil {
+		return errors.Wrap(err, "error encoding backup verification result to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutBackupVerification(result.BackupName, buf)
+}
+
+func itemKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+func (r *backupVerificationRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.BackupVerificationRequest{}).
+		Complete(r)
+}
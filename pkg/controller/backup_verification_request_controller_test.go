@@ -0,0 +1,265 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/volume"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	repomocks "github.com/vmware-tanzu/velero/pkg/repository/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func readyBackupRepo(volumeNamespace, repoType string) *velerov1api.BackupRepository {
+	return &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      volumeNamespace + "-default-" + repoType,
+			Labels: map[string]string{
+				velerov1api.StorageLocationLabel: "default",
+				velerov1api.VolumeNamespaceLabel: volumeNamespace,
+				velerov1api.RepositoryTypeLabel:  repoType,
+			},
+		},
+		Status: velerov1api.BackupRepositoryStatus{
+			Phase: velerov1api.BackupRepositoryPhaseReady,
+		},
+	}
+}
+
+func TestBackupVerificationRequestReconcile(t *testing.T) {
+	now, err := time.Parse(time.RFC1123, time.RFC1123)
+	require.NoError(t, err)
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+		Phase(velerov1api.BackupPhaseCompleted).Result()
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").PodName("pod-1").
+		Volume("vol-1").UploaderType("kopia").SnapshotID("pvb-snapshot").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result()
+	volumeInfos := []*volume.BackupVolumeInfo{
+		{
+			PVCName:           "pvc-1",
+			PVCNamespace:      "ns-2",
+			BackupMethod:      volume.CSISnapshot,
+			SnapshotDataMoved: true,
+			SnapshotDataMovementInfo: &volume.SnapshotDataMovementInfo{
+				DataMover:      "velero",
+				SnapshotHandle: "du-snapshot",
+			},
+		},
+		{
+			PVCName:           "pvc-2",
+			PVCNamespace:      "ns-2",
+			BackupMethod:      volume.CSISnapshot,
+			SnapshotDataMoved: true,
+			SnapshotDataMovementInfo: &volume.SnapshotDataMovementInfo{
+				DataMover:      "third-party",
+				SnapshotHandle: "third-party-snapshot",
+			},
+		},
+	}
+
+	tests := []struct {
+		name                     string
+		request                  *velerov1api.BackupVerificationRequest
+		objects                  []runtime.Object
+		contents                 func(t *testing.T) io.ReadCloser
+		verifySnapshotErr        error
+		expectedPhase            velerov1api.BackupVerificationRequestPhase
+		expectedItems            int
+		expectedSnapshots        int
+		expectedErrors           []string
+		expectUploadVerification bool
+	}{
+		{
+			name:           "backup doesn't exist",
+			request:        builder.ForBackupVerificationRequest(velerov1api.DefaultNamespace, "request-1").BackupName("backup-2").Result(),
+			expectedPhase:  velerov1api.BackupVerificationRequestPhaseFailed,
+			expectedErrors: []string{`error getting backup backup-2: backups.velero.io "backup-2" not found`},
+		},
+		{
+			name:          "completed request is skipped",
+			request:       builder.ForBackupVerificationRequest(velerov1api.DefaultNamespace, "request-1").BackupName("backup-1").Phase(velerov1api.BackupVerificationRequestPhaseCompleted).Result(),
+			expectedPhase: velerov1api.BackupVerificationRequestPhaseCompleted,
+		},
+		{
+			name:           "in progress request is interrupted",
+			request:        builder.ForBackupVerificationRequest(velerov1api.DefaultNamespace, "request-1").BackupName("backup-1").Phase(velerov1api.BackupVerificationRequestPhaseInProgress).Result(),
+			expectedPhase:  velerov1api.BackupVerificationRequestPhaseFailed,
+			expectedErrors: []string{"verification is interrupted"},
+		},
+		{
+			name:    "backup is verified",
+			request: builder.ForBackupVerificationRequest(velerov1api.DefaultNamespace, "request-1").BackupName("backup-1").Result(),
+			objects: []runtime.Object{backup, location, readyBackupRepo("ns-1", "kopia"), readyBackupRepo("ns-2", "kopia")},
+			contents: func(t *testing.T) io.ReadCloser {
+				return io.NopCloser(velerotest.NewTarWriter(t).
+					AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+					AddItems("namespaces", builder.ForNamespace("ns-1").Result()).
+					Done())
+			},
+			expectedPhase:            velerov1api.BackupVerificationRequestPhaseCompleted,
+			expectedItems:            2,
+			expectedSnapshots:        2,
+			expectUploadVerification: true,
+		},
+		{
+			name:    "backup has corrupted item and missing snapshot",
+			request: builder.ForBackupVerificationRequest(velerov1api.DefaultNamespace, "request-1").BackupName("backup-1").Result(),
+			objects: []runtime.Object{backup, location, readyBackupRepo("ns-1", "kopia")},
+			contents: func(t *testing.T) io.ReadCloser {
+				return io.NopCloser(velerotest.NewTarWriter(t).
+					AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+					Add("resources/pods/namespaces/ns-1/pod-2.json", []byte("{corrupted")).
+					Done())
+			},
+			verifySnapshotErr: errors.New("fake-verify-error"),
+			expectedPhase:     velerov1api.BackupVerificationRequestPhaseFailed,
+			expectedItems:     1,
+			expectedErrors: []string{
+				"error decoding item pods ns-1/pod-2: invalid character 'c' looking for beginning of object key string",
+				"error verifying snapshot pvb-snapshot of pod volume ns-1/pod-1/vol-1: fake-verify-error",
+				"error verifying snapshot du-snapshot of PVC ns-2/pvc-1: backup repository not found",
+			},
+			expectUploadVerification: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, append(test.objects, test.request)...)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			backupStore := &persistencemocks.BackupStore{}
+			if test.contents != nil {
+				backupStore.On("GetBackupContents", "backup-1").Return(test.contents(t), nil)
+			}
+			backupStore.On("GetPodVolumeBackups", "backup-1").Return([]*velerov1api.PodVolumeBackup{pvb}, nil)
+			backupStore.On("GetBackupVolumeInfos", "backup-1").Return(volumeInfos, nil)
+			backupStore.On("PutBackupVerification", "backup-1", mock.Anything).Return(nil)
+
+			repoManager := &repomocks.Manager{}
+			repoManager.On("VerifySnapshot", mock.Anything, mock.Anything, mock.Anything, false).Return(test.verifySnapshotErr)
+
+			r := NewBackupVerificationRequestReconciler(
+				client,
+				testclocks.NewFakeClock(now),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+				repoManager,
+				velerotest.NewLogger(),
+			)
+			r.fileSystem = velerotest.NewFakeFileSystem()
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: kbclient.ObjectKeyFromObject(test.request)})
+			require.NoError(t, err)
+
+			request := &velerov1api.BackupVerificationRequest{}
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKeyFromObject(test.request), request))
+
+			assert.Equal(t, test.expectedPhase, request.Status.Phase)
+			assert.Equal(t, test.expectedItems, request.Status.ItemsVerified)
+			assert.Equal(t, test.expectedSnapshots, request.Status.VolumeSnapshotsVerified)
+			assert.Equal(t, test.expectedErrors, request.Status.Errors)
+
+			if test.expectUploadVerification {
+				backupStore.AssertCalled(t, "PutBackupVerification", "backup-1", mock.Anything)
+			} else {
+				backupStore.AssertNotCalled(t, "PutBackupVerification", "backup-1", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestVerifyReadContent(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+		Phase(velerov1api.BackupPhaseCompleted).Result()
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").PodName("pod-1").
+		Volume("vol-1").UploaderType("restic").SnapshotID("pvb-snapshot").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result()
+	volumeInfos := []*volume.BackupVolumeInfo{
+		{
+			PVCName:           "pvc-1",
+			PVCNamespace:      "ns-2",
+			BackupMethod:      volume.CSISnapshot,
+			SnapshotDataMoved: true,
+			SnapshotDataMovementInfo: &volume.SnapshotDataMovementInfo{
+				DataMover:      "velero",
+				SnapshotHandle: "du-snapshot",
+			},
+		},
+	}
+	request := builder.ForBackupVerificationRequest(velerov1api.DefaultNamespace, "request-1").BackupName("backup-1").Result()
+	request.Spec.ReadContent = true
+	client := velerotest.NewFakeControllerRuntimeClient(t, backup, location, readyBackupRepo("ns-1", "restic"), readyBackupRepo("ns-2", "kopia"))
+
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetBackupContents", "backup-1").Return(io.NopCloser(velerotest.NewTarWriter(t).
+		AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+		Done()), nil)
+	backupStore.On("GetPodVolumeBackups", "backup-1").Return([]*velerov1api.PodVolumeBackup{pvb}, nil)
+	backupStore.On("GetBackupVolumeInfos", "backup-1").Return(volumeInfos, nil)
+
+	// the content is only read from the kopia repository
+	repoManager := &repomocks.Manager{}
+	repoManager.On("VerifySnapshot", mock.Anything, mock.Anything, "pvb-snapshot", false).Return(nil)
+	repoManager.On("VerifySnapshot", mock.Anything, mock.Anything, "du-snapshot", true).Return(nil)
+
+	r := NewBackupVerificationRequestReconciler(
+		client,
+		testclocks.NewFakeClock(time.Now()),
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+		repoManager,
+		velerotest.NewLogger(),
+	)
+	r.fileSystem = velerotest.NewFakeFileSystem()
+
+	result := &backupVerificationResult{}
+	r.verify(context.Background(), request, result, velerotest.NewLogger())
+
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []verifiedVolumeSnapshot{
+		{Source: "pod volume ns-1/pod-1/vol-1", SnapshotID: "pvb-snapshot", RepositoryType: "restic", Verified: true},
+		{Source: "PVC ns-2/pvc-1", SnapshotID: "du-snapshot", RepositoryType: "kopia", Verified: true, ContentRead: true},
+	}, result.VolumeSnapshots)
+	repoManager.AssertExpectations(t)
+}
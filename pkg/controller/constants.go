@@ -22,8 +22,11 @@ const (
 	BackupDeletion        = "backup-deletion"
 	BackupFinalizer       = "backup-finalizer"
 	BackupRepo            = "backup-repo"
+	BackupReplication     = "backup-replication"
 	BackupStorageLocation = "backup-storage-location"
 	BackupSync            = "backup-sync"
+	BackupVerification    = "backup-verification"
+	CredentialRotation    = "credential-rotation"
 	DownloadRequest       = "download-request"
 	GarbageCollection     = "gc"
 	PodVolumeBackup       = "pod-volume-backup"
@@ -31,6 +34,7 @@ const (
 	Restore               = "restore"
 	RestoreOperations     = "restore-operations"
 	Schedule              = "schedule"
+	ScheduleRetention     = "schedule-retention"
 	ServerStatusRequest   = "server-status-request"
 	RestoreFinalizer      = "restore-finalizer"
 )
@@ -41,13 +45,17 @@ var DisableableControllers = []string{
 	BackupOperations,
 	BackupDeletion,
 	BackupFinalizer,
+	BackupReplication,
 	BackupSync,
+	BackupVerification,
+	CredentialRotation,
 	DownloadRequest,
 	GarbageCollection,
 	BackupRepo,
 	Restore,
 	RestoreOperations,
 	Schedule,
+	ScheduleRetention,
 	ServerStatusRequest,
 	RestoreFinalizer,
 }
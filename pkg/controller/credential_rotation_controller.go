@@ -0,0 +1,179 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// credentialRotationReconciler watches the secrets referenced as credentials
+// by backup and volume snapshot locations. When the data of such a secret
+// changes, it refreshes the serialized credentials, so that the plugin
+// instances and repository connections using them are reinitialized on their
+// next use, and records the rotation in the status of the affected backup
+// storage locations.
+type credentialRotationReconciler struct {
+	client              kbclient.Client
+	namespace           string
+	credentialFileStore credentials.FileStore
+	clock               clocks.Clock
+	log                 logrus.FieldLogger
+
+	// generations are the rotation generations of the credentials files
+	// last seen by the reconciler.
+	generations     map[string]uint64
+	generationsLock sync.Mutex
+}
+
+// NewCredentialRotationReconciler initializes and returns credentialRotationReconciler struct.
+func NewCredentialRotationReconciler(
+	client kbclient.Client,
+	namespace string,
+	credentialFileStore credentials.FileStore,
+	clock clocks.Clock,
+	log logrus.FieldLogger,
+) *credentialRotationReconciler {
+	return &credentialRotationReconciler{
+		client:              client,
+		namespace:           namespace,
+		credentialFileStore: credentialFileStore,
+		clock:               clock,
+		log:                 log,
+		generations:         map[string]uint64{},
+	}
+}
+
+func (r *credentialRotationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(CredentialRotation).
+		For(&corev1api.Secret{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=volumesnapshotlocations,verbs=get;list;watch
+
+func (r *credentialRotationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.log.WithFields(logrus.Fields{
+		"controller": CredentialRotation,
+		"secret":     req.NamespacedName,
+	})
+
+	if req.Namespace != r.namespace {
+		return ctrl.Result{}, nil
+	}
+
+	secret := &corev1api.Secret{}
+	if err := r.client.Get(ctx, req.NamespacedName, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find Secret")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting secret %s", req.String())
+	}
+
+	locations := &velerov1api.BackupStorageLocationList{}
+	if err := r.client.List(ctx, locations, kbclient.InNamespace(r.namespace)); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error listing backup storage locations")
+	}
+
+	for i := range locations.Items {
+		location := &locations.Items[i]
+		if !referencesSecret(location.Spec.Credential, secret.Name) {
+			continue
+		}
+
+		rotated, err := r.refresh(location.Spec.Credential)
+		if err != nil {
+			log.WithError(err).Errorf("Error refreshing the credentials of backup storage location %s", location.Name)
+			continue
+		}
+		if !rotated {
+			continue
+		}
+
+		log.Infof("Credentials of backup storage location %s were rotated", location.Name)
+
+		original := location.DeepCopy()
+		location.Status.LastCredentialRotationTime = &metav1.Time{Time: r.clock.Now()}
+		if err := r.client.Patch(ctx, location, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(err).Errorf("Error updating the status of backup storage location %s", location.Name)
+		}
+	}
+
+	snapshotLocations := &velerov1api.VolumeSnapshotLocationList{}
+	if err := r.client.List(ctx, snapshotLocations, kbclient.InNamespace(r.namespace)); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error listing volume snapshot locations")
+	}
+
+	for i := range snapshotLocations.Items {
+		location := &snapshotLocations.Items[i]
+		if !referencesSecret(location.Spec.Credential, secret.Name) {
+			continue
+		}
+
+		rotated, err := r.refresh(location.Spec.Credential)
+		if err != nil {
+			log.WithError(err).Errorf("Error refreshing the credentials of volume snapshot location %s", location.Name)
+			continue
+		}
+		if rotated {
+			log.Infof("Credentials of volume snapshot location %s were rotated", location.Name)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// refresh serializes the credentials defined by selector again and reports
+// whether they were rotated since the reconciler last saw them. The first
+// time a credentials file is seen is not reported as a rotation.
+func (r *credentialRotationReconciler) refresh(selector *corev1api.SecretKeySelector) (bool, error) {
+	path, err := r.credentialFileStore.Path(selector)
+	if err != nil {
+		return false, err
+	}
+
+	generation := credentials.Generation(path)
+
+	r.generationsLock.Lock()
+	defer r.generationsLock.Unlock()
+
+	last, found := r.generations[path]
+	r.generations[path] = generation
+
+	return found && last != generation, nil
+}
+
+func referencesSecret(selector *corev1api.SecretKeySelector, name string) bool {
+	return selector != nil && selector.Name == name
+}
@@ -0,0 +1,94 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCredentialRotationReconcile(t *testing.T) {
+	secret := builder.ForSecret(velerov1api.DefaultNamespace, "cloud-credentials").Data(map[string][]byte{"cloud": []byte("old")}).Result()
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").
+		Provider("aws").
+		Bucket("bucket").
+		Credential(builder.ForSecretKeySelector("cloud-credentials", "cloud").Result()).
+		Result()
+	other := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "other").
+		Provider("aws").
+		Bucket("bucket").
+		Result()
+
+	client := velerotest.NewFakeControllerRuntimeClient(t, secret, location, other)
+	fileStore, err := credentials.NewNamespacedFileStore(client, velerov1api.DefaultNamespace, t.TempDir(), velerotest.NewFakeFileSystem())
+	require.NoError(t, err)
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	r := NewCredentialRotationReconciler(client, velerov1api.DefaultNamespace, fileStore, testclocks.NewFakeClock(now), velerotest.NewLogger())
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}}
+
+	getLocation := func(name string) *velerov1api.BackupStorageLocation {
+		res := &velerov1api.BackupStorageLocation{}
+		require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: name}, res))
+		return res
+	}
+
+	// the first sight of the credentials is not a rotation
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Nil(t, getLocation("default").Status.LastCredentialRotationTime)
+
+	// an unrelated update of the secret is not a rotation either
+	secret.Labels = map[string]string{"foo": "bar"}
+	require.NoError(t, client.Update(context.Background(), secret))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Nil(t, getLocation("default").Status.LastCredentialRotationTime)
+
+	// changing the data of the secret rotates the credentials of the locations referencing it
+	secret.Data["cloud"] = []byte("new")
+	require.NoError(t, client.Update(context.Background(), secret))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	rotated := getLocation("default").Status.LastCredentialRotationTime
+	require.NotNil(t, rotated)
+	assert.True(t, rotated.Time.Equal(now))
+	assert.Nil(t, getLocation("other").Status.LastCredentialRotationTime)
+}
+
+func TestCredentialRotationReconcileSecretNotFound(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t)
+	fileStore, err := credentials.NewNamespacedFileStore(client, velerov1api.DefaultNamespace, t.TempDir(), velerotest.NewFakeFileSystem())
+	require.NoError(t, err)
+
+	r := NewCredentialRotationReconciler(client, velerov1api.DefaultNamespace, fileStore, testclocks.NewFakeClock(time.Now()), velerotest.NewLogger())
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "missing"}})
+	require.NoError(t, err)
+}
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
@@ -32,6 +33,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -100,7 +102,7 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, errors.WithStack(err)
 	}
 
-	if downloadRequest.Status != (velerov1api.DownloadRequestStatus{}) && downloadRequest.Status.Expiration != nil {
+	if downloadRequest.Status.Expiration != nil {
 		if downloadRequest.Status.Expiration.Time.Before(r.clock.Now()) {
 			// Delete any request that is expired, regardless of the phase: it is not
 			// worth proceeding and trying/retrying to find it.
@@ -203,6 +205,32 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			_ = r.restoreItemOperationsMap.UpdateForRestore(backupStore, downloadRequest.Spec.Target.Name)
 		}
 
+		// The files of the locations configured with encryption can only be read with
+		// their data keys, which are passed along with the URL wrapped for the public
+		// key of the request, so they aren't exposed to anyone else reading the request.
+		dataKey, err := backupStore.GetDownloadDataKey(downloadRequest.Spec.Target)
+		if err != nil {
+			log.Warnf("fail to get the data key of the download target %s, retry later: %s", downloadRequest.Spec.Target, err)
+			return ctrl.Result{}, errors.WithStack(err)
+		}
+		if len(dataKey) > 0 {
+			// The request can't be served without wrapping the data key, it's
+			// processed with the reason in its message, so the client fails
+			// instead of waiting for the DownloadURL.
+			if len(downloadRequest.Spec.EncryptionPublicKey) == 0 {
+				log.Error("The download target is encrypted, but the DownloadRequest has no encryption public key to wrap its data key for")
+				downloadRequest.Status.Phase = velerov1api.DownloadRequestPhaseProcessed
+				downloadRequest.Status.Message = "the download target is encrypted, but the DownloadRequest has no encryption public key"
+				return ctrl.Result{}, nil
+			}
+			if downloadRequest.Status.WrappedEncryptionKey, err = encryption.WrapKeyForRecipient(downloadRequest.Spec.EncryptionPublicKey, dataKey); err != nil {
+				log.WithError(err).Error("Error wrapping the data key of the download target")
+				downloadRequest.Status.Phase = velerov1api.DownloadRequestPhaseProcessed
+				downloadRequest.Status.Message = fmt.Sprintf("error wrapping the data key of the download target: %s", err)
+				return ctrl.Result{}, nil
+			}
+		}
+
 		if downloadRequest.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
 			log.Warnf("fail to get Backup metadata file's download URL %s, retry later: %s", downloadRequest.Spec.Target, err)
 			return ctrl.Result{}, errors.WithStack(err)
@@ -222,7 +250,7 @@ func (r *downloadRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		&velerov1api.DownloadRequestList{}, defaultDownloadRequestSyncPeriod, kube.PeriodicalEnqueueSourceOption{})
 	downloadRequestPredicates := kube.NewGenericEventPredicate(func(object kbclient.Object) bool {
 		downloadRequest := object.(*velerov1api.DownloadRequest)
-		if downloadRequest.Status != (velerov1api.DownloadRequestStatus{}) && downloadRequest.Status.Expiration != nil {
+		if downloadRequest.Status.Expiration != nil {
 			return downloadRequest.Status.Expiration.Time.Before(r.clock.Now())
 		}
 		return true
@@ -17,13 +17,17 @@ limitations under the License.
 package controller
 
 import (
+	"bytes"
 	"context"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -35,6 +39,7 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -118,6 +123,7 @@ var _ = Describe("Download Request Reconciler", func() {
 
 			if test.backupLocation != nil && test.expectGetsURL {
 				backupStores[test.backupLocation.Name].On("GetDownloadURL", test.downloadRequest.Spec.Target).Return("a-url", nil)
+				backupStores[test.backupLocation.Name].On("GetDownloadDataKey", test.downloadRequest.Spec.Target).Return(nil, nil)
 			}
 
 			actualResult, err := r.Reconcile(context.Background(), ctrl.Request{
@@ -152,6 +158,7 @@ var _ = Describe("Download Request Reconciler", func() {
 			if test.expectGetsURL {
 				Expect(string(instance.Status.Phase)).To(Equal(string(velerov1api.DownloadRequestPhaseProcessed)))
 				Expect(instance.Status.DownloadURL).To(Equal("a-url"))
+				Expect(instance.Status.WrappedEncryptionKey).To(BeNil())
 				Expect(velerotest.TimesAreEqual(instance.Status.Expiration.Time, r.clock.Now().Add(signedURLTTL))).To(BeTrue())
 			}
 		},
@@ -263,3 +270,78 @@ var _ = Describe("Download Request Reconciler", func() {
 		}),
 	)
 })
+
+func TestDownloadRequestReconcilerEncryptedTarget(t *testing.T) {
+	recipient, err := encryption.GenerateRecipientKey()
+	require.NoError(t, err)
+	dataKey := bytes.Repeat([]byte{1}, encryption.DataKeySize)
+
+	tests := []struct {
+		name            string
+		publicKey       []byte
+		expectedURL     string
+		expectedMessage string
+	}{
+		{
+			name:        "the data key is wrapped for the public key of the request",
+			publicKey:   recipient.PublicKey().Bytes(),
+			expectedURL: "a-url",
+		},
+		{
+			name:            "the request without a public key is processed with an error message",
+			expectedMessage: "the download target is encrypted, but the DownloadRequest has no encryption public key",
+		},
+		{
+			name:            "the request with an invalid public key is processed with an error message",
+			publicKey:       []byte("invalid"),
+			expectedMessage: "error wrapping the data key of the download target",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			downloadRequest := builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").
+				Target(velerov1api.DownloadTargetKindBackupContents, "a-backup").EncryptionPublicKey(test.publicKey).Result()
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+				downloadRequest,
+				builder.ForBackup(velerov1api.DefaultNamespace, "a-backup").StorageLocation("a-location").Result(),
+				builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			)
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetDownloadDataKey", downloadRequest.Spec.Target).Return(dataKey, nil)
+			backupStore.On("GetDownloadURL", downloadRequest.Spec.Target).Return("a-url", nil).Maybe()
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			r := NewDownloadRequestReconciler(
+				fakeClient,
+				testclocks.NewFakeClock(time.Now()),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"a-location": backupStore}),
+				velerotest.NewLogger(),
+				nil,
+				nil,
+			)
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: kbclient.ObjectKeyFromObject(downloadRequest)})
+			require.NoError(t, err)
+
+			instance := &velerov1api.DownloadRequest{}
+			require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKeyFromObject(downloadRequest), instance))
+			assert.Equal(t, test.expectedURL, instance.Status.DownloadURL)
+			assert.Equal(t, velerov1api.DownloadRequestPhaseProcessed, instance.Status.Phase)
+			if test.expectedURL == "" {
+				assert.Contains(t, instance.Status.Message, test.expectedMessage)
+				assert.Nil(t, instance.Status.WrappedEncryptionKey)
+				return
+			}
+			assert.Empty(t, instance.Status.Message)
+
+			assert.NotContains(t, string(instance.Status.WrappedEncryptionKey), string(dataKey))
+			unwrapped, err := encryption.UnwrapKeyForRecipient(recipient, instance.Status.WrappedEncryptionKey)
+			require.NoError(t, err)
+			assert.Equal(t, dataKey, unwrapped)
+		})
+	}
+}
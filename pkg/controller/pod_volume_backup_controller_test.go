@@ -179,7 +179,7 @@ var _ = Describe("PodVolumeBackup Reconciler", func() {
 				test.dataMgr = datapath.NewManager(1)
 			}
 
-			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, *velerov1api.BandwidthLimits, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
 				return &fakeFSBR{
 					pvb:    test.pvb,
 					client: fakeClient,
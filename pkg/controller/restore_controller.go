@@ -46,6 +46,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -53,6 +54,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/restore/dryrun"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -318,6 +321,13 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate included items
+	for _, item := range restore.Spec.IncludedItems {
+		if _, err := archive.ParseItemReference(item); err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included items: %v", err))
+		}
+	}
+
 	// validate that only one exists orLabelSelector or just labelSelector (singular)
 	if restore.Spec.OrLabelSelectors != nil && restore.Spec.LabelSelector != nil {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "encountered labelSelector as well as orLabelSelectors in restore spec, only one can be specified")
@@ -351,6 +361,11 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 	if restore.Spec.ExistingResourcePolicy != "" && !pkgrestoreUtil.IsResourcePolicyValid(string(restore.Spec.ExistingResourcePolicy)) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid ExistingResourcePolicy: %s", restore.Spec.ExistingResourcePolicy))
 	}
+	for resource, policy := range restore.Spec.ExistingResourcePolicyOverrides {
+		if !pkgrestoreUtil.IsResourcePolicyValid(string(policy)) {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid ExistingResourcePolicyOverrides for %s: %s", resource, policy))
+		}
+	}
 
 	// if ScheduleName is specified, fill in BackupName with the most recent successful backup from
 	// the schedule
@@ -643,6 +658,22 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		r.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
+	if boolptr.IsSetToTrue(restore.Spec.DryRun) {
+		if err := putDryRunResults(restore, restoreReq.DryRunResults(), backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading dry-run results to backup storage")
+		}
+
+		// nothing is changed by a dry-run restore, so there is nothing to wait for or finalize
+		if restore.Status.Errors > 0 {
+			restore.Status.Phase = api.RestorePhasePartiallyFailed
+			r.metrics.RegisterRestorePartialFailure(restore.Spec.ScheduleName)
+		} else {
+			restore.Status.Phase = api.RestorePhaseCompleted
+			r.metrics.RegisterRestoreSuccess(restore.Spec.ScheduleName)
+		}
+		return nil
+	}
+
 	if err := putRestoredResourceList(restore, restoreReq.RestoredResourceList(), backupStore); err != nil {
 		r.logger.WithError(err).Error("Error uploading restored resource list to backup storage")
 	}
@@ -752,6 +783,22 @@ func putResults(restore *api.Restore, results map[string]results.Result, backupS
 	return nil
 }
 
+func putDryRunResults(restore *api.Restore, items []dryrun.ItemResult, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(items); err != nil {
+		return errors.Wrap(err, "error encoding dry-run results to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreDryRunResults(restore.Name, buf)
+}
+
 func putRestoredResourceList(restore *api.Restore, list map[string][]string, backupStore persistence.BackupStore) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -48,6 +48,7 @@ import (
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
@@ -256,6 +257,15 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:                     "restore with invalid included item fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "*", "", velerov1api.RestorePhaseNew).IncludedItems("configmaps").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid included items: invalid item \"configmaps\", expected <resource>[.<group>]/[<namespace>/]<name>"},
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
@@ -334,6 +344,18 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedCompletedTime: &timestamp,
 			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).ExistingResourcePolicy("update").Result(),
 		},
+		{
+			name:                  "valid dry-run restore gets executed and completed",
+			location:              defaultStorageLocation,
+			restore:               NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).DryRun(true).Result(),
+			backup:                defaultBackup().StorageLocation("default").Result(),
+			expectedErr:           false,
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedFinalPhase:    string(velerov1api.RestorePhaseCompleted),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).DryRun(true).Result(),
+		},
 		{
 			name:                  "invalid restore with invalid existingresourcepolicy errors",
 			location:              defaultStorageLocation,
@@ -537,9 +559,13 @@ func TestRestoreReconcile(t *testing.T) {
 				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(test.putRestoreLogErr)
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
-				backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
-				backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
-				backupStore.On("PutRestoreVolumeInfo", test.restore.Name, mock.Anything).Return(nil)
+				if boolptr.IsSetToTrue(test.restore.Spec.DryRun) {
+					backupStore.On("PutRestoreDryRunResults", test.restore.Name, mock.Anything).Return(nil)
+				} else {
+					backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
+					backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
+					backupStore.On("PutRestoreVolumeInfo", test.restore.Name, mock.Anything).Return(nil)
+				}
 				if test.emptyVolumeInfo == true {
 					backupStore.On("GetBackupVolumeInfos", test.backup.Name).Return(nil, nil)
 				} else {
@@ -658,6 +684,13 @@ func TestRestoreReconcile(t *testing.T) {
 			// the mock stores the pointer, which gets modified after
 			assert.Equal(t, test.expectedRestorerCall.Spec, restorer.calledWithArg.Spec)
 			assert.Equal(t, test.expectedRestorerCall.Status.Phase, restorer.calledWithArg.Status.Phase)
+
+			// a dry-run restore only uploads the dry-run results and is completed without finalizing
+			if boolptr.IsSetToTrue(test.restore.Spec.DryRun) {
+				restore := new(velerov1api.Restore)
+				require.NoError(t, r.kbClient.Get(context.Background(), types.NamespacedName{Namespace: test.restore.Namespace, Name: test.restore.Name}, restore))
+				assert.Equal(t, velerov1api.RestorePhaseCompleted, restore.Status.Phase)
+			}
 		})
 	}
 }
@@ -19,11 +19,15 @@ package controller
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"time"
+	// embed the IANA time zone database for the time zones of the schedules
+	_ "time/tzdata"
 
 	"github.com/pkg/errors"
 	"github.com/robfig/cron"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -87,7 +91,7 @@ func (c *scheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 // +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=velero.io,resources=schedules/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=create
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=create;list;delete
 
 func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := c.logger.WithField("schedule", req.String())
@@ -119,6 +123,10 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	currentPhase := schedule.Status.Phase
 
 	cronSchedule, errs := parseCronSchedule(schedule, c.logger)
+	errs = append(errs, validateScheduleTiming(schedule)...)
+	if schedule.Spec.Retention != nil {
+		errs = append(errs, validateScheduleRetention(schedule.Spec.Retention)...)
+	}
 	if len(errs) > 0 {
 		schedule.Status.Phase = velerov1.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
@@ -154,13 +162,114 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	// Check for the schedule being due to run.
-	// If there are backup created by this schedule still in New or InProgress state,
-	// skip current backup creation to avoid running overlap backups.
+	// The scheduled runs which can't produce a backup, e.g. because they are skipped by a
+	// blackout window or by the concurrency policy, or they passed the starting deadline
+	// while the server was down, are recorded as missed runs.
 	// As the schedule must be validated before checking whether it's due, we cannot put the checking log in Predicate
-	if c.ifDue(schedule, cronSchedule) && !c.checkIfBackupInNewOrProgress(schedule) {
+	original = schedule.DeepCopy()
+	now := c.clock.Now()
+	var missedRuns int64
+	var lastMissedRun time.Time
+	miss := func(count int64, at time.Time) {
+		missedRuns += count
+		if at.After(lastMissedRun) {
+			lastMissedRun = at
+		}
+	}
+	isDue, nextRunTime := getNextRunTime(schedule, cronSchedule, now)
+	if !isDue {
+		log.WithField("nextRunTime", nextRunTime).Debug("Schedule is not due, skipping")
+	} else if i, end := activeBlackoutWindow(schedule, now); i >= 0 {
+		isDue = false
+		window := schedule.Spec.BlackoutWindows[i]
+		name := window.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i)
+		}
+		if window.Action == velerov1.BlackoutWindowActionSkip {
+			miss(countRunsBefore(schedule, cronSchedule, nextRunTime, now), latestRunBefore(schedule, cronSchedule, nextRunTime, now))
+			schedule.Status.LastSkipped = &metav1.Time{Time: now}
+			schedule.Status.DeferralReason = fmt.Sprintf("the backup due at %s was skipped by blackout window %s", nextRunTime.Format(time.RFC3339), name)
+			_, nextRunTime = getNextRunTime(schedule, cronSchedule, now)
+		} else {
+			schedule.Status.DeferralReason = fmt.Sprintf("the backup due at %s is deferred by blackout window %s until %s", nextRunTime.Format(time.RFC3339), name, end.Format(time.RFC3339))
+			nextRunTime = end
+		}
+		log.Info(schedule.Status.DeferralReason)
+	}
+
+	// only the latest of the due runs can be run, the earlier ones are missed
+	var latestRunTime time.Time
+	if isDue {
+		latestRunTime = latestRunBefore(schedule, cronSchedule, nextRunTime, now)
+	}
+	if isDue && schedule.Spec.StartingDeadlineSeconds != nil && now.Sub(latestRunTime) > time.Duration(*schedule.Spec.StartingDeadlineSeconds)*time.Second {
+		isDue = false
+		miss(countRunsBefore(schedule, cronSchedule, nextRunTime, now), latestRunTime)
+		schedule.Status.LastSkipped = &metav1.Time{Time: now}
+		schedule.Status.DeferralReason = fmt.Sprintf("the backup due at %s was skipped as it passed the starting deadline of %ds", latestRunTime.Format(time.RFC3339), *schedule.Spec.StartingDeadlineSeconds)
+		log.Info(schedule.Status.DeferralReason)
+		_, nextRunTime = getNextRunTime(schedule, cronSchedule, now)
+	}
+
+	if isDue {
+		backups, err := c.getBackupsInNewOrProgress(ctx, schedule)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error listing the backups of schedule %s", req.String())
+		}
+		if len(backups) > 0 {
+			switch schedule.Spec.ConcurrencyPolicy {
+			case velerov1.ScheduleConcurrencyPolicyForbid:
+				isDue = false
+				miss(countRunsBefore(schedule, cronSchedule, nextRunTime, now), latestRunTime)
+				schedule.Status.LastSkipped = &metav1.Time{Time: now}
+				schedule.Status.DeferralReason = fmt.Sprintf("the backup due at %s was skipped as backup %s hasn't finished", latestRunTime.Format(time.RFC3339), backups[0].Name)
+				log.Info(schedule.Status.DeferralReason)
+				_, nextRunTime = getNextRunTime(schedule, cronSchedule, now)
+			case velerov1.ScheduleConcurrencyPolicyReplace:
+				for i := range backups {
+					replaced, err := c.deleteNewBackup(ctx, &backups[i])
+					if err != nil {
+						return ctrl.Result{}, errors.Wrapf(err, "error replacing backup %s of schedule %s", backups[i].Name, req.String())
+					}
+					if replaced {
+						log.Infof("Backup %s hasn't started, replaced it with the due backup", backups[i].Name)
+						miss(1, backups[i].CreationTimestamp.Time)
+					}
+				}
+			default:
+				isDue = false
+				schedule.Status.DeferralReason = fmt.Sprintf("the backup due at %s is queued until backup %s finishes", nextRunTime.Format(time.RFC3339), backups[0].Name)
+				log.Debug(schedule.Status.DeferralReason)
+			}
+		}
+	}
+
+	if isDue {
 		if err := c.submitBackup(ctx, schedule); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "error submit backup for schedule %s", req.String())
 		}
+		original = schedule.DeepCopy()
+		schedule.Status.DeferralReason = ""
+		if latestRunTime.After(nextRunTime) {
+			miss(countRunsBefore(schedule, cronSchedule, nextRunTime, latestRunTime), latestRunBefore(schedule, cronSchedule, nextRunTime, latestRunTime))
+		}
+		_, nextRunTime = getNextRunTime(schedule, cronSchedule, now)
+	}
+
+	if missedRuns > 0 {
+		log.WithField("lastMissedRun", lastMissedRun).Warnf("%d scheduled runs didn't produce a backup", missedRuns)
+		schedule.Status.MissedRuns += missedRuns
+		schedule.Status.LastMissedRun = &metav1.Time{Time: lastMissedRun}
+		c.metrics.RegisterScheduleMissedRuns(schedule.Name, missedRuns, lastMissedRun)
+	}
+	if schedule.Status.NextRunTime == nil || !schedule.Status.NextRunTime.Time.Equal(nextRunTime) {
+		schedule.Status.NextRunTime = &metav1.Time{Time: nextRunTime}
+	}
+	if !equality.Semantic.DeepEqual(original.Status, schedule.Status) {
+		if err := c.Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating the next run time of schedule %s", req.String())
+		}
 	}
 
 	return ctrl.Result{}, nil
@@ -206,9 +315,8 @@ func parseCronSchedule(itm *velerov1.Schedule, logger logrus.FieldLogger) (cron.
 	return schedule, nil
 }
 
-// checkIfBackupInNewOrProgress check whether there are backups created by this schedule still in New or InProgress state
-func (c *scheduleReconciler) checkIfBackupInNewOrProgress(schedule *velerov1.Schedule) bool {
-	log := c.logger.WithField("schedule", kube.NamespaceAndName(schedule))
+// getBackupsInNewOrProgress returns the backups created by this schedule which are still in New or InProgress state
+func (c *scheduleReconciler) getBackupsInNewOrProgress(ctx context.Context, schedule *velerov1.Schedule) ([]velerov1.Backup, error) {
 	backupList := &velerov1.BackupList{}
 	options := &client.ListOptions{
 		Namespace: schedule.Namespace,
@@ -217,32 +325,37 @@ func (c *scheduleReconciler) checkIfBackupInNewOrProgress(schedule *velerov1.Sch
 		}).AsSelector(),
 	}
 
-	err := c.List(context.Background(), backupList, options)
-	if err != nil {
-		log.Errorf("fail to list backup for schedule %s/%s: %s", schedule.Namespace, schedule.Name, err.Error())
-		return true
+	if err := c.List(ctx, backupList, options); err != nil {
+		return nil, errors.Wrapf(err, "fail to list backup for schedule %s/%s", schedule.Namespace, schedule.Name)
 	}
 
+	var backups []velerov1.Backup
 	for _, backup := range backupList.Items {
 		if backup.Status.Phase == velerov1.BackupPhaseNew || backup.Status.Phase == velerov1.BackupPhaseInProgress {
-			log.Debugf("%s/%s still has backups that are in InProgress or New...", schedule.Namespace, schedule.Name)
-			return true
+			backups = append(backups, backup)
 		}
 	}
-	return false
+	if len(backups) > 0 {
+		c.logger.WithField("schedule", kube.NamespaceAndName(schedule)).Debugf("%s/%s still has backups that are in InProgress or New...", schedule.Namespace, schedule.Name)
+	}
+	return backups, nil
 }
 
-// ifDue check whether schedule is due to create a new backup.
-func (c *scheduleReconciler) ifDue(schedule *velerov1.Schedule, cronSchedule cron.Schedule) bool {
-	isDue, nextRunTime := getNextRunTime(schedule, cronSchedule, c.clock.Now())
-	log := c.logger.WithField("schedule", kube.NamespaceAndName(schedule))
-
-	if !isDue {
-		log.WithField("nextRunTime", nextRunTime).Debug("Schedule is not due, skipping")
-		return false
+// deleteNewBackup deletes the backup if it's still in New state, and returns
+// whether it's deleted. A backup which has started can't be interrupted.
+func (c *scheduleReconciler) deleteNewBackup(ctx context.Context, backup *velerov1.Backup) (bool, error) {
+	if backup.Status.Phase != velerov1.BackupPhaseNew {
+		return false, nil
 	}
-
-	return true
+	// the precondition makes sure the backup hasn't been picked up since it's listed
+	err := c.Delete(ctx, backup, client.Preconditions{UID: &backup.UID, ResourceVersion: &backup.ResourceVersion})
+	if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // submitBackup create a backup from schedule.
@@ -278,11 +391,149 @@ func getNextRunTime(schedule *velerov1.Schedule, cronSchedule cron.Schedule, asO
 		lastBackupTime = schedule.Status.LastSkipped.Time
 	}
 
-	nextRunTime := cronSchedule.Next(lastBackupTime)
+	nextRunTime := nextRunAfter(schedule, cronSchedule, lastBackupTime)
 
 	return asOf.After(nextRunTime), nextRunTime
 }
 
+// nextRunAfter returns the first run time of the schedule after t.
+func nextRunAfter(schedule *velerov1.Schedule, cronSchedule cron.Schedule, t time.Time) time.Time {
+	// the run times are the ones of the cron expression plus the jitter
+	jitter := jitterOffset(schedule)
+	return cronSchedule.Next(inScheduleLocation(schedule, t.Add(-jitter))).Add(jitter)
+}
+
+// latestRunBefore returns the latest run time of the schedule before asOf,
+// given from is a run time before asOf.
+func latestRunBefore(schedule *velerov1.Schedule, cronSchedule cron.Schedule, from, asOf time.Time) time.Time {
+	latest := from
+	// look for a run time close to asOf first, to avoid walking through all
+	// the run times since from, which can be plenty after a long downtime
+	for d := time.Minute; asOf.Add(-d).After(from); d *= 2 {
+		if t := nextRunAfter(schedule, cronSchedule, asOf.Add(-d)); t.Before(asOf) {
+			latest = t
+			break
+		}
+	}
+	for t := nextRunAfter(schedule, cronSchedule, latest); t.Before(asOf); t = nextRunAfter(schedule, cronSchedule, t) {
+		latest = t
+	}
+	return latest
+}
+
+// maxCountedRuns is the maximum number of run times countRunsBefore walks through.
+const maxCountedRuns = 1000000
+
+// countRunsBefore returns the number of run times of the schedule from from,
+// which is a run time, until asOf. It's capped at maxCountedRuns.
+func countRunsBefore(schedule *velerov1.Schedule, cronSchedule cron.Schedule, from, asOf time.Time) int64 {
+	var count int64
+	for t := from; t.Before(asOf) && count < maxCountedRuns; t = nextRunAfter(schedule, cronSchedule, t) {
+		count++
+	}
+	return count
+}
+
+// scheduleLocation returns the time zone the schedule is evaluated in.
+func scheduleLocation(schedule *velerov1.Schedule) (*time.Location, error) {
+	if schedule.Spec.TimeZone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(schedule.Spec.TimeZone)
+}
+
+// inScheduleLocation returns the time in the time zone of the schedule. It's
+// returned as is if the schedule doesn't specify a valid time zone.
+func inScheduleLocation(schedule *velerov1.Schedule, t time.Time) time.Time {
+	if schedule.Spec.TimeZone == "" {
+		return t
+	}
+	location, err := scheduleLocation(schedule)
+	if err != nil {
+		return t
+	}
+	return t.In(location)
+}
+
+// jitterOffset returns the delay added to the run times of the schedule, which
+// is derived from the schedule's namespace and name to stay the same across runs.
+func jitterOffset(schedule *velerov1.Schedule) time.Duration {
+	if schedule.Spec.Jitter.Duration <= 0 {
+		return 0
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(schedule.Namespace + "/" + schedule.Name))
+	return time.Duration(hash.Sum64() % uint64(schedule.Spec.Jitter.Duration))
+}
+
+// activeBlackoutWindow returns the index of the first blackout window of the
+// schedule which asOf is in, and the time the window ends. The index is -1 if
+// asOf isn't in any window.
+func activeBlackoutWindow(schedule *velerov1.Schedule, asOf time.Time) (int, time.Time) {
+	for i, window := range schedule.Spec.BlackoutWindows {
+		start, err := parseStandardCron(window.Start)
+		if err != nil {
+			continue
+		}
+		// the first start of the window after asOf-duration is the start of
+		// the window asOf is in if it isn't after asOf
+		windowStart := start.Next(inScheduleLocation(schedule, asOf.Add(-window.Duration.Duration)))
+		if !windowStart.After(asOf) {
+			return i, windowStart.Add(window.Duration.Duration)
+		}
+	}
+	return -1, time.Time{}
+}
+
+// validateScheduleTiming returns the validation errors of the time zone,
+// blackout windows, jitter, concurrency policy and starting deadline of the
+// schedule.
+func validateScheduleTiming(schedule *velerov1.Schedule) []string {
+	var errs []string
+	if _, err := scheduleLocation(schedule); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid timeZone: %v", err))
+	}
+	if schedule.Spec.Jitter.Duration < 0 {
+		errs = append(errs, "jitter cannot be negative")
+	}
+	switch schedule.Spec.ConcurrencyPolicy {
+	case "", velerov1.ScheduleConcurrencyPolicyForbid, velerov1.ScheduleConcurrencyPolicyQueue, velerov1.ScheduleConcurrencyPolicyReplace:
+	default:
+		errs = append(errs, fmt.Sprintf("invalid concurrencyPolicy: %s", schedule.Spec.ConcurrencyPolicy))
+	}
+	if schedule.Spec.StartingDeadlineSeconds != nil && *schedule.Spec.StartingDeadlineSeconds < 0 {
+		errs = append(errs, "startingDeadlineSeconds cannot be negative")
+	}
+	for i, window := range schedule.Spec.BlackoutWindows {
+		if _, err := parseStandardCron(window.Start); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid start of blackoutWindows[%d]: %v", i, err))
+		}
+		if window.Duration.Duration <= 0 {
+			errs = append(errs, fmt.Sprintf("duration of blackoutWindows[%d] must be positive", i))
+		}
+		switch window.Action {
+		case "", velerov1.BlackoutWindowActionDefer, velerov1.BlackoutWindowActionSkip:
+		default:
+			errs = append(errs, fmt.Sprintf("invalid action of blackoutWindows[%d]: %s", i, window.Action))
+		}
+	}
+	return errs
+}
+
+// parseStandardCron parses the standard Cron expression, without panicking on
+// the invalid ones.
+func parseStandardCron(expression string) (schedule cron.Schedule, err error) {
+	if expression == "" {
+		return nil, errors.New("empty Cron expression")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("%v", r)
+		}
+	}()
+	return cron.ParseStandard(expression)
+}
+
 func getBackup(item *velerov1.Schedule, timestamp time.Time) *velerov1.Backup {
 	name := item.TimestampedName(timestamp)
 	return builder.
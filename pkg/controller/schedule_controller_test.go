@@ -55,6 +55,9 @@ func TestReconcileOfSchedule(t *testing.T) {
 		expectedBackupCreate      *velerov1.Backup
 		expectedLastBackup        string
 		expectedLastSkipped       string
+		expectedDeferralReason    string
+		expectedMissedRuns        int64
+		expectedLastMissedRun     string
 		backup                    *velerov1.Backup
 		reconcilerSkipImmediately bool
 	}{
@@ -84,6 +87,14 @@ func TestReconcileOfSchedule(t *testing.T) {
 			expectedPhase:            string(velerov1.SchedulePhaseFailedValidation),
 			expectedValidationErrors: []string{"Schedule must be a non-empty valid Cron expression"},
 		},
+		{
+			name: "schedule with phase New gets validated and failed if retention is invalid",
+			schedule: newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").Retention(&velerov1.ScheduleRetention{
+				ScheduleRetentionRules: velerov1.ScheduleRetentionRules{KeepDaily: -1},
+			}).Result(),
+			expectedPhase:            string(velerov1.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"retention must keep at least one backup", "retention.keepDaily cannot be negative"},
+		},
 		{
 			name:                 "schedule with phase New gets validated and triggers a backup",
 			schedule:             newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").Result(),
@@ -143,12 +154,96 @@ func TestReconcileOfSchedule(t *testing.T) {
 			expectedLastBackup:  "2000-01-01 00:00:00",
 			expectedLastSkipped: "2017-01-01 12:00:00",
 		},
+		{
+			name: "schedule due in a blackout window gets deferred",
+			schedule: newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").
+				BlackoutWindows(velerov1.BlackoutWindow{Name: "freeze", Start: "0 12 * * *", Duration: metav1.Duration{Duration: time.Hour}}).Result(),
+			fakeClockTime:          "2017-01-01 12:00:00",
+			expectedLastBackup:     "2000-01-01 00:00:00",
+			expectedDeferralReason: "the backup due at 2000-01-01T00:05:00Z is deferred by blackout window freeze until 2017-01-01T13:00:00Z",
+		},
+		{
+			name: "schedule due in a blackout window gets skipped",
+			schedule: newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").
+				BlackoutWindows(velerov1.BlackoutWindow{Start: "0 12 * * *", Duration: metav1.Duration{Duration: time.Hour}, Action: velerov1.BlackoutWindowActionSkip}).Result(),
+			fakeClockTime:          "2017-01-01 12:00:00",
+			expectedLastBackup:     "2000-01-01 00:00:00",
+			expectedLastSkipped:    "2017-01-01 12:00:00",
+			expectedDeferralReason: "the backup due at 2000-01-01T00:05:00Z was skipped by blackout window #0",
+		},
+		{
+			name: "schedule due out of blackout windows triggers a backup",
+			schedule: newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").
+				BlackoutWindows(velerov1.BlackoutWindow{Start: "0 12 * * *", Duration: metav1.Duration{Duration: time.Hour}}).Result(),
+			fakeClockTime:        "2017-01-01 13:00:00",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101130000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 13:00:00",
+		},
+		{
+			name: "schedule with invalid time zone and blackout window gets failed",
+			schedule: newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").TimeZone("Mars/Olympus").
+				BlackoutWindows(velerov1.BlackoutWindow{Start: "0 12 * *"}).Result(),
+			expectedPhase: string(velerov1.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				"invalid timeZone: unknown time zone Mars/Olympus",
+				"invalid start of blackoutWindows[0]: Expected exactly 5 fields, found 4: 0 12 * *",
+				"duration of blackoutWindows[0] must be positive",
+			},
+		},
 		{
 			name:          "schedule already has backup in New state.",
 			schedule:      newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Result(),
 			expectedPhase: string(velerov1.SchedulePhaseEnabled),
 			backup:        builder.ForBackup("ns", "name-20220905120000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Phase(velerov1.BackupPhaseNew).Result(),
 		},
+		{
+			name:                   "schedule with Queue concurrency policy waits for the backup in InProgress state",
+			schedule:               newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:50:00").ConcurrencyPolicy(velerov1.ScheduleConcurrencyPolicyQueue).Result(),
+			fakeClockTime:          "2017-01-01 12:01:00",
+			expectedLastBackup:     "2017-01-01 11:50:00",
+			expectedDeferralReason: "the backup due at 2017-01-01T11:55:00Z is queued until backup name-20170101115000 finishes",
+			backup:                 builder.ForBackup("ns", "name-20170101115000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Phase(velerov1.BackupPhaseInProgress).Result(),
+		},
+		{
+			name:                   "schedule with Forbid concurrency policy records the missed runs",
+			schedule:               newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:50:00").ConcurrencyPolicy(velerov1.ScheduleConcurrencyPolicyForbid).Result(),
+			fakeClockTime:          "2017-01-01 12:01:00",
+			expectedLastBackup:     "2017-01-01 11:50:00",
+			expectedLastSkipped:    "2017-01-01 12:01:00",
+			expectedDeferralReason: "the backup due at 2017-01-01T12:00:00Z was skipped as backup name-20170101115000 hasn't finished",
+			expectedMissedRuns:     2,
+			expectedLastMissedRun:  "2017-01-01 12:00:00",
+			backup:                 builder.ForBackup("ns", "name-20170101115000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Phase(velerov1.BackupPhaseInProgress).Result(),
+		},
+		{
+			name:                  "schedule with Replace concurrency policy replaces the backup in New state",
+			schedule:              newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:50:00").ConcurrencyPolicy(velerov1.ScheduleConcurrencyPolicyReplace).Result(),
+			fakeClockTime:         "2017-01-01 12:01:00",
+			expectedBackupCreate:  builder.ForBackup("ns", "name-20170101120100").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:    "2017-01-01 12:01:00",
+			expectedMissedRuns:    2,
+			expectedLastMissedRun: "2017-01-01 11:55:00",
+			backup:                builder.ForBackup("ns", "name-20170101115000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Phase(velerov1.BackupPhaseNew).Result(),
+		},
+		{
+			name:                   "schedule past the starting deadline records the missed runs",
+			schedule:               newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:50:00").StartingDeadlineSeconds(30).Result(),
+			fakeClockTime:          "2017-01-01 12:01:00",
+			expectedLastBackup:     "2017-01-01 11:50:00",
+			expectedLastSkipped:    "2017-01-01 12:01:00",
+			expectedDeferralReason: "the backup due at 2017-01-01T12:00:00Z was skipped as it passed the starting deadline of 30s",
+			expectedMissedRuns:     2,
+			expectedLastMissedRun:  "2017-01-01 12:00:00",
+		},
+		{
+			name:                  "schedule within the starting deadline triggers a backup and records the earlier missed runs",
+			schedule:              newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:50:00").StartingDeadlineSeconds(120).Result(),
+			fakeClockTime:         "2017-01-01 12:01:00",
+			expectedBackupCreate:  builder.ForBackup("ns", "name-20170101120100").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:    "2017-01-01 12:01:00",
+			expectedMissedRuns:    1,
+			expectedLastMissedRun: "2017-01-01 11:55:00",
+		},
 	}
 
 	for _, test := range tests {
@@ -205,6 +300,16 @@ func TestReconcileOfSchedule(t *testing.T) {
 				require.NotNil(t, schedule.Status.LastSkipped)
 				assert.Equal(t, parseTime(test.expectedLastSkipped).Unix(), schedule.Status.LastSkipped.Unix())
 			}
+			if len(test.expectedDeferralReason) > 0 {
+				require.Nil(t, err)
+				assert.Equal(t, test.expectedDeferralReason, schedule.Status.DeferralReason)
+			}
+			if test.expectedMissedRuns > 0 {
+				require.Nil(t, err)
+				assert.Equal(t, test.expectedMissedRuns, schedule.Status.MissedRuns)
+				require.NotNil(t, schedule.Status.LastMissedRun)
+				assert.Equal(t, parseTime(test.expectedLastMissedRun).Unix(), schedule.Status.LastMissedRun.Unix())
+			}
 
 			// we expect reconcile to flip SkipImmediately to false if it's true or the server is configured to skip immediately and the schedule doesn't have it set
 			if scheduleb4reconcile.Spec.SkipImmediately != nil && *scheduleb4reconcile.Spec.SkipImmediately ||
@@ -216,8 +321,8 @@ func TestReconcileOfSchedule(t *testing.T) {
 			require.Nil(t, client.List(ctx, backups))
 
 			// If backup associated with schedule's status is in New or InProgress,
-			// new backup shouldn't be submitted.
-			if test.backup != nil &&
+			// new backup shouldn't be submitted unless it's replaced.
+			if test.backup != nil && test.expectedBackupCreate == nil &&
 				(test.backup.Status.Phase == velerov1.BackupPhaseNew || test.backup.Status.Phase == velerov1.BackupPhaseInProgress) {
 				assert.Len(t, backups.Items, 1)
 				require.Nil(t, client.Delete(ctx, test.backup))
@@ -370,6 +475,85 @@ func TestParseCronSchedule(t *testing.T) {
 	assert.Equal(t, time.Date(2017, 8, 12, 9, 0, 0, 0, time.UTC), next)
 }
 
+func TestGetNextRunTimeWithTimeZoneAndJitter(t *testing.T) {
+	lastBackup := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 3 * * *").TimeZone("Asia/Tokyo").Result()
+	s.Status.LastBackup = &metav1.Time{Time: lastBackup}
+
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	// 3am in Tokyo is 6pm in UTC
+	due, next := getNextRunTime(s, c, lastBackup)
+	assert.False(t, due)
+	assert.True(t, time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC).Equal(next))
+
+	s.Spec.Jitter = metav1.Duration{Duration: time.Hour}
+	jitter := jitterOffset(s)
+	assert.GreaterOrEqual(t, jitter, time.Duration(0))
+	assert.Less(t, jitter, time.Hour)
+
+	_, next = getNextRunTime(s, c, lastBackup)
+	assert.True(t, time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC).Add(jitter).Equal(next))
+
+	// the backup run with the jitter isn't due again until the next day
+	s.Status.LastBackup = &metav1.Time{Time: next.Add(time.Second)}
+	due, next = getNextRunTime(s, c, next.Add(time.Minute))
+	assert.False(t, due)
+	assert.True(t, time.Date(2024, 1, 2, 18, 0, 0, 0, time.UTC).Add(jitter).Equal(next))
+
+	// the jitter is different for the schedules with the same cron expression
+	other := s.DeepCopy()
+	other.Name = "schedule-2"
+	assert.NotEqual(t, jitter, jitterOffset(other))
+}
+
+func TestActiveBlackoutWindow(t *testing.T) {
+	s := builder.ForSchedule("velero", "schedule-1").TimeZone("Europe/Berlin").BlackoutWindows(
+		velerov1.BlackoutWindow{Name: "business-hours", Start: "0 9 * * 1-5", Duration: metav1.Duration{Duration: 8 * time.Hour}},
+	).Result()
+
+	tests := []struct {
+		name          string
+		asOf          time.Time
+		expectedIndex int
+		expectedEnd   time.Time
+	}{
+		{
+			name:          "in the window",
+			asOf:          time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC),
+			expectedIndex: 0,
+			expectedEnd:   time.Date(2024, 1, 8, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "at the start of the window",
+			asOf:          time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC),
+			expectedIndex: 0,
+			expectedEnd:   time.Date(2024, 1, 8, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "at the end of the window",
+			asOf:          time.Date(2024, 1, 8, 16, 0, 0, 0, time.UTC),
+			expectedIndex: -1,
+		},
+		{
+			name:          "in the weekend",
+			asOf:          time.Date(2024, 1, 6, 10, 0, 0, 0, time.UTC),
+			expectedIndex: -1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index, end := activeBlackoutWindow(s, test.asOf)
+			assert.Equal(t, test.expectedIndex, index)
+			if test.expectedIndex >= 0 {
+				assert.True(t, test.expectedEnd.Equal(end), "expected %v, got %v", test.expectedEnd, end)
+			}
+		})
+	}
+}
+
 func TestGetBackup(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -443,7 +627,7 @@ func TestGetBackup(t *testing.T) {
 	}
 }
 
-func TestCheckIfBackupInNewOrProgress(t *testing.T) {
+func TestGetBackupsInNewOrProgress(t *testing.T) {
 	require.Nil(t, velerov1.AddToScheme(scheme.Scheme))
 
 	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
@@ -452,31 +636,58 @@ func TestCheckIfBackupInNewOrProgress(t *testing.T) {
 	// Create testing schedule
 	testSchedule := builder.ForSchedule("ns", "name").Phase(velerov1.SchedulePhaseEnabled).Result()
 	err := client.Create(ctx, testSchedule)
-	require.NoError(t, err, "fail to create schedule in TestCheckIfBackupInNewOrProgress: %v", err)
+	require.NoError(t, err, "fail to create schedule in TestGetBackupsInNewOrProgress: %v", err)
 
 	// Create backup in New phase.
 	newBackup := builder.ForBackup("ns", "backup-1").
 		ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).
 		Phase(velerov1.BackupPhaseNew).Result()
 	err = client.Create(ctx, newBackup)
-	require.NoError(t, err, "fail to create backup in New phase in TestCheckIfBackupInNewOrProgress: %v", err)
+	require.NoError(t, err, "fail to create backup in New phase in TestGetBackupsInNewOrProgress: %v", err)
 
 	reconciler := NewScheduleReconciler("ns", logger, client, metrics.NewServerMetrics(), false)
-	result := reconciler.checkIfBackupInNewOrProgress(testSchedule)
-	assert.True(t, result)
+	backups, err := reconciler.getBackupsInNewOrProgress(ctx, testSchedule)
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "backup-1", backups[0].Name)
 
 	// Clean backup in New phase.
 	err = client.Delete(ctx, newBackup)
-	require.NoError(t, err, "fail to delete backup in New phase in TestCheckIfBackupInNewOrProgress: %v", err)
+	require.NoError(t, err, "fail to delete backup in New phase in TestGetBackupsInNewOrProgress: %v", err)
 
 	// Create backup in InProgress phase.
 	inProgressBackup := builder.ForBackup("ns", "backup-2").
 		ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).
 		Phase(velerov1.BackupPhaseInProgress).Result()
 	err = client.Create(ctx, inProgressBackup)
-	require.NoError(t, err, "fail to create backup in InProgress phase in TestCheckIfBackupInNewOrProgress: %v", err)
+	require.NoError(t, err, "fail to create backup in InProgress phase in TestGetBackupsInNewOrProgress: %v", err)
+
+	// Create backup in Completed phase.
+	completedBackup := builder.ForBackup("ns", "backup-3").
+		ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).
+		Phase(velerov1.BackupPhaseCompleted).Result()
+	err = client.Create(ctx, completedBackup)
+	require.NoError(t, err, "fail to create backup in Completed phase in TestGetBackupsInNewOrProgress: %v", err)
 
 	reconciler = NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics(), false)
-	result = reconciler.checkIfBackupInNewOrProgress(testSchedule)
-	assert.True(t, result)
+	backups, err = reconciler.getBackupsInNewOrProgress(ctx, testSchedule)
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "backup-2", backups[0].Name)
+}
+
+func TestLatestRunBeforeAndCountRunsBefore(t *testing.T) {
+	schedule := builder.ForSchedule("ns", "name").Result()
+	cronSchedule, err := cron.ParseStandard("0 * * * *")
+	require.NoError(t, err)
+
+	from := parseTime("2017-01-01 00:00:00")
+	asOf := parseTime("2017-01-08 12:30:00")
+	assert.Equal(t, parseTime("2017-01-08 12:00:00"), latestRunBefore(schedule, cronSchedule, from, asOf))
+	assert.Equal(t, int64(7*24+13), countRunsBefore(schedule, cronSchedule, from, asOf))
+
+	// from is the only run time before asOf
+	asOf = parseTime("2017-01-01 00:30:00")
+	assert.Equal(t, from, latestRunBefore(schedule, cronSchedule, from, asOf))
+	assert.Equal(t, int64(1), countRunsBefore(schedule, cronSchedule, from, asOf))
 }
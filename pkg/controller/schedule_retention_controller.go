@@ -0,0 +1,275 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// scheduleRetentionReconciler creates DeleteBackupRequests for the backups of
+// a schedule which aren't kept by the schedule's retention policy.
+type scheduleRetentionReconciler struct {
+	client.Client
+	logger    logrus.FieldLogger
+	frequency time.Duration
+}
+
+// NewScheduleRetentionReconciler constructs a new scheduleRetentionReconciler.
+func NewScheduleRetentionReconciler(
+	logger logrus.FieldLogger,
+	client client.Client,
+	frequency time.Duration,
+) *scheduleRetentionReconciler {
+	r := &scheduleRetentionReconciler{
+		Client:    client,
+		logger:    logger,
+		frequency: frequency,
+	}
+	if r.frequency <= 0 {
+		r.frequency = defaultGCFrequency
+	}
+	return r
+}
+
+func (r *scheduleRetentionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger, mgr.GetClient(), &velerov1api.ScheduleList{}, r.frequency, kube.PeriodicalEnqueueSourceOption{})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(ScheduleRetention).
+		For(&velerov1api.Schedule{}, builder.WithPredicates(kube.SpecChangePredicate{})).
+		WatchesRawSource(s, nil).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *scheduleRetentionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("schedule", req.String())
+
+	schedule := &velerov1api.Schedule{}
+	if err := r.Get(ctx, req.NamespacedName, schedule); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("schedule not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting schedule %s", req.String())
+	}
+
+	if schedule.Spec.Retention == nil {
+		return ctrl.Result{}, nil
+	}
+	if errs := validateScheduleRetention(schedule.Spec.Retention); len(errs) > 0 {
+		log.Warnf("Skip applying the retention policy of the schedule as it's invalid: %v", errs)
+		return ctrl.Result{}, nil
+	}
+
+	backups := &velerov1api.BackupList{}
+	if err := r.List(ctx, backups, client.InNamespace(schedule.Namespace), client.MatchingLabels{
+		velerov1api.ScheduleNameLabel: label.GetValidName(schedule.Name),
+	}); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error listing backups of schedule %s", req.String())
+	}
+
+	var completed, partiallyFailed []velerov1api.Backup
+	for _, backup := range backups.Items {
+		switch backup.Status.Phase {
+		case velerov1api.BackupPhaseCompleted:
+			completed = append(completed, backup)
+		case velerov1api.BackupPhasePartiallyFailed:
+			partiallyFailed = append(partiallyFailed, backup)
+		}
+	}
+
+	// rules for the PartiallyFailed backups which don't keep any backup are taken as
+	// unset, rather than deleting every PartiallyFailed backup
+	partiallyFailedRules := schedule.Spec.Retention.ScheduleRetentionRules
+	if rules := schedule.Spec.Retention.PartiallyFailed; rules != nil && !rules.IsEmpty() {
+		partiallyFailedRules = *rules
+	}
+
+	// the periods are in the time zone the schedule runs in
+	location, err := scheduleLocation(schedule)
+	if err != nil {
+		log.WithError(err).Warn("Skip applying the retention policy of the schedule as its time zone is invalid")
+		return ctrl.Result{}, nil
+	}
+
+	expired := append(
+		backupsNotRetained(completed, schedule.Spec.Retention.ScheduleRetentionRules, location),
+		backupsNotRetained(partiallyFailed, partiallyFailedRules, location)...,
+	)
+
+	for i := range expired {
+		if err := r.requestDeletion(ctx, &expired[i], log); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// requestDeletion creates a DeleteBackupRequest for the backup unless there's
+// a pending one or the backup storage location isn't writable.
+func (r *scheduleRetentionReconciler) requestDeletion(ctx context.Context, backup *velerov1api.Backup, log logrus.FieldLogger) error {
+	log = log.WithField("backup", backup.Name)
+
+	loc := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.StorageLocation}, loc); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Warnf("Backup isn't retained but cannot be deleted because backup storage location %s does not exist", backup.Spec.StorageLocation)
+			return nil
+		}
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+	if loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		log.Infof("Backup isn't retained but cannot be deleted because backup storage location %s is currently in read-only mode", loc.Name)
+		return nil
+	}
+
+	dbrs := &velerov1api.DeleteBackupRequestList{}
+	if err := r.List(ctx, dbrs, client.InNamespace(backup.Namespace), client.MatchingLabels{
+		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+		velerov1api.BackupUIDLabel:  string(backup.UID),
+	}); err != nil {
+		return errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")
+	}
+	for _, dbr := range dbrs.Items {
+		switch dbr.Status.Phase {
+		case "", velerov1api.DeleteBackupRequestPhaseNew, velerov1api.DeleteBackupRequestPhaseInProgress:
+			log.Debug("Backup already has a pending deletion request")
+			return nil
+		}
+	}
+
+	log.Info("Backup isn't retained by the schedule's retention policy, creating a new deletion request")
+	dbr := pkgbackup.NewDeleteBackupRequest(backup.Name, string(backup.UID))
+	dbr.SetNamespace(backup.Namespace)
+	if err := veleroclient.CreateRetryGenerateName(r, ctx, dbr); err != nil {
+		return errors.Wrap(err, "error creating DeleteBackupRequest")
+	}
+	return nil
+}
+
+// validateScheduleRetention returns the validation errors of the retention policy.
+func validateScheduleRetention(retention *velerov1api.ScheduleRetention) []string {
+	var errs []string
+	if retention.IsEmpty() {
+		errs = append(errs, "retention must keep at least one backup")
+	}
+	errs = append(errs, validateScheduleRetentionRules("retention", retention.ScheduleRetentionRules)...)
+	if retention.PartiallyFailed != nil {
+		errs = append(errs, validateScheduleRetentionRules("retention.partiallyFailed", *retention.PartiallyFailed)...)
+	}
+	return errs
+}
+
+func validateScheduleRetentionRules(field string, rules velerov1api.ScheduleRetentionRules) []string {
+	var errs []string
+	for name, value := range map[string]int{
+		"keepLast":    rules.KeepLast,
+		"keepHourly":  rules.KeepHourly,
+		"keepDaily":   rules.KeepDaily,
+		"keepWeekly":  rules.KeepWeekly,
+		"keepMonthly": rules.KeepMonthly,
+		"keepYearly":  rules.KeepYearly,
+	} {
+		if value < 0 {
+			errs = append(errs, fmt.Sprintf("%s.%s cannot be negative", field, name))
+		}
+	}
+	sort.Strings(errs)
+	return errs
+}
+
+// backupsNotRetained returns the backups which aren't kept by any of the rules,
+// the periods are evaluated in the location.
+func backupsNotRetained(backups []velerov1api.Backup, rules velerov1api.ScheduleRetentionRules, location *time.Location) []velerov1api.Backup {
+	sorted := make([]velerov1api.Backup, len(backups))
+	copy(sorted, backups)
+	// the latest first
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return backupTime(&sorted[i]).After(backupTime(&sorted[j]))
+	})
+
+	kept := sets.New[string]()
+	for i := 0; i < rules.KeepLast && i < len(sorted); i++ {
+		kept.Insert(sorted[i].Name)
+	}
+
+	for _, period := range []struct {
+		count int
+		key   func(time.Time) string
+	}{
+		{rules.KeepHourly, func(t time.Time) string { return t.Format("2006-01-02 15") }},
+		{rules.KeepDaily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{rules.KeepWeekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%02d", year, week)
+		}},
+		{rules.KeepMonthly, func(t time.Time) string { return t.Format("2006-01") }},
+		{rules.KeepYearly, func(t time.Time) string { return t.Format("2006") }},
+	} {
+		last, periods := "", 0
+		for i := range sorted {
+			if periods >= period.count {
+				break
+			}
+			// keep the latest backup of each period
+			if key := period.key(backupTime(&sorted[i]).In(location)); key != last {
+				kept.Insert(sorted[i].Name)
+				last = key
+				periods++
+			}
+		}
+	}
+
+	var notRetained []velerov1api.Backup
+	for _, backup := range sorted {
+		if !kept.Has(backup.Name) {
+			notRetained = append(notRetained, backup)
+		}
+	}
+	return notRetained
+}
+
+// backupTime returns the time the backup was started at, or created at if it
+// hasn't been started.
+func backupTime(backup *velerov1api.Backup) time.Time {
+	if backup.Status.StartTimestamp != nil {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
@@ -0,0 +1,265 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupsNotRetained(t *testing.T) {
+	newBackup := func(name, startTime string) velerov1api.Backup {
+		start, err := time.Parse(time.RFC3339, startTime)
+		require.NoError(t, err)
+		return *builder.ForBackup(velerov1api.DefaultNamespace, name).StartTimestamp(start).Result()
+	}
+
+	backups := []velerov1api.Backup{
+		newBackup("b-2024-01-01-00", "2024-01-01T00:00:00Z"),
+		newBackup("b-2024-01-01-12", "2024-01-01T12:00:00Z"),
+		newBackup("b-2024-01-02-00", "2024-01-02T00:00:00Z"),
+		newBackup("b-2024-01-08-00", "2024-01-08T00:00:00Z"),
+		newBackup("b-2024-02-01-00", "2024-02-01T00:00:00Z"),
+		newBackup("b-2024-02-01-01", "2024-02-01T01:00:00Z"),
+		newBackup("b-2024-02-01-02", "2024-02-01T02:00:00Z"),
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		rules    velerov1api.ScheduleRetentionRules
+		location *time.Location
+		expected []string
+	}{
+		{
+			name:     "keep last",
+			rules:    velerov1api.ScheduleRetentionRules{KeepLast: 2},
+			expected: []string{"b-2024-02-01-00", "b-2024-01-08-00", "b-2024-01-02-00", "b-2024-01-01-12", "b-2024-01-01-00"},
+		},
+		{
+			name:     "keep hourly",
+			rules:    velerov1api.ScheduleRetentionRules{KeepHourly: 4},
+			expected: []string{"b-2024-01-02-00", "b-2024-01-01-12", "b-2024-01-01-00"},
+		},
+		{
+			name:     "keep daily keeps the latest backup of each day",
+			rules:    velerov1api.ScheduleRetentionRules{KeepDaily: 4},
+			expected: []string{"b-2024-02-01-01", "b-2024-02-01-00", "b-2024-01-01-00"},
+		},
+		{
+			name:     "keep weekly",
+			rules:    velerov1api.ScheduleRetentionRules{KeepWeekly: 2},
+			expected: []string{"b-2024-02-01-01", "b-2024-02-01-00", "b-2024-01-02-00", "b-2024-01-01-12", "b-2024-01-01-00"},
+		},
+		{
+			name:     "keep monthly and yearly",
+			rules:    velerov1api.ScheduleRetentionRules{KeepMonthly: 2, KeepYearly: 1},
+			expected: []string{"b-2024-02-01-01", "b-2024-02-01-00", "b-2024-01-02-00", "b-2024-01-01-12", "b-2024-01-01-00"},
+		},
+		{
+			name:     "the backups kept by any of the rules are retained",
+			rules:    velerov1api.ScheduleRetentionRules{KeepLast: 2, KeepDaily: 3, KeepMonthly: 3},
+			expected: []string{"b-2024-02-01-00", "b-2024-01-01-12", "b-2024-01-01-00"},
+		},
+		{
+			name:     "the periods are in the location",
+			rules:    velerov1api.ScheduleRetentionRules{KeepDaily: 4},
+			location: newYork,
+			expected: []string{"b-2024-02-01-01", "b-2024-02-01-00", "b-2024-01-01-12"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			location := test.location
+			if location == nil {
+				location = time.UTC
+			}
+			var names []string
+			for _, backup := range backupsNotRetained(backups, test.rules, location) {
+				names = append(names, backup.Name)
+			}
+			assert.Equal(t, test.expected, names)
+		})
+	}
+}
+
+func TestScheduleRetentionReconcile(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	readOnlyLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result()
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	newBackup := func(name string, phase velerov1api.BackupPhase, age time.Duration) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).
+			StorageLocation("default").
+			Phase(phase).
+			StartTimestamp(now.Add(-age)).
+			Result()
+	}
+
+	tests := []struct {
+		name      string
+		schedule  *velerov1api.Schedule
+		backups   []*velerov1api.Backup
+		dbrs      []*velerov1api.DeleteBackupRequest
+		location  *velerov1api.BackupStorageLocation
+		deletions []string
+	}{
+		{
+			name:     "schedule without retention deletes nothing",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Result(),
+			backups: []*velerov1api.Backup{
+				newBackup("backup-1", velerov1api.BackupPhaseCompleted, time.Hour),
+				newBackup("backup-2", velerov1api.BackupPhaseCompleted, 2*time.Hour),
+			},
+		},
+		{
+			name: "completed and partially failed backups are counted separately",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(&velerov1api.ScheduleRetention{
+				ScheduleRetentionRules: velerov1api.ScheduleRetentionRules{KeepLast: 1},
+			}).Result(),
+			backups: []*velerov1api.Backup{
+				newBackup("backup-1", velerov1api.BackupPhaseCompleted, time.Hour),
+				newBackup("backup-2", velerov1api.BackupPhasePartiallyFailed, 2*time.Hour),
+				newBackup("backup-3", velerov1api.BackupPhaseCompleted, 3*time.Hour),
+				newBackup("backup-4", velerov1api.BackupPhasePartiallyFailed, 4*time.Hour),
+				newBackup("backup-5", velerov1api.BackupPhaseFailed, 5*time.Hour),
+				newBackup("backup-6", velerov1api.BackupPhaseInProgress, 6*time.Hour),
+			},
+			deletions: []string{"backup-3", "backup-4"},
+		},
+		{
+			name: "partially failed backups use their own rules",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(&velerov1api.ScheduleRetention{
+				ScheduleRetentionRules: velerov1api.ScheduleRetentionRules{KeepLast: 2},
+				PartiallyFailed:        &velerov1api.ScheduleRetentionRules{KeepLast: 1},
+			}).Result(),
+			backups: []*velerov1api.Backup{
+				newBackup("backup-1", velerov1api.BackupPhaseCompleted, time.Hour),
+				newBackup("backup-2", velerov1api.BackupPhasePartiallyFailed, 2*time.Hour),
+				newBackup("backup-3", velerov1api.BackupPhaseCompleted, 3*time.Hour),
+				newBackup("backup-4", velerov1api.BackupPhasePartiallyFailed, 4*time.Hour),
+			},
+			deletions: []string{"backup-4"},
+		},
+		{
+			name: "empty rules of partially failed backups are ignored",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(&velerov1api.ScheduleRetention{
+				ScheduleRetentionRules: velerov1api.ScheduleRetentionRules{KeepLast: 2},
+				PartiallyFailed:        &velerov1api.ScheduleRetentionRules{},
+			}).Result(),
+			backups: []*velerov1api.Backup{
+				newBackup("backup-1", velerov1api.BackupPhaseCompleted, time.Hour),
+				newBackup("backup-2", velerov1api.BackupPhasePartiallyFailed, 2*time.Hour),
+				newBackup("backup-3", velerov1api.BackupPhaseCompleted, 3*time.Hour),
+				newBackup("backup-4", velerov1api.BackupPhasePartiallyFailed, 4*time.Hour),
+				newBackup("backup-5", velerov1api.BackupPhasePartiallyFailed, 5*time.Hour),
+			},
+			deletions: []string{"backup-5"},
+		},
+		{
+			name: "backups with a pending deletion request are skipped",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(&velerov1api.ScheduleRetention{
+				ScheduleRetentionRules: velerov1api.ScheduleRetentionRules{KeepLast: 1},
+			}).Result(),
+			backups: []*velerov1api.Backup{
+				newBackup("backup-1", velerov1api.BackupPhaseCompleted, time.Hour),
+				newBackup("backup-2", velerov1api.BackupPhaseCompleted, 2*time.Hour),
+			},
+			dbrs: []*velerov1api.DeleteBackupRequest{
+				builder.ForDeleteBackupRequest(velerov1api.DefaultNamespace, "dbr-1").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-2", velerov1api.BackupUIDLabel, "")).
+					BackupName("backup-2").
+					Phase(velerov1api.DeleteBackupRequestPhaseInProgress).
+					Result(),
+			},
+			deletions: []string{"backup-2"},
+		},
+		{
+			name: "the periods are in the time zone of the schedule",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").TimeZone("Asia/Tokyo").Retention(&velerov1api.ScheduleRetention{
+				ScheduleRetentionRules: velerov1api.ScheduleRetentionRules{KeepDaily: 2},
+			}).Result(),
+			backups: []*velerov1api.Backup{
+				// 2024-03-01 08:00 and 07:00 in Tokyo
+				newBackup("backup-1", velerov1api.BackupPhaseCompleted, time.Hour),
+				newBackup("backup-2", velerov1api.BackupPhaseCompleted, 2*time.Hour),
+				// 2024-02-29 23:00 in Tokyo
+				newBackup("backup-3", velerov1api.BackupPhaseCompleted, 10*time.Hour),
+			},
+			deletions: []string{"backup-2"},
+		},
+		{
+			name: "backups in read-only location are not deleted",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(&velerov1api.ScheduleRetention{
+				ScheduleRetentionRules: velerov1api.ScheduleRetentionRules{KeepLast: 1},
+			}).Result(),
+			backups: []*velerov1api.Backup{
+				newBackup("backup-1", velerov1api.BackupPhaseCompleted, time.Hour),
+				builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").
+					ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).
+					StorageLocation("read-only").
+					Phase(velerov1api.BackupPhaseCompleted).
+					StartTimestamp(now.Add(-2 * time.Hour)).
+					Result(),
+			},
+			location: readOnlyLocation,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initObjs := []runtime.Object{test.schedule, location}
+			if test.location != nil {
+				initObjs = append(initObjs, test.location)
+			}
+			for _, backup := range test.backups {
+				initObjs = append(initObjs, backup)
+			}
+			for _, dbr := range test.dbrs {
+				initObjs = append(initObjs, dbr)
+			}
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, initObjs...)
+			reconciler := NewScheduleRetentionReconciler(velerotest.NewLogger(), fakeClient, time.Hour)
+
+			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.schedule.Namespace, Name: test.schedule.Name}})
+			require.NoError(t, err)
+
+			dbrs := &velerov1api.DeleteBackupRequestList{}
+			require.NoError(t, fakeClient.List(context.TODO(), dbrs))
+			var deletions []string
+			for _, dbr := range dbrs.Items {
+				deletions = append(deletions, dbr.Spec.BackupName)
+			}
+			assert.ElementsMatch(t, test.deletions, deletions)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package datapath
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -34,28 +35,31 @@ import (
 )
 
 type fileSystemBR struct {
-	ctx            context.Context
-	cancel         context.CancelFunc
-	backupRepo     *velerov1api.BackupRepository
-	uploaderProv   provider.Provider
-	log            logrus.FieldLogger
-	client         client.Client
-	backupLocation *velerov1api.BackupStorageLocation
-	namespace      string
-	initialized    bool
-	callbacks      Callbacks
-	jobName        string
-	requestorType  string
+	ctx             context.Context
+	cancel          context.CancelFunc
+	backupRepo      *velerov1api.BackupRepository
+	uploaderProv    provider.Provider
+	log             logrus.FieldLogger
+	client          client.Client
+	backupLocation  *velerov1api.BackupStorageLocation
+	bandwidthLimits *velerov1api.BandwidthLimits
+	namespace       string
+	initialized     bool
+	callbacks       Callbacks
+	jobName         string
+	requestorType   string
 }
 
-func newFileSystemBR(jobName string, requestorType string, client client.Client, namespace string, callbacks Callbacks, log logrus.FieldLogger) AsyncBR {
+func newFileSystemBR(jobName string, requestorType string, client client.Client, namespace string, bandwidthLimits *velerov1api.BandwidthLimits,
+	callbacks Callbacks, log logrus.FieldLogger) AsyncBR {
 	fs := &fileSystemBR{
-		jobName:       jobName,
-		requestorType: requestorType,
-		client:        client,
-		namespace:     namespace,
-		callbacks:     callbacks,
-		log:           log,
+		jobName:         jobName,
+		requestorType:   requestorType,
+		client:          client,
+		namespace:       namespace,
+		bandwidthLimits: bandwidthLimits,
+		callbacks:       callbacks,
+		log:             log,
 	}
 
 	return fs
@@ -92,8 +96,18 @@ func (fs *fileSystemBR) Init(ctx context.Context, bslName string, sourceNamespac
 		return errors.Wrapf(err, "error to boost backup repository connection %s-%s-%s", bslName, sourceNamespace, repositoryType)
 	}
 
+	// the kopia repository opened by the uploader keeps the bandwidth limits
+	// up to date while the data is transferred
+	var throttlingOptions map[string]string
+	if repositoryType == velerov1api.BackupRepositoryTypeKopia {
+		throttlingOptions, err = repoProvider.GetThrottlingOptions(repoProvider.RepoParam{BackupLocation: fs.backupLocation, BackupRepo: fs.backupRepo, BandwidthLimits: fs.bandwidthLimits}, time.Now())
+		if err != nil {
+			return errors.Wrapf(err, "error to get throttling options of backup repository %s-%s-%s", bslName, sourceNamespace, repositoryType)
+		}
+	}
+
 	fs.uploaderProv, err = provider.NewUploaderProvider(ctx, fs.client, uploaderType, fs.requestorType, repoIdentifier,
-		fs.backupLocation, fs.backupRepo, credentialGetter, repokey.RepoKeySelector(), fs.log)
+		fs.backupLocation, fs.backupRepo, credentialGetter, repokey.RepoKeySelector(), throttlingOptions, fs.log)
 	if err != nil {
 		return errors.Wrapf(err, "error creating uploader %s", uploaderType)
 	}
@@ -192,7 +206,7 @@ func (fs *fileSystemBR) Cancel() {
 
 func (fs *fileSystemBR) boostRepoConnect(ctx context.Context, repositoryType string, credentialGetter *credentials.CredentialGetter) error {
 	if repositoryType == velerov1api.BackupRepositoryTypeKopia {
-		if err := repoProvider.NewUnifiedRepoProvider(*credentialGetter, repositoryType, fs.client, fs.log).BoostRepoConnect(ctx, repoProvider.RepoParam{BackupLocation: fs.backupLocation, BackupRepo: fs.backupRepo}); err != nil {
+		if err := repoProvider.NewUnifiedRepoProvider(*credentialGetter, repositoryType, fs.client, fs.log).BoostRepoConnect(ctx, repoProvider.RepoParam{BackupLocation: fs.backupLocation, BackupRepo: fs.backupRepo, BandwidthLimits: fs.bandwidthLimits}); err != nil {
 			return err
 		}
 	} else {
@@ -23,15 +23,18 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 var ConcurrentLimitExceed error = errors.New("Concurrent number exceeds")
 var FSBRCreator = newFileSystemBR
 
 type Manager struct {
-	cocurrentNum int
-	trackerLock  sync.Mutex
-	tracker      map[string]AsyncBR
+	cocurrentNum    int
+	bandwidthLimits *velerov1api.BandwidthLimits
+	trackerLock     sync.Mutex
+	tracker         map[string]AsyncBR
 }
 
 // NewManager creates the data path manager to manage concurrent data path instances
@@ -42,6 +45,15 @@ func NewManager(cocurrentNum int) *Manager {
 	}
 }
 
+// SetBandwidthLimits sets the bandwidth limits applying to the data path instances created afterwards,
+// on top of the ones of their backup storage locations
+func (m *Manager) SetBandwidthLimits(limits *velerov1api.BandwidthLimits) {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	m.bandwidthLimits = limits
+}
+
 // CreateFileSystemBR creates a new file system backup/restore data path instance
 func (m *Manager) CreateFileSystemBR(jobName string, requestorType string, ctx context.Context, client client.Client, namespace string, callbacks Callbacks, log logrus.FieldLogger) (AsyncBR, error) {
 	m.trackerLock.Lock()
@@ -51,7 +63,7 @@ func (m *Manager) CreateFileSystemBR(jobName string, requestorType string, ctx c
 		return nil, ConcurrentLimitExceed
 	}
 
-	m.tracker[jobName] = FSBRCreator(jobName, requestorType, client, namespace, callbacks, log)
+	m.tracker[jobName] = FSBRCreator(jobName, requestorType, client, namespace, m.bandwidthLimits, callbacks, log)
 
 	return m.tracker[jobName], nil
 }
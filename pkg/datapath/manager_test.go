@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 func TestManager(t *testing.T) {
@@ -50,3 +52,16 @@ func TestManager(t *testing.T) {
 	ret = m.GetAsyncBR("job-1")
 	assert.Nil(t, ret)
 }
+
+func TestManagerBandwidthLimits(t *testing.T) {
+	m := NewManager(1)
+
+	limits := &velerov1api.BandwidthLimits{
+		BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 1000},
+	}
+	m.SetBandwidthLimits(limits)
+
+	async, err := m.CreateFileSystemBR("job-1", "test", context.TODO(), nil, "velero", Callbacks{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, limits, async.(*fileSystemBR).bandwidthLimits)
+}
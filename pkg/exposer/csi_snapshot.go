@@ -65,7 +65,10 @@ type CSISnapshotExposeParam struct {
 	VolumeSize resource.Quantity
 
 	// Affinity specifies the node affinity of the backup pod
-	Affinity *nodeagent.LoadAffinity
+	Affinity *kube.LoadAffinity
+
+	// BackupPVCConfig is the config for backupPVC (intermediate PVC) of snapshot data movement
+	BackupPVCConfig map[string]nodeagent.BackupPVC
 }
 
 // CSISnapshotExposeWaitParam define the input param for WaitExposed of CSI snapshots
@@ -162,7 +165,25 @@ func (e *csiSnapshotExposer) Expose(ctx context.Context, ownerObject corev1.Obje
 		curLog.WithField("vs name", volumeSnapshot.Name).Warnf("The snapshot doesn't contain a valid restore size, use source volume's size %v", volumeSize)
 	}
 
-	backupPVC, err := e.createBackupPVC(ctx, ownerObject, backupVS.Name, csiExposeParam.StorageClass, csiExposeParam.AccessMode, volumeSize)
+	backupPVCStorageClass := csiExposeParam.StorageClass
+	backupPVCReadOnly := false
+	spcNoRelabeling := false
+	if value, exists := csiExposeParam.BackupPVCConfig[csiExposeParam.StorageClass]; exists {
+		if value.StorageClass != "" {
+			backupPVCStorageClass = value.StorageClass
+		}
+
+		backupPVCReadOnly = value.ReadOnly
+		if value.SPCNoRelabeling {
+			if backupPVCReadOnly {
+				spcNoRelabeling = true
+			} else {
+				curLog.WithField("vs name", volumeSnapshot.Name).Warn("Ignoring spcNoRelabeling for read-write volume")
+			}
+		}
+	}
+
+	backupPVC, err := e.createBackupPVC(ctx, ownerObject, backupVS.Name, backupPVCStorageClass, csiExposeParam.AccessMode, volumeSize, backupPVCReadOnly)
 	if err != nil {
 		return errors.Wrap(err, "error to create backup pvc")
 	}
@@ -174,7 +195,7 @@ func (e *csiSnapshotExposer) Expose(ctx context.Context, ownerObject corev1.Obje
 		}
 	}()
 
-	backupPod, err := e.createBackupPod(ctx, ownerObject, backupPVC, csiExposeParam.HostingPodLabels, csiExposeParam.Affinity)
+	backupPod, err := e.createBackupPod(ctx, ownerObject, backupPVC, csiExposeParam.HostingPodLabels, csiExposeParam.Affinity, backupPVCReadOnly, spcNoRelabeling)
 	if err != nil {
 		return errors.Wrap(err, "error to create backup pod")
 	}
@@ -338,7 +359,7 @@ func (e *csiSnapshotExposer) createBackupVSC(ctx context.Context, ownerObject co
 	return e.csiSnapshotClient.VolumeSnapshotContents().Create(ctx, vsc, metav1.CreateOptions{})
 }
 
-func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject corev1.ObjectReference, backupVS, storageClass, accessMode string, resource resource.Quantity) (*corev1.PersistentVolumeClaim, error) {
+func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject corev1.ObjectReference, backupVS, storageClass, accessMode string, resource resource.Quantity, readOnly bool) (*corev1.PersistentVolumeClaim, error) {
 	backupPVCName := ownerObject.Name
 
 	volumeMode, err := getVolumeModeByAccessMode(accessMode)
@@ -346,6 +367,11 @@ func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject co
 		return nil, err
 	}
 
+	pvcAccessMode := corev1.ReadWriteOnce
+	if readOnly {
+		pvcAccessMode = corev1.ReadOnlyMany
+	}
+
 	dataSource := &corev1.TypedLocalObjectReference{
 		APIGroup: &snapshotv1api.SchemeGroupVersion.Group,
 		Kind:     "VolumeSnapshot",
@@ -368,7 +394,7 @@ func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject co
 		},
 		Spec: corev1.PersistentVolumeClaimSpec{
 			AccessModes: []corev1.PersistentVolumeAccessMode{
-				corev1.ReadWriteOnce,
+				pvcAccessMode,
 			},
 			StorageClassName: &storageClass,
 			VolumeMode:       &volumeMode,
@@ -392,7 +418,7 @@ func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject co
 }
 
 func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject corev1.ObjectReference, backupPVC *corev1.PersistentVolumeClaim,
-	label map[string]string, affinity *nodeagent.LoadAffinity) (*corev1.Pod, error) {
+	label map[string]string, affinity *kube.LoadAffinity, backupPVCReadOnly, spcNoRelabeling bool) (*corev1.Pod, error) {
 	podName := ownerObject.Name
 
 	volumeName := string(ownerObject.UID)
@@ -404,7 +430,7 @@ func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject co
 	}
 
 	var gracePeriod int64 = 0
-	volumeMounts, volumeDevices := kube.MakePodPVCAttachment(volumeName, backupPVC.Spec.VolumeMode)
+	volumeMounts, volumeDevices := kube.MakePodPVCAttachment(volumeName, backupPVC.Spec.VolumeMode, backupPVCReadOnly)
 
 	if label == nil {
 		label = make(map[string]string)
@@ -440,7 +466,7 @@ func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject co
 					},
 				},
 			},
-			Affinity: toSystemAffinity(affinity),
+			Affinity: kube.ToSystemAffinity(affinity),
 			Containers: []corev1.Container{
 				{
 					Name:            containerName,
@@ -458,50 +484,23 @@ func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject co
 				VolumeSource: corev1.VolumeSource{
 					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
 						ClaimName: backupPVC.Name,
+						ReadOnly:  backupPVCReadOnly,
 					},
 				},
 			}},
 		},
 	}
 
-	return e.kubeClient.CoreV1().Pods(ownerObject.Namespace).Create(ctx, pod, metav1.CreateOptions{})
-}
-
-func toSystemAffinity(loadAffinity *nodeagent.LoadAffinity) *corev1.Affinity {
-	if loadAffinity == nil {
-		return nil
-	}
-
-	requirements := []corev1.NodeSelectorRequirement{}
-	for k, v := range loadAffinity.NodeSelector.MatchLabels {
-		requirements = append(requirements, corev1.NodeSelectorRequirement{
-			Key:      k,
-			Values:   []string{v},
-			Operator: corev1.NodeSelectorOpIn,
-		})
-	}
-
-	for _, exp := range loadAffinity.NodeSelector.MatchExpressions {
-		requirements = append(requirements, corev1.NodeSelectorRequirement{
-			Key:      exp.Key,
-			Values:   exp.Values,
-			Operator: corev1.NodeSelectorOperator(exp.Operator),
-		})
-	}
-
-	if len(requirements) == 0 {
-		return nil
-	}
-
-	return &corev1.Affinity{
-		NodeAffinity: &corev1.NodeAffinity{
-			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
-				NodeSelectorTerms: []corev1.NodeSelectorTerm{
-					{
-						MatchExpressions: requirements,
-					},
-				},
+	// With the "spc_t" type, the volume is mounted without relabeling the
+	// files, which is expensive for large volumes and impossible for read
+	// only ones.
+	if spcNoRelabeling {
+		pod.Spec.SecurityContext = &corev1.PodSecurityContext{
+			SELinuxOptions: &corev1.SELinuxOptions{
+				Type: "spc_t",
 			},
-		},
+		}
 	}
+
+	return e.kubeClient.CoreV1().Pods(ownerObject.Namespace).Create(ctx, pod, metav1.CreateOptions{})
 }
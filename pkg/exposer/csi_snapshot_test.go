@@ -18,7 +18,6 @@ package exposer
 
 import (
 	"context"
-	"reflect"
 	"testing"
 	"time"
 
@@ -49,6 +48,13 @@ type reactor struct {
 	reactorFunc clientTesting.ReactionFunc
 }
 
+type expectedBackupPVC struct {
+	storageClass    string
+	accessMode      corev1.PersistentVolumeAccessMode
+	readOnly        bool
+	securityContext *corev1.PodSecurityContext
+}
+
 func TestExpose(t *testing.T) {
 	vscName := "fake-vsc"
 	backup := &velerov1.Backup{
@@ -151,6 +157,7 @@ func TestExpose(t *testing.T) {
 		kubeReactors       []reactor
 		err                string
 		expectedVolumeSize *resource.Quantity
+		expectedBackupPVC  *expectedBackupPVC
 	}{
 		{
 			name:        "wait vs ready fail",
@@ -377,6 +384,127 @@ func TestExpose(t *testing.T) {
 			},
 			expectedVolumeSize: resource.NewQuantity(567890, ""),
 		},
+		{
+			name:        "backupPVC storage class from backupPVC config",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass: "fake-sc-shallow",
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupPVC: &expectedBackupPVC{
+				storageClass: "fake-sc-shallow",
+				accessMode:   corev1.ReadWriteOnce,
+			},
+		},
+		{
+			name:        "backupPVC config of another storage class is ignored",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc-2": {
+						StorageClass: "fake-sc-shallow",
+						ReadOnly:     true,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupPVC: &expectedBackupPVC{
+				storageClass: "fake-sc",
+				accessMode:   corev1.ReadWriteOnce,
+			},
+		},
+		{
+			name:        "read only backupPVC without relabeling",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass:    "fake-sc-shallow",
+						ReadOnly:        true,
+						SPCNoRelabeling: true,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupPVC: &expectedBackupPVC{
+				storageClass: "fake-sc-shallow",
+				accessMode:   corev1.ReadOnlyMany,
+				readOnly:     true,
+				securityContext: &corev1.PodSecurityContext{
+					SELinuxOptions: &corev1.SELinuxOptions{
+						Type: "spc_t",
+					},
+				},
+			},
+		},
+		{
+			name:        "relabeling is not skipped for read-write backupPVC",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						SPCNoRelabeling: true,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupPVC: &expectedBackupPVC{
+				storageClass: "fake-sc",
+				accessMode:   corev1.ReadWriteOnce,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -413,7 +541,7 @@ func TestExpose(t *testing.T) {
 			if err == nil {
 				assert.NoError(t, err)
 
-				_, err = exposer.kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+				backupPod, err := exposer.kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
 				assert.NoError(t, err)
 
 				backupPVC, err := exposer.kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
@@ -439,6 +567,14 @@ func TestExpose(t *testing.T) {
 				} else {
 					assert.Equal(t, *resource.NewQuantity(restoreSize, ""), backupPVC.Spec.Resources.Requests[corev1.ResourceStorage])
 				}
+
+				if test.expectedBackupPVC != nil {
+					assert.Equal(t, test.expectedBackupPVC.storageClass, *backupPVC.Spec.StorageClassName)
+					assert.Equal(t, []corev1.PersistentVolumeAccessMode{test.expectedBackupPVC.accessMode}, backupPVC.Spec.AccessModes)
+					assert.Equal(t, test.expectedBackupPVC.readOnly, backupPod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly)
+					assert.Equal(t, test.expectedBackupPVC.readOnly, backupPod.Spec.Containers[0].VolumeMounts[0].ReadOnly)
+					assert.Equal(t, test.expectedBackupPVC.securityContext, backupPod.Spec.SecurityContext)
+				}
 			} else {
 				assert.EqualError(t, err, test.err)
 			}
@@ -719,102 +855,3 @@ func TestPeekExpose(t *testing.T) {
 		})
 	}
 }
-
-func TestToSystemAffinity(t *testing.T) {
-	tests := []struct {
-		name         string
-		loadAffinity *nodeagent.LoadAffinity
-		expected     *corev1.Affinity
-	}{
-		{
-			name: "loadAffinity is nil",
-		},
-		{
-			name:         "loadAffinity is empty",
-			loadAffinity: &nodeagent.LoadAffinity{},
-		},
-		{
-			name: "with match label",
-			loadAffinity: &nodeagent.LoadAffinity{
-				NodeSelector: metav1.LabelSelector{
-					MatchLabels: map[string]string{
-						"key-1": "value-1",
-					},
-				},
-			},
-			expected: &corev1.Affinity{
-				NodeAffinity: &corev1.NodeAffinity{
-					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
-						NodeSelectorTerms: []corev1.NodeSelectorTerm{
-							{
-								MatchExpressions: []corev1.NodeSelectorRequirement{
-									{
-										Key:      "key-1",
-										Values:   []string{"value-1"},
-										Operator: corev1.NodeSelectorOpIn,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "with match expression",
-			loadAffinity: &nodeagent.LoadAffinity{
-				NodeSelector: metav1.LabelSelector{
-					MatchLabels: map[string]string{
-						"key-2": "value-2",
-					},
-					MatchExpressions: []metav1.LabelSelectorRequirement{
-						{
-							Key:      "key-3",
-							Values:   []string{"value-3-1", "value-3-2"},
-							Operator: metav1.LabelSelectorOpNotIn,
-						},
-						{
-							Key:      "key-4",
-							Values:   []string{"value-4-1", "value-4-2", "value-4-3"},
-							Operator: metav1.LabelSelectorOpDoesNotExist,
-						},
-					},
-				},
-			},
-			expected: &corev1.Affinity{
-				NodeAffinity: &corev1.NodeAffinity{
-					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
-						NodeSelectorTerms: []corev1.NodeSelectorTerm{
-							{
-								MatchExpressions: []corev1.NodeSelectorRequirement{
-									{
-										Key:      "key-2",
-										Values:   []string{"value-2"},
-										Operator: corev1.NodeSelectorOpIn,
-									},
-									{
-										Key:      "key-3",
-										Values:   []string{"value-3-1", "value-3-2"},
-										Operator: corev1.NodeSelectorOpNotIn,
-									},
-									{
-										Key:      "key-4",
-										Values:   []string{"value-4-1", "value-4-2", "value-4-3"},
-										Operator: corev1.NodeSelectorOpDoesNotExist,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			affinity := toSystemAffinity(test.loadAffinity)
-			assert.Equal(t, true, reflect.DeepEqual(affinity, test.expected))
-		})
-	}
-}
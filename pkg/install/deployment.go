@@ -53,6 +53,7 @@ type podTemplateConfig struct {
 	disableInformerCache            bool
 	scheduleSkipImmediately         bool
 	maintenanceConfig               repository.MaintenanceConfig
+	backupRepoConfig                string
 }
 
 func WithImage(image string) podTemplateOption {
@@ -185,6 +186,12 @@ func WithMaintenanceConfig(config repository.MaintenanceConfig) podTemplateOptio
 	}
 }
 
+func WithBackupRepoConfig(val string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.backupRepoConfig = val
+	}
+}
+
 func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment {
 	// TODO: Add support for server args
 	c := &podTemplateConfig{
@@ -262,6 +269,14 @@ func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment
 		args = append(args, fmt.Sprintf("--maintenance-job-mem-request=%s", c.maintenanceConfig.MemRequest))
 	}
 
+	if c.maintenanceConfig.JobConfigName != "" {
+		args = append(args, fmt.Sprintf("--repo-maintenance-job-configmap=%s", c.maintenanceConfig.JobConfigName))
+	}
+
+	if len(c.backupRepoConfig) > 0 {
+		args = append(args, fmt.Sprintf("--backup-repository-configmap=%s", c.backupRepoConfig))
+	}
+
 	deployment := &appsv1.Deployment{
 		ObjectMeta: objectMeta(namespace, "velero"),
 		TypeMeta: metav1.TypeMeta{
@@ -77,11 +77,17 @@ func TestDeployment(t *testing.T) {
 		MemRequest:               "256Mi",
 		CPULimit:                 "200m",
 		MemLimit:                 "512Mi",
+		JobConfigName:            "repo-maintenance-job-config",
 	}))
-	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 6)
+	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 7)
 	assert.Equal(t, "--keep-latest-maintenance-jobs=3", deploy.Spec.Template.Spec.Containers[0].Args[1])
 	assert.Equal(t, "--maintenance-job-cpu-limit=200m", deploy.Spec.Template.Spec.Containers[0].Args[2])
 	assert.Equal(t, "--maintenance-job-cpu-request=100m", deploy.Spec.Template.Spec.Containers[0].Args[3])
 	assert.Equal(t, "--maintenance-job-mem-limit=512Mi", deploy.Spec.Template.Spec.Containers[0].Args[4])
 	assert.Equal(t, "--maintenance-job-mem-request=256Mi", deploy.Spec.Template.Spec.Containers[0].Args[5])
+	assert.Equal(t, "--repo-maintenance-job-configmap=repo-maintenance-job-config", deploy.Spec.Template.Spec.Containers[0].Args[6])
+
+	deploy = Deployment("velero", WithBackupRepoConfig("test-backup-repo-config"))
+	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 2)
+	assert.Equal(t, "--backup-repository-configmap=test-backup-repo-config", deploy.Spec.Template.Spec.Containers[0].Args[1])
 }
@@ -266,6 +266,7 @@ type VeleroOptions struct {
 	FormatFlag                      *logging.FormatFlag
 	LogLevelFlag                    *logging.LevelFlag
 	MaintenanceCfg                  repository.MaintenanceConfig
+	BackupRepoConfig                string
 }
 
 func AllCRDs() *unstructured.UnstructuredList {
@@ -351,6 +352,7 @@ func AllResources(o *VeleroOptions) *unstructured.UnstructuredList {
 		WithUploaderType(o.UploaderType),
 		WithScheduleSkipImmediately(o.ScheduleSkipImmediately),
 		WithMaintenanceConfig(o.MaintenanceCfg),
+		WithBackupRepoConfig(o.BackupRepoConfig),
 	}
 
 	if len(o.Features) > 0 {
@@ -0,0 +1,128 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// PVCAction implements ItemBlockAction.
+type PVCAction struct {
+	log      logrus.FieldLogger
+	crClient crclient.Client
+}
+
+// NewPVCAction creates a new ItemBlockAction for PersistentVolumeClaims.
+func NewPVCAction(logger logrus.FieldLogger, crClient crclient.Client) *PVCAction {
+	return &PVCAction{
+		log:      logger,
+		crClient: crClient,
+	}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to PVCs.
+func (a *PVCAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"persistentvolumeclaims"},
+	}, nil
+}
+
+// GetRelatedItems returns the PersistentVolume bound by the provided PersistentVolumeClaim,
+// if any, as well as all of the pods in the PVC's namespace that mount it. This puts all of
+// the pods sharing a volume in the same item block, so that their hooks are run together.
+// The other PVCs of the PVC's volume group, if any, are returned as well.
+func (a *PVCAction) GetRelatedItems(item runtime.Unstructured, backup *v1.Backup) ([]velero.ResourceIdentifier, error) {
+	a.log.Info("Executing PVC ItemBlockAction")
+	defer a.log.Info("Done executing PVC ItemBlockAction")
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pvc); err != nil {
+		return nil, errors.Wrap(err, "unable to convert unstructured item to persistent volume claim")
+	}
+
+	var relatedItems []velero.ResourceIdentifier
+
+	if pvc.Status.Phase == corev1api.ClaimBound && pvc.Spec.VolumeName != "" {
+		a.log.Infof("Adding PV %s to relatedItems", pvc.Spec.VolumeName)
+		relatedItems = append(relatedItems, velero.ResourceIdentifier{
+			GroupResource: kuberesource.PersistentVolumes,
+			Name:          pvc.Spec.VolumeName,
+		})
+	}
+
+	pods := new(corev1api.PodList)
+	if err := a.crClient.List(context.Background(), pods, crclient.InNamespace(pvc.Namespace)); err != nil {
+		return nil, errors.Wrapf(err, "failed to list pods in namespace %s", pvc.Namespace)
+	}
+
+	for _, pod := range pods.Items {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvc.Name {
+				a.log.Infof("Adding pod %s/%s to relatedItems for PVC %s", pod.Namespace, pod.Name, pvc.Name)
+				relatedItems = append(relatedItems, velero.ResourceIdentifier{
+					GroupResource: kuberesource.Pods,
+					Namespace:     pod.Namespace,
+					Name:          pod.Name,
+				})
+				break
+			}
+		}
+	}
+
+	// PVCs of the same volume group are snapshotted together by a single
+	// VolumeGroupSnapshot, so they must be backed up in the same item block.
+	if group := pvc.Labels[v1.VolumeGroupLabel]; group != "" {
+		pvcs := new(corev1api.PersistentVolumeClaimList)
+		if err := a.crClient.List(
+			context.Background(),
+			pvcs,
+			crclient.InNamespace(pvc.Namespace),
+			crclient.MatchingLabels{v1.VolumeGroupLabel: group},
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to list PVCs of volume group %s in namespace %s", group, pvc.Namespace)
+		}
+		for _, groupPVC := range pvcs.Items {
+			if groupPVC.Name == pvc.Name {
+				continue
+			}
+			a.log.Infof("Adding PVC %s/%s of volume group %s to relatedItems for PVC %s", groupPVC.Namespace, groupPVC.Name, group, pvc.Name)
+			relatedItems = append(relatedItems, velero.ResourceIdentifier{
+				GroupResource: kuberesource.PersistentVolumeClaims,
+				Namespace:     groupPVC.Namespace,
+				Name:          groupPVC.Name,
+			})
+		}
+	}
+
+	return relatedItems, nil
+}
+
+// Name is required to implement the interface, but the Velero PVC ItemBlockAction
+// doesn't need to return anything here
+func (a *PVCAction) Name() string {
+	return ""
+}
@@ -0,0 +1,115 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestPVCActionAppliesTo(t *testing.T) {
+	a := NewPVCAction(velerotest.NewLogger(), velerotest.NewFakeControllerRuntimeClient(t))
+
+	actual, err := a.AppliesTo()
+	require.NoError(t, err)
+
+	expected := velero.ResourceSelector{
+		IncludedResources: []string{"persistentvolumeclaims"},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestPVCActionGetRelatedItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		pvc      *corev1api.PersistentVolumeClaim
+		objects  []runtime.Object
+		expected []velero.ResourceIdentifier
+	}{
+		{
+			name: "unbound PVC without pods returns nothing",
+			pvc:  builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Phase(corev1api.ClaimPending).Result(),
+		},
+		{
+			name: "bound PVC returns its PV",
+			pvc:  builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Phase(corev1api.ClaimBound).Result(),
+			expected: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
+			},
+		},
+		{
+			name: "bound PVC returns its PV and the pods mounting it",
+			pvc:  builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Phase(corev1api.ClaimBound).Result(),
+			objects: []runtime.Object{
+				builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).Result(),
+				builder.ForPod("ns-1", "pod-2").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-2").Result()).Result(),
+				builder.ForPod("ns-1", "pod-3").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).Result(),
+				builder.ForPod("ns-2", "pod-4").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).Result(),
+			},
+			expected: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
+				{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"},
+				{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-3"},
+			},
+		},
+		{
+			name: "PVC in a volume group returns the other PVCs of the group",
+			pvc: builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+				ObjectMeta(builder.WithLabels(velerov1api.VolumeGroupLabel, "db")).
+				VolumeName("pv-1").Phase(corev1api.ClaimBound).Result(),
+			objects: []runtime.Object{
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+					ObjectMeta(builder.WithLabels(velerov1api.VolumeGroupLabel, "db")).Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-2").
+					ObjectMeta(builder.WithLabels(velerov1api.VolumeGroupLabel, "db")).Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-3").
+					ObjectMeta(builder.WithLabels(velerov1api.VolumeGroupLabel, "logs")).Result(),
+				builder.ForPersistentVolumeClaim("ns-2", "pvc-4").
+					ObjectMeta(builder.WithLabels(velerov1api.VolumeGroupLabel, "db")).Result(),
+			},
+			expected: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
+				{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-2"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := NewPVCAction(velerotest.NewLogger(), velerotest.NewFakeControllerRuntimeClient(t, test.objects...))
+
+			pvcMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(test.pvc)
+			require.NoError(t, err)
+
+			relatedItems, err := a.GetRelatedItems(&unstructured.Unstructured{Object: pvcMap}, nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, relatedItems)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package itemblock
+
+import (
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ItemBlock is a group of related items which are backed up together,
+// with pre and post hooks for all of its pods run before and after
+// the whole block is backed up.
+type ItemBlock struct {
+	Log   logrus.FieldLogger
+	Items []ItemBlockItem
+}
+
+// ItemBlockItem is a single item in an ItemBlock.
+type ItemBlockItem struct {
+	Gr           schema.GroupResource
+	Item         *unstructured.Unstructured
+	PreferredGVR schema.GroupVersionResource
+}
+
+// AddUnstructured adds an item to the ItemBlock.
+func (ib *ItemBlock) AddUnstructured(gr schema.GroupResource, item *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) {
+	ib.Items = append(ib.Items, ItemBlockItem{
+		Gr:           gr,
+		Item:         item,
+		PreferredGVR: preferredGVR,
+	})
+}
+
+// FindItem returns all the items in the ItemBlock matching the given group resource,
+// namespace and name. More than one item can be returned when multiple API versions
+// of the same resource are backed up.
+func (ib *ItemBlock) FindItem(gr schema.GroupResource, namespace, name string) []ItemBlockItem {
+	var itemList []ItemBlockItem
+	for _, item := range ib.Items {
+		if item.Gr == gr && item.Item != nil &&
+			item.Item.GetName() == name && item.Item.GetNamespace() == namespace {
+			itemList = append(itemList, item)
+		}
+	}
+	return itemList
+}
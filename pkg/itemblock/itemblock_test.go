@@ -0,0 +1,84 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package itemblock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestFindItem(t *testing.T) {
+	newItem := func(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+
+	block := &ItemBlock{Log: velerotest.NewLogger()}
+	block.AddUnstructured(kuberesource.Pods, newItem("v1", "Pod", "ns-1", "pod-1"), schema.GroupVersionResource{Version: "v1", Resource: "pods"})
+	block.AddUnstructured(kuberesource.PersistentVolumeClaims, newItem("v1", "PersistentVolumeClaim", "ns-1", "pvc-1"), schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"})
+	block.AddUnstructured(kuberesource.PersistentVolumes, newItem("v1", "PersistentVolume", "", "pv-1"), schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumes"})
+
+	tests := []struct {
+		name          string
+		gr            schema.GroupResource
+		namespace     string
+		itemName      string
+		expectedFound int
+	}{
+		{
+			name:          "namespaced item is found",
+			gr:            kuberesource.PersistentVolumeClaims,
+			namespace:     "ns-1",
+			itemName:      "pvc-1",
+			expectedFound: 1,
+		},
+		{
+			name:          "cluster-scoped item is found",
+			gr:            kuberesource.PersistentVolumes,
+			itemName:      "pv-1",
+			expectedFound: 1,
+		},
+		{
+			name:      "item in a different namespace is not found",
+			gr:        kuberesource.Pods,
+			namespace: "ns-2",
+			itemName:  "pod-1",
+		},
+		{
+			name:      "item with a different resource is not found",
+			gr:        kuberesource.PersistentVolumeClaims,
+			namespace: "ns-1",
+			itemName:  "pod-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, block.FindItem(tc.gr, tc.namespace, tc.itemName), tc.expectedFound)
+		})
+	}
+}
@@ -47,6 +47,8 @@ const (
 	backupItemsErrorsGauge        = "backup_items_errors"
 	backupWarningTotal            = "backup_warning_total"
 	backupLastStatus              = "backup_last_status"
+	scheduleMissedRunsTotal       = "schedule_missed_runs_total"
+	scheduleLastMissedRun         = "schedule_last_missed_run_timestamp"
 	restoreTotal                  = "restore_total"
 	restoreAttemptTotal           = "restore_attempt_total"
 	restoreValidationFailedTotal  = "restore_validation_failed_total"
@@ -228,6 +230,22 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			scheduleMissedRunsTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      scheduleMissedRunsTotal,
+					Help:      "Total number of scheduled runs which didn't produce a backup",
+				},
+				[]string{scheduleLabel},
+			),
+			scheduleLastMissedRun: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      scheduleLastMissedRun,
+					Help:      "Last scheduled time which didn't produce a backup, Unix timestamp in seconds",
+				},
+				[]string{scheduleLabel},
+			),
 			restoreTotal: prometheus.NewGauge(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -470,6 +488,9 @@ func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupLastStatus].(*prometheus.GaugeVec); ok {
 		c.WithLabelValues(scheduleName).Set(float64(1))
 	}
+	if c, ok := m.metrics[scheduleMissedRunsTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
 	if c, ok := m.metrics[restoreAttemptTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
@@ -552,6 +573,12 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupLastStatus].(*prometheus.GaugeVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
+	if c, ok := m.metrics[scheduleMissedRunsTotal].(*prometheus.CounterVec); ok {
+		c.DeleteLabelValues(scheduleName)
+	}
+	if g, ok := m.metrics[scheduleLastMissedRun].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(scheduleName)
+	}
 	if c, ok := m.metrics[restoreAttemptTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
@@ -699,6 +726,17 @@ func (m *ServerMetrics) SetBackupLastSuccessfulTimestamp(backupSchedule string,
 	}
 }
 
+// RegisterScheduleMissedRuns records the scheduled runs of a schedule which
+// didn't produce a backup, and the latest of them.
+func (m *ServerMetrics) RegisterScheduleMissedRuns(scheduleName string, count int64, lastMissedRun time.Time) {
+	if c, ok := m.metrics[scheduleMissedRunsTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(float64(count))
+	}
+	if g, ok := m.metrics[scheduleLastMissedRun].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(scheduleName).Set(float64(lastMissedRun.Unix()))
+	}
+}
+
 // SetBackupTotal records the current number of existent backups.
 func (m *ServerMetrics) SetBackupTotal(numberOfBackups int64) {
 	if g, ok := m.metrics[backupTotal].(prometheus.Gauge); ok {
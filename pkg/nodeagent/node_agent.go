@@ -29,6 +29,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -50,11 +51,6 @@ type LoadConcurrency struct {
 	PerNodeConfig []RuledConfigs `json:"perNodeConfig,omitempty"`
 }
 
-type LoadAffinity struct {
-	// NodeSelector specifies the label selector to match nodes
-	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
-}
-
 type RuledConfigs struct {
 	// NodeSelector specifies the label selector to match nodes
 	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
@@ -63,12 +59,32 @@ type RuledConfigs struct {
 	Number int `json:"number"`
 }
 
+type BackupPVC struct {
+	// StorageClass is the name of storage class to be used by the backupPVC
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// ReadOnly sets the backupPVC's access mode as read only
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// SPCNoRelabeling sets Spec.SecurityContext.SELinux.Type to "spc_t" for the pod mounting the backupPVC,
+	// so that the volume is not relabeled. It only takes effect when ReadOnly is true
+	SPCNoRelabeling bool `json:"spcNoRelabeling,omitempty"`
+}
+
 type Configs struct {
 	// LoadConcurrency is the config for data path load concurrency per node.
 	LoadConcurrency *LoadConcurrency `json:"loadConcurrency,omitempty"`
 
 	// LoadAffinity is the config for data path load affinity.
-	LoadAffinity []*LoadAffinity `json:"loadAffinity,omitempty"`
+	LoadAffinity []*kube.LoadAffinity `json:"loadAffinity,omitempty"`
+
+	// BackupPVCConfig is the config for backupPVC (intermediate PVC) of snapshot data movement,
+	// keyed by the storage class of the source volume.
+	BackupPVCConfig map[string]BackupPVC `json:"backupPVC,omitempty"`
+
+	// BandwidthLimits is the config for the rate limits of the data transferred by the data path
+	// to and from the object storage, on top of the ones of the backup storage locations.
+	BandwidthLimits *velerov1api.BandwidthLimits `json:"bandwidthLimits,omitempty"`
 }
 
 // IsRunning checks if the node agent daemonset is running properly. If not, return the error found
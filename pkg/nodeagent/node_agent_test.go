@@ -30,6 +30,7 @@ import (
 	clientTesting "k8s.io/client-go/testing"
 	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
@@ -245,6 +246,7 @@ func TestGetConfigs(t *testing.T) {
 	cmWithInvalidDataFormat := builder.ForConfigMap("fake-ns", "node-agent-config").Data("fake-key", "wrong").Result()
 	cmWithoutCocurrentData := builder.ForConfigMap("fake-ns", "node-agent-config").Data("fake-key", "{\"someothers\":{\"someother\": 10}}").Result()
 	cmWithValidData := builder.ForConfigMap("fake-ns", "node-agent-config").Data("fake-key", "{\"loadConcurrency\":{\"globalConfig\": 5}}").Result()
+	cmWithBandwidthLimits := builder.ForConfigMap("fake-ns", "node-agent-config").Data("fake-key", "{\"bandwidthLimits\":{\"uploadBytesPerSecond\": 1000,\"schedule\":[{\"start\":\"09:00\",\"end\":\"17:00\",\"uploadBytesPerSecond\":100}]}}").Result()
 
 	tests := []struct {
 		name          string
@@ -308,6 +310,21 @@ func TestGetConfigs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "bandwidth limits",
+			namespace: "fake-ns",
+			kubeClientObj: []runtime.Object{
+				cmWithBandwidthLimits,
+			},
+			expectResult: &Configs{
+				BandwidthLimits: &velerov1api.BandwidthLimits{
+					BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 1000},
+					Schedule: []velerov1api.ScheduledBandwidthLimit{
+						{Start: "09:00", End: "17:00", BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 100}},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,193 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupIndex lists the backups of a backup store with the fields the backup
+// sync filters them by, so the backups can be synced without listing the
+// backup store and getting the metadata of every backup. It's stored under
+// the metadata directory of the backup store, and updated when a backup is
+// uploaded or deleted.
+//
+// The updates aren't atomic, so concurrent updates may lose entries. The index
+// is rebuilt from the listing of the backups periodically by the backup sync.
+// The index is only a hint of which backups exist: it's used to find the new
+// backups, but a backup missing from it is never assumed to be deleted without
+// checking the listing of the backup store.
+type BackupIndex struct {
+	// Backups is a map from the name of a backup to its entry.
+	Backups map[string]BackupIndexEntry `json:"backups"`
+}
+
+// BackupIndexEntry is the entry of a backup in the BackupIndex.
+type BackupIndexEntry struct {
+	// Labels are the labels of the backup.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// StartTimestamp is when the backup started, or when it was created if it
+	// hasn't started.
+	StartTimestamp time.Time `json:"startTimestamp"`
+}
+
+// NewBackupIndexEntry returns the index entry of the backup.
+func NewBackupIndexEntry(backup *velerov1api.Backup) BackupIndexEntry {
+	entry := BackupIndexEntry{
+		Labels:         backup.Labels,
+		StartTimestamp: backup.CreationTimestamp.Time.UTC(),
+	}
+	if backup.Status.StartTimestamp != nil {
+		entry.StartTimestamp = backup.Status.StartTimestamp.Time.UTC()
+	}
+
+	return entry
+}
+
+// GetBackupIndex returns the index of the backups, or nil if the backup store
+// has no index.
+func (s *objectBackupStore) GetBackupIndex() (*BackupIndex, error) {
+	res, err := s.tryGet(s.layout.getBackupIndexKey())
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	index := new(BackupIndex)
+	if err := decode(res, index); err != nil {
+		return nil, errors.Wrap(err, "error decoding backup index")
+	}
+	if index.Backups == nil {
+		index.Backups = map[string]BackupIndexEntry{}
+	}
+
+	return index, nil
+}
+
+// BuildBackupIndex returns the index of the backups listed in the backup store.
+// The entries of the previous index are reused, so only the metadata of the
+// backups missing from it is read. The index isn't written to the backup store.
+func (s *objectBackupStore) BuildBackupIndex(previous *BackupIndex) (*BackupIndex, error) {
+	names, err := s.ListBackups()
+	if err != nil {
+		return nil, err
+	}
+
+	index := &BackupIndex{Backups: make(map[string]BackupIndexEntry, len(names))}
+	for _, name := range names {
+		if previous != nil {
+			if entry, ok := previous.Backups[name]; ok {
+				index.Backups[name] = entry
+				continue
+			}
+		}
+
+		exists, err := s.objectStore.ObjectExists(s.bucket, s.layout.getBackupMetadataKey(name))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if !exists {
+			// the backup is being uploaded or deleted
+			continue
+		}
+
+		backup, err := s.GetBackupMetadata(name)
+		if err != nil {
+			s.logger.WithError(err).WithField("backup", name).Warn("Error getting backup metadata, the backup is left out of the backup index")
+			continue
+		}
+		index.Backups[name] = NewBackupIndexEntry(backup)
+	}
+
+	return index, nil
+}
+
+// PutBackupIndex writes the index of the backups to the backup store.
+func (s *objectBackupStore) PutBackupIndex(index *BackupIndex) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(index); err != nil {
+		return errors.Wrap(err, "error encoding backup index to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return s.putObject(s.layout.getBackupIndexKey(), buf)
+}
+
+// updateBackupIndex applies the update to the index of the backups, and writes the
+// index if the update returns true. Nothing is done if the backup store has no
+// index yet, it's built by the backup sync. The index is only an optimization of
+// the backup sync, so the errors are logged rather than failing the operation
+// updating the index.
+func (s *objectBackupStore) updateBackupIndex(update func(index *BackupIndex) bool) {
+	index, err := s.GetBackupIndex()
+	if err != nil {
+		s.logger.WithError(err).Warn("Error getting backup index")
+		return
+	}
+	if index == nil {
+		return
+	}
+
+	if !update(index) {
+		return
+	}
+
+	if err := s.PutBackupIndex(index); err != nil {
+		s.logger.WithError(err).Warn("Error updating backup index")
+	}
+}
+
+// indexBackup adds the backup, whose metadata is uploaded, to the index of the backups.
+func (s *objectBackupStore) indexBackup(name string) {
+	s.updateBackupIndex(func(index *BackupIndex) bool {
+		backup, err := s.GetBackupMetadata(name)
+		if err != nil {
+			s.logger.WithError(err).WithField("backup", name).Warn("Error getting backup metadata to update backup index")
+			return false
+		}
+		index.Backups[name] = NewBackupIndexEntry(backup)
+		return true
+	})
+}
+
+// unindexBackup removes the deleted backup from the index of the backups.
+func (s *objectBackupStore) unindexBackup(name string) {
+	s.updateBackupIndex(func(index *BackupIndex) bool {
+		if _, ok := index.Backups[name]; !ok {
+			return false
+		}
+		delete(index.Backups, name)
+		return true
+	})
+}
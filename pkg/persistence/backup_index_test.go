@@ -0,0 +1,96 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+func putIndexedBackup(t *testing.T, harness *objectBackupStoreTestHarness, backup *velerov1api.Backup) {
+	t.Helper()
+
+	metadata := new(bytes.Buffer)
+	require.NoError(t, encode.To(backup, "json", metadata))
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     backup.Name,
+		Metadata: metadata,
+		Contents: newStringReadSeeker("contents"),
+	}))
+}
+
+func TestBackupIndex(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the index isn't created by the backup uploads
+	putIndexedBackup(t, harness, builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+		ObjectMeta(builder.WithLabels("app", "a")).StartTimestamp(startTime).Result())
+	index, err := harness.GetBackupIndex()
+	require.NoError(t, err)
+	assert.Nil(t, index)
+
+	index, err = harness.BuildBackupIndex(nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]BackupIndexEntry{
+		"backup-1": {Labels: map[string]string{"app": "a"}, StartTimestamp: startTime},
+	}, index.Backups)
+	require.NoError(t, harness.PutBackupIndex(index))
+
+	// the index is updated on backup uploads and deletions once it exists
+	putIndexedBackup(t, harness, builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").
+		ObjectMeta(builder.WithLabels("app", "b")).StartTimestamp(startTime.Add(time.Hour)).Result())
+	require.NoError(t, harness.DeleteBackup("backup-1"))
+
+	index, err = harness.GetBackupIndex()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]BackupIndexEntry{
+		"backup-2": {Labels: map[string]string{"app": "b"}, StartTimestamp: startTime.Add(time.Hour)},
+	}, index.Backups)
+
+	// the entries of the previous index are reused, and the backups which
+	// aren't in the backup store any more are dropped
+	previous := &BackupIndex{Backups: map[string]BackupIndexEntry{
+		"backup-2": {Labels: map[string]string{"app": "previous"}},
+		"backup-3": {},
+	}}
+	index, err = harness.BuildBackupIndex(previous)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]BackupIndexEntry{
+		"backup-2": {Labels: map[string]string{"app": "previous"}},
+	}, index.Backups)
+}
+
+func TestNewBackupIndexEntry(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	backup.CreationTimestamp = metav1.NewTime(created)
+
+	assert.Equal(t, BackupIndexEntry{StartTimestamp: created}, NewBackupIndexEntry(backup))
+
+	backup.Status.StartTimestamp = &metav1.Time{Time: created.Add(time.Minute)}
+	assert.Equal(t, BackupIndexEntry{StartTimestamp: created.Add(time.Minute)}, NewBackupIndexEntry(backup))
+}
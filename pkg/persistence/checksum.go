@@ -0,0 +1,110 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// ChecksumAlgorithmSHA256 is the algorithm of the checksums in the BackupChecksums manifest.
+const ChecksumAlgorithmSHA256 = "sha256"
+
+// BackupChecksums is the manifest of the checksums of the files of a backup in the
+// backup storage. It's stored in the backup's directory next to the backup metadata.
+type BackupChecksums struct {
+	// Algorithm is the algorithm to compute the checksums.
+	Algorithm string `json:"algorithm"`
+
+	// Checksums is a map from the name of a file in the backup's directory to its
+	// hex encoded checksum.
+	Checksums map[string]string `json:"checksums"`
+}
+
+// ChecksumMismatchError is returned when the content of a backup file doesn't match
+// the checksum recorded when the file was uploaded.
+type ChecksumMismatchError struct {
+	File     string
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for backup file %s: expected %s %s, got %s, the file may be corrupted or tampered with",
+		e.File, ChecksumAlgorithmSHA256, e.Expected, e.Actual)
+}
+
+// ChecksumVerifier computes the checksum of the data written to it and
+// compares it with the expected one.
+type ChecksumVerifier struct {
+	file     string
+	expected string
+	hash     hash.Hash
+}
+
+// NewChecksumVerifier returns a ChecksumVerifier for the supplied file.
+func NewChecksumVerifier(file, expected string) *ChecksumVerifier {
+	return &ChecksumVerifier{
+		file:     file,
+		expected: expected,
+		hash:     sha256.New(),
+	}
+}
+
+func (v *ChecksumVerifier) Write(p []byte) (int, error) {
+	return v.hash.Write(p)
+}
+
+// Verify returns a ChecksumMismatchError if the checksum of the data written so far
+// doesn't match the expected one.
+func (v *ChecksumVerifier) Verify() error {
+	if actual := hex.EncodeToString(v.hash.Sum(nil)); actual != v.expected {
+		return &ChecksumMismatchError{File: v.file, Expected: v.expected, Actual: actual}
+	}
+
+	return nil
+}
+
+// checksumVerifyingReader verifies the checksum of the data read through it
+// when the end of the data is reached.
+type checksumVerifyingReader struct {
+	io.ReadCloser
+	verifier *ChecksumVerifier
+}
+
+func newChecksumVerifyingReader(reader io.ReadCloser, file, expected string) io.ReadCloser {
+	return &checksumVerifyingReader{
+		ReadCloser: reader,
+		verifier:   NewChecksumVerifier(file, expected),
+	}
+}
+
+func (r *checksumVerifyingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	_, _ = r.verifier.Write(p[:n])
+
+	if err == io.EOF {
+		if verifyErr := r.verifier.Verify(); verifyErr != nil {
+			return n, verifyErr
+		}
+	}
+
+	return n, err
+}
@@ -0,0 +1,395 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements the envelope encryption of the files Velero
+// stores in object storage. Every file is encrypted with its own random data key,
+// and the data key is stored in the header of the file, wrapped with a key
+// encryption key which never leaves Velero or a key management service. The ID
+// of the key encryption key is recorded in the header too, so the files
+// encrypted before a key is rotated can still be decrypted.
+//
+// The content is encrypted with AES-256-GCM in chunks, so files of any size can
+// be encrypted and decrypted as streams. The last chunk is authenticated as such,
+// which makes truncated files fail to decrypt.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DataKeySize is the size of the data keys, and of the key encryption keys.
+	DataKeySize = 32
+
+	chunkSize      = 64 * 1024
+	maxHeaderSize  = 64 * 1024
+	chunkLenSize   = 4
+	nonceSize      = 12
+	counterOffset  = nonceSize - 8
+	lastChunkAD    = 1
+	notLastChunkAD = 0
+)
+
+// magic is the prefix of the encrypted files, which tells them apart from the
+// files stored before the encryption was enabled.
+var magic = []byte("VELEROE1")
+
+// KeyWrapper encrypts and decrypts the data keys with key encryption keys.
+type KeyWrapper interface {
+	// WrapKey encrypts the data key with the key of the given ID.
+	WrapKey(keyID string, dataKey []byte) ([]byte, error)
+
+	// UnwrapKey decrypts the data key wrapped with the key of the given ID.
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+type header struct {
+	KeyID      string `json:"keyID"`
+	WrappedKey []byte `json:"wrappedKey"`
+	Nonce      []byte `json:"nonce"`
+}
+
+// Encrypt returns a reader of the plaintext encrypted with a new data key, which
+// is wrapped with the key of the given ID.
+func Encrypt(plaintext io.Reader, keyID string, wrapper KeyWrapper) (io.Reader, error) {
+	dataKey := make([]byte, DataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, errors.Wrap(err, "error generating data key")
+	}
+
+	wrappedKey, err := wrapper.WrapKey(keyID, dataKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error wrapping data key with key %s", keyID)
+	}
+
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "error generating nonce")
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(&header{KeyID: keyID, WrappedKey: wrappedKey, Nonce: nonce})
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding header")
+	}
+
+	prefix := new(bytes.Buffer)
+	prefix.Write(magic)
+	_ = binary.Write(prefix, binary.BigEndian, uint32(len(data)))
+	prefix.Write(data)
+
+	return io.MultiReader(prefix, &encryptingReader{src: plaintext, aead: aead, nonce: nonce}), nil
+}
+
+// ErrNotEncrypted is returned when decrypting content which isn't encrypted.
+var ErrNotEncrypted = errors.New("content is not encrypted")
+
+// Decrypt returns a reader of the plaintext of the ciphertext, whose data key is
+// unwrapped with the wrapper. Content which isn't encrypted, e.g. because it was
+// stored before the encryption was enabled, is returned as is if allowPlaintext
+// is true, otherwise ErrNotEncrypted is returned.
+func Decrypt(ciphertext io.Reader, wrapper KeyWrapper, allowPlaintext bool) (io.Reader, error) {
+	r := bufio.NewReader(ciphertext)
+	hdr, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if hdr == nil {
+		if allowPlaintext {
+			return r, nil
+		}
+		return nil, ErrNotEncrypted
+	}
+
+	if wrapper == nil {
+		return nil, errors.Errorf("content is encrypted with key %s, but no key is configured", hdr.KeyID)
+	}
+
+	dataKey, err := wrapper.UnwrapKey(hdr.KeyID, hdr.WrappedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unwrapping data key with key %s", hdr.KeyID)
+	}
+
+	return newDecryptingReader(r, dataKey, hdr.Nonce)
+}
+
+// DecryptWithDataKey returns a reader of the plaintext of the ciphertext encrypted
+// with the data key. ErrNotEncrypted is returned if the content isn't encrypted.
+func DecryptWithDataKey(ciphertext io.Reader, dataKey []byte) (io.Reader, error) {
+	r := bufio.NewReader(ciphertext)
+	hdr, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if hdr == nil {
+		return nil, ErrNotEncrypted
+	}
+
+	return newDecryptingReader(r, dataKey, hdr.Nonce)
+}
+
+// ReadDataKey returns the data key of the ciphertext unwrapped with the wrapper,
+// or ErrNotEncrypted if the content isn't encrypted. Only the header of the
+// ciphertext is read.
+func ReadDataKey(ciphertext io.Reader, wrapper KeyWrapper) ([]byte, error) {
+	hdr, err := readHeader(bufio.NewReader(ciphertext))
+	if err != nil {
+		return nil, err
+	}
+	if hdr == nil {
+		return nil, ErrNotEncrypted
+	}
+
+	dataKey, err := wrapper.UnwrapKey(hdr.KeyID, hdr.WrappedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unwrapping data key with key %s", hdr.KeyID)
+	}
+
+	return dataKey, nil
+}
+
+// readHeader reads the header of the encrypted content, or returns nil without
+// consuming anything if the content isn't encrypted.
+func readHeader(r *bufio.Reader) (*header, error) {
+	prefix, err := r.Peek(len(magic))
+	if err != nil && err != io.EOF {
+		return nil, errors.WithStack(err)
+	}
+	if !bytes.Equal(prefix, magic) {
+		return nil, nil
+	}
+
+	if _, err := r.Discard(len(magic)); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, errors.Wrap(err, "error reading header size")
+	}
+	if size > maxHeaderSize {
+		return nil, errors.Errorf("header size %d exceeds the maximum of %d", size, maxHeaderSize)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, errors.Wrap(err, "error reading header")
+	}
+
+	hdr := new(header)
+	if err := json.Unmarshal(data, hdr); err != nil {
+		return nil, errors.Wrap(err, "error decoding header")
+	}
+	if len(hdr.Nonce) != nonceSize {
+		return nil, errors.Errorf("invalid nonce size %d", len(hdr.Nonce))
+	}
+
+	return hdr, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating GCM")
+	}
+
+	return aead, nil
+}
+
+// chunkNonce returns the nonce of the chunk, the base nonce XORed with the chunk counter.
+func chunkNonce(base []byte, counter uint64) []byte {
+	nonce := make([]byte, nonceSize)
+	copy(nonce, base)
+
+	var c [8]byte
+	binary.BigEndian.PutUint64(c[:], counter)
+	for i := range c {
+		nonce[counterOffset+i] ^= c[i]
+	}
+
+	return nonce
+}
+
+// encryptingReader encrypts the content of src chunk by chunk. Every chunk is
+// prefixed with the size of its ciphertext.
+type encryptingReader struct {
+	src     io.Reader
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint64
+	plain   []byte
+	peeked  []byte
+	out     []byte
+	done    bool
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.sealChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+func (r *encryptingReader) sealChunk() error {
+	if r.plain == nil {
+		r.plain = make([]byte, chunkSize)
+	}
+
+	n := copy(r.plain, r.peeked)
+	r.peeked = nil
+
+	m, err := io.ReadFull(r.src, r.plain[n:])
+	n += m
+
+	last := false
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		last = true
+	case err != nil:
+		return errors.WithStack(err)
+	default:
+		// the chunk is full, read one more byte to know if it's the last one
+		var b [1]byte
+		if _, err := io.ReadFull(r.src, b[:]); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return errors.WithStack(err)
+		} else {
+			r.peeked = b[:]
+		}
+	}
+
+	ad := []byte{notLastChunkAD}
+	if last {
+		ad[0] = lastChunkAD
+	}
+
+	out := make([]byte, chunkLenSize, chunkLenSize+n+r.aead.Overhead())
+	out = r.aead.Seal(out, chunkNonce(r.nonce, r.counter), r.plain[:n], ad)
+	binary.BigEndian.PutUint32(out, uint32(len(out)-chunkLenSize))
+
+	r.out = out
+	r.counter++
+	r.done = last
+
+	return nil
+}
+
+// decryptingReader decrypts the chunks written by an encryptingReader.
+type decryptingReader struct {
+	src     io.Reader
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint64
+	sealed  []byte
+	out     []byte
+	done    bool
+}
+
+func newDecryptingReader(src io.Reader, dataKey []byte, nonce []byte) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptingReader{src: src, aead: aead, nonce: nonce}, nil
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.openChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+func (r *decryptingReader) openChunk() error {
+	var size uint32
+	if err := binary.Read(r.src, binary.BigEndian, &size); err != nil {
+		if err == io.EOF {
+			return errors.New("encrypted content is truncated")
+		}
+		return errors.Wrap(err, "error reading chunk size")
+	}
+	if int(size) > chunkSize+r.aead.Overhead() {
+		return errors.Errorf("chunk size %d exceeds the maximum of %d", size, chunkSize+r.aead.Overhead())
+	}
+
+	if cap(r.sealed) < int(size) {
+		r.sealed = make([]byte, chunkSize+r.aead.Overhead())
+	}
+	sealed := r.sealed[:size]
+	if _, err := io.ReadFull(r.src, sealed); err != nil {
+		return errors.Wrap(err, "error reading chunk")
+	}
+
+	nonce := chunkNonce(r.nonce, r.counter)
+	plain, err := r.aead.Open(nil, nonce, sealed, []byte{notLastChunkAD})
+	if err != nil {
+		plain, err = r.aead.Open(nil, nonce, sealed, []byte{lastChunkAD})
+		if err != nil {
+			return errors.New("error decrypting chunk, the content is corrupted or the key is wrong")
+		}
+
+		// make sure nothing follows the last chunk, which also lets the
+		// readers under this one see the end of the content
+		var b [1]byte
+		if n, err := r.src.Read(b[:]); n > 0 {
+			return errors.New("unexpected content after the last chunk")
+		} else if err != nil && err != io.EOF {
+			return errors.WithStack(err)
+		}
+		r.done = true
+	}
+
+	r.out = plain
+	r.counter++
+
+	return nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWrapper(keys map[string][]byte) KeyWrapper {
+	return NewAESKeyWrapper(func(keyID string) ([]byte, error) {
+		key, ok := keys[keyID]
+		if !ok {
+			return nil, errors.Errorf("key %s not found", keyID)
+		}
+		return key, nil
+	})
+}
+
+func randomBytes(t *testing.T, size int) []byte {
+	t.Helper()
+
+	data := make([]byte, size)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return data
+}
+
+func encrypt(t *testing.T, plaintext []byte, keyID string, wrapper KeyWrapper) []byte {
+	t.Helper()
+
+	r, err := Encrypt(bytes.NewReader(plaintext), keyID, wrapper)
+	require.NoError(t, err)
+	ciphertext, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return ciphertext
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	wrapper := newTestWrapper(map[string][]byte{"key-1": randomBytes(t, DataKeySize)})
+
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "empty", size: 0},
+		{name: "smaller than a chunk", size: 100},
+		{name: "exactly one chunk", size: chunkSize},
+		{name: "one byte more than a chunk", size: chunkSize + 1},
+		{name: "several chunks", size: 3*chunkSize + 42},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plaintext := randomBytes(t, test.size)
+			ciphertext := encrypt(t, plaintext, "key-1", wrapper)
+			assert.True(t, bytes.HasPrefix(ciphertext, magic))
+
+			r, err := Decrypt(bytes.NewReader(ciphertext), wrapper, false)
+			require.NoError(t, err)
+			res, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, res)
+
+			dataKey, err := ReadDataKey(bytes.NewReader(ciphertext), wrapper)
+			require.NoError(t, err)
+			r, err = DecryptWithDataKey(bytes.NewReader(ciphertext), dataKey)
+			require.NoError(t, err)
+			res, err = io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, res)
+		})
+	}
+}
+
+func TestDecryptPlaintext(t *testing.T) {
+	wrapper := newTestWrapper(map[string][]byte{"key-1": randomBytes(t, DataKeySize)})
+
+	// the plaintext is only returned as is when it's explicitly allowed
+	r, err := Decrypt(bytes.NewReader([]byte("not encrypted")), wrapper, true)
+	require.NoError(t, err)
+	res, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "not encrypted", string(res))
+
+	_, err = Decrypt(bytes.NewReader([]byte("not encrypted")), wrapper, false)
+	assert.ErrorIs(t, err, ErrNotEncrypted)
+
+	_, err = DecryptWithDataKey(bytes.NewReader([]byte("not encrypted")), randomBytes(t, DataKeySize))
+	assert.ErrorIs(t, err, ErrNotEncrypted)
+
+	_, err = ReadDataKey(bytes.NewReader([]byte("not encrypted")), wrapper)
+	assert.ErrorIs(t, err, ErrNotEncrypted)
+}
+
+func TestDecryptFailures(t *testing.T) {
+	wrapper := newTestWrapper(map[string][]byte{
+		"key-1": randomBytes(t, DataKeySize),
+		"key-2": randomBytes(t, DataKeySize),
+	})
+	ciphertext := encrypt(t, randomBytes(t, 2*chunkSize+10), "key-1", wrapper)
+
+	decrypt := func(ciphertext []byte, wrapper KeyWrapper) error {
+		r, err := Decrypt(bytes.NewReader(ciphertext), wrapper, false)
+		if err != nil {
+			return err
+		}
+		_, err = io.ReadAll(r)
+		return err
+	}
+
+	t.Run("no key configured", func(t *testing.T) {
+		assert.ErrorContains(t, decrypt(ciphertext, nil), "no key is configured")
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		assert.ErrorContains(t, decrypt(ciphertext, newTestWrapper(nil)), "key key-1 not found")
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		wrong := newTestWrapper(map[string][]byte{"key-1": randomBytes(t, DataKeySize)})
+		assert.ErrorContains(t, decrypt(ciphertext, wrong), "the key is wrong")
+	})
+
+	t.Run("truncated at a chunk boundary", func(t *testing.T) {
+		hdrSize := len(ciphertext) - 2*(chunkLenSize+chunkSize+16) - (chunkLenSize + 10 + 16)
+		truncated := ciphertext[:hdrSize+chunkLenSize+chunkSize+16]
+		assert.ErrorContains(t, decrypt(truncated, wrapper), "truncated")
+	})
+
+	t.Run("truncated inside a chunk", func(t *testing.T) {
+		assert.Error(t, decrypt(ciphertext[:len(ciphertext)-5], wrapper))
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := bytes.Clone(ciphertext)
+		tampered[len(tampered)-20] ^= 0xff
+		assert.ErrorContains(t, decrypt(tampered, wrapper), "corrupted")
+	})
+
+	t.Run("trailing content", func(t *testing.T) {
+		assert.ErrorContains(t, decrypt(append(bytes.Clone(ciphertext), 'x'), wrapper), "after the last chunk")
+	})
+}
+
+func TestWrapKeyForRecipient(t *testing.T) {
+	recipient, err := GenerateRecipientKey()
+	require.NoError(t, err)
+	dataKey := randomBytes(t, DataKeySize)
+
+	wrapped, err := WrapKeyForRecipient(recipient.PublicKey().Bytes(), dataKey)
+	require.NoError(t, err)
+	assert.NotContains(t, string(wrapped), string(dataKey))
+
+	unwrapped, err := UnwrapKeyForRecipient(recipient, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	other, err := GenerateRecipientKey()
+	require.NoError(t, err)
+	_, err = UnwrapKeyForRecipient(other, wrapped)
+	assert.ErrorContains(t, err, "the key is wrong")
+
+	_, err = UnwrapKeyForRecipient(recipient, wrapped[:10])
+	assert.ErrorContains(t, err, "too short")
+
+	_, err = WrapKeyForRecipient([]byte("invalid"), dataKey)
+	assert.ErrorContains(t, err, "invalid recipient public key")
+}
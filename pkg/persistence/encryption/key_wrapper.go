@@ -0,0 +1,75 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+)
+
+type aesKeyWrapper struct {
+	getKey func(keyID string) ([]byte, error)
+}
+
+// NewAESKeyWrapper returns a KeyWrapper wrapping the data keys with AES-256-GCM,
+// using the key encryption keys returned by getKey.
+func NewAESKeyWrapper(getKey func(keyID string) ([]byte, error)) KeyWrapper {
+	return &aesKeyWrapper{getKey: getKey}
+}
+
+func (w *aesKeyWrapper) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	key, err := w.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "error generating nonce")
+	}
+
+	return aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+func (w *aesKeyWrapper) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	key, err := w.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+
+	dataKey, err := aead.Open(nil, wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, errors.New("error decrypting data key, the key is wrong")
+	}
+
+	return dataKey, nil
+}
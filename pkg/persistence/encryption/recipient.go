@@ -0,0 +1,114 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+)
+
+// GenerateRecipientKey returns a new X25519 key, whose public key the data keys
+// are wrapped for with WrapKeyForRecipient.
+func GenerateRecipientKey() (*ecdh.PrivateKey, error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating recipient key")
+	}
+
+	return key, nil
+}
+
+// WrapKeyForRecipient encrypts the data key for the holder of the private key of
+// the X25519 public key, so the data key can be handed out, e.g. in the status of
+// a DownloadRequest, without being readable by anyone else. The data key is
+// encrypted with AES-256-GCM, using a key agreed between a new ephemeral key and
+// the public key, and the ephemeral public key is prepended to the result.
+func WrapKeyForRecipient(publicKey, dataKey []byte) ([]byte, error) {
+	recipient, err := ecdh.X25519().NewPublicKey(publicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid recipient public key")
+	}
+
+	ephemeral, err := GenerateRecipientKey()
+	if err != nil {
+		return nil, err
+	}
+
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, errors.Wrap(err, "error agreeing on the key with the recipient")
+	}
+
+	aead, err := newAEAD(recipientKEK(shared, ephemeral.PublicKey().Bytes(), publicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	out := append([]byte{}, ephemeral.PublicKey().Bytes()...)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "error generating nonce")
+	}
+	out = append(out, nonce...)
+
+	return aead.Seal(out, nonce, dataKey, nil), nil
+}
+
+// UnwrapKeyForRecipient decrypts the data key wrapped with WrapKeyForRecipient for
+// the public key of the private key.
+func UnwrapKeyForRecipient(privateKey *ecdh.PrivateKey, wrappedKey []byte) ([]byte, error) {
+	publicKeySize := len(privateKey.PublicKey().Bytes())
+	if len(wrappedKey) < publicKeySize+nonceSize {
+		return nil, errors.New("wrapped key is too short")
+	}
+
+	ephemeral, err := ecdh.X25519().NewPublicKey(wrappedKey[:publicKeySize])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ephemeral public key")
+	}
+
+	shared, err := privateKey.ECDH(ephemeral)
+	if err != nil {
+		return nil, errors.Wrap(err, "error agreeing on the key with the sender")
+	}
+
+	aead, err := newAEAD(recipientKEK(shared, wrappedKey[:publicKeySize], privateKey.PublicKey().Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := wrappedKey[publicKeySize : publicKeySize+nonceSize]
+	dataKey, err := aead.Open(nil, nonce, wrappedKey[publicKeySize+nonceSize:], nil)
+	if err != nil {
+		return nil, errors.New("error decrypting data key, the key is wrong")
+	}
+
+	return dataKey, nil
+}
+
+// recipientKEK derives the key encrypting the data key from the shared secret and
+// both public keys.
+func recipientKEK(shared, ephemeralPublicKey, recipientPublicKey []byte) []byte {
+	hash := sha256.New()
+	hash.Write(shared)
+	hash.Write(ephemeralPublicKey)
+	hash.Write(recipientPublicKey)
+	return hash.Sum(nil)
+}
@@ -22,25 +22,37 @@ import (
 	"io"
 	"strings"
 	"time"
+
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
 )
 
 type BucketData map[string][]byte
 
-// inMemoryObjectStore is a simple implementation of the ObjectStore interface
+// objectLock is the retention and legal hold of an object in an inMemoryObjectStore.
+type objectLock struct {
+	mode        osv2.RetentionMode
+	retainUntil time.Time
+	legalHold   bool
+}
+
+// inMemoryObjectStore is a simple implementation of the ObjectStore v2 interface
 // that stores its data in-memory/in-proc. This is mainly intended to be used
 // as a test fake.
 type inMemoryObjectStore struct {
 	Data   map[string]BucketData
+	Locks  map[string]map[string]*objectLock
 	Config map[string]string
 }
 
 func newInMemoryObjectStore(buckets ...string) *inMemoryObjectStore {
 	o := &inMemoryObjectStore{
-		Data: make(map[string]BucketData),
+		Data:  make(map[string]BucketData),
+		Locks: make(map[string]map[string]*objectLock),
 	}
 
 	for _, bucket := range buckets {
 		o.Data[bucket] = make(map[string][]byte)
+		o.Locks[bucket] = make(map[string]*objectLock)
 	}
 
 	return o
@@ -61,6 +73,10 @@ func (o *inMemoryObjectStore) PutObject(bucket, key string, body io.Reader) erro
 		return errors.New("bucket not found")
 	}
 
+	if o.isLocked(bucket, key) {
+		return errors.New("object is locked")
+	}
+
 	obj, err := io.ReadAll(body)
 	if err != nil {
 		return err
@@ -147,7 +163,12 @@ func (o *inMemoryObjectStore) DeleteObject(bucket, key string) error {
 		return errors.New("bucket not found")
 	}
 
+	if o.isLocked(bucket, key) {
+		return errors.New("object is locked")
+	}
+
 	delete(bucketData, key)
+	delete(o.Locks[bucket], key)
 
 	return nil
 }
@@ -166,6 +187,85 @@ func (o *inMemoryObjectStore) CreateSignedURL(bucket, key string, ttl time.Durat
 	return "a-url", nil
 }
 
+func (o *inMemoryObjectStore) PutObjectRetention(bucket, key string, mode osv2.RetentionMode, retainUntil time.Time) error {
+	lock, err := o.getLock(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if lock.mode == osv2.RetentionModeCompliance && retainUntil.Before(lock.retainUntil) {
+		return errors.New("object is locked for longer in compliance mode")
+	}
+
+	lock.mode = mode
+	lock.retainUntil = retainUntil
+
+	return nil
+}
+
+func (o *inMemoryObjectStore) GetObjectRetention(bucket, key string) (time.Time, error) {
+	lock, err := o.getLock(bucket, key)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return lock.retainUntil, nil
+}
+
+func (o *inMemoryObjectStore) PutObjectLegalHold(bucket, key string, hold bool) error {
+	lock, err := o.getLock(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	lock.legalHold = hold
+
+	return nil
+}
+
+func (o *inMemoryObjectStore) GetObjectLegalHold(bucket, key string) (bool, error) {
+	lock, err := o.getLock(bucket, key)
+	if err != nil {
+		return false, err
+	}
+
+	return lock.legalHold, nil
+}
+
+// getLock returns the lock of the object, creating an empty one if the
+// object isn't locked yet.
+func (o *inMemoryObjectStore) getLock(bucket, key string) (*objectLock, error) {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return nil, errors.New("bucket not found")
+	}
+
+	if _, ok := bucketData[key]; !ok {
+		return nil, errors.New("key not found")
+	}
+
+	if o.Locks[bucket] == nil {
+		o.Locks[bucket] = make(map[string]*objectLock)
+	}
+
+	lock, ok := o.Locks[bucket][key]
+	if !ok {
+		lock = new(objectLock)
+		o.Locks[bucket][key] = lock
+	}
+
+	return lock, nil
+}
+
+func (o *inMemoryObjectStore) isLocked(bucket, key string) bool {
+	lock, ok := o.Locks[bucket][key]
+	if !ok {
+		return false
+	}
+
+	return lock.legalHold || time.Now().Before(lock.retainUntil)
+}
+
 //
 // Test Helper Methods
 //
@@ -176,4 +276,5 @@ func (o *inMemoryObjectStore) ClearBucket(bucket string) {
 	}
 
 	o.Data[bucket] = make(map[string][]byte)
+	o.Locks[bucket] = make(map[string]*objectLock)
 }
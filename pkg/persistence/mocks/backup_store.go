@@ -18,7 +18,9 @@ limitations under the License.
 package mocks
 
 import (
+	context "context"
 	io "io"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
@@ -26,6 +28,7 @@ import (
 	itemoperation "github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 
@@ -247,6 +250,29 @@ func (_m *BackupStore) GetCSIVolumeSnapshots(name string) ([]*volumesnapshotv1.V
 	return r0, r1
 }
 
+// GetDownloadDataKey provides a mock function with given fields: target
+func (_m *BackupStore) GetDownloadDataKey(target v1.DownloadTarget) ([]byte, error) {
+	ret := _m.Called(target)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(v1.DownloadTarget) []byte); ok {
+		r0 = rf(target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(v1.DownloadTarget) error); ok {
+		r1 = rf(target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDownloadURL provides a mock function with given fields: target
 func (_m *BackupStore) GetDownloadURL(target v1.DownloadTarget) (string, error) {
 	ret := _m.Called(target)
@@ -314,6 +340,29 @@ func (_m *BackupStore) GetRestoreItemOperations(name string) ([]*itemoperation.R
 	return r0, r1
 }
 
+// GetBackupResourceModifiers provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupResourceModifiers(name string) (*resourcemodifiers.ResourceModifiers, error) {
+	ret := _m.Called(name)
+
+	var r0 *resourcemodifiers.ResourceModifiers
+	if rf, ok := ret.Get(0).(func(string) *resourcemodifiers.ResourceModifiers); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcemodifiers.ResourceModifiers)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreItemOperations provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupVolumeInfos(name string) ([]*volume.BackupVolumeInfo, error) {
 	ret := _m.Called(name)
@@ -351,6 +400,182 @@ func (_m *BackupStore) PutBackupVolumeInfos(name string, volumeInfo io.Reader) e
 	return r0
 }
 
+// PutBackupVerification provides a mock function with given fields: backup, verification
+func (_m *BackupStore) PutBackupVerification(backup string, verification io.Reader) error {
+	ret := _m.Called(backup, verification)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, verification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBackupChecksums provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupChecksums(name string) (*persistence.BackupChecksums, error) {
+	ret := _m.Called(name)
+
+	var r0 *persistence.BackupChecksums
+	if rf, ok := ret.Get(0).(func(string) *persistence.BackupChecksums); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.BackupChecksums)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BuildBackupIndex provides a mock function with given fields: previous
+func (_m *BackupStore) BuildBackupIndex(previous *persistence.BackupIndex) (*persistence.BackupIndex, error) {
+	ret := _m.Called(previous)
+
+	var r0 *persistence.BackupIndex
+	if rf, ok := ret.Get(0).(func(*persistence.BackupIndex) *persistence.BackupIndex); ok {
+		r0 = rf(previous)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.BackupIndex)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.BackupIndex) error); ok {
+		r1 = rf(previous)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupIndex provides a mock function with given fields:
+func (_m *BackupStore) GetBackupIndex() (*persistence.BackupIndex, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.BackupIndex
+	if rf, ok := ret.Get(0).(func() *persistence.BackupIndex); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.BackupIndex)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutBackupIndex provides a mock function with given fields: index
+func (_m *BackupStore) PutBackupIndex(index *persistence.BackupIndex) error {
+	ret := _m.Called(index)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.BackupIndex) error); ok {
+		r0 = rf(index)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockBackup provides a mock function with given fields: name, retainUntil
+func (_m *BackupStore) LockBackup(name string, retainUntil time.Time) error {
+	ret := _m.Called(name, retainUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = rf(name, retainUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBackupLock provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupLock(name string) (*persistence.BackupLock, error) {
+	ret := _m.Called(name)
+
+	var r0 *persistence.BackupLock
+	if rf, ok := ret.Get(0).(func(string) *persistence.BackupLock); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.BackupLock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicateBackup provides a mock function with given fields: ctx, name, destination, progress
+func (_m *BackupStore) ReplicateBackup(ctx context.Context, name string, destination persistence.BackupStore, progress func()) (int, error) {
+	ret := _m.Called(ctx, name, destination, progress)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string, persistence.BackupStore, func()) int); ok {
+		r0 = rf(ctx, name, destination, progress)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, persistence.BackupStore, func()) error); ok {
+		r1 = rf(ctx, name, destination, progress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicateRepository provides a mock function with given fields: ctx, repositoryType, volumeNamespace, destination, progress
+func (_m *BackupStore) ReplicateRepository(ctx context.Context, repositoryType string, volumeNamespace string, destination persistence.BackupStore, progress func()) (int, error) {
+	ret := _m.Called(ctx, repositoryType, volumeNamespace, destination, progress)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, persistence.BackupStore, func()) int); ok {
+		r0 = rf(ctx, repositoryType, volumeNamespace, destination, progress)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, persistence.BackupStore, func()) error); ok {
+		r1 = rf(ctx, repositoryType, volumeNamespace, destination, progress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreResults provides a mock function with given fields: name
 func (_m *BackupStore) GetRestoreResults(name string) (map[string]results.Result, error) {
 	ret := _m.Called(name)
@@ -559,6 +784,21 @@ func (_m *BackupStore) 	PutRestoreVolumeInfo(restore string, results io.Reader)
 
 	return r0
 }
+
+// PutRestoreDryRunResults provides a mock function with given fields: restore, results
+func (_m *BackupStore) PutRestoreDryRunResults(restore string, results io.Reader) error {
+	ret := _m.Called(restore, results)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, results)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewBackupStore interface {
 	mock.TestingT
 	Cleanup(func())
@@ -17,9 +17,16 @@ limitations under the License.
 package persistence
 
 import (
+	"bytes"
 	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"io"
+	"os"
+	"path"
 	"strings"
 	"time"
 
@@ -27,14 +34,19 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	kmv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/keymanager/v1"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
 	"github.com/vmware-tanzu/velero/pkg/util"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
@@ -52,7 +64,8 @@ type BackupInfo struct {
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents,
 	CSIVolumeSnapshotClasses,
-	BackupVolumeInfo io.Reader
+	BackupVolumeInfo,
+	ResourceModifiers io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -76,23 +89,73 @@ type BackupStore interface {
 	GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error)
 	PutBackupVolumeInfos(name string, volumeInfo io.Reader) error
 	GetBackupVolumeInfos(name string) ([]*volume.BackupVolumeInfo, error)
+	// GetBackupResourceModifiers returns the resource modifiers applied to the items of
+	// the backup, or nil if the backup has none stored.
+	GetBackupResourceModifiers(name string) (*resourcemodifiers.ResourceModifiers, error)
 	GetRestoreResults(name string) (map[string]results.Result, error)
+	PutBackupVerification(backup string, verification io.Reader) error
+	GetBackupChecksums(name string) (*BackupChecksums, error)
+
+	// GetBackupIndex returns the index of the backups, or nil if there is none.
+	GetBackupIndex() (*BackupIndex, error)
+
+	// BuildBackupIndex returns the index of the backups listed in the backup
+	// store, reusing the entries of the previous index.
+	BuildBackupIndex(previous *BackupIndex) (*BackupIndex, error)
+
+	// PutBackupIndex writes the index of the backups.
+	PutBackupIndex(index *BackupIndex) error
+
+	// ReplicateBackup copies the files of the backup to the destination backup store,
+	// and returns the number of the copied files.
+	ReplicateBackup(ctx context.Context, name string, destination BackupStore, progress func()) (int, error)
+	// ReplicateRepository copies the files of the backup repository of the type and
+	// volume namespace which the destination backup store doesn't have yet, and
+	// returns the number of the copied files.
+	ReplicateRepository(ctx context.Context, repositoryType, volumeNamespace string, destination BackupStore, progress func()) (int, error)
 
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
 	DeleteBackup(name string) error
 
+	// LockBackup locks the files of the backup so they can't be deleted or overwritten
+	// until retainUntil. It does nothing if the location isn't configured with immutability.
+	LockBackup(name string, retainUntil time.Time) error
+	// GetBackupLock returns the locks of the files of the backup, or nil if the location
+	// isn't configured with immutability.
+	GetBackupLock(name string) (*BackupLock, error)
+
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
 	PutRestoredResourceList(restore string, results io.Reader) error
 	PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error
 	GetRestoreItemOperations(name string) ([]*itemoperation.RestoreOperation, error)
 	PutRestoreVolumeInfo(restore string, volumeInfo io.Reader) error
+	PutRestoreDryRunResults(restore string, results io.Reader) error
 	DeleteRestore(name string) error
 	GetRestoredResourceList(name string) (map[string][]string, error)
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+
+	// GetDownloadDataKey returns the data key decrypting the file of the download
+	// target, or nil if the file isn't encrypted. An error is returned for the
+	// unencrypted files of a location configured with encryption, unless the
+	// location allows them.
+	GetDownloadDataKey(target velerov1api.DownloadTarget) ([]byte, error)
+}
+
+// BackupLock describes the object locks of the files of a backup.
+type BackupLock struct {
+	// RetainUntil is the latest time until which a file of the backup is locked.
+	RetainUntil time.Time
+	// LegalHold is true if a file of the backup is under legal hold.
+	LegalHold bool
+}
+
+// Locked returns true if the backup can't be deleted at the given time.
+func (l *BackupLock) Locked(now time.Time) bool {
+	return l != nil && (l.LegalHold || now.Before(l.RetainUntil))
 }
 
 // DownloadURLTTL is how long a download URL is valid for.
@@ -103,6 +166,17 @@ type objectBackupStore struct {
 	bucket      string
 	layout      *ObjectStoreLayout
 	logger      logrus.FieldLogger
+
+	// lockStore and lockMode are set when the location is configured with immutability.
+	lockStore osv2.ObjectStore
+	lockMode  osv2.RetentionMode
+
+	// keyWrapper and keyID are set when the location is configured with encryption.
+	keyWrapper encryption.KeyWrapper
+	keyID      string
+	// allowUnencrypted allows reading the unencrypted files of a location configured
+	// with encryption, e.g. the ones stored before the encryption was enabled.
+	allowUnencrypted bool
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -111,6 +185,13 @@ type ObjectStoreGetter interface {
 	GetObjectStore(provider string) (velero.ObjectStore, error)
 }
 
+// KeyManagerGetter is a type that can get a KeyManager from a provider name.
+// The ObjectStoreGetter passed to ObjectBackupStoreGetter.Get must implement
+// it for the locations encrypting their files with a KeyManager plugin.
+type KeyManagerGetter interface {
+	GetKeyManager(provider string) (kmv1.KeyManager, error)
+}
+
 // ObjectBackupStoreGetter is a type that can get a velero.BackupStore for a
 // given BackupStorageLocation and ObjectStore.
 type ObjectBackupStoreGetter interface {
@@ -175,7 +256,7 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 			return nil, errors.Wrap(err, "unable to get credentials")
 		}
 
-		objectStoreConfig["credentialsFile"] = credsFile
+		objectStoreConfig[credentials.FileConfigKey] = credsFile
 	}
 
 	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
@@ -183,10 +264,31 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		return nil, err
 	}
 
+	var lockStore osv2.ObjectStore
+	if location.Spec.Immutability != nil {
+		var ok bool
+		if lockStore, ok = objectStore.(osv2.ObjectStore); !ok {
+			return nil, errors.Errorf("object store plugin %s doesn't support object locks, which the immutability of the backup storage location requires", location.Spec.Provider)
+		}
+	}
+
 	if err := objectStore.Init(objectStoreConfig); err != nil {
 		return nil, err
 	}
 
+	if location.Spec.BandwidthLimits != nil {
+		if objectStore, err = newThrottledObjectStore(objectStore, location.Spec.BandwidthLimits, time.Now, logger); err != nil {
+			return nil, errors.Wrap(err, "invalid bandwidth limits")
+		}
+	}
+
+	var keyWrapper encryption.KeyWrapper
+	if location.Spec.Encryption != nil {
+		if keyWrapper, err = b.getKeyWrapper(location.Spec.Encryption, objectStoreGetter); err != nil {
+			return nil, err
+		}
+	}
+
 	log := logger.WithFields(logrus.Fields(map[string]interface{}{
 		"bucket": bucket,
 		"prefix": prefix,
@@ -197,9 +299,98 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		bucket:      bucket,
 		layout:      NewObjectStoreLayout(prefix),
 		logger:      log,
+		lockStore:   lockStore,
+		lockMode:    objectLockMode(location.Spec.Immutability),
+		keyWrapper:  keyWrapper,
+		keyID:       keyIDOf(location.Spec.Encryption),
+
+		allowUnencrypted: location.Spec.Encryption != nil && location.Spec.Encryption.AllowUnencryptedFiles,
 	}, nil
 }
 
+// getKeyWrapper returns the KeyWrapper wrapping the data keys with the key
+// encryption keys of the location's encryption.
+func (b *objectBackupStoreGetter) getKeyWrapper(config *velerov1api.BackupStorageLocationEncryption, objectStoreGetter ObjectStoreGetter) (encryption.KeyWrapper, error) {
+	if config.KeyID == "" {
+		return nil, errors.New("encryption key ID must not be empty")
+	}
+
+	switch {
+	case config.KeySecret != "" && config.KMS != nil:
+		return nil, errors.New("encryption must specify only one of key secret and KMS")
+	case config.KeySecret != "":
+		return encryption.NewAESKeyWrapper(func(keyID string) ([]byte, error) {
+			return b.getSecretKey(config.KeySecret, keyID)
+		}), nil
+	case config.KMS != nil:
+		if config.KMS.Provider == "" {
+			return nil, errors.New("KMS provider name must not be empty")
+		}
+
+		getter, ok := objectStoreGetter.(KeyManagerGetter)
+		if !ok {
+			return nil, errors.Errorf("unable to get key manager plugin %s", config.KMS.Provider)
+		}
+
+		keyManager, err := getter.GetKeyManager(config.KMS.Provider)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := keyManager.Init(config.KMS.Config); err != nil {
+			return nil, errors.Wrapf(err, "error initializing key manager plugin %s", config.KMS.Provider)
+		}
+
+		return keyManager, nil
+	default:
+		return nil, errors.New("encryption must specify a key secret or KMS")
+	}
+}
+
+// getSecretKey returns the key of the given ID from the secret. The key is
+// either 32 raw bytes, or their base64 encoding.
+func (b *objectBackupStoreGetter) getSecretKey(secret, keyID string) ([]byte, error) {
+	keyFile, err := b.credentialStore.Path(&corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{Name: secret},
+		Key:                  keyID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get encryption key %s", keyID)
+	}
+
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read encryption key %s", keyID)
+	}
+
+	if len(data) == encryption.DataKeySize {
+		return data, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != encryption.DataKeySize {
+		return nil, errors.Errorf("encryption key %s must be %d bytes, raw or base64 encoded", keyID, encryption.DataKeySize)
+	}
+
+	return key, nil
+}
+
+func keyIDOf(config *velerov1api.BackupStorageLocationEncryption) string {
+	if config == nil {
+		return ""
+	}
+	return config.KeyID
+}
+
+// objectLockMode returns the retention mode of the object locks set by the
+// location's immutability, defaulting to the compliance mode.
+func objectLockMode(immutability *velerov1api.BackupStorageLocationImmutability) osv2.RetentionMode {
+	if immutability != nil && immutability.Mode == velerov1api.ObjectLockModeGovernance {
+		return osv2.RetentionModeGovernance
+	}
+	return osv2.RetentionModeCompliance
+}
+
 func (s *objectBackupStore) IsValid() error {
 	dirs, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.rootPrefix, "/")
 	if err != nil {
@@ -250,18 +441,22 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 }
 
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+	// The checksums of all the uploaded files are recorded in the checksums manifest
+	// after all the files are uploaded.
+	checksums := map[string]string{}
+
+	if err := s.putObjectWithChecksum(s.layout.getBackupLogKey(info.Name), info.Log, checksums); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
+	if err := s.putObjectWithChecksum(s.layout.getBackupMetadataKey(info.Name), info.Metadata, checksums); err != nil {
 		// failure to upload metadata file is a hard-stop
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.putObjectWithChecksum(s.layout.getBackupContentsKey(info.Name), info.Contents, checksums); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
@@ -278,29 +473,314 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getCSIVolumeSnapshotClassesKey(info.Name):  info.CSIVolumeSnapshotClasses,
 		s.layout.getBackupResultsKey(info.Name):             info.BackupResults,
 		s.layout.getBackupVolumeInfoKey(info.Name):          info.BackupVolumeInfo,
+		s.layout.getBackupResourceModifiersKey(info.Name):   info.ResourceModifiers,
 	}
 
 	for key, reader := range backupObjs {
-		if err := seekAndPutObject(s.objectStore, s.bucket, key, reader); err != nil {
-			errs := []error{err}
+		if err := s.putObjectWithChecksum(key, reader, checksums); err != nil {
+			return s.cleanupFailedBackupUpload(info.Name, err)
+		}
+	}
+
+	if err := s.putBackupChecksums(info.Name, &BackupChecksums{Algorithm: ChecksumAlgorithmSHA256, Checksums: checksums}); err != nil {
+		return s.cleanupFailedBackupUpload(info.Name, err)
+	}
+
+	s.indexBackup(info.Name)
+
+	return nil
+}
+
+// cleanupFailedBackupUpload attempts to clean up the backup contents and metadata if we fail
+// to upload any of the extra files.
+func (s *objectBackupStore) cleanupFailedBackupUpload(backup string, err error) error {
+	errs := []error{err}
+
+	deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(backup))
+	errs = append(errs, deleteErr)
+
+	deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(backup))
+	errs = append(errs, deleteErr)
+	return kerrors.NewAggregate(errs)
+}
+
+// putObjectWithChecksum uploads the file and records its checksum, computed while
+// streaming the file to the object store, in the checksums map keyed by the file name.
+func (s *objectBackupStore) putObjectWithChecksum(key string, file io.Reader, checksums map[string]string) error {
+	if file == nil {
+		return nil
+	}
+
+	if err := seekToBeginning(file); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// the checksum is of the stored content, so the encrypted files can be
+	// verified, e.g. when replicated, without decrypting them
+	content, err := s.encrypt(key, file)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	if err := s.objectStore.PutObject(s.bucket, key, io.TeeReader(content, hash)); err != nil {
+		return err
+	}
 
-			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
-			deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
-			errs = append(errs, deleteErr)
+	checksums[path.Base(key)] = hex.EncodeToString(hash.Sum(nil))
 
-			deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
-			errs = append(errs, deleteErr)
-			return kerrors.NewAggregate(errs)
+	return nil
+}
+
+// putBackupFile uploads a single file of an existing backup and updates its checksum
+// in the backup's checksums manifest.
+func (s *objectBackupStore) putBackupFile(backup, key string, file io.Reader) error {
+	checksums := map[string]string{}
+	if err := s.putObjectWithChecksum(key, file, checksums); err != nil {
+		return err
+	}
+
+	if len(checksums) == 0 {
+		return nil
+	}
+
+	manifest, err := s.GetBackupChecksums(backup)
+	if err != nil {
+		return err
+	}
+
+	for name, checksum := range checksums {
+		manifest.Checksums[name] = checksum
+	}
+
+	return s.putBackupChecksums(backup, manifest)
+}
+
+func (s *objectBackupStore) putBackupChecksums(backup string, manifest *BackupChecksums) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(manifest); err != nil {
+		return errors.Wrap(err, "error encoding backup checksums to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupChecksumsKey(backup), buf)
+}
+
+// GetBackupChecksums returns the checksums manifest of the backup. The returned manifest
+// is empty if the backup has no checksums manifest, e.g. the backup is created by a
+// Velero version that doesn't record the checksums.
+func (s *objectBackupStore) GetBackupChecksums(name string) (*BackupChecksums, error) {
+	manifest := &BackupChecksums{
+		Algorithm: ChecksumAlgorithmSHA256,
+		Checksums: map[string]string{},
+	}
+
+	// the manifest is never encrypted, the checksums in it are of the stored content
+	res, err := s.tryGetStored(s.layout.getBackupChecksumsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return manifest, nil
+	}
+	defer res.Close()
+
+	if err := decode(res, manifest); err != nil {
+		return nil, err
+	}
+
+	if manifest.Algorithm != ChecksumAlgorithmSHA256 {
+		return nil, errors.Errorf("unsupported checksum algorithm %q for backup %s", manifest.Algorithm, name)
+	}
+
+	if manifest.Checksums == nil {
+		manifest.Checksums = map[string]string{}
+	}
+
+	return manifest, nil
+}
+
+// ReplicateBackup copies the files of the backup to the destination backup store. Each
+// copied file is read back from the destination and compared with the checksum of the
+// source file, which is also checked against the checksums manifest of the backup. The
+// metadata file is copied last, so the backup isn't visible in the destination, e.g. to
+// the backup sync controller, until all the other files are copied and verified.
+// progress, if not nil, is called after each copied file, and the replication stops
+// before the next file once ctx is done.
+func (s *objectBackupStore) ReplicateBackup(ctx context.Context, name string, destination BackupStore, progress func()) (int, error) {
+	dest, ok := destination.(*objectBackupStore)
+	if !ok {
+		return 0, errors.Errorf("backup store %T doesn't support replication", destination)
+	}
+
+	manifest, err := s.GetBackupChecksums(name)
+	if err != nil {
+		return 0, err
+	}
+
+	dir := s.layout.getBackupDir(name)
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	metadataKey := s.layout.getBackupMetadataKey(name)
+	files := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key != metadataKey {
+			files = append(files, key)
 		}
 	}
+	if len(files) == len(keys) {
+		return 0, errors.Errorf("backup %s doesn't have a metadata file", name)
+	}
+	files = append(files, metadataKey)
+
+	destDir := dest.layout.getBackupDir(name)
+	copied := 0
+	for _, key := range files {
+		if err := ctx.Err(); err != nil {
+			return copied, errors.WithStack(err)
+		}
+		if err := s.copyObject(key, dest, destDir+strings.TrimPrefix(key, dir), manifest.Checksums[path.Base(key)], true); err != nil {
+			return copied, err
+		}
+		copied++
+		if progress != nil {
+			progress()
+		}
+	}
+
+	dest.indexBackup(name)
+
+	return copied, nil
+}
+
+// ReplicateRepository copies the files of the backup repository which the destination
+// doesn't have yet. The files of a Kopia repository are immutable except the kopia.*
+// ones, such as the maintenance schedule, so those are copied every time. The files
+// deleted from the source repository, e.g. by its maintenance, are kept in the
+// destination. progress and ctx are handled the same way as by ReplicateBackup.
+func (s *objectBackupStore) ReplicateRepository(ctx context.Context, repositoryType, volumeNamespace string, destination BackupStore, progress func()) (int, error) {
+	dest, ok := destination.(*objectBackupStore)
+	if !ok {
+		return 0, errors.Errorf("backup store %T doesn't support replication", destination)
+	}
+
+	if repositoryType != velerov1api.BackupRepositoryTypeKopia {
+		return 0, errors.Errorf("replication of %s repositories isn't supported", repositoryType)
+	}
+
+	dir := s.layout.getRepositoryDir(repositoryType, volumeNamespace)
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	destDir := dest.layout.getRepositoryDir(repositoryType, volumeNamespace)
+	destKeys, err := dest.objectStore.ListObjects(dest.bucket, destDir)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	existing := make(map[string]bool, len(destKeys))
+	for _, key := range destKeys {
+		existing[strings.TrimPrefix(key, destDir)] = true
+	}
+
+	copied := 0
+	for _, key := range keys {
+		file := strings.TrimPrefix(key, dir)
+		if existing[file] && !strings.HasPrefix(path.Base(file), "kopia.") {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return copied, errors.WithStack(err)
+		}
+		if err := s.copyObject(key, dest, destDir+file, "", false); err != nil {
+			return copied, err
+		}
+		copied++
+		if progress != nil {
+			progress()
+		}
+	}
+
+	return copied, nil
+}
+
+// copyObject copies the object to the destination backup store. The checksum of the
+// object is compared with the expected one if it's not empty, and with the checksum of
+// the copy read back from the destination if verify is true. The copy is deleted from
+// the destination if any of the checksums doesn't match, so a corrupted file is never
+// left behind.
+func (s *objectBackupStore) copyObject(key string, destination *objectBackupStore, destinationKey, checksum string, verify bool) error {
+	src, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return errors.Wrapf(err, "error getting %s", key)
+	}
+	defer src.Close()
+
+	hash := sha256.New()
+	if err := destination.objectStore.PutObject(destination.bucket, destinationKey, io.TeeReader(src, hash)); err != nil {
+		return errors.Wrapf(err, "error putting %s", destinationKey)
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+
+	if checksum != "" && sum != checksum {
+		return destination.deleteCorruptedObject(destinationKey,
+			errors.Errorf("checksum of %s doesn't match the checksums manifest, got %s, expected %s", key, sum, checksum))
+	}
+
+	if !verify {
+		return nil
+	}
+
+	copiedSum, err := destination.getObjectChecksum(destinationKey)
+	if err != nil {
+		return err
+	}
+	if copiedSum != sum {
+		return destination.deleteCorruptedObject(destinationKey,
+			errors.Errorf("checksum of the copy %s doesn't match the source, got %s, expected %s", destinationKey, copiedSum, sum))
+	}
 
 	return nil
 }
 
+// getObjectChecksum returns the hex encoded sha256 checksum of the stored object.
+func (s *objectBackupStore) getObjectChecksum(key string) (string, error) {
+	obj, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting %s", key)
+	}
+	defer obj.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, obj); err != nil {
+		return "", errors.Wrapf(err, "error reading %s", key)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// deleteCorruptedObject deletes the object whose checksum didn't match, and returns
+// the checksum error along with the deletion error if any.
+func (s *objectBackupStore) deleteCorruptedObject(key string, checksumErr error) error {
+	if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+		return errors.Wrapf(checksumErr, "error deleting the corrupted copy %s: %v", key, err)
+	}
+	return checksumErr
+}
+
 func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup, error) {
 	metadataKey := s.layout.getBackupMetadataKey(name)
 
-	res, err := s.objectStore.GetObject(s.bucket, metadataKey)
+	res, err := s.getObject(metadataKey)
 	if err != nil {
 		return nil, err
 	}
@@ -328,14 +808,20 @@ func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup,
 }
 
 func (s *objectBackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(backup), backupMetadata)
+	if err := s.putBackupFile(backup, s.layout.getBackupMetadataKey(backup), backupMetadata); err != nil {
+		return err
+	}
+
+	s.indexBackup(backup)
+
+	return nil
 }
 
 func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	// if the volumesnapshots file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no snapshots would not have this file, so check for
 	// its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupVolumeSnapshotsKey(name))
+	res, err := s.tryGet(s.layout.getBackupVolumeSnapshotsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -356,7 +842,7 @@ func (s *objectBackupStore) GetBackupItemOperations(name string) ([]*itemoperati
 	// if the itemoperations file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no async operations would not have this file, so check for
 	// its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(name))
+	res, err := s.tryGet(s.layout.getBackupItemOperationsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -377,7 +863,7 @@ func (s *objectBackupStore) GetRestoreItemOperations(name string) ([]*itemoperat
 	// if the itemoperations file doesn't exist, we don't want to return an error, since
 	// a legacy restore or a restore with no async operations would not have this file, so check for
 	// its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRestoreItemOperationsKey(name))
+	res, err := s.tryGet(s.layout.getRestoreItemOperationsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -396,8 +882,19 @@ func (s *objectBackupStore) GetRestoreItemOperations(name string) ([]*itemoperat
 
 // tryGet returns the object with the given key if it exists, nil if it does not exist,
 // or an error if it was unable to check existence or get the object.
-func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser, error) {
-	exists, err := objectStore.ObjectExists(bucket, key)
+func (s *objectBackupStore) tryGet(key string) (io.ReadCloser, error) {
+	res, err := s.tryGetStored(key)
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	return s.decrypt(res), nil
+}
+
+// tryGetStored is like tryGet, but returns the object as it's stored, without
+// decrypting it.
+func (s *objectBackupStore) tryGetStored(key string) (io.ReadCloser, error) {
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -405,7 +902,78 @@ func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser,
 		return nil, nil
 	}
 
-	return objectStore.GetObject(bucket, key)
+	return s.objectStore.GetObject(s.bucket, key)
+}
+
+// getObject returns the object with the given key, decrypted if it's encrypted.
+func (s *objectBackupStore) getObject(key string) (io.ReadCloser, error) {
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decrypt(res), nil
+}
+
+// putObject uploads the object with the given key, encrypted if the location
+// is configured with encryption.
+func (s *objectBackupStore) putObject(key string, file io.Reader) error {
+	content, err := s.encrypt(key, file)
+	if err != nil {
+		return err
+	}
+
+	return s.objectStore.PutObject(s.bucket, key, content)
+}
+
+func (s *objectBackupStore) encrypt(key string, file io.Reader) (io.Reader, error) {
+	if s.keyWrapper == nil {
+		return file, nil
+	}
+
+	content, err := encryption.Encrypt(file, s.keyID, s.keyWrapper)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error encrypting %s", key)
+	}
+
+	return content, nil
+}
+
+// decrypt returns the decrypted content of the object. The unencrypted objects are
+// returned as they are if the location isn't configured with encryption, or allows
+// them, e.g. to read the objects stored before the encryption was enabled. The object is decrypted on
+// the first read, so the errors of reading the object, e.g. checksum mismatches,
+// are returned by the reads.
+func (s *objectBackupStore) decrypt(res io.ReadCloser) io.ReadCloser {
+	return &decryptingReadCloser{src: res, keyWrapper: s.keyWrapper, allowPlaintext: s.allowsUnencrypted()}
+}
+
+// allowsUnencrypted returns whether the unencrypted objects can be read.
+func (s *objectBackupStore) allowsUnencrypted() bool {
+	return s.keyWrapper == nil || s.allowUnencrypted
+}
+
+type decryptingReadCloser struct {
+	src            io.ReadCloser
+	keyWrapper     encryption.KeyWrapper
+	allowPlaintext bool
+	content        io.Reader
+}
+
+func (r *decryptingReadCloser) Read(p []byte) (int, error) {
+	if r.content == nil {
+		content, err := encryption.Decrypt(r.src, r.keyWrapper, r.allowPlaintext)
+		if err != nil {
+			return 0, err
+		}
+		r.content = content
+	}
+
+	return r.content.Read(p)
+}
+
+func (r *decryptingReadCloser) Close() error {
+	return r.src.Close()
 }
 
 // decode extracts a .json.gz file reader into the object pointed to
@@ -425,7 +993,7 @@ func decode(jsongzReader io.Reader, into interface{}) error {
 }
 
 func (s *objectBackupStore) GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error) {
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getCSIVolumeSnapshotClassesKey(name))
+	res, err := s.tryGet(s.layout.getCSIVolumeSnapshotClassesKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -443,7 +1011,7 @@ func (s *objectBackupStore) GetCSIVolumeSnapshotClasses(name string) ([]*snapsho
 }
 
 func (s *objectBackupStore) GetCSIVolumeSnapshots(name string) ([]*snapshotv1api.VolumeSnapshot, error) {
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getCSIVolumeSnapshotKey(name))
+	res, err := s.tryGet(s.layout.getCSIVolumeSnapshotKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -461,7 +1029,7 @@ func (s *objectBackupStore) GetCSIVolumeSnapshots(name string) ([]*snapshotv1api
 }
 
 func (s *objectBackupStore) GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1api.VolumeSnapshotContent, error) {
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getCSIVolumeSnapshotContentsKey(name))
+	res, err := s.tryGet(s.layout.getCSIVolumeSnapshotContentsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -482,7 +1050,7 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 	// if the podvolumebackups file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no pod volume backups would not have this file, so
 	// check for its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getPodVolumeBackupsKey(name))
+	res, err := s.tryGet(s.layout.getPodVolumeBackupsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -502,7 +1070,7 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 func (s *objectBackupStore) GetBackupVolumeInfos(name string) ([]*volume.BackupVolumeInfo, error) {
 	volumeInfos := make([]*volume.BackupVolumeInfo, 0)
 
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupVolumeInfoKey(name))
+	res, err := s.tryGet(s.layout.getBackupVolumeInfoKey(name))
 	if err != nil {
 		return volumeInfos, err
 	}
@@ -518,14 +1086,36 @@ func (s *objectBackupStore) GetBackupVolumeInfos(name string) ([]*volume.BackupV
 	return volumeInfos, nil
 }
 
+func (s *objectBackupStore) GetBackupResourceModifiers(name string) (*resourcemodifiers.ResourceModifiers, error) {
+	res, err := s.tryGet(s.layout.getBackupResourceModifiersKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	resourceModifiers := new(resourcemodifiers.ResourceModifiers)
+	if err := decode(res, resourceModifiers); err != nil {
+		return nil, err
+	}
+
+	return resourceModifiers, nil
+}
+
 func (s *objectBackupStore) PutBackupVolumeInfos(name string, volumeInfo io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getBackupVolumeInfoKey(name), volumeInfo)
+	return s.putBackupFile(name, s.layout.getBackupVolumeInfoKey(name), volumeInfo)
+}
+
+func (s *objectBackupStore) PutBackupVerification(backup string, verification io.Reader) error {
+	return s.putObject(s.layout.getBackupVerificationKey(backup), verification)
 }
 
 func (s *objectBackupStore) GetRestoreResults(name string) (map[string]results.Result, error) {
 	results := make(map[string]results.Result)
 
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRestoreResultsKey(name))
+	res, err := s.tryGet(s.layout.getRestoreResultsKey(name))
 	if err != nil {
 		return results, err
 	}
@@ -541,8 +1131,26 @@ func (s *objectBackupStore) GetRestoreResults(name string) (map[string]results.R
 	return results, nil
 }
 
+// GetBackupContents returns the backup tarball. If the checksum of the tarball is recorded,
+// reading to the end of the returned reader fails with a ChecksumMismatchError when the
+// content doesn't match the checksum.
 func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
-	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
+	manifest, err := s.GetBackupChecksums(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting backup checksums")
+	}
+
+	key := s.layout.getBackupContentsKey(name)
+	contents, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if checksum, ok := manifest.Checksums[path.Base(key)]; ok {
+		contents = newChecksumVerifyingReader(contents, path.Base(key), checksum)
+	}
+
+	return s.decrypt(contents), nil
 }
 
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
@@ -565,9 +1173,77 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 		}
 	}
 
+	if len(errs) == 0 {
+		s.unindexBackup(name)
+	}
+
+	return errors.WithStack(kerrors.NewAggregate(errs))
+}
+
+func (s *objectBackupStore) LockBackup(name string, retainUntil time.Time) error {
+	if s.lockStore == nil {
+		return nil
+	}
+
+	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, key := range objects {
+		current, err := s.lockStore.GetObjectRetention(s.bucket, key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// never shorten an existing lock, which the compliance mode doesn't allow anyway
+		if !current.Before(retainUntil) {
+			continue
+		}
+
+		s.logger.WithFields(logrus.Fields{
+			"key":         key,
+			"retainUntil": retainUntil,
+		}).Debug("Locking object")
+		if err := s.lockStore.PutObjectRetention(s.bucket, key, s.lockMode, retainUntil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) GetBackupLock(name string) (*BackupLock, error) {
+	if s.lockStore == nil {
+		return nil, nil
+	}
+
+	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
+	if err != nil {
+		return nil, err
+	}
+
+	lock := new(BackupLock)
+	for _, key := range objects {
+		retainUntil, err := s.lockStore.GetObjectRetention(s.bucket, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting the retention of object %s", key)
+		}
+		if retainUntil.After(lock.RetainUntil) {
+			lock.RetainUntil = retainUntil
+		}
+
+		hold, err := s.lockStore.GetObjectLegalHold(s.bucket, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting the legal hold of object %s", key)
+		}
+		lock.LegalHold = lock.LegalHold || hold
+	}
+
+	return lock, nil
+}
+
 func (s *objectBackupStore) DeleteRestore(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRestoreDir(name))
 	if err != nil {
@@ -588,63 +1264,119 @@ func (s *objectBackupStore) DeleteRestore(name string) error {
 }
 
 func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log)
+	return s.putObject(s.layout.getRestoreLogKey(restore), log)
 }
 
 func (s *objectBackupStore) PutRestoreResults(backup string, restore string, results io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
+	return s.putObject(s.layout.getRestoreResultsKey(restore), results)
 }
 
 func (s *objectBackupStore) PutRestoredResourceList(restore string, list io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResourceListKey(restore), list)
+	return s.putObject(s.layout.getRestoreResourceListKey(restore), list)
 }
 
 func (s *objectBackupStore) PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreItemOperationsKey(restore), restoreItemOperations)
+	return s.seekAndPutObject(s.layout.getRestoreItemOperationsKey(restore), restoreItemOperations)
 }
 
 func (s *objectBackupStore) PutRestoreVolumeInfo(restore string, volumeInfo io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreVolumeInfoKey(restore), volumeInfo)
+	return s.seekAndPutObject(s.layout.getRestoreVolumeInfoKey(restore), volumeInfo)
+}
+
+func (s *objectBackupStore) PutRestoreDryRunResults(restore string, results io.Reader) error {
+	return s.seekAndPutObject(s.layout.getRestoreDryRunResultsKey(restore), results)
 }
 
 func (s *objectBackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(backup), backupItemOperations)
+	return s.putBackupFile(backup, s.layout.getBackupItemOperationsKey(backup), backupItemOperations)
 }
 
 func (s *objectBackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(backup), backupContents)
+	return s.putBackupFile(backup, s.layout.getBackupContentsKey(backup), backupContents)
 }
 
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
+	key, err := s.getDownloadKey(target)
+	if err != nil {
+		return "", err
+	}
+
+	return s.objectStore.CreateSignedURL(s.bucket, key, DownloadURLTTL)
+}
+
+func (s *objectBackupStore) GetDownloadDataKey(target velerov1api.DownloadTarget) ([]byte, error) {
+	// the checksums manifest is never encrypted
+	if s.keyWrapper == nil || target.Kind == velerov1api.DownloadTargetKindBackupChecksums {
+		return nil, nil
+	}
+
+	key, err := s.getDownloadKey(target)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	dataKey, err := encryption.ReadDataKey(res, s.keyWrapper)
+	if errors.Is(err, encryption.ErrNotEncrypted) && s.allowUnencrypted {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading data key of %s", key)
+	}
+
+	return dataKey, nil
+}
+
+// getDownloadKey returns the key of the file of the download target.
+func (s *objectBackupStore) getDownloadKey(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupContentsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupLogKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupLogKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupVolumeSnapshotsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupItemOperations:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupItemOperationsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupItemOperationsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreItemOperations:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreItemOperationsKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreItemOperationsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupResourceListKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreLogKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreResults:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreResultsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResourceListKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreResourceListKey(target.Name), nil
 	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotKey(target.Name), DownloadURLTTL)
+		return s.layout.getCSIVolumeSnapshotKey(target.Name), nil
 	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotContentsKey(target.Name), DownloadURLTTL)
+		return s.layout.getCSIVolumeSnapshotContentsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupResults:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResultsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupResultsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupVolumeInfos:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeInfoKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupVolumeInfoKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupChecksums:
+		return s.layout.getBackupChecksumsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupVerification:
+		return s.layout.getBackupVerificationKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreVolumeInfo:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreVolumeInfoKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreVolumeInfoKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreDryRunResults:
+		return s.layout.getRestoreDryRunResultsKey(target.Name), nil
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
@@ -653,7 +1385,7 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 func (s *objectBackupStore) GetRestoredResourceList(name string) (map[string][]string, error) {
 	list := make(map[string][]string)
 
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRestoreResourceListKey(name))
+	res, err := s.tryGet(s.layout.getRestoreResourceListKey(name))
 	if err != nil {
 		return list, err
 	}
@@ -679,7 +1411,7 @@ func seekToBeginning(r io.Reader) error {
 	return err
 }
 
-func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file io.Reader) error {
+func (s *objectBackupStore) seekAndPutObject(key string, file io.Reader) error {
 	if file == nil {
 		return nil
 	}
@@ -688,5 +1420,5 @@ func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file i
 		return errors.WithStack(err)
 	}
 
-	return objectStore.PutObject(bucket, key, file)
+	return s.putObject(key, file)
 }
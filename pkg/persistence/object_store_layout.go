@@ -65,6 +65,10 @@ func (l *ObjectStoreLayout) getBackupDir(backup string) string {
 	return path.Join(l.subdirs["backups"], backup) + "/"
 }
 
+func (l *ObjectStoreLayout) getRepositoryDir(repositoryType, volumeNamespace string) string {
+	return path.Join(l.subdirs[repositoryType], volumeNamespace) + "/"
+}
+
 func (l *ObjectStoreLayout) getRestoreDir(restore string) string {
 	return path.Join(l.subdirs["restores"], restore) + "/"
 }
@@ -113,6 +117,10 @@ func (l *ObjectStoreLayout) getRestoreItemOperationsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-itemoperations.json.gz", restore))
 }
 
+func (l *ObjectStoreLayout) getRestoreDryRunResultsKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-dryrun-results.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshots.json.gz", backup))
 }
@@ -133,6 +141,22 @@ func (l *ObjectStoreLayout) getBackupVolumeInfoKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-volumeinfo.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupResourceModifiersKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-modifiers.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupChecksumsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-checksums.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupVerificationKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-verification.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getRestoreVolumeInfoKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("%s-volumeinfo.json.gz", restore))
 }
+
+func (l *ObjectStoreLayout) getBackupIndexKey() string {
+	return path.Join(l.subdirs["metadata"], "backup-index.json.gz")
+}
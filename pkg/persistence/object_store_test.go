@@ -19,13 +19,18 @@ package persistence
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	"github.com/stretchr/testify/assert"
@@ -34,13 +39,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
@@ -252,6 +260,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-itemoperations.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-checksums.json.gz",
 			},
 		},
 		{
@@ -275,6 +284,7 @@ func TestPutBackup(t *testing.T) {
 				"prefix-1/backups/backup-1/backup-1-itemoperations.json.gz",
 				"prefix-1/backups/backup-1/backup-1-resource-list.json.gz",
 				"prefix-1/backups/backup-1/backup-1-volumeinfo.json.gz",
+				"prefix-1/backups/backup-1/backup-1-checksums.json.gz",
 			},
 		},
 		{
@@ -321,6 +331,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-itemoperations.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-checksums.json.gz",
 			},
 		},
 		{
@@ -340,6 +351,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-checksums.json.gz",
 			},
 		},
 	}
@@ -570,6 +582,197 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestGetBackupContentsWithChecksum(t *testing.T) {
+	tests := []struct {
+		name        string
+		checksums   map[string]string
+		expectedErr string
+	}{
+		{
+			name: "no checksum is recorded",
+		},
+		{
+			name: "checksum matches",
+			checksums: map[string]string{
+				// sha256 of "foo"
+				"test-backup.tar.gz": "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+			},
+		},
+		{
+			name: "checksum mismatches",
+			checksums: map[string]string{
+				"test-backup.tar.gz": "0000",
+			},
+			expectedErr: "checksum mismatch for backup file test-backup.tar.gz: expected sha256 0000, got 2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae, the file may be corrupted or tampered with",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+			require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup.tar.gz", newStringReadSeeker("foo")))
+			if test.checksums != nil {
+				require.NoError(t, harness.putBackupChecksums("test-backup", &BackupChecksums{Algorithm: ChecksumAlgorithmSHA256, Checksums: test.checksums}))
+			}
+
+			rc, err := harness.GetBackupContents("test-backup")
+			require.NoError(t, err)
+
+			data, err := io.ReadAll(rc)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "foo", string(data))
+		})
+	}
+}
+
+func TestBackupChecksumsUpdatedOnUpload(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: newStringReadSeeker("metadata"),
+		Contents: newStringReadSeeker("contents"),
+		Log:      newStringReadSeeker("log"),
+	}))
+
+	manifest, err := harness.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, ChecksumAlgorithmSHA256, manifest.Algorithm)
+	assert.Equal(t, map[string]string{
+		"velero-backup.json": "45447b7afbd5e544f7d0f1df0fccd26014d9850130abd3f020b89ff96b82079f",
+		"backup-1.tar.gz":    "d1b2a59fbea7e20077af9f91b27e95e865061b270be03ff539ab3b73587882e8",
+		"backup-1-logs.gz":   "836ff184e7b41b1e13cb5fd89fa1de98dbbab99e9d2918913ff43b86a5c7c213",
+	}, manifest.Checksums)
+
+	// the contents are re-uploaded, e.g. after the async operations complete
+	require.NoError(t, harness.PutBackupContents("backup-1", newStringReadSeeker("foo")))
+
+	manifest, err = harness.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	assert.Len(t, manifest.Checksums, 3)
+	assert.Equal(t, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", manifest.Checksums["backup-1.tar.gz"])
+
+	rc, err := harness.GetBackupContents("backup-1")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", string(data))
+}
+
+func TestReplicateBackup(t *testing.T) {
+	source := newObjectBackupStoreTestHarness("source-bucket", "")
+	destination := newObjectBackupStoreTestHarness("destination-bucket", "velero/dr")
+
+	require.NoError(t, source.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: newStringReadSeeker("metadata"),
+		Contents: newStringReadSeeker("contents"),
+		Log:      newStringReadSeeker("log"),
+	}))
+
+	copied, err := source.ReplicateBackup(context.Background(), "backup-1", destination.objectBackupStore, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 4, copied)
+
+	exists, err := destination.BackupExists("destination-bucket", "backup-1")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "contents", string(destination.objectStore.Data["destination-bucket"]["velero/dr/backups/backup-1/backup-1.tar.gz"]))
+	assert.Equal(t, "metadata", string(destination.objectStore.Data["destination-bucket"]["velero/dr/backups/backup-1/velero-backup.json"]))
+
+	manifest, err := destination.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	assert.Len(t, manifest.Checksums, 3)
+
+	// a file corrupted in the source isn't copied, and the backup stays
+	// invisible in a new destination
+	source.objectStore.Data["source-bucket"]["backups/backup-1/backup-1.tar.gz"] = []byte("corrupted")
+	destination = newObjectBackupStoreTestHarness("destination-bucket", "")
+	_, err = source.ReplicateBackup(context.Background(), "backup-1", destination.objectBackupStore, nil)
+	require.ErrorContains(t, err, "checksum of backups/backup-1/backup-1.tar.gz doesn't match the checksums manifest")
+	assert.NotContains(t, destination.objectStore.Data["destination-bucket"], "backups/backup-1/backup-1.tar.gz")
+	exists, err = destination.BackupExists("destination-bucket", "backup-1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// a copy corrupted in the destination is deleted
+	source.objectStore.Data["source-bucket"]["backups/backup-1/backup-1.tar.gz"] = []byte("contents")
+	destination = newObjectBackupStoreTestHarness("destination-bucket", "")
+	destination.objectBackupStore.objectStore = &corruptingObjectStore{inMemoryObjectStore: destination.objectStore}
+	copied, err = source.ReplicateBackup(context.Background(), "backup-1", destination.objectBackupStore, nil)
+	require.ErrorContains(t, err, "doesn't match the source")
+	assert.Equal(t, 0, copied)
+	assert.Empty(t, destination.objectStore.Data["destination-bucket"])
+
+	// the replication stops once the context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	destination = newObjectBackupStoreTestHarness("destination-bucket", "")
+	copied, err = source.ReplicateBackup(ctx, "backup-1", destination.objectBackupStore, cancel)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, copied)
+
+	// a backup without metadata can't be replicated
+	_, err = source.ReplicateBackup(context.Background(), "backup-2", destination.objectBackupStore, nil)
+	require.EqualError(t, err, "backup backup-2 doesn't have a metadata file")
+}
+
+// corruptingObjectStore stores corrupted data instead of the data of the objects put.
+type corruptingObjectStore struct {
+	*inMemoryObjectStore
+}
+
+func (o *corruptingObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		return err
+	}
+	return o.inMemoryObjectStore.PutObject(bucket, key, strings.NewReader("corrupted"))
+}
+
+func TestReplicateRepository(t *testing.T) {
+	source := newObjectBackupStoreTestHarness("source-bucket", "velero")
+	destination := newObjectBackupStoreTestHarness("destination-bucket", "")
+
+	for key, data := range map[string]string{
+		"velero/kopia/ns-1/kopia.repository":  "format",
+		"velero/kopia/ns-1/kopia.maintenance": "maintenance-2",
+		"velero/kopia/ns-1/p0001":             "pack-1",
+		"velero/kopia/ns-1/p0002":             "pack-2",
+		"velero/kopia/ns-2/p0001":             "other-pack",
+	} {
+		require.NoError(t, source.objectStore.PutObject("source-bucket", key, newStringReadSeeker(data)))
+	}
+	for key, data := range map[string]string{
+		"kopia/ns-1/kopia.repository":  "format",
+		"kopia/ns-1/kopia.maintenance": "maintenance-1",
+		"kopia/ns-1/p0001":             "pack-1",
+		"kopia/ns-1/p0003":             "deleted-pack",
+	} {
+		require.NoError(t, destination.objectStore.PutObject("destination-bucket", key, newStringReadSeeker(data)))
+	}
+
+	progress := 0
+	copied, err := source.ReplicateRepository(context.Background(), velerov1api.BackupRepositoryTypeKopia, "ns-1", destination.objectBackupStore, func() { progress++ })
+	require.NoError(t, err)
+	// the kopia.* files and the missing pack are copied
+	assert.Equal(t, 3, copied)
+	assert.Equal(t, 3, progress)
+	assert.Equal(t, BucketData{
+		"kopia/ns-1/kopia.repository":  []byte("format"),
+		"kopia/ns-1/kopia.maintenance": []byte("maintenance-2"),
+		"kopia/ns-1/p0001":             []byte("pack-1"),
+		"kopia/ns-1/p0002":             []byte("pack-2"),
+		"kopia/ns-1/p0003":             []byte("deleted-pack"),
+	}, destination.objectStore.Data["destination-bucket"])
+
+	_, err = source.ReplicateRepository(context.Background(), velerov1api.BackupRepositoryTypeRestic, "ns-1", destination.objectBackupStore, nil)
+	require.EqualError(t, err, "replication of restic repositories isn't supported")
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -614,6 +817,8 @@ func TestDeleteBackup(t *testing.T) {
 
 				objectStore.On("DeleteObject", backupStore.bucket, obj).Return(err)
 			}
+			// the backup store has no backup index to update
+			objectStore.On("ObjectExists", backupStore.bucket, test.prefix+"metadata/backup-index.json.gz").Return(false, nil).Maybe()
 
 			err := backupStore.DeleteBackup("bak")
 
@@ -622,6 +827,50 @@ func TestDeleteBackup(t *testing.T) {
 	}
 }
 
+func TestLockBackup(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	files := []string{"backups/bak/velero-backup.json", "backups/bak/bak.tar.gz", "backups/other/velero-backup.json"}
+	for _, key := range files {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, newStringReadSeeker("foo")))
+	}
+
+	// without immutability, nothing is locked
+	require.NoError(t, harness.LockBackup("bak", time.Now().Add(time.Hour)))
+	lock, err := harness.GetBackupLock("bak")
+	require.NoError(t, err)
+	assert.Nil(t, lock)
+
+	harness.lockStore = harness.objectStore
+	harness.lockMode = osv2.RetentionModeCompliance
+
+	retainUntil := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, harness.LockBackup("bak", retainUntil))
+
+	// an earlier time doesn't shorten the locks
+	require.NoError(t, harness.LockBackup("bak", retainUntil.Add(-time.Minute)))
+
+	lock, err = harness.GetBackupLock("bak")
+	require.NoError(t, err)
+	assert.Equal(t, &BackupLock{RetainUntil: retainUntil}, lock)
+	assert.True(t, lock.Locked(time.Now()))
+	assert.False(t, lock.Locked(retainUntil))
+
+	// the files of the other backup aren't locked
+	lock, err = harness.GetBackupLock("other")
+	require.NoError(t, err)
+	assert.False(t, lock.Locked(time.Now()))
+
+	// the locked files can't be deleted
+	require.Error(t, harness.DeleteBackup("bak"))
+	require.NoError(t, harness.DeleteBackup("other"))
+
+	require.NoError(t, harness.objectStore.PutObjectLegalHold(harness.bucket, "backups/bak/bak.tar.gz", true))
+	lock, err = harness.GetBackupLock("bak")
+	require.NoError(t, err)
+	assert.True(t, lock.LegalHold)
+	assert.True(t, lock.Locked(retainUntil.Add(time.Hour)))
+}
+
 func TestDeleteRestore(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -746,6 +995,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindRestoreResults:        "restores/my-backup/restore-my-backup-results.gz",
 				velerov1api.DownloadTargetKindRestoreItemOperations: "restores/my-backup/restore-my-backup-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindRestoreResourceList:   "restores/my-backup/restore-my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindRestoreDryRunResults:  "restores/my-backup/restore-my-backup-dryrun-results.json.gz",
 			},
 		},
 		{
@@ -757,6 +1007,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindRestoreResults:        "velero-backups/restores/my-backup/restore-my-backup-results.gz",
 				velerov1api.DownloadTargetKindRestoreItemOperations: "velero-backups/restores/my-backup/restore-my-backup-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindRestoreResourceList:   "velero-backups/restores/my-backup/restore-my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindRestoreDryRunResults:  "velero-backups/restores/my-backup/restore-my-backup-dryrun-results.json.gz",
 			},
 		},
 		{
@@ -773,7 +1024,8 @@ func TestGetDownloadURL(t *testing.T) {
 			name:       "",
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindBackupVolumeInfos: "backups/my-backup/my-backup-volumeinfo.json.gz",
+				velerov1api.DownloadTargetKindBackupVolumeInfos:  "backups/my-backup/my-backup-volumeinfo.json.gz",
+				velerov1api.DownloadTargetKindBackupVerification: "backups/my-backup/my-backup-verification.json.gz",
 			},
 		},
 	}
@@ -911,6 +1163,90 @@ func (osg objectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore
 // TestNewObjectBackupStore runs the NewObjectBackupStoreGetter constructor and ensures
 // that it provides a BackupStore with a correctly constructed ObjectBackupStore or
 // that an appropriate error is returned.
+func TestEncryptedBackupStore(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key-1")
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, encryption.DataKeySize))), 0600))
+
+	location := builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Encryption(&velerov1api.BackupStorageLocationEncryption{
+		KeyID:     "key-1",
+		KeySecret: "keys",
+	}).Result()
+	objectStore := newInMemoryObjectStore("bucket")
+
+	res, err := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(keyFile, nil)).Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	require.NoError(t, err)
+	store := res.(*objectBackupStore)
+
+	metadata := new(bytes.Buffer)
+	require.NoError(t, encode.To(builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result(), "json", metadata))
+
+	require.NoError(t, store.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: metadata,
+		Contents: newStringReadSeeker("contents"),
+		Log:      newStringReadSeeker("log"),
+	}))
+
+	// the files are stored encrypted, except the checksums manifest
+	assert.NotContains(t, string(objectStore.Data["bucket"]["backups/backup-1/backup-1.tar.gz"]), "contents")
+	assert.NotContains(t, string(objectStore.Data["bucket"]["backups/backup-1/velero-backup.json"]), "backup-1")
+	manifest, err := store.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	assert.Len(t, manifest.Checksums, 3)
+
+	backup, err := store.GetBackupMetadata("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-1", backup.Name)
+
+	rc, err := store.GetBackupContents("backup-1")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	// the data key passed along with the download URL decrypts the file
+	target := velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContents, Name: "backup-1"}
+	dataKey, err := store.GetDownloadDataKey(target)
+	require.NoError(t, err)
+	decrypted, err := encryption.DecryptWithDataKey(bytes.NewReader(objectStore.Data["bucket"]["backups/backup-1/backup-1.tar.gz"]), dataKey)
+	require.NoError(t, err)
+	data, err = io.ReadAll(decrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	// the unencrypted files, e.g. the ones stored before the encryption is enabled,
+	// can't be read unless the location allows them
+	require.NoError(t, objectStore.PutObject("bucket", "backups/backup-2/backup-2.tar.gz", newStringReadSeeker("plaintext")))
+	rc, err = store.GetBackupContents("backup-2")
+	require.NoError(t, err)
+	_, err = io.ReadAll(rc)
+	require.ErrorIs(t, err, encryption.ErrNotEncrypted)
+	_, err = store.GetDownloadDataKey(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContents, Name: "backup-2"})
+	require.ErrorIs(t, err, encryption.ErrNotEncrypted)
+
+	store.allowUnencrypted = true
+	rc, err = store.GetBackupContents("backup-2")
+	require.NoError(t, err)
+	data, err = io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext", string(data))
+	dataKey, err = store.GetDownloadDataKey(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContents, Name: "backup-2"})
+	require.NoError(t, err)
+	assert.Nil(t, dataKey)
+
+	// the checksums manifest is never encrypted
+	dataKey, err = store.GetDownloadDataKey(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupChecksums, Name: "backup-1"})
+	require.NoError(t, err)
+	assert.Nil(t, dataKey)
+
+	// the encrypted files can't be read without the key
+	harness := newObjectBackupStoreTestHarness("bucket", "")
+	harness.objectStore = objectStore
+	harness.objectBackupStore.objectStore = objectStore
+	_, err = harness.GetBackupMetadata("backup-1")
+	require.ErrorContains(t, err, "content is encrypted with key key-1, but no key is configured")
+}
+
 func TestNewObjectBackupStoreGetter(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -977,6 +1313,67 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 			wantBucket:    "bucket",
 			wantPrefix:    "prefix/",
 		},
+		{
+			name:     "when the location has immutability and the object store doesn't support object locks, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Immutability(velerov1api.ObjectLockModeCompliance, 0).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": new(providermocks.ObjectStore),
+			},
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "object store plugin provider-1 doesn't support object locks, which the immutability of the backup storage location requires",
+		},
+		{
+			name:     "when the location has immutability and the object store supports object locks, a backup store is retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Immutability(velerov1api.ObjectLockModeGovernance, 0).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantBucket:    "bucket",
+		},
+		{
+			name:     "when the location's encryption has no key ID, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Encryption(&velerov1api.BackupStorageLocationEncryption{KeySecret: "keys"}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "encryption key ID must not be empty",
+		},
+		{
+			name: "when the location's encryption has both a key secret and KMS, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Encryption(&velerov1api.BackupStorageLocationEncryption{
+				KeyID:     "key-1",
+				KeySecret: "keys",
+				KMS:       &velerov1api.KMSConfig{Provider: "kms-1"},
+			}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "encryption must specify only one of key secret and KMS",
+		},
+		{
+			name:     "when the location's encryption has neither a key secret nor KMS, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Encryption(&velerov1api.BackupStorageLocationEncryption{KeyID: "key-1"}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "encryption must specify a key secret or KMS",
+		},
+		{
+			name: "when the location's encryption uses KMS and key managers can't be retrieved, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Encryption(&velerov1api.BackupStorageLocationEncryption{
+				KeyID: "key-1",
+				KMS:   &velerov1api.KMSConfig{Provider: "kms-1"},
+			}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "unable to get key manager plugin kms-1",
+		},
 	}
 
 	for _, tc := range tests {
@@ -1145,6 +1542,40 @@ func TestGetBackupVolumeInfos(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBackupResourceModifiers(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// the backup without stored resource modifiers has none
+	result, err := harness.GetBackupResourceModifiers("test-backup")
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	resourceModifiers := &resourcemodifiers.ResourceModifiers{
+		Version: "v1",
+		ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{
+			{
+				Conditions: resourcemodifiers.Conditions{GroupResource: "deployments.apps"},
+				Patches:    []resourcemodifiers.JSONPatch{{Operation: "replace", Path: "/spec/replicas", Value: "0"}},
+			},
+		},
+	}
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+	require.NoError(t, json.NewEncoder(gzw).Encode(resourceModifiers))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:              "test-backup",
+		Metadata:          newStringReadSeeker("metadata"),
+		Contents:          newStringReadSeeker("contents"),
+		ResourceModifiers: obj,
+	}))
+
+	result, err = harness.GetBackupResourceModifiers("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, resourceModifiers, result)
+}
+
 func TestGetRestoreResults(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -1239,7 +1670,8 @@ func TestPutBackupVolumeInfos(t *testing.T) {
 			err := harness.PutBackupVolumeInfos("backup-1", buf)
 
 			velerotest.AssertErrorMatches(t, tc.expectedErr, err)
-			assert.Len(t, harness.objectStore.Data[harness.bucket], len(tc.expectedKeys))
+			// the checksums manifest is uploaded along with the volume infos
+			assert.Len(t, harness.objectStore.Data[harness.bucket], len(tc.expectedKeys)+1)
 			for _, key := range tc.expectedKeys {
 				assert.Contains(t, harness.objectStore.Data[harness.bucket], key)
 				assert.Equal(t, harness.objectStore.Data[harness.bucket][key], bufferContent)
@@ -1248,6 +1680,14 @@ func TestPutBackupVolumeInfos(t *testing.T) {
 	}
 }
 
+func TestPutBackupVerification(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("foo", "")
+
+	require.NoError(t, harness.PutBackupVerification("backup-1", newStringReadSeeker("verification")))
+	assert.Len(t, harness.objectStore.Data[harness.bucket], 1)
+	assert.Equal(t, []byte("verification"), harness.objectStore.Data[harness.bucket]["backups/backup-1/backup-1-verification.json.gz"])
+}
+
 func encodeToBytes(obj runtime.Object) []byte {
 	res, err := encode.Encode(obj, "json")
 	if err != nil {
@@ -0,0 +1,138 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/bandwidth"
+)
+
+// throttledObjectStore limits the rate of the operations of an object store,
+// and of the data uploaded to and downloaded from it, to the bandwidth limits
+// of a backup storage location. The limits are reevaluated on every operation,
+// so the scheduled ones apply as the day goes.
+type throttledObjectStore struct {
+	velero.ObjectStore
+	limits *velerov1api.BandwidthLimits
+	now    func() time.Time
+	logger logrus.FieldLogger
+
+	lock     sync.Mutex
+	current  velerov1api.BandwidthLimit
+	upload   *rate.Limiter
+	download *rate.Limiter
+	ops      *rate.Limiter
+}
+
+func newThrottledObjectStore(objectStore velero.ObjectStore, limits *velerov1api.BandwidthLimits, now func() time.Time, logger logrus.FieldLogger) (*throttledObjectStore, error) {
+	current, err := bandwidth.Current(limits, now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &throttledObjectStore{
+		ObjectStore: objectStore,
+		limits:      limits,
+		now:         now,
+		logger:      logger,
+		current:     current,
+		upload:      bandwidth.NewLimiter(current.UploadBytesPerSecond),
+		download:    bandwidth.NewLimiter(current.DownloadBytesPerSecond),
+		ops:         bandwidth.NewLimiter(current.OperationsPerSecond),
+	}, nil
+}
+
+// wait updates the limiters to the limits applying now, and waits until the
+// next operation is allowed.
+func (t *throttledObjectStore) wait() error {
+	t.lock.Lock()
+	current, err := bandwidth.Current(t.limits, t.now())
+	if err != nil {
+		// the limits were valid when the store was created, so this can't happen
+		t.logger.WithError(err).Warn("Error evaluating the bandwidth limits, keeping the current ones")
+	} else if current != t.current {
+		t.current = current
+		bandwidth.SetLimit(t.upload, current.UploadBytesPerSecond)
+		bandwidth.SetLimit(t.download, current.DownloadBytesPerSecond)
+		bandwidth.SetLimit(t.ops, current.OperationsPerSecond)
+	}
+	t.lock.Unlock()
+
+	return t.ops.Wait(context.Background())
+}
+
+func (t *throttledObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	if err := t.wait(); err != nil {
+		return err
+	}
+
+	return t.ObjectStore.PutObject(bucket, key, bandwidth.NewReader(context.Background(), body, t.upload))
+}
+
+func (t *throttledObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	if err := t.wait(); err != nil {
+		return false, err
+	}
+
+	return t.ObjectStore.ObjectExists(bucket, key)
+}
+
+func (t *throttledObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	if err := t.wait(); err != nil {
+		return nil, err
+	}
+
+	res, err := t.ObjectStore.GetObject(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return bandwidth.NewReadCloser(context.Background(), res, t.download), nil
+}
+
+func (t *throttledObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	if err := t.wait(); err != nil {
+		return nil, err
+	}
+
+	return t.ObjectStore.ListCommonPrefixes(bucket, prefix, delimiter)
+}
+
+func (t *throttledObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	if err := t.wait(); err != nil {
+		return nil, err
+	}
+
+	return t.ObjectStore.ListObjects(bucket, prefix)
+}
+
+func (t *throttledObjectStore) DeleteObject(bucket, key string) error {
+	if err := t.wait(); err != nil {
+		return err
+	}
+
+	return t.ObjectStore.DeleteObject(bucket, key)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestThrottledObjectStore(t *testing.T) {
+	now := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	limits := &velerov1api.BandwidthLimits{
+		Schedule: []velerov1api.ScheduledBandwidthLimit{
+			{
+				Start: "09:00",
+				End:   "17:00",
+				BandwidthLimit: velerov1api.BandwidthLimit{
+					UploadBytesPerSecond:   1000,
+					DownloadBytesPerSecond: 1000,
+				},
+			},
+		},
+	}
+
+	store, err := newThrottledObjectStore(newInMemoryObjectStore("bucket"), limits, func() time.Time { return now }, velerotest.NewLogger())
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte("a"), 1500)
+
+	// outside of the window, the transfers aren't throttled
+	start := time.Now()
+	require.NoError(t, store.PutObject("bucket", "key", bytes.NewReader(data)))
+	assert.Less(t, time.Since(start), 400*time.Millisecond)
+
+	// within the window, the second worth of data beyond the burst is throttled
+	now = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	start = time.Now()
+	require.NoError(t, store.PutObject("bucket", "key", bytes.NewReader(data)))
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	res, err := store.GetObject("bucket", "key")
+	require.NoError(t, err)
+	content, err := io.ReadAll(res)
+	require.NoError(t, err)
+	assert.Equal(t, data, content)
+	require.NoError(t, res.Close())
+}
+
+func TestThrottledObjectStoreInvalidLimits(t *testing.T) {
+	_, err := newThrottledObjectStore(newInMemoryObjectStore("bucket"), &velerov1api.BandwidthLimits{TimeZone: "Nowhere/Town"}, time.Now, velerotest.NewLogger())
+	require.ErrorContains(t, err, `invalid time zone "Nowhere/Town"`)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	kmv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/keymanager/v1"
+)
+
+// RestartableKeyManager is a key manager for a given implementation (such as "aws-kms"). It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the RestartableKeyManager asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type RestartableKeyManager struct {
+	Key                 process.KindAndName
+	SharedPluginProcess process.RestartableProcess
+	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
+	// sharedPluginProcess gets restarted.
+	config map[string]string
+}
+
+// NewRestartableKeyManager returns a new RestartableKeyManager.
+func NewRestartableKeyManager(name string, sharedPluginProcess process.RestartableProcess) *RestartableKeyManager {
+	key := process.KindAndName{Kind: common.PluginKindKeyManager, Name: name}
+	r := &RestartableKeyManager{
+		Key:                 key,
+		SharedPluginProcess: sharedPluginProcess,
+	}
+
+	// Register our reinitializer so we can reinitialize after a restart with r.config.
+	sharedPluginProcess.AddReinitializer(key, r)
+
+	return r
+}
+
+// Reinitialize reinitializes a re-dispensed plugin using the initial data passed to Init().
+func (r *RestartableKeyManager) Reinitialize(dispensed interface{}) error {
+	keyManager, ok := dispensed.(kmv1.KeyManager)
+	if !ok {
+		return errors.Errorf("plugin %T is not a KeyManager", dispensed)
+	}
+
+	return keyManager.Init(r.config)
+}
+
+// getKeyManager returns the key manager for this RestartableKeyManager. It does *not* restart the
+// plugin process.
+func (r *RestartableKeyManager) getKeyManager() (kmv1.KeyManager, error) {
+	plugin, err := r.SharedPluginProcess.GetByKindAndName(r.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyManager, ok := plugin.(kmv1.KeyManager)
+	if !ok {
+		return nil, errors.Errorf("plugin %T is not a KeyManager", plugin)
+	}
+
+	return keyManager, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the key manager for this RestartableKeyManager.
+func (r *RestartableKeyManager) getDelegate() (kmv1.KeyManager, error) {
+	if err := r.SharedPluginProcess.ResetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getKeyManager()
+}
+
+// Init initializes the key manager instance using config. If this is the first invocation, r stores config for future
+// reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
+func (r *RestartableKeyManager) Init(config map[string]string) error {
+	if r.config != nil {
+		return errors.Errorf("already initialized")
+	}
+
+	// Not using getDelegate() to avoid possible infinite recursion
+	delegate, err := r.getKeyManager()
+	if err != nil {
+		return err
+	}
+
+	r.config = config
+
+	return delegate.Init(config)
+}
+
+// WrapKey restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableKeyManager) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.WrapKey(keyID, dataKey)
+}
+
+// UnwrapKey restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableKeyManager) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.UnwrapKey(keyID, wrappedKey)
+}
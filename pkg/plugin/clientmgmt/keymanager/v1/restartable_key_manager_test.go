@@ -0,0 +1,170 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/internal/restartabletest"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/keymanager/v1"
+)
+
+func TestRestartableGetKeyManager(t *testing.T) {
+	tests := []struct {
+		name          string
+		plugin        interface{}
+		getError      error
+		expectedError string
+	}{
+		{
+			name:          "error getting by kind and name",
+			getError:      errors.Errorf("get error"),
+			expectedError: "get error",
+		},
+		{
+			name:          "wrong type",
+			plugin:        3,
+			expectedError: "plugin int is not a KeyManager",
+		},
+		{
+			name:   "happy path",
+			plugin: new(providermocks.KeyManager),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := new(restartabletest.MockRestartableProcess)
+			p.Test(t)
+			defer p.AssertExpectations(t)
+
+			name := "kms"
+			key := process.KindAndName{Kind: common.PluginKindKeyManager, Name: name}
+			p.On("GetByKindAndName", key).Return(tc.plugin, tc.getError)
+
+			r := &RestartableKeyManager{
+				Key:                 key,
+				SharedPluginProcess: p,
+			}
+			a, err := r.getKeyManager()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.plugin, a)
+		})
+	}
+}
+
+func TestRestartableKeyManagerReinitialize(t *testing.T) {
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	key := process.KindAndName{Kind: common.PluginKindKeyManager, Name: "kms"}
+	r := &RestartableKeyManager{
+		Key:                 key,
+		SharedPluginProcess: p,
+		config: map[string]string{
+			"region": "us-east-1",
+		},
+	}
+
+	err := r.Reinitialize(3)
+	assert.EqualError(t, err, "plugin int is not a KeyManager")
+
+	keyManager := new(providermocks.KeyManager)
+	keyManager.Test(t)
+	defer keyManager.AssertExpectations(t)
+
+	keyManager.On("Init", r.config).Return(errors.Errorf("init error")).Once()
+	err = r.Reinitialize(keyManager)
+	assert.EqualError(t, err, "init error")
+
+	keyManager.On("Init", r.config).Return(nil)
+	err = r.Reinitialize(keyManager)
+	assert.NoError(t, err)
+}
+
+func TestRestartableKeyManagerInit(t *testing.T) {
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	key := process.KindAndName{Kind: common.PluginKindKeyManager, Name: "kms"}
+	r := &RestartableKeyManager{
+		Key:                 key,
+		SharedPluginProcess: p,
+	}
+	p.On("GetByKindAndName", key).Return(nil, errors.Errorf("GetByKindAndName error")).Once()
+
+	config := map[string]string{
+		"region": "us-east-1",
+	}
+	err := r.Init(config)
+	assert.EqualError(t, err, "GetByKindAndName error")
+
+	keyManager := new(providermocks.KeyManager)
+	keyManager.Test(t)
+	defer keyManager.AssertExpectations(t)
+	p.On("GetByKindAndName", key).Return(keyManager, nil)
+	keyManager.On("Init", config).Return(nil)
+
+	err = r.Init(config)
+	assert.NoError(t, err)
+	assert.Equal(t, config, r.config)
+
+	// Calling Init twice is forbidden
+	err = r.Init(config)
+	assert.EqualError(t, err, "already initialized")
+}
+
+func TestRestartableKeyManagerDelegatedFunctions(t *testing.T) {
+	restartabletest.RunRestartableDelegateTests(
+		t,
+		common.PluginKindKeyManager,
+		func(key process.KindAndName, p process.RestartableProcess) interface{} {
+			return &RestartableKeyManager{
+				Key:                 key,
+				SharedPluginProcess: p,
+			}
+		},
+		func() restartabletest.Mockable {
+			return new(providermocks.KeyManager)
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "WrapKey",
+			Inputs:                  []interface{}{"key-1", []byte("data key")},
+			ExpectedErrorOutputs:    []interface{}{([]byte)(nil), errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{[]byte("wrapped key"), errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "UnwrapKey",
+			Inputs:                  []interface{}{"key-1", []byte("wrapped key")},
+			ExpectedErrorOutputs:    []interface{}{([]byte)(nil), errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{[]byte("data key"), errors.Errorf("delegate error")},
+		},
+	)
+}
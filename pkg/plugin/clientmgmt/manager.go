@@ -26,6 +26,9 @@ import (
 
 	biav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v1"
 	biav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v2"
+	ibav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/itemblockaction/v1"
+	kmv1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/keymanager/v1"
+	osv2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/objectstore/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	riav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v1"
 	riav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v2"
@@ -34,6 +37,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v1"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	ibav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/itemblockaction/v1"
+	kmv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/keymanager/v1"
 	riav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v1"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
@@ -77,6 +82,15 @@ type Manager interface {
 	// GetDeleteItemAction returns the delete item action plugin for name.
 	GetDeleteItemAction(name string) (velero.DeleteItemAction, error)
 
+	// GetItemBlockActions returns all item block action plugins.
+	GetItemBlockActions() ([]ibav1.ItemBlockAction, error)
+
+	// GetItemBlockAction returns the item block action plugin for name.
+	GetItemBlockAction(name string) (ibav1.ItemBlockAction, error)
+
+	// GetKeyManager returns the KeyManager plugin for name.
+	GetKeyManager(name string) (kmv1.KeyManager, error)
+
 	// CleanupClients terminates all of the Manager's running plugin processes.
 	CleanupClients()
 }
@@ -157,11 +171,20 @@ func (m *manager) getRestartableProcess(kind common.PluginKind, name string) (pr
 	return restartableProcess, nil
 }
 
-// GetObjectStore returns a restartableObjectStore for name.
+// GetObjectStore returns a restartableObjectStore for name. If the plugin implements
+// the v2 ObjectStore API, the returned object store supports object locks.
 func (m *manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	name = sanitizeName(name)
 
-	restartableProcess, err := m.getRestartableProcess(common.PluginKindObjectStore, name)
+	restartableProcess, err := m.getRestartableProcess(common.PluginKindObjectStoreV2, name)
+	if err == nil {
+		return osv2cli.NewRestartableObjectStore(name, restartableProcess), nil
+	}
+	if !errors.As(err, &pluginNotFoundErrType) {
+		return nil, err
+	}
+
+	restartableProcess, err = m.getRestartableProcess(common.PluginKindObjectStore, name)
 	if err != nil {
 		return nil, err
 	}
@@ -374,6 +397,56 @@ func (m *manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, err
 	return r, nil
 }
 
+// GetItemBlockActions returns all item block actions as RestartableItemBlockActions.
+func (m *manager) GetItemBlockActions() ([]ibav1.ItemBlockAction, error) {
+	list := m.registry.List(common.PluginKindItemBlockAction)
+
+	actions := make([]ibav1.ItemBlockAction, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetItemBlockAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetItemBlockAction returns a RestartableItemBlockAction for name.
+func (m *manager) GetItemBlockAction(name string) (ibav1.ItemBlockAction, error) {
+	name = sanitizeName(name)
+
+	for _, adaptedItemBlockAction := range ibav1cli.AdaptedItemBlockActions() {
+		restartableProcess, err := m.getRestartableProcess(adaptedItemBlockAction.Kind, name)
+		// Check if plugin was not found
+		if errors.As(err, &pluginNotFoundErrType) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return adaptedItemBlockAction.GetRestartable(name, restartableProcess), nil
+	}
+	return nil, fmt.Errorf("unable to get valid ItemBlockAction for %q", name)
+}
+
+// GetKeyManager returns a restartableKeyManager for name.
+func (m *manager) GetKeyManager(name string) (kmv1.KeyManager, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(common.PluginKindKeyManager, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return kmv1cli.NewRestartableKeyManager(name, restartableProcess), nil
+}
+
 // sanitizeName adds "velero.io" to legacy plugins that weren't namespaced.
 func sanitizeName(name string) string {
 	// Backwards compatibility with non-namespaced Velero plugins, following principle of least surprise
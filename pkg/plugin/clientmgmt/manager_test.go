@@ -29,6 +29,8 @@ import (
 	"github.com/vmware-tanzu/velero/internal/restartabletest"
 	biav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v1"
 	biav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v2"
+	kmv1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/keymanager/v1"
+	osv2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/objectstore/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	riav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v1"
 	riav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v2"
@@ -171,6 +173,40 @@ func TestGetObjectStore(t *testing.T) {
 	)
 }
 
+func TestGetObjectStoreV2(t *testing.T) {
+	getPluginTest(t,
+		common.PluginKindObjectStoreV2,
+		"velero.io/aws",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetObjectStore(name)
+		},
+		func(name string, sharedPluginProcess process.RestartableProcess) interface{} {
+			return &osv2cli.RestartableObjectStore{
+				Key:                 process.KindAndName{Kind: common.PluginKindObjectStoreV2, Name: name},
+				SharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		true,
+	)
+}
+
+func TestGetKeyManager(t *testing.T) {
+	getPluginTest(t,
+		common.PluginKindKeyManager,
+		"velero.io/kms",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetKeyManager(name)
+		},
+		func(name string, sharedPluginProcess process.RestartableProcess) interface{} {
+			return &kmv1cli.RestartableKeyManager{
+				Key:                 process.KindAndName{Kind: common.PluginKindKeyManager, Name: name},
+				SharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		true,
+	)
+}
+
 func TestGetVolumeSnapshotter(t *testing.T) {
 	getPluginTest(t,
 		common.PluginKindVolumeSnapshotter,
@@ -283,6 +319,8 @@ func getPluginTest(
 		Name:    pluginName,
 	}
 	registry.On("Get", pluginKind, pluginName).Return(pluginID, nil)
+	// the plugin isn't registered as any other kind
+	registry.On("Get", mock.Anything, pluginName).Return(nil, &process.PluginNotFoundError{}).Maybe()
 
 	restartableProcess := &restartabletest.MockRestartableProcess{}
 	defer restartableProcess.AssertExpectations(t)
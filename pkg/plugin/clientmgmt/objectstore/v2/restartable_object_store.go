@@ -0,0 +1,257 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+// RestartableObjectStore is a v2 object store for a given implementation (such as "aws"). It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the RestartableObjectStore asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type RestartableObjectStore struct {
+	Key                 process.KindAndName
+	SharedPluginProcess process.RestartableProcess
+	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
+	// sharedPluginProcess gets restarted.
+	config map[string]string
+	// credentialsGeneration is the rotation generation of the credentials file in config when the plugin was last
+	// initialized. Once the credentials are rotated the plugin is reinitialized with config to pick them up.
+	credentialsGeneration uint64
+	credentialsLock       sync.Mutex
+}
+
+// NewRestartableObjectStore returns a new RestartableObjectStore.
+func NewRestartableObjectStore(name string, sharedPluginProcess process.RestartableProcess) *RestartableObjectStore {
+	key := process.KindAndName{Kind: common.PluginKindObjectStoreV2, Name: name}
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: sharedPluginProcess,
+	}
+
+	// Register our reinitializer so we can reinitialize after a restart with r.config.
+	sharedPluginProcess.AddReinitializer(key, r)
+
+	return r
+}
+
+// reinitialize reinitializes a re-dispensed plugin using the initial data passed to Init().
+func (r *RestartableObjectStore) Reinitialize(dispensed interface{}) error {
+	objectStore, ok := dispensed.(osv2.ObjectStore)
+	if !ok {
+		return errors.Errorf("plugin %T is not an ObjectStoreV2", dispensed)
+	}
+
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
+	return r.init(objectStore, r.config)
+}
+
+// getObjectStore returns the object store for this RestartableObjectStore. It does *not* restart the
+// plugin process.
+func (r *RestartableObjectStore) getObjectStore() (osv2.ObjectStore, error) {
+	plugin, err := r.SharedPluginProcess.GetByKindAndName(r.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	objectStore, ok := plugin.(osv2.ObjectStore)
+	if !ok {
+		return nil, errors.Errorf("plugin %T is not an ObjectStoreV2", plugin)
+	}
+
+	return objectStore, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the object store for this RestartableObjectStore.
+func (r *RestartableObjectStore) getDelegate() (osv2.ObjectStore, error) {
+	if err := r.SharedPluginProcess.ResetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	objectStore, err := r.getObjectStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.reinitializeIfCredentialsRotated(objectStore); err != nil {
+		return nil, err
+	}
+
+	return objectStore, nil
+}
+
+// reinitializeIfCredentialsRotated reinitializes objectStore with the stored config if the credentials file it was
+// initialized with has been rotated since.
+func (r *RestartableObjectStore) reinitializeIfCredentialsRotated(objectStore osv2.ObjectStore) error {
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
+	if r.config == nil {
+		return nil
+	}
+
+	if credentials.Generation(r.config[credentials.FileConfigKey]) == r.credentialsGeneration {
+		return nil
+	}
+
+	return r.init(objectStore, r.config)
+}
+
+// Init initializes the object store instance using config. If this is the first invocation, r stores config for future
+// reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
+func (r *RestartableObjectStore) Init(config map[string]string) error {
+	// Not using getDelegate() to avoid possible infinite recursion. The plugin is got before taking credentialsLock
+	// because the process reinitializes the plugin holding its own lock, which then takes credentialsLock.
+	delegate, err := r.getObjectStore()
+	if err != nil {
+		return err
+	}
+
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
+	if r.config != nil {
+		return errors.Errorf("already initialized")
+	}
+
+	r.config = config
+
+	return r.init(delegate, config)
+}
+
+// init calls Init on objectStore with config. This is split out from Init() so that both Init() and reinitialize() may
+// call it using a specific ObjectStore. The caller must hold credentialsLock.
+func (r *RestartableObjectStore) init(objectStore osv2.ObjectStore, config map[string]string) error {
+	generation := credentials.Generation(config[credentials.FileConfigKey])
+	if err := objectStore.Init(config); err != nil {
+		return err
+	}
+
+	r.credentialsGeneration = generation
+	return nil
+}
+
+// PutObject restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) PutObject(bucket string, key string, body io.Reader) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	return delegate.PutObject(bucket, key, body)
+}
+
+// ObjectExists restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return false, err
+	}
+	return delegate.ObjectExists(bucket, key)
+}
+
+// GetObject restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) GetObject(bucket string, key string) (io.ReadCloser, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.GetObject(bucket, key)
+}
+
+// ListCommonPrefixes restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) ListCommonPrefixes(bucket string, prefix string, delimiter string) ([]string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.ListCommonPrefixes(bucket, prefix, delimiter)
+}
+
+// ListObjects restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) ListObjects(bucket string, prefix string) ([]string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.ListObjects(bucket, prefix)
+}
+
+// DeleteObject restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) DeleteObject(bucket string, key string) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	return delegate.DeleteObject(bucket, key)
+}
+
+// CreateSignedURL restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) CreateSignedURL(bucket string, key string, ttl time.Duration) (string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return "", err
+	}
+	return delegate.CreateSignedURL(bucket, key, ttl)
+}
+
+// PutObjectRetention restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) PutObjectRetention(bucket string, key string, mode osv2.RetentionMode, retainUntil time.Time) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	return delegate.PutObjectRetention(bucket, key, mode, retainUntil)
+}
+
+// GetObjectRetention restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) GetObjectRetention(bucket string, key string) (time.Time, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return delegate.GetObjectRetention(bucket, key)
+}
+
+// PutObjectLegalHold restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) PutObjectLegalHold(bucket string, key string, hold bool) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	return delegate.PutObjectLegalHold(bucket, key, hold)
+}
+
+// GetObjectLegalHold restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) GetObjectLegalHold(bucket string, key string) (bool, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return false, err
+	}
+	return delegate.GetObjectLegalHold(bucket, key)
+}
@@ -29,6 +29,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	ibav1 "github.com/vmware-tanzu/velero/pkg/plugin/framework/itemblockaction/v1"
+	kmv1 "github.com/vmware-tanzu/velero/pkg/plugin/framework/keymanager/v1"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/objectstore/v2"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -66,10 +69,13 @@ func TestClientConfig(t *testing.T) {
 			string(common.PluginKindBackupItemActionV2):  biav2.NewBackupItemActionPlugin(common.ClientLogger(logger)),
 			string(common.PluginKindVolumeSnapshotter):   framework.NewVolumeSnapshotterPlugin(common.ClientLogger(logger)),
 			string(common.PluginKindObjectStore):         framework.NewObjectStorePlugin(common.ClientLogger(logger)),
+			string(common.PluginKindObjectStoreV2):       osv2.NewObjectStorePlugin(common.ClientLogger(logger)),
 			string(common.PluginKindPluginLister):        &framework.PluginListerPlugin{},
 			string(common.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(common.ClientLogger(logger)),
 			string(common.PluginKindRestoreItemActionV2): riav2.NewRestoreItemActionPlugin(common.ClientLogger(logger)),
 			string(common.PluginKindDeleteItemAction):    framework.NewDeleteItemActionPlugin(common.ClientLogger(logger)),
+			string(common.PluginKindItemBlockAction):     ibav1.NewItemBlockActionPlugin(common.ClientLogger(logger)),
+			string(common.PluginKindKeyManager):          kmv1.NewKeyManagerPlugin(common.ClientLogger(logger)),
 		},
 		Logger: cb.pluginLogger,
 		Cmd:    exec.Command(cb.commandName, cb.commandArgs...),
@@ -18,10 +18,12 @@ package clientmgmt
 
 import (
 	"io"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -37,6 +39,10 @@ type restartableObjectStore struct {
 	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
 	// sharedPluginProcess gets restarted.
 	config map[string]string
+	// credentialsGeneration is the rotation generation of the credentials file in config when the plugin was last
+	// initialized. Once the credentials are rotated the plugin is reinitialized with config to pick them up.
+	credentialsGeneration uint64
+	credentialsLock       sync.Mutex
 }
 
 // NewRestartableObjectStore returns a new restartableObjectStore.
@@ -60,6 +66,9 @@ func (r *restartableObjectStore) Reinitialize(dispensed interface{}) error {
 		return errors.Errorf("plugin %T is not a ObjectStore", dispensed)
 	}
 
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
 	return r.init(objectStore, r.config)
 }
 
@@ -85,31 +94,67 @@ func (r *restartableObjectStore) getDelegate() (velero.ObjectStore, error) {
 		return nil, err
 	}
 
-	return r.getObjectStore()
+	objectStore, err := r.getObjectStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.reinitializeIfCredentialsRotated(objectStore); err != nil {
+		return nil, err
+	}
+
+	return objectStore, nil
+}
+
+// reinitializeIfCredentialsRotated reinitializes objectStore with the stored config if the credentials file it was
+// initialized with has been rotated since.
+func (r *restartableObjectStore) reinitializeIfCredentialsRotated(objectStore velero.ObjectStore) error {
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
+	if r.config == nil {
+		return nil
+	}
+
+	if credentials.Generation(r.config[credentials.FileConfigKey]) == r.credentialsGeneration {
+		return nil
+	}
+
+	return r.init(objectStore, r.config)
 }
 
 // Init initializes the object store instance using config. If this is the first invocation, r stores config for future
 // reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
 func (r *restartableObjectStore) Init(config map[string]string) error {
-	if r.config != nil {
-		return errors.Errorf("already initialized")
-	}
-
-	// Not using getDelegate() to avoid possible infinite recursion
+	// Not using getDelegate() to avoid possible infinite recursion. The plugin is got before taking credentialsLock
+	// because the process reinitializes the plugin holding its own lock, which then takes credentialsLock.
 	delegate, err := r.getObjectStore()
 	if err != nil {
 		return err
 	}
 
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
+	if r.config != nil {
+		return errors.Errorf("already initialized")
+	}
+
 	r.config = config
 
 	return r.init(delegate, config)
 }
 
 // init calls Init on objectStore with config. This is split out from Init() so that both Init() and reinitialize() may
-// call it using a specific ObjectStore.
+// call it using a specific ObjectStore. The caller must hold credentialsLock.
 func (r *restartableObjectStore) init(objectStore velero.ObjectStore, config map[string]string) error {
-	return objectStore.Init(config)
+	generation := credentials.Generation(config[credentials.FileConfigKey])
+	if err := objectStore.Init(config); err != nil {
+		return err
+	}
+
+	r.credentialsGeneration = generation
+	return nil
 }
 
 // PutObject restarts the plugin's process if needed, then delegates the call.
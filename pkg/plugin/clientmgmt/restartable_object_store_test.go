@@ -19,6 +19,7 @@ package clientmgmt
 import (
 	"io"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/restartabletest"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
@@ -141,6 +143,61 @@ func TestRestartableObjectStoreGetDelegate(t *testing.T) {
 	assert.Equal(t, objectStore, a)
 }
 
+func TestRestartableObjectStoreGetDelegateCredentialsRotated(t *testing.T) {
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: name}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+
+	config := map[string]string{
+		credentials.FileConfigKey: t.TempDir() + "/credentials",
+	}
+	p.On("ResetIfNeeded").Return(nil)
+	p.On("GetByKindAndName", key).Return(objectStore, nil)
+	objectStore.On("Init", config).Return(nil).Once()
+	require.NoError(t, r.Init(config))
+
+	// credentials unchanged, no reinitialization
+	_, err := r.getDelegate()
+	require.NoError(t, err)
+
+	// credentials rotated, the plugin is reinitialized once
+	credentials.NotifyRotated(config[credentials.FileConfigKey])
+	objectStore.On("Init", config).Return(nil).Once()
+	_, err = r.getDelegate()
+	require.NoError(t, err)
+	_, err = r.getDelegate()
+	require.NoError(t, err)
+
+	// the plugin is used and reinitialized concurrently once the credentials are rotated again
+	credentials.NotifyRotated(config[credentials.FileConfigKey])
+	objectStore.On("Init", config).Return(nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := r.getDelegate()
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, r.Reinitialize(objectStore))
+		}()
+	}
+	wg.Wait()
+}
+
 func TestRestartableObjectStoreInit(t *testing.T) {
 	p := new(restartabletest.MockRestartableProcess)
 	p.Test(t)
@@ -17,9 +17,12 @@ limitations under the License.
 package v1
 
 import (
+	"sync"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
@@ -52,6 +55,10 @@ type RestartableVolumeSnapshotter struct {
 	Key                 process.KindAndName
 	SharedPluginProcess process.RestartableProcess
 	config              map[string]string
+	// credentialsGeneration is the rotation generation of the credentials file in config when the plugin was last
+	// initialized. Once the credentials are rotated the plugin is reinitialized with config to pick them up.
+	credentialsGeneration uint64
+	credentialsLock       sync.Mutex
 }
 
 // NewRestartableVolumeSnapshotter returns a new restartableVolumeSnapshotter.
@@ -74,6 +81,10 @@ func (r *RestartableVolumeSnapshotter) Reinitialize(dispensed interface{}) error
 	if !ok {
 		return errors.Errorf("plugin %T is not a VolumeSnapshotter", dispensed)
 	}
+
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
 	return r.init(volumeSnapshotter, r.config)
 }
 
@@ -99,31 +110,67 @@ func (r *RestartableVolumeSnapshotter) getDelegate() (vsv1.VolumeSnapshotter, er
 		return nil, err
 	}
 
-	return r.getVolumeSnapshotter()
+	volumeSnapshotter, err := r.getVolumeSnapshotter()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.reinitializeIfCredentialsRotated(volumeSnapshotter); err != nil {
+		return nil, err
+	}
+
+	return volumeSnapshotter, nil
+}
+
+// reinitializeIfCredentialsRotated reinitializes volumeSnapshotter with the stored config if the credentials file it was
+// initialized with has been rotated since.
+func (r *RestartableVolumeSnapshotter) reinitializeIfCredentialsRotated(volumeSnapshotter vsv1.VolumeSnapshotter) error {
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
+	if r.config == nil {
+		return nil
+	}
+
+	if credentials.Generation(r.config[credentials.FileConfigKey]) == r.credentialsGeneration {
+		return nil
+	}
+
+	return r.init(volumeSnapshotter, r.config)
 }
 
 // Init initializes the volume snapshotter instance using config. If this is the first invocation, r stores config for future
 // reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
 func (r *RestartableVolumeSnapshotter) Init(config map[string]string) error {
-	if r.config != nil {
-		return errors.Errorf("already initialized")
-	}
-
-	// Not using getDelegate() to avoid possible infinite recursion
+	// Not using getDelegate() to avoid possible infinite recursion. The plugin is got before taking credentialsLock
+	// because the process reinitializes the plugin holding its own lock, which then takes credentialsLock.
 	delegate, err := r.getVolumeSnapshotter()
 	if err != nil {
 		return err
 	}
 
+	r.credentialsLock.Lock()
+	defer r.credentialsLock.Unlock()
+
+	if r.config != nil {
+		return errors.Errorf("already initialized")
+	}
+
 	r.config = config
 
 	return r.init(delegate, config)
 }
 
 // init calls Init on volumeSnapshotter with config. This is split out from Init() so that both Init() and reinitialize() may
-// call it using a specific VolumeSnapshotter.
+// call it using a specific VolumeSnapshotter. The caller must hold credentialsLock.
 func (r *RestartableVolumeSnapshotter) init(volumeSnapshotter vsv1.VolumeSnapshotter, config map[string]string) error {
-	return volumeSnapshotter.Init(config)
+	generation := credentials.Generation(config[credentials.FileConfigKey])
+	if err := volumeSnapshotter.Init(config); err != nil {
+		return err
+	}
+
+	r.credentialsGeneration = generation
+	return nil
 }
 
 // CreateVolumeFromSnapshot restarts the plugin's process if needed, then delegates the call.
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/restartabletest"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
@@ -140,6 +141,43 @@ func TestRestartableVolumeSnapshotterGetDelegate(t *testing.T) {
 	assert.Equal(t, volumeSnapshotter, a)
 }
 
+func TestRestartableVolumeSnapshotterGetDelegateCredentialsRotated(t *testing.T) {
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := process.KindAndName{Kind: common.PluginKindVolumeSnapshotter, Name: name}
+	r := &RestartableVolumeSnapshotter{
+		Key:                 key,
+		SharedPluginProcess: p,
+	}
+
+	volumeSnapshotter := new(providermocks.VolumeSnapshotter)
+	volumeSnapshotter.Test(t)
+	defer volumeSnapshotter.AssertExpectations(t)
+
+	config := map[string]string{
+		credentials.FileConfigKey: t.TempDir() + "/credentials",
+	}
+	p.On("ResetIfNeeded").Return(nil)
+	p.On("GetByKindAndName", key).Return(volumeSnapshotter, nil)
+	volumeSnapshotter.On("Init", config).Return(nil).Once()
+	require.NoError(t, r.Init(config))
+
+	// credentials unchanged, no reinitialization
+	_, err := r.getDelegate()
+	require.NoError(t, err)
+
+	// credentials rotated, the plugin is reinitialized once
+	credentials.NotifyRotated(config[credentials.FileConfigKey])
+	volumeSnapshotter.On("Init", config).Return(nil).Once()
+	_, err = r.getDelegate()
+	require.NoError(t, err)
+	_, err = r.getDelegate()
+	require.NoError(t, err)
+}
+
 func TestRestartableVolumeSnapshotterInit(t *testing.T) {
 	p := new(restartabletest.MockRestartableProcess)
 	p.Test(t)
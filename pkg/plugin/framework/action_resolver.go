@@ -27,6 +27,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v1"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	ibav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/itemblockaction/v1"
 	riav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v1"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
@@ -140,6 +141,12 @@ func NewDeleteItemActionResolver(actions []velero.DeleteItemAction) DeleteItemAc
 	}
 }
 
+func NewItemBlockActionResolver(actions []ibav1.ItemBlockAction) ItemBlockActionResolver {
+	return ItemBlockActionResolver{
+		actions: actions,
+	}
+}
+
 type ActionResolver interface {
 	ResolveAction(helper discovery.Helper, action velero.Applicable, log logrus.FieldLogger) (ResolvedAction, error)
 }
@@ -280,3 +287,34 @@ func (recv DeleteItemActionResolver) ResolveActions(helper discovery.Helper, log
 	}
 	return resolved, nil
 }
+
+type ItemBlockResolvedAction struct {
+	ibav1.ItemBlockAction
+	resolvedAction
+}
+
+type ItemBlockActionResolver struct {
+	actions []ibav1.ItemBlockAction
+}
+
+func (recv ItemBlockActionResolver) ResolveActions(helper discovery.Helper, log logrus.FieldLogger) ([]ItemBlockResolvedAction, error) {
+	var resolved []ItemBlockResolvedAction
+	for _, action := range recv.actions {
+		log.Debugf("resolving ItemBlockAction for: %v", action)
+		resources, namespaces, selector, err := resolveAction(helper, action)
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Debugf("resolveAction error, action: %v", action)
+			return nil, err
+		}
+		res := ItemBlockResolvedAction{
+			ItemBlockAction: action,
+			resolvedAction: resolvedAction{
+				ResourceIncludesExcludes:  resources,
+				NamespaceIncludesExcludes: namespaces,
+				Selector:                  selector,
+			},
+		}
+		resolved = append(resolved, res)
+	}
+	return resolved, nil
+}
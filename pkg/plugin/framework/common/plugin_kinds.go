@@ -29,6 +29,9 @@ const (
 	// PluginKindObjectStore represents an object store plugin.
 	PluginKindObjectStore PluginKind = "ObjectStore"
 
+	// PluginKindObjectStoreV2 represents a v2 object store plugin, which supports object locks.
+	PluginKindObjectStoreV2 PluginKind = "ObjectStoreV2"
+
 	// PluginKindVolumeSnapshotter represents a volume snapshotter plugin.
 	PluginKindVolumeSnapshotter PluginKind = "VolumeSnapshotter"
 
@@ -47,6 +50,12 @@ const (
 	// PluginKindDeleteItemAction represents a delete item action plugin.
 	PluginKindDeleteItemAction PluginKind = "DeleteItemAction"
 
+	// PluginKindItemBlockAction represents an item block action plugin.
+	PluginKindItemBlockAction PluginKind = "ItemBlockAction"
+
+	// PluginKindKeyManager represents a key manager plugin, which wraps the keys encrypting the backups.
+	PluginKindKeyManager PluginKind = "KeyManager"
+
 	// PluginKindPluginLister represents a plugin lister plugin.
 	PluginKindPluginLister PluginKind = "PluginLister"
 )
@@ -64,11 +73,14 @@ var PluginKindsAdaptableTo = map[PluginKind][]PluginKind{
 func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds := make(map[string]PluginKind)
 	allPluginKinds[PluginKindObjectStore.String()] = PluginKindObjectStore
+	allPluginKinds[PluginKindObjectStoreV2.String()] = PluginKindObjectStoreV2
 	allPluginKinds[PluginKindVolumeSnapshotter.String()] = PluginKindVolumeSnapshotter
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
 	allPluginKinds[PluginKindBackupItemActionV2.String()] = PluginKindBackupItemActionV2
 	allPluginKinds[PluginKindRestoreItemAction.String()] = PluginKindRestoreItemAction
 	allPluginKinds[PluginKindRestoreItemActionV2.String()] = PluginKindRestoreItemActionV2
 	allPluginKinds[PluginKindDeleteItemAction.String()] = PluginKindDeleteItemAction
+	allPluginKinds[PluginKindItemBlockAction.String()] = PluginKindItemBlockAction
+	allPluginKinds[PluginKindKeyManager.String()] = PluginKindKeyManager
 	return allPluginKinds
 }
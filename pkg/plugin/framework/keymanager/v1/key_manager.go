@@ -0,0 +1,44 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	plugin "github.com/hashicorp/go-plugin"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protokmv1 "github.com/vmware-tanzu/velero/pkg/plugin/generated/keymanager/v1"
+)
+
+// KeyManagerPlugin is an implementation of go-plugin's Plugin
+// interface with support for gRPC for the KeyManager interface.
+type KeyManagerPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	*common.PluginBase
+}
+
+// GRPCClient returns a KeyManager gRPC client.
+func (p *KeyManagerPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, clientConn *grpc.ClientConn) (interface{}, error) {
+	return common.NewClientDispenser(p.ClientLogger, clientConn, newKeyManagerGRPCClient), nil
+}
+
+// GRPCServer registers a KeyManager gRPC server.
+func (p *KeyManagerPlugin) GRPCServer(_ *plugin.GRPCBroker, server *grpc.Server) error {
+	protokmv1.RegisterKeyManagerServer(server, &KeyManagerGRPCServer{mux: p.ServerMux})
+	return nil
+}
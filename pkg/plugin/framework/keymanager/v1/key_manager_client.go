@@ -0,0 +1,97 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protokmv1 "github.com/vmware-tanzu/velero/pkg/plugin/generated/keymanager/v1"
+	kmv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/keymanager/v1"
+)
+
+var _ kmv1.KeyManager = &KeyManagerGRPCClient{}
+
+// NewKeyManagerPlugin construct a KeyManagerPlugin.
+func NewKeyManagerPlugin(options ...common.PluginOption) *KeyManagerPlugin {
+	return &KeyManagerPlugin{
+		PluginBase: common.NewPluginBase(options...),
+	}
+}
+
+// KeyManagerGRPCClient implements the KeyManager interface and uses a
+// gRPC client to make calls to the plugin server.
+type KeyManagerGRPCClient struct {
+	*common.ClientBase
+	grpcClient protokmv1.KeyManagerClient
+}
+
+func newKeyManagerGRPCClient(base *common.ClientBase, clientConn *grpc.ClientConn) interface{} {
+	return &KeyManagerGRPCClient{
+		ClientBase: base,
+		grpcClient: protokmv1.NewKeyManagerClient(clientConn),
+	}
+}
+
+// Init prepares the KeyManager for usage using the provided map of
+// configuration key-value pairs. It returns an error if the KeyManager
+// cannot be initialized from the provided config.
+func (c *KeyManagerGRPCClient) Init(config map[string]string) error {
+	req := &protokmv1.KeyManagerInitRequest{
+		Plugin: c.Plugin,
+		Config: config,
+	}
+
+	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+		return common.FromGRPCError(err)
+	}
+
+	return nil
+}
+
+// WrapKey encrypts the data key with the key of the given ID.
+func (c *KeyManagerGRPCClient) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	req := &protokmv1.KeyManagerWrapKeyRequest{
+		Plugin:  c.Plugin,
+		KeyID:   keyID,
+		DataKey: dataKey,
+	}
+
+	res, err := c.grpcClient.WrapKey(context.Background(), req)
+	if err != nil {
+		return nil, common.FromGRPCError(err)
+	}
+
+	return res.WrappedKey, nil
+}
+
+// UnwrapKey decrypts the data key wrapped with the key of the given ID.
+func (c *KeyManagerGRPCClient) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	req := &protokmv1.KeyManagerUnwrapKeyRequest{
+		Plugin:     c.Plugin,
+		KeyID:      keyID,
+		WrappedKey: wrappedKey,
+	}
+
+	res, err := c.grpcClient.UnwrapKey(context.Background(), req)
+	if err != nil {
+		return nil, common.FromGRPCError(err)
+	}
+
+	return res.DataKey, nil
+}
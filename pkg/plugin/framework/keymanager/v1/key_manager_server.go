@@ -0,0 +1,111 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	protokmv1 "github.com/vmware-tanzu/velero/pkg/plugin/generated/keymanager/v1"
+	kmv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/keymanager/v1"
+)
+
+// KeyManagerGRPCServer implements the proto-generated KeyManagerServer interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type KeyManagerGRPCServer struct {
+	mux *common.ServerMux
+}
+
+func (s *KeyManagerGRPCServer) getImpl(name string) (kmv1.KeyManager, error) {
+	impl, err := s.mux.GetHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	keyManager, ok := impl.(kmv1.KeyManager)
+	if !ok {
+		return nil, errors.Errorf("%T is not a key manager", impl)
+	}
+
+	return keyManager, nil
+}
+
+// Init prepares the KeyManager for usage using the provided map of
+// configuration key-value pairs. It returns an error if the KeyManager
+// cannot be initialized from the provided config.
+func (s *KeyManagerGRPCServer) Init(ctx context.Context, req *protokmv1.KeyManagerInitRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	if err := impl.Init(req.Config); err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// WrapKey encrypts the data key with the key of the given ID.
+func (s *KeyManagerGRPCServer) WrapKey(ctx context.Context, req *protokmv1.KeyManagerWrapKeyRequest) (response *protokmv1.KeyManagerWrapKeyResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	wrappedKey, err := impl.WrapKey(req.KeyID, req.DataKey)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &protokmv1.KeyManagerWrapKeyResponse{WrappedKey: wrappedKey}, nil
+}
+
+// UnwrapKey decrypts the data key wrapped with the key of the given ID.
+func (s *KeyManagerGRPCServer) UnwrapKey(ctx context.Context, req *protokmv1.KeyManagerUnwrapKeyRequest) (response *protokmv1.KeyManagerUnwrapKeyResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	dataKey, err := impl.UnwrapKey(req.KeyID, req.WrappedKey)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &protokmv1.KeyManagerUnwrapKeyResponse{DataKey: dataKey}, nil
+}
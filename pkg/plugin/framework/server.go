@@ -27,6 +27,9 @@ import (
 
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	ibav1 "github.com/vmware-tanzu/velero/pkg/plugin/framework/itemblockaction/v1"
+	kmv1 "github.com/vmware-tanzu/velero/pkg/plugin/framework/keymanager/v1"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/objectstore/v2"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
@@ -69,6 +72,13 @@ type Server interface {
 	// RegisterObjectStores registers multiple object stores.
 	RegisterObjectStores(map[string]common.HandlerInitializer) Server
 
+	// RegisterObjectStoreV2 registers a v2 object store, which supports object locks.
+	// Accepted format for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterObjectStoreV2(pluginName string, initializer common.HandlerInitializer) Server
+
+	// RegisterObjectStoresV2 registers multiple v2 object stores.
+	RegisterObjectStoresV2(map[string]common.HandlerInitializer) Server
+
 	// RegisterRestoreItemAction registers a restore item action. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterRestoreItemAction(pluginName string, initializer common.HandlerInitializer) Server
@@ -90,6 +100,20 @@ type Server interface {
 	// RegisterDeleteItemActions registers multiple Delete item actions.
 	RegisterDeleteItemActions(map[string]common.HandlerInitializer) Server
 
+	// RegisterItemBlockAction registers an item block action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterItemBlockAction(pluginName string, initializer common.HandlerInitializer) Server
+
+	// RegisterItemBlockActions registers multiple item block actions.
+	RegisterItemBlockActions(map[string]common.HandlerInitializer) Server
+
+	// RegisterKeyManager registers a key manager. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterKeyManager(pluginName string, initializer common.HandlerInitializer) Server
+
+	// RegisterKeyManagers registers multiple key managers.
+	RegisterKeyManagers(map[string]common.HandlerInitializer) Server
+
 	// Server runs the plugin server.
 	Serve()
 }
@@ -103,9 +127,12 @@ type server struct {
 	backupItemActionV2  *biav2.BackupItemActionPlugin
 	volumeSnapshotter   *VolumeSnapshotterPlugin
 	objectStore         *ObjectStorePlugin
+	objectStoreV2       *osv2.ObjectStorePlugin
 	restoreItemAction   *RestoreItemActionPlugin
 	restoreItemActionV2 *riav2.RestoreItemActionPlugin
 	deleteItemAction    *DeleteItemActionPlugin
+	itemBlockAction     *ibav1.ItemBlockActionPlugin
+	keyManager          *kmv1.KeyManagerPlugin
 }
 
 // NewServer returns a new Server
@@ -119,9 +146,12 @@ func NewServer() Server {
 		backupItemActionV2:  biav2.NewBackupItemActionPlugin(common.ServerLogger(log)),
 		volumeSnapshotter:   NewVolumeSnapshotterPlugin(common.ServerLogger(log)),
 		objectStore:         NewObjectStorePlugin(common.ServerLogger(log)),
+		objectStoreV2:       osv2.NewObjectStorePlugin(common.ServerLogger(log)),
 		restoreItemAction:   NewRestoreItemActionPlugin(common.ServerLogger(log)),
 		restoreItemActionV2: riav2.NewRestoreItemActionPlugin(common.ServerLogger(log)),
 		deleteItemAction:    NewDeleteItemActionPlugin(common.ServerLogger(log)),
+		itemBlockAction:     ibav1.NewItemBlockActionPlugin(common.ServerLogger(log)),
+		keyManager:          kmv1.NewKeyManagerPlugin(common.ServerLogger(log)),
 	}
 }
 
@@ -181,6 +211,18 @@ func (s *server) RegisterObjectStores(m map[string]common.HandlerInitializer) Se
 	return s
 }
 
+func (s *server) RegisterObjectStoreV2(name string, initializer common.HandlerInitializer) Server {
+	s.objectStoreV2.Register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterObjectStoresV2(m map[string]common.HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterObjectStoreV2(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterRestoreItemAction(name string, initializer common.HandlerInitializer) Server {
 	s.restoreItemAction.Register(name, initializer)
 	return s
@@ -217,6 +259,30 @@ func (s *server) RegisterDeleteItemActions(m map[string]common.HandlerInitialize
 	return s
 }
 
+func (s *server) RegisterItemBlockAction(name string, initializer common.HandlerInitializer) Server {
+	s.itemBlockAction.Register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterItemBlockActions(m map[string]common.HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterItemBlockAction(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterKeyManager(name string, initializer common.HandlerInitializer) Server {
+	s.keyManager.Register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterKeyManagers(m map[string]common.HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterKeyManager(name, m[name])
+	}
+	return s
+}
+
 // getNames returns a list of PluginIdentifiers registered with plugin.
 func getNames(command string, kind common.PluginKind, plugin Interface) []PluginIdentifier {
 	var pluginIdentifiers []PluginIdentifier
@@ -248,9 +314,12 @@ func (s *server) Serve() {
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindBackupItemActionV2, s.backupItemActionV2)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindVolumeSnapshotter, s.volumeSnapshotter)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindObjectStore, s.objectStore)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindObjectStoreV2, s.objectStoreV2)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindRestoreItemAction, s.restoreItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindRestoreItemActionV2, s.restoreItemActionV2)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindDeleteItemAction, s.deleteItemAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindItemBlockAction, s.itemBlockAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindKeyManager, s.keyManager)...)
 
 	pluginLister := NewPluginLister(pluginIdentifiers...)
 
@@ -261,10 +330,13 @@ func (s *server) Serve() {
 			string(common.PluginKindBackupItemActionV2):  s.backupItemActionV2,
 			string(common.PluginKindVolumeSnapshotter):   s.volumeSnapshotter,
 			string(common.PluginKindObjectStore):         s.objectStore,
+			string(common.PluginKindObjectStoreV2):       s.objectStoreV2,
 			string(common.PluginKindPluginLister):        NewPluginListerPlugin(pluginLister),
 			string(common.PluginKindRestoreItemAction):   s.restoreItemAction,
 			string(common.PluginKindRestoreItemActionV2): s.restoreItemActionV2,
 			string(common.PluginKindDeleteItemAction):    s.deleteItemAction,
+			string(common.PluginKindItemBlockAction):     s.itemBlockAction,
+			string(common.PluginKindKeyManager):          s.keyManager,
 		},
 		GRPCServer: plugin.DefaultGRPCServer,
 	})
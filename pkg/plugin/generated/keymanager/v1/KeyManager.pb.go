@@ -0,0 +1,640 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.14.0
+// source: keymanager/v1/KeyManager.proto
+
+package v1
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	generated "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type KeyManagerInitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin string            `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Config map[string]string `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *KeyManagerInitRequest) Reset() {
+	*x = KeyManagerInitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymanager_v1_KeyManager_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyManagerInitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyManagerInitRequest) ProtoMessage() {}
+
+func (x *KeyManagerInitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_KeyManager_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyManagerInitRequest.ProtoReflect.Descriptor instead.
+func (*KeyManagerInitRequest) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_KeyManager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyManagerInitRequest) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *KeyManagerInitRequest) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type KeyManagerWrapKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin  string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	KeyID   string `protobuf:"bytes,2,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	DataKey []byte `protobuf:"bytes,3,opt,name=dataKey,proto3" json:"dataKey,omitempty"`
+}
+
+func (x *KeyManagerWrapKeyRequest) Reset() {
+	*x = KeyManagerWrapKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymanager_v1_KeyManager_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyManagerWrapKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyManagerWrapKeyRequest) ProtoMessage() {}
+
+func (x *KeyManagerWrapKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_KeyManager_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyManagerWrapKeyRequest.ProtoReflect.Descriptor instead.
+func (*KeyManagerWrapKeyRequest) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_KeyManager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeyManagerWrapKeyRequest) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *KeyManagerWrapKeyRequest) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *KeyManagerWrapKeyRequest) GetDataKey() []byte {
+	if x != nil {
+		return x.DataKey
+	}
+	return nil
+}
+
+type KeyManagerWrapKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WrappedKey []byte `protobuf:"bytes,1,opt,name=wrappedKey,proto3" json:"wrappedKey,omitempty"`
+}
+
+func (x *KeyManagerWrapKeyResponse) Reset() {
+	*x = KeyManagerWrapKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymanager_v1_KeyManager_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyManagerWrapKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyManagerWrapKeyResponse) ProtoMessage() {}
+
+func (x *KeyManagerWrapKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_KeyManager_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyManagerWrapKeyResponse.ProtoReflect.Descriptor instead.
+func (*KeyManagerWrapKeyResponse) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_KeyManager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KeyManagerWrapKeyResponse) GetWrappedKey() []byte {
+	if x != nil {
+		return x.WrappedKey
+	}
+	return nil
+}
+
+type KeyManagerUnwrapKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin     string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	KeyID      string `protobuf:"bytes,2,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	WrappedKey []byte `protobuf:"bytes,3,opt,name=wrappedKey,proto3" json:"wrappedKey,omitempty"`
+}
+
+func (x *KeyManagerUnwrapKeyRequest) Reset() {
+	*x = KeyManagerUnwrapKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymanager_v1_KeyManager_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyManagerUnwrapKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyManagerUnwrapKeyRequest) ProtoMessage() {}
+
+func (x *KeyManagerUnwrapKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_KeyManager_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyManagerUnwrapKeyRequest.ProtoReflect.Descriptor instead.
+func (*KeyManagerUnwrapKeyRequest) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_KeyManager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KeyManagerUnwrapKeyRequest) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *KeyManagerUnwrapKeyRequest) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *KeyManagerUnwrapKeyRequest) GetWrappedKey() []byte {
+	if x != nil {
+		return x.WrappedKey
+	}
+	return nil
+}
+
+type KeyManagerUnwrapKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataKey []byte `protobuf:"bytes,1,opt,name=dataKey,proto3" json:"dataKey,omitempty"`
+}
+
+func (x *KeyManagerUnwrapKeyResponse) Reset() {
+	*x = KeyManagerUnwrapKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymanager_v1_KeyManager_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyManagerUnwrapKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyManagerUnwrapKeyResponse) ProtoMessage() {}
+
+func (x *KeyManagerUnwrapKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_KeyManager_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyManagerUnwrapKeyResponse.ProtoReflect.Descriptor instead.
+func (*KeyManagerUnwrapKeyResponse) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_KeyManager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KeyManagerUnwrapKeyResponse) GetDataKey() []byte {
+	if x != nil {
+		return x.DataKey
+	}
+	return nil
+}
+
+var File_keymanager_v1_KeyManager_proto protoreflect.FileDescriptor
+
+var file_keymanager_v1_KeyManager_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f,
+	0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x76, 0x31, 0x1a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x62,
+	0x0a, 0x18, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x57, 0x72, 0x61, 0x70,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61,
+	0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x4b,
+	0x65, 0x79, 0x22, 0x3b, 0x0a, 0x19, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x57, 0x72, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x22,
+	0x6a, 0x0a, 0x1a, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x22, 0x37, 0x0a, 0x1b, 0x4b,
+	0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61,
+	0x74, 0x61, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x61, 0x74,
+	0x61, 0x4b, 0x65, 0x79, 0x32, 0xd7, 0x01, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x07, 0x57, 0x72, 0x61, 0x70,
+	0x4b, 0x65, 0x79, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x57, 0x72, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x57, 0x72, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x09, 0x55, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x43,
+	0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x2d, 0x74, 0x61, 0x6e, 0x7a, 0x75, 0x2f, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x6f,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_keymanager_v1_KeyManager_proto_rawDescOnce sync.Once
+	file_keymanager_v1_KeyManager_proto_rawDescData = file_keymanager_v1_KeyManager_proto_rawDesc
+)
+
+func file_keymanager_v1_KeyManager_proto_rawDescGZIP() []byte {
+	file_keymanager_v1_KeyManager_proto_rawDescOnce.Do(func() {
+		file_keymanager_v1_KeyManager_proto_rawDescData = protoimpl.X.CompressGZIP(file_keymanager_v1_KeyManager_proto_rawDescData)
+	})
+	return file_keymanager_v1_KeyManager_proto_rawDescData
+}
+
+var file_keymanager_v1_KeyManager_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_keymanager_v1_KeyManager_proto_goTypes = []interface{}{
+	(*KeyManagerInitRequest)(nil),       // 0: v1.KeyManagerInitRequest
+	(*KeyManagerWrapKeyRequest)(nil),    // 1: v1.KeyManagerWrapKeyRequest
+	(*KeyManagerWrapKeyResponse)(nil),   // 2: v1.KeyManagerWrapKeyResponse
+	(*KeyManagerUnwrapKeyRequest)(nil),  // 3: v1.KeyManagerUnwrapKeyRequest
+	(*KeyManagerUnwrapKeyResponse)(nil), // 4: v1.KeyManagerUnwrapKeyResponse
+	nil,                                 // 5: v1.KeyManagerInitRequest.ConfigEntry
+	(*generated.Empty)(nil),             // 6: generated.Empty
+}
+var file_keymanager_v1_KeyManager_proto_depIdxs = []int32{
+	5, // 0: v1.KeyManagerInitRequest.config:type_name -> v1.KeyManagerInitRequest.ConfigEntry
+	0, // 1: v1.KeyManager.Init:input_type -> v1.KeyManagerInitRequest
+	1, // 2: v1.KeyManager.WrapKey:input_type -> v1.KeyManagerWrapKeyRequest
+	3, // 3: v1.KeyManager.UnwrapKey:input_type -> v1.KeyManagerUnwrapKeyRequest
+	6, // 4: v1.KeyManager.Init:output_type -> generated.Empty
+	2, // 5: v1.KeyManager.WrapKey:output_type -> v1.KeyManagerWrapKeyResponse
+	4, // 6: v1.KeyManager.UnwrapKey:output_type -> v1.KeyManagerUnwrapKeyResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_keymanager_v1_KeyManager_proto_init() }
+func file_keymanager_v1_KeyManager_proto_init() {
+	if File_keymanager_v1_KeyManager_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_keymanager_v1_KeyManager_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyManagerInitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymanager_v1_KeyManager_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyManagerWrapKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymanager_v1_KeyManager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyManagerWrapKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymanager_v1_KeyManager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyManagerUnwrapKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymanager_v1_KeyManager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyManagerUnwrapKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_keymanager_v1_KeyManager_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keymanager_v1_KeyManager_proto_goTypes,
+		DependencyIndexes: file_keymanager_v1_KeyManager_proto_depIdxs,
+		MessageInfos:      file_keymanager_v1_KeyManager_proto_msgTypes,
+	}.Build()
+	File_keymanager_v1_KeyManager_proto = out.File
+	file_keymanager_v1_KeyManager_proto_rawDesc = nil
+	file_keymanager_v1_KeyManager_proto_goTypes = nil
+	file_keymanager_v1_KeyManager_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// KeyManagerClient is the client API for KeyManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KeyManagerClient interface {
+	Init(ctx context.Context, in *KeyManagerInitRequest, opts ...grpc.CallOption) (*generated.Empty, error)
+	WrapKey(ctx context.Context, in *KeyManagerWrapKeyRequest, opts ...grpc.CallOption) (*KeyManagerWrapKeyResponse, error)
+	UnwrapKey(ctx context.Context, in *KeyManagerUnwrapKeyRequest, opts ...grpc.CallOption) (*KeyManagerUnwrapKeyResponse, error)
+}
+
+type keyManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeyManagerClient(cc grpc.ClientConnInterface) KeyManagerClient {
+	return &keyManagerClient{cc}
+}
+
+func (c *keyManagerClient) Init(ctx context.Context, in *KeyManagerInitRequest, opts ...grpc.CallOption) (*generated.Empty, error) {
+	out := new(generated.Empty)
+	err := c.cc.Invoke(ctx, "/v1.KeyManager/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyManagerClient) WrapKey(ctx context.Context, in *KeyManagerWrapKeyRequest, opts ...grpc.CallOption) (*KeyManagerWrapKeyResponse, error) {
+	out := new(KeyManagerWrapKeyResponse)
+	err := c.cc.Invoke(ctx, "/v1.KeyManager/WrapKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyManagerClient) UnwrapKey(ctx context.Context, in *KeyManagerUnwrapKeyRequest, opts ...grpc.CallOption) (*KeyManagerUnwrapKeyResponse, error) {
+	out := new(KeyManagerUnwrapKeyResponse)
+	err := c.cc.Invoke(ctx, "/v1.KeyManager/UnwrapKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyManagerServer is the server API for KeyManager service.
+type KeyManagerServer interface {
+	Init(context.Context, *KeyManagerInitRequest) (*generated.Empty, error)
+	WrapKey(context.Context, *KeyManagerWrapKeyRequest) (*KeyManagerWrapKeyResponse, error)
+	UnwrapKey(context.Context, *KeyManagerUnwrapKeyRequest) (*KeyManagerUnwrapKeyResponse, error)
+}
+
+// UnimplementedKeyManagerServer can be embedded to have forward compatible implementations.
+type UnimplementedKeyManagerServer struct {
+}
+
+func (*UnimplementedKeyManagerServer) Init(context.Context, *KeyManagerInitRequest) (*generated.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (*UnimplementedKeyManagerServer) WrapKey(context.Context, *KeyManagerWrapKeyRequest) (*KeyManagerWrapKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrapKey not implemented")
+}
+func (*UnimplementedKeyManagerServer) UnwrapKey(context.Context, *KeyManagerUnwrapKeyRequest) (*KeyManagerUnwrapKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwrapKey not implemented")
+}
+
+func RegisterKeyManagerServer(s *grpc.Server, srv KeyManagerServer) {
+	s.RegisterService(&_KeyManager_serviceDesc, srv)
+}
+
+func _KeyManager_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyManagerInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyManagerServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.KeyManager/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyManagerServer).Init(ctx, req.(*KeyManagerInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyManager_WrapKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyManagerWrapKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyManagerServer).WrapKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.KeyManager/WrapKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyManagerServer).WrapKey(ctx, req.(*KeyManagerWrapKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyManager_UnwrapKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyManagerUnwrapKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyManagerServer).UnwrapKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.KeyManager/UnwrapKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyManagerServer).UnwrapKey(ctx, req.(*KeyManagerUnwrapKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _KeyManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.KeyManager",
+	HandlerType: (*KeyManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _KeyManager_Init_Handler,
+		},
+		{
+			MethodName: "WrapKey",
+			Handler:    _KeyManager_WrapKey_Handler,
+		},
+		{
+			MethodName: "UnwrapKey",
+			Handler:    _KeyManager_UnwrapKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "keymanager/v1/KeyManager.proto",
+}
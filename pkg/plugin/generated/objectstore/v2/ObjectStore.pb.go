@@ -0,0 +1,1222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.14.0
+// source: objectstore/v2/ObjectStore.proto
+
+package v2
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	generated "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ObjectStorePutObjectRetentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Bucket      string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key         string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Mode        string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	RetainUntil int64  `protobuf:"varint,5,opt,name=retainUntil,proto3" json:"retainUntil,omitempty"`
+}
+
+func (x *ObjectStorePutObjectRetentionRequest) Reset() {
+	*x = ObjectStorePutObjectRetentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectStorePutObjectRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStorePutObjectRetentionRequest) ProtoMessage() {}
+
+func (x *ObjectStorePutObjectRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStorePutObjectRetentionRequest.ProtoReflect.Descriptor instead.
+func (*ObjectStorePutObjectRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_objectstore_v2_ObjectStore_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ObjectStorePutObjectRetentionRequest) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ObjectStorePutObjectRetentionRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ObjectStorePutObjectRetentionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ObjectStorePutObjectRetentionRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ObjectStorePutObjectRetentionRequest) GetRetainUntil() int64 {
+	if x != nil {
+		return x.RetainUntil
+	}
+	return 0
+}
+
+type ObjectStoreGetObjectRetentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ObjectStoreGetObjectRetentionRequest) Reset() {
+	*x = ObjectStoreGetObjectRetentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectStoreGetObjectRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStoreGetObjectRetentionRequest) ProtoMessage() {}
+
+func (x *ObjectStoreGetObjectRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStoreGetObjectRetentionRequest.ProtoReflect.Descriptor instead.
+func (*ObjectStoreGetObjectRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_objectstore_v2_ObjectStore_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ObjectStoreGetObjectRetentionRequest) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ObjectStoreGetObjectRetentionRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ObjectStoreGetObjectRetentionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ObjectStoreGetObjectRetentionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetainUntil int64 `protobuf:"varint,1,opt,name=retainUntil,proto3" json:"retainUntil,omitempty"`
+}
+
+func (x *ObjectStoreGetObjectRetentionResponse) Reset() {
+	*x = ObjectStoreGetObjectRetentionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectStoreGetObjectRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStoreGetObjectRetentionResponse) ProtoMessage() {}
+
+func (x *ObjectStoreGetObjectRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStoreGetObjectRetentionResponse.ProtoReflect.Descriptor instead.
+func (*ObjectStoreGetObjectRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_objectstore_v2_ObjectStore_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ObjectStoreGetObjectRetentionResponse) GetRetainUntil() int64 {
+	if x != nil {
+		return x.RetainUntil
+	}
+	return 0
+}
+
+type ObjectStorePutObjectLegalHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Hold   bool   `protobuf:"varint,4,opt,name=hold,proto3" json:"hold,omitempty"`
+}
+
+func (x *ObjectStorePutObjectLegalHoldRequest) Reset() {
+	*x = ObjectStorePutObjectLegalHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectStorePutObjectLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStorePutObjectLegalHoldRequest) ProtoMessage() {}
+
+func (x *ObjectStorePutObjectLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStorePutObjectLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*ObjectStorePutObjectLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_objectstore_v2_ObjectStore_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ObjectStorePutObjectLegalHoldRequest) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ObjectStorePutObjectLegalHoldRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ObjectStorePutObjectLegalHoldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ObjectStorePutObjectLegalHoldRequest) GetHold() bool {
+	if x != nil {
+		return x.Hold
+	}
+	return false
+}
+
+type ObjectStoreGetObjectLegalHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ObjectStoreGetObjectLegalHoldRequest) Reset() {
+	*x = ObjectStoreGetObjectLegalHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectStoreGetObjectLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStoreGetObjectLegalHoldRequest) ProtoMessage() {}
+
+func (x *ObjectStoreGetObjectLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStoreGetObjectLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*ObjectStoreGetObjectLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_objectstore_v2_ObjectStore_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ObjectStoreGetObjectLegalHoldRequest) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ObjectStoreGetObjectLegalHoldRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ObjectStoreGetObjectLegalHoldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ObjectStoreGetObjectLegalHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hold bool `protobuf:"varint,1,opt,name=hold,proto3" json:"hold,omitempty"`
+}
+
+func (x *ObjectStoreGetObjectLegalHoldResponse) Reset() {
+	*x = ObjectStoreGetObjectLegalHoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectStoreGetObjectLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStoreGetObjectLegalHoldResponse) ProtoMessage() {}
+
+func (x *ObjectStoreGetObjectLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_objectstore_v2_ObjectStore_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStoreGetObjectLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*ObjectStoreGetObjectLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_objectstore_v2_ObjectStore_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ObjectStoreGetObjectLegalHoldResponse) GetHold() bool {
+	if x != nil {
+		return x.Hold
+	}
+	return false
+}
+
+var File_objectstore_v2_ObjectStore_proto protoreflect.FileDescriptor
+
+var file_objectstore_v2_ObjectStore_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x32,
+	0x2f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x76, 0x32, 0x1a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x01, 0x0a, 0x24, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x68, 0x0a, 0x24, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x49, 0x0a, 0x25, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x7c, 0x0a,
+	0x24, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x68, 0x0a, 0x24, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3b, 0x0a, 0x25, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x67,
+	0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x6f,
+	0x6c, 0x64, 0x32, 0xde, 0x07, 0x0a, 0x0b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3c, 0x0a, 0x09, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x4f, 0x0a,
+	0x0c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1d,
+	0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x58, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55,
+	0x52, 0x4c, 0x12, 0x21, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x12, 0x50, 0x75, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x69, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x32,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x2e, 0x76,
+	0x32, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x69, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x28,
+	0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47,
+	0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x76, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x2d, 0x74, 0x61, 0x6e, 0x7a, 0x75, 0x2f, 0x76,
+	0x65, 0x6c, 0x65, 0x72, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_objectstore_v2_ObjectStore_proto_rawDescOnce sync.Once
+	file_objectstore_v2_ObjectStore_proto_rawDescData = file_objectstore_v2_ObjectStore_proto_rawDesc
+)
+
+func file_objectstore_v2_ObjectStore_proto_rawDescGZIP() []byte {
+	file_objectstore_v2_ObjectStore_proto_rawDescOnce.Do(func() {
+		file_objectstore_v2_ObjectStore_proto_rawDescData = protoimpl.X.CompressGZIP(file_objectstore_v2_ObjectStore_proto_rawDescData)
+	})
+	return file_objectstore_v2_ObjectStore_proto_rawDescData
+}
+
+var file_objectstore_v2_ObjectStore_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_objectstore_v2_ObjectStore_proto_goTypes = []interface{}{
+	(*ObjectStorePutObjectRetentionRequest)(nil),  // 0: v2.ObjectStorePutObjectRetentionRequest
+	(*ObjectStoreGetObjectRetentionRequest)(nil),  // 1: v2.ObjectStoreGetObjectRetentionRequest
+	(*ObjectStoreGetObjectRetentionResponse)(nil), // 2: v2.ObjectStoreGetObjectRetentionResponse
+	(*ObjectStorePutObjectLegalHoldRequest)(nil),  // 3: v2.ObjectStorePutObjectLegalHoldRequest
+	(*ObjectStoreGetObjectLegalHoldRequest)(nil),  // 4: v2.ObjectStoreGetObjectLegalHoldRequest
+	(*ObjectStoreGetObjectLegalHoldResponse)(nil), // 5: v2.ObjectStoreGetObjectLegalHoldResponse
+	(*generated.ObjectStoreInitRequest)(nil),      // 6: generated.ObjectStoreInitRequest
+	(*generated.PutObjectRequest)(nil),            // 7: generated.PutObjectRequest
+	(*generated.ObjectExistsRequest)(nil),         // 8: generated.ObjectExistsRequest
+	(*generated.GetObjectRequest)(nil),            // 9: generated.GetObjectRequest
+	(*generated.ListCommonPrefixesRequest)(nil),   // 10: generated.ListCommonPrefixesRequest
+	(*generated.ListObjectsRequest)(nil),          // 11: generated.ListObjectsRequest
+	(*generated.DeleteObjectRequest)(nil),         // 12: generated.DeleteObjectRequest
+	(*generated.CreateSignedURLRequest)(nil),      // 13: generated.CreateSignedURLRequest
+	(*generated.Empty)(nil),                       // 14: generated.Empty
+	(*generated.ObjectExistsResponse)(nil),        // 15: generated.ObjectExistsResponse
+	(*generated.Bytes)(nil),                       // 16: generated.Bytes
+	(*generated.ListCommonPrefixesResponse)(nil),  // 17: generated.ListCommonPrefixesResponse
+	(*generated.ListObjectsResponse)(nil),         // 18: generated.ListObjectsResponse
+	(*generated.CreateSignedURLResponse)(nil),     // 19: generated.CreateSignedURLResponse
+}
+var file_objectstore_v2_ObjectStore_proto_depIdxs = []int32{
+	6,  // 0: v2.ObjectStore.Init:input_type -> generated.ObjectStoreInitRequest
+	7,  // 1: v2.ObjectStore.PutObject:input_type -> generated.PutObjectRequest
+	8,  // 2: v2.ObjectStore.ObjectExists:input_type -> generated.ObjectExistsRequest
+	9,  // 3: v2.ObjectStore.GetObject:input_type -> generated.GetObjectRequest
+	10, // 4: v2.ObjectStore.ListCommonPrefixes:input_type -> generated.ListCommonPrefixesRequest
+	11, // 5: v2.ObjectStore.ListObjects:input_type -> generated.ListObjectsRequest
+	12, // 6: v2.ObjectStore.DeleteObject:input_type -> generated.DeleteObjectRequest
+	13, // 7: v2.ObjectStore.CreateSignedURL:input_type -> generated.CreateSignedURLRequest
+	0,  // 8: v2.ObjectStore.PutObjectRetention:input_type -> v2.ObjectStorePutObjectRetentionRequest
+	1,  // 9: v2.ObjectStore.GetObjectRetention:input_type -> v2.ObjectStoreGetObjectRetentionRequest
+	3,  // 10: v2.ObjectStore.PutObjectLegalHold:input_type -> v2.ObjectStorePutObjectLegalHoldRequest
+	4,  // 11: v2.ObjectStore.GetObjectLegalHold:input_type -> v2.ObjectStoreGetObjectLegalHoldRequest
+	14, // 12: v2.ObjectStore.Init:output_type -> generated.Empty
+	14, // 13: v2.ObjectStore.PutObject:output_type -> generated.Empty
+	15, // 14: v2.ObjectStore.ObjectExists:output_type -> generated.ObjectExistsResponse
+	16, // 15: v2.ObjectStore.GetObject:output_type -> generated.Bytes
+	17, // 16: v2.ObjectStore.ListCommonPrefixes:output_type -> generated.ListCommonPrefixesResponse
+	18, // 17: v2.ObjectStore.ListObjects:output_type -> generated.ListObjectsResponse
+	14, // 18: v2.ObjectStore.DeleteObject:output_type -> generated.Empty
+	19, // 19: v2.ObjectStore.CreateSignedURL:output_type -> generated.CreateSignedURLResponse
+	14, // 20: v2.ObjectStore.PutObjectRetention:output_type -> generated.Empty
+	2,  // 21: v2.ObjectStore.GetObjectRetention:output_type -> v2.ObjectStoreGetObjectRetentionResponse
+	14, // 22: v2.ObjectStore.PutObjectLegalHold:output_type -> generated.Empty
+	5,  // 23: v2.ObjectStore.GetObjectLegalHold:output_type -> v2.ObjectStoreGetObjectLegalHoldResponse
+	12, // [12:24] is the sub-list for method output_type
+	0,  // [0:12] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_objectstore_v2_ObjectStore_proto_init() }
+func file_objectstore_v2_ObjectStore_proto_init() {
+	if File_objectstore_v2_ObjectStore_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_objectstore_v2_ObjectStore_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStorePutObjectRetentionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_objectstore_v2_ObjectStore_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStoreGetObjectRetentionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_objectstore_v2_ObjectStore_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStoreGetObjectRetentionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_objectstore_v2_ObjectStore_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStorePutObjectLegalHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_objectstore_v2_ObjectStore_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStoreGetObjectLegalHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_objectstore_v2_ObjectStore_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectStoreGetObjectLegalHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_objectstore_v2_ObjectStore_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_objectstore_v2_ObjectStore_proto_goTypes,
+		DependencyIndexes: file_objectstore_v2_ObjectStore_proto_depIdxs,
+		MessageInfos:      file_objectstore_v2_ObjectStore_proto_msgTypes,
+	}.Build()
+	File_objectstore_v2_ObjectStore_proto = out.File
+	file_objectstore_v2_ObjectStore_proto_rawDesc = nil
+	file_objectstore_v2_ObjectStore_proto_goTypes = nil
+	file_objectstore_v2_ObjectStore_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ObjectStoreClient is the client API for ObjectStore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ObjectStoreClient interface {
+	Init(ctx context.Context, in *generated.ObjectStoreInitRequest, opts ...grpc.CallOption) (*generated.Empty, error)
+	PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectClient, error)
+	ObjectExists(ctx context.Context, in *generated.ObjectExistsRequest, opts ...grpc.CallOption) (*generated.ObjectExistsResponse, error)
+	GetObject(ctx context.Context, in *generated.GetObjectRequest, opts ...grpc.CallOption) (ObjectStore_GetObjectClient, error)
+	ListCommonPrefixes(ctx context.Context, in *generated.ListCommonPrefixesRequest, opts ...grpc.CallOption) (*generated.ListCommonPrefixesResponse, error)
+	ListObjects(ctx context.Context, in *generated.ListObjectsRequest, opts ...grpc.CallOption) (*generated.ListObjectsResponse, error)
+	DeleteObject(ctx context.Context, in *generated.DeleteObjectRequest, opts ...grpc.CallOption) (*generated.Empty, error)
+	CreateSignedURL(ctx context.Context, in *generated.CreateSignedURLRequest, opts ...grpc.CallOption) (*generated.CreateSignedURLResponse, error)
+	PutObjectRetention(ctx context.Context, in *ObjectStorePutObjectRetentionRequest, opts ...grpc.CallOption) (*generated.Empty, error)
+	GetObjectRetention(ctx context.Context, in *ObjectStoreGetObjectRetentionRequest, opts ...grpc.CallOption) (*ObjectStoreGetObjectRetentionResponse, error)
+	PutObjectLegalHold(ctx context.Context, in *ObjectStorePutObjectLegalHoldRequest, opts ...grpc.CallOption) (*generated.Empty, error)
+	GetObjectLegalHold(ctx context.Context, in *ObjectStoreGetObjectLegalHoldRequest, opts ...grpc.CallOption) (*ObjectStoreGetObjectLegalHoldResponse, error)
+}
+
+type objectStoreClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewObjectStoreClient(cc grpc.ClientConnInterface) ObjectStoreClient {
+	return &objectStoreClient{cc}
+}
+
+func (c *objectStoreClient) Init(ctx context.Context, in *generated.ObjectStoreInitRequest, opts ...grpc.CallOption) (*generated.Empty, error) {
+	out := new(generated.Empty)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectStore_serviceDesc.Streams[0], "/v2.ObjectStore/PutObject", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectStorePutObjectClient{stream}
+	return x, nil
+}
+
+type ObjectStore_PutObjectClient interface {
+	Send(*generated.PutObjectRequest) error
+	CloseAndRecv() (*generated.Empty, error)
+	grpc.ClientStream
+}
+
+type objectStorePutObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectStorePutObjectClient) Send(m *generated.PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectClient) CloseAndRecv() (*generated.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(generated.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *objectStoreClient) ObjectExists(ctx context.Context, in *generated.ObjectExistsRequest, opts ...grpc.CallOption) (*generated.ObjectExistsResponse, error) {
+	out := new(generated.ObjectExistsResponse)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/ObjectExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) GetObject(ctx context.Context, in *generated.GetObjectRequest, opts ...grpc.CallOption) (ObjectStore_GetObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectStore_serviceDesc.Streams[1], "/v2.ObjectStore/GetObject", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectStoreGetObjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ObjectStore_GetObjectClient interface {
+	Recv() (*generated.Bytes, error)
+	grpc.ClientStream
+}
+
+type objectStoreGetObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectStoreGetObjectClient) Recv() (*generated.Bytes, error) {
+	m := new(generated.Bytes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *objectStoreClient) ListCommonPrefixes(ctx context.Context, in *generated.ListCommonPrefixesRequest, opts ...grpc.CallOption) (*generated.ListCommonPrefixesResponse, error) {
+	out := new(generated.ListCommonPrefixesResponse)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/ListCommonPrefixes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) ListObjects(ctx context.Context, in *generated.ListObjectsRequest, opts ...grpc.CallOption) (*generated.ListObjectsResponse, error) {
+	out := new(generated.ListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/ListObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) DeleteObject(ctx context.Context, in *generated.DeleteObjectRequest, opts ...grpc.CallOption) (*generated.Empty, error) {
+	out := new(generated.Empty)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/DeleteObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) CreateSignedURL(ctx context.Context, in *generated.CreateSignedURLRequest, opts ...grpc.CallOption) (*generated.CreateSignedURLResponse, error) {
+	out := new(generated.CreateSignedURLResponse)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/CreateSignedURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) PutObjectRetention(ctx context.Context, in *ObjectStorePutObjectRetentionRequest, opts ...grpc.CallOption) (*generated.Empty, error) {
+	out := new(generated.Empty)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/PutObjectRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) GetObjectRetention(ctx context.Context, in *ObjectStoreGetObjectRetentionRequest, opts ...grpc.CallOption) (*ObjectStoreGetObjectRetentionResponse, error) {
+	out := new(ObjectStoreGetObjectRetentionResponse)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/GetObjectRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) PutObjectLegalHold(ctx context.Context, in *ObjectStorePutObjectLegalHoldRequest, opts ...grpc.CallOption) (*generated.Empty, error) {
+	out := new(generated.Empty)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/PutObjectLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) GetObjectLegalHold(ctx context.Context, in *ObjectStoreGetObjectLegalHoldRequest, opts ...grpc.CallOption) (*ObjectStoreGetObjectLegalHoldResponse, error) {
+	out := new(ObjectStoreGetObjectLegalHoldResponse)
+	err := c.cc.Invoke(ctx, "/v2.ObjectStore/GetObjectLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ObjectStoreServer is the server API for ObjectStore service.
+type ObjectStoreServer interface {
+	Init(context.Context, *generated.ObjectStoreInitRequest) (*generated.Empty, error)
+	PutObject(ObjectStore_PutObjectServer) error
+	ObjectExists(context.Context, *generated.ObjectExistsRequest) (*generated.ObjectExistsResponse, error)
+	GetObject(*generated.GetObjectRequest, ObjectStore_GetObjectServer) error
+	ListCommonPrefixes(context.Context, *generated.ListCommonPrefixesRequest) (*generated.ListCommonPrefixesResponse, error)
+	ListObjects(context.Context, *generated.ListObjectsRequest) (*generated.ListObjectsResponse, error)
+	DeleteObject(context.Context, *generated.DeleteObjectRequest) (*generated.Empty, error)
+	CreateSignedURL(context.Context, *generated.CreateSignedURLRequest) (*generated.CreateSignedURLResponse, error)
+	PutObjectRetention(context.Context, *ObjectStorePutObjectRetentionRequest) (*generated.Empty, error)
+	GetObjectRetention(context.Context, *ObjectStoreGetObjectRetentionRequest) (*ObjectStoreGetObjectRetentionResponse, error)
+	PutObjectLegalHold(context.Context, *ObjectStorePutObjectLegalHoldRequest) (*generated.Empty, error)
+	GetObjectLegalHold(context.Context, *ObjectStoreGetObjectLegalHoldRequest) (*ObjectStoreGetObjectLegalHoldResponse, error)
+}
+
+// UnimplementedObjectStoreServer can be embedded to have forward compatible implementations.
+type UnimplementedObjectStoreServer struct {
+}
+
+func (*UnimplementedObjectStoreServer) Init(context.Context, *generated.ObjectStoreInitRequest) (*generated.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (*UnimplementedObjectStoreServer) PutObject(ObjectStore_PutObjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutObject not implemented")
+}
+func (*UnimplementedObjectStoreServer) ObjectExists(context.Context, *generated.ObjectExistsRequest) (*generated.ObjectExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectExists not implemented")
+}
+func (*UnimplementedObjectStoreServer) GetObject(*generated.GetObjectRequest, ObjectStore_GetObjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+}
+func (*UnimplementedObjectStoreServer) ListCommonPrefixes(context.Context, *generated.ListCommonPrefixesRequest) (*generated.ListCommonPrefixesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommonPrefixes not implemented")
+}
+func (*UnimplementedObjectStoreServer) ListObjects(context.Context, *generated.ListObjectsRequest) (*generated.ListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (*UnimplementedObjectStoreServer) DeleteObject(context.Context, *generated.DeleteObjectRequest) (*generated.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (*UnimplementedObjectStoreServer) CreateSignedURL(context.Context, *generated.CreateSignedURLRequest) (*generated.CreateSignedURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSignedURL not implemented")
+}
+func (*UnimplementedObjectStoreServer) PutObjectRetention(context.Context, *ObjectStorePutObjectRetentionRequest) (*generated.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutObjectRetention not implemented")
+}
+func (*UnimplementedObjectStoreServer) GetObjectRetention(context.Context, *ObjectStoreGetObjectRetentionRequest) (*ObjectStoreGetObjectRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectRetention not implemented")
+}
+func (*UnimplementedObjectStoreServer) PutObjectLegalHold(context.Context, *ObjectStorePutObjectLegalHoldRequest) (*generated.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutObjectLegalHold not implemented")
+}
+func (*UnimplementedObjectStoreServer) GetObjectLegalHold(context.Context, *ObjectStoreGetObjectLegalHoldRequest) (*ObjectStoreGetObjectLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectLegalHold not implemented")
+}
+
+func RegisterObjectStoreServer(s *grpc.Server, srv ObjectStoreServer) {
+	s.RegisterService(&_ObjectStore_serviceDesc, srv)
+}
+
+func _ObjectStore_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(generated.ObjectStoreInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).Init(ctx, req.(*generated.ObjectStoreInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_PutObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectStoreServer).PutObject(&objectStorePutObjectServer{stream})
+}
+
+type ObjectStore_PutObjectServer interface {
+	SendAndClose(*generated.Empty) error
+	Recv() (*generated.PutObjectRequest, error)
+	grpc.ServerStream
+}
+
+type objectStorePutObjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectStorePutObjectServer) SendAndClose(m *generated.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectServer) Recv() (*generated.PutObjectRequest, error) {
+	m := new(generated.PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ObjectStore_ObjectExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(generated.ObjectExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).ObjectExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/ObjectExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).ObjectExists(ctx, req.(*generated.ObjectExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(generated.GetObjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectStoreServer).GetObject(m, &objectStoreGetObjectServer{stream})
+}
+
+type ObjectStore_GetObjectServer interface {
+	Send(*generated.Bytes) error
+	grpc.ServerStream
+}
+
+type objectStoreGetObjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectStoreGetObjectServer) Send(m *generated.Bytes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ObjectStore_ListCommonPrefixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(generated.ListCommonPrefixesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).ListCommonPrefixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/ListCommonPrefixes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).ListCommonPrefixes(ctx, req.(*generated.ListCommonPrefixesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(generated.ListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/ListObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).ListObjects(ctx, req.(*generated.ListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(generated.DeleteObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/DeleteObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).DeleteObject(ctx, req.(*generated.DeleteObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_CreateSignedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(generated.CreateSignedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).CreateSignedURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/CreateSignedURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).CreateSignedURL(ctx, req.(*generated.CreateSignedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_PutObjectRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectStorePutObjectRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).PutObjectRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/PutObjectRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).PutObjectRetention(ctx, req.(*ObjectStorePutObjectRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_GetObjectRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectStoreGetObjectRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).GetObjectRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/GetObjectRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).GetObjectRetention(ctx, req.(*ObjectStoreGetObjectRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_PutObjectLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectStorePutObjectLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).PutObjectLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/PutObjectLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).PutObjectLegalHold(ctx, req.(*ObjectStorePutObjectLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_GetObjectLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectStoreGetObjectLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).GetObjectLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2.ObjectStore/GetObjectLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).GetObjectLegalHold(ctx, req.(*ObjectStoreGetObjectLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ObjectStore_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v2.ObjectStore",
+	HandlerType: (*ObjectStoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _ObjectStore_Init_Handler,
+		},
+		{
+			MethodName: "ObjectExists",
+			Handler:    _ObjectStore_ObjectExists_Handler,
+		},
+		{
+			MethodName: "ListCommonPrefixes",
+			Handler:    _ObjectStore_ListCommonPrefixes_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _ObjectStore_ListObjects_Handler,
+		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _ObjectStore_DeleteObject_Handler,
+		},
+		{
+			MethodName: "CreateSignedURL",
+			Handler:    _ObjectStore_CreateSignedURL_Handler,
+		},
+		{
+			MethodName: "PutObjectRetention",
+			Handler:    _ObjectStore_PutObjectRetention_Handler,
+		},
+		{
+			MethodName: "GetObjectRetention",
+			Handler:    _ObjectStore_GetObjectRetention_Handler,
+		},
+		{
+			MethodName: "PutObjectLegalHold",
+			Handler:    _ObjectStore_PutObjectLegalHold_Handler,
+		},
+		{
+			MethodName: "GetObjectLegalHold",
+			Handler:    _ObjectStore_GetObjectLegalHold_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PutObject",
+			Handler:       _ObjectStore_PutObject_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetObject",
+			Handler:       _ObjectStore_GetObject_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "objectstore/v2/ObjectStore.proto",
+}
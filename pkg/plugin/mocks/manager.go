@@ -14,6 +14,10 @@ import (
 
 	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
+	itemblockactionv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/itemblockaction/v1"
+
+	keymanagerv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/keymanager/v1"
+
 	volumesnapshotterv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 )
 
@@ -165,6 +169,75 @@ func (_m *Manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
 	return r0, r1
 }
 
+// GetItemBlockAction provides a mock function with given fields: name
+func (_m *Manager) GetItemBlockAction(name string) (itemblockactionv1.ItemBlockAction, error) {
+	ret := _m.Called(name)
+
+	var r0 itemblockactionv1.ItemBlockAction
+	if rf, ok := ret.Get(0).(func(string) itemblockactionv1.ItemBlockAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(itemblockactionv1.ItemBlockAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemBlockActions provides a mock function with given fields:
+func (_m *Manager) GetItemBlockActions() ([]itemblockactionv1.ItemBlockAction, error) {
+	ret := _m.Called()
+
+	var r0 []itemblockactionv1.ItemBlockAction
+	if rf, ok := ret.Get(0).(func() []itemblockactionv1.ItemBlockAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]itemblockactionv1.ItemBlockAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyManager provides a mock function with given fields: name
+func (_m *Manager) GetKeyManager(name string) (keymanagerv1.KeyManager, error) {
+	ret := _m.Called(name)
+
+	var r0 keymanagerv1.KeyManager
+	if rf, ok := ret.Get(0).(func(string) keymanagerv1.KeyManager); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keymanagerv1.KeyManager)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetObjectStore provides a mock function with given fields: name
 func (_m *Manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	ret := _m.Called(name)
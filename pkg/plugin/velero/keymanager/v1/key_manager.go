@@ -0,0 +1,35 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// KeyManager wraps the data keys which Velero encrypts the backup files with,
+// using keys that never leave a key management service (KMS).
+type KeyManager interface {
+	// Init prepares the KeyManager for usage using the provided map of
+	// configuration key-value pairs. It returns an error if the KeyManager
+	// cannot be initialized from the provided config.
+	Init(config map[string]string) error
+
+	// WrapKey encrypts the data key with the key of the given ID, and returns
+	// the wrapped data key.
+	WrapKey(keyID string, dataKey []byte) ([]byte, error)
+
+	// UnwrapKey decrypts the data key wrapped with the key of the given ID.
+	// The key must still be available after it's rotated, as long as backups
+	// encrypted with it exist.
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+}
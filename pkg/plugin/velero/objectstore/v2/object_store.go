@@ -0,0 +1,63 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"time"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// RetentionMode is the mode of the lock that protects an object
+// from being deleted or overwritten until its retention expires.
+type RetentionMode string
+
+const (
+	// RetentionModeGovernance locks the object, but allows the users
+	// with special permissions to remove or shorten the lock.
+	RetentionModeGovernance RetentionMode = "Governance"
+
+	// RetentionModeCompliance locks the object, and nobody can remove
+	// or shorten the lock.
+	RetentionModeCompliance RetentionMode = "Compliance"
+)
+
+// ObjectStore exposes the object-storage operations required by Velero,
+// and the object lock operations that protect the backups from being deleted
+// or overwritten, e.g. by ransomware, before they expire.
+type ObjectStore interface {
+	velero.ObjectStore
+
+	// PutObjectRetention locks the object with the given key in the bucket, so it
+	// can't be deleted or overwritten until retainUntil. It returns an error if the
+	// bucket doesn't support object locks, or if the object is already locked for
+	// longer in the compliance mode.
+	PutObjectRetention(bucket, key string, mode RetentionMode, retainUntil time.Time) error
+
+	// GetObjectRetention returns the time until which the object with the given key
+	// in the bucket is locked. It returns the zero time if the object isn't locked.
+	GetObjectRetention(bucket, key string) (time.Time, error)
+
+	// PutObjectLegalHold places a legal hold on the object with the given key in the
+	// bucket, or removes it if hold is false. An object under legal hold can't be
+	// deleted or overwritten regardless of its retention.
+	PutObjectLegalHold(bucket, key string, hold bool) error
+
+	// GetObjectLegalHold returns true if the object with the given key in the
+	// bucket is under legal hold.
+	GetObjectLegalHold(bucket, key string) (bool, error)
+}
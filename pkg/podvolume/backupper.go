@@ -19,6 +19,7 @@ package podvolume
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -146,18 +147,24 @@ func resultsKey(ns, name string) string {
 	return fmt.Sprintf("%s/%s", ns, name)
 }
 
-func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pvc *corev1api.PersistentVolumeClaim, volume *corev1api.Volume) (*resourcepolicies.Action, error) {
+func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pod *corev1api.Pod, pvc *corev1api.PersistentVolumeClaim, volume *corev1api.Volume) (*resourcepolicies.Action, error) {
 	if pvc != nil {
 		pv := new(corev1api.PersistentVolume)
 		err := b.crClient.Get(context.TODO(), ctrlclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error getting pv for pvc %s", pvc.Spec.VolumeName)
 		}
-		return resPolicies.GetMatchAction(pv)
+		return resPolicies.GetMatchAction(resourcepolicies.VolumeFilterData{
+			PersistentVolume:      pv,
+			PersistentVolumeClaim: pvc,
+		})
 	}
 
 	if volume != nil {
-		return resPolicies.GetMatchAction(volume)
+		return resPolicies.GetMatchAction(resourcepolicies.VolumeFilterData{
+			PodVolume:    volume,
+			PodNamespace: pod.Namespace,
+		})
 	}
 
 	return nil, errors.Errorf("failed to check resource policies for empty volume")
@@ -199,22 +206,45 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		return nil, nil, []error{err}
 	}
 
-	repositoryType := getRepositoryType(b.uploaderType)
-	if repositoryType == "" {
-		err := errors.Errorf("empty repository type, uploader %s", b.uploaderType)
-		return nil, nil, []error{err}
+	// the repositories are ensured per repository type, as volume policies
+	// may choose an uploader other than the default one for some volumes
+	repos := make(map[string]*velerov1api.BackupRepository)
+	ensureRepo := func(uploaderType string) (string, error) {
+		repositoryType := getRepositoryType(uploaderType)
+		if repositoryType == "" {
+			return "", errors.Errorf("empty repository type, uploader %s", uploaderType)
+		}
+
+		repo, ok := repos[repositoryType]
+		if !ok {
+			var err error
+			repo, err = b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation, repositoryType)
+			if err != nil {
+				return "", err
+			}
+
+			// get a single non-exclusive lock since we'll wait for all individual
+			// backups to be complete before releasing it.
+			b.repoLocker.Lock(repo.Name)
+			repos[repositoryType] = repo
+		}
+
+		if repositoryType == velerov1api.BackupRepositoryTypeRestic {
+			return repo.Spec.ResticIdentifier, nil
+		}
+		return "", nil
 	}
+	defer func() {
+		for _, repo := range repos {
+			b.repoLocker.Unlock(repo.Name)
+		}
+	}()
 
-	repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation, repositoryType)
+	repoIdentifier, err := ensureRepo(b.uploaderType)
 	if err != nil {
 		return nil, nil, []error{err}
 	}
 
-	// get a single non-exclusive lock since we'll wait for all individual
-	// backups to be complete before releasing it.
-	b.repoLocker.Lock(repo.Name)
-	defer b.repoLocker.Unlock(repo.Name)
-
 	var (
 		podVolumeBackups   []*velerov1api.PodVolumeBackup
 		mountedPodVolumes  = sets.Set[string]{}
@@ -230,11 +260,6 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 	}
 
-	repoIdentifier := ""
-	if repositoryType == velerov1api.BackupRepositoryTypeRestic {
-		repoIdentifier = repo.Spec.ResticIdentifier
-	}
-
 	for _, volumeName := range volumesToBackup {
 		volume, ok := podVolumes[volumeName]
 		if !ok {
@@ -250,8 +275,9 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			}
 		}
 
+		var action *resourcepolicies.Action
 		if resPolicies != nil {
-			if action, err := b.getMatchAction(resPolicies, pvc, &volume); err != nil {
+			if action, err = b.getMatchAction(resPolicies, pod, pvc, &volume); err != nil {
 				errs = append(errs, errors.Wrapf(err, "error getting pv for pvc %s", pvc.Spec.VolumeName))
 				continue
 			} else if action != nil && action.Type == resourcepolicies.Skip {
@@ -291,7 +317,23 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			continue
 		}
 
-		volumeBackup := newPodVolumeBackup(backup, pod, volume, repoIdentifier, b.uploaderType, pvc)
+		// the uploader settings of the matched volume policy take precedence over the backup's
+		uploaderType, volumeRepoIdentifier := b.uploaderType, repoIdentifier
+		if t := action.UploaderType(); t != "" && t != b.uploaderType {
+			if volumeRepoIdentifier, err = ensureRepo(t); err != nil {
+				errs = append(errs, errors.Wrapf(err, "error ensuring repository of uploader %s for volume %s", t, volumeName))
+				continue
+			}
+			uploaderType = t
+		}
+
+		volumeBackup := newPodVolumeBackup(backup, pod, volume, volumeRepoIdentifier, uploaderType, pvc)
+		if parallelFilesUpload := action.ParallelFilesUpload(); parallelFilesUpload > 0 {
+			if volumeBackup.Spec.UploaderSettings == nil {
+				volumeBackup.Spec.UploaderSettings = make(map[string]string)
+			}
+			volumeBackup.Spec.UploaderSettings[uploaderutil.ParallelFilesUpload] = strconv.Itoa(parallelFilesUpload)
+		}
 		if err := veleroclient.CreateRetryGenerateName(b.crClient, b.ctx, volumeBackup); err != nil {
 			errs = append(errs, err)
 			continue
@@ -319,7 +319,10 @@ func TestBackupPodVolumes(t *testing.T) {
 		veleroClientObj []runtime.Object
 		veleroReactors  []reactor
 		runtimeScheme   *runtime.Scheme
+		resPolicies     *resourcepolicies.ResourcePolicies
 		pvbs            int
+		pvbUploaderType string
+		pvbSettings     map[string]string
 		errs            []string
 	}{
 		{
@@ -505,6 +508,54 @@ func TestBackupPodVolumes(t *testing.T) {
 			bsl:           "fake-bsl",
 			pvbs:          1,
 		},
+		{
+			name: "uploader settings of the matched volume policy are used",
+			volumes: []string{
+				"fake-volume-1",
+			},
+			sourcePod: createPodObj(true, true, true, 1),
+			kubeClientObj: []runtime.Object{
+				createNodeAgentPodObj(true),
+				createPVCObj(1),
+				createPVObj(1, false),
+			},
+			ctlClientObj: []runtime.Object{
+				createBackupRepoObj(),
+				func() *velerov1api.BackupRepository {
+					repo := repository.NewBackupRepository(velerov1api.DefaultNamespace, repository.BackupRepositoryKey{
+						VolumeNamespace: "fake-ns",
+						BackupLocation:  "fake-bsl",
+						RepositoryType:  "restic",
+					})
+					repo.Name = "fake-restic-repo"
+					repo.Status.Phase = velerov1api.BackupRepositoryPhaseReady
+					return repo
+				}(),
+			},
+			runtimeScheme: scheme,
+			uploaderType:  "kopia",
+			bsl:           "fake-bsl",
+			resPolicies: &resourcepolicies.ResourcePolicies{
+				Version: "v1",
+				VolumePolicies: []resourcepolicies.VolumePolicy{
+					{
+						Conditions: map[string]interface{}{
+							"namespaces": []string{"fake-ns"},
+						},
+						Action: resourcepolicies.Action{
+							Type: resourcepolicies.FSBackup,
+							Parameters: map[string]interface{}{
+								resourcepolicies.UploaderTypeParameter:        "restic",
+								resourcepolicies.ParallelFilesUploadParameter: 4,
+							},
+						},
+					},
+				},
+			},
+			pvbs:            1,
+			pvbUploaderType: "restic",
+			pvbSettings:     map[string]string{"ParallelFilesUpload": "4"},
+		},
 	}
 	// TODO add more verification around PVCBackupSummary returned by "BackupPodVolumes"
 	for _, test := range tests {
@@ -542,7 +593,13 @@ func TestBackupPodVolumes(t *testing.T) {
 
 			require.NoError(t, err)
 
-			pvbs, _, errs := bp.BackupPodVolumes(backupObj, test.sourcePod, test.volumes, nil, velerotest.NewLogger())
+			var resPolicies *resourcepolicies.Policies
+			if test.resPolicies != nil {
+				resPolicies = &resourcepolicies.Policies{}
+				require.NoError(t, resPolicies.BuildPolicy(test.resPolicies))
+			}
+
+			pvbs, _, errs := bp.BackupPodVolumes(backupObj, test.sourcePod, test.volumes, resPolicies, velerotest.NewLogger())
 
 			if errs == nil {
 				assert.Nil(t, test.errs)
@@ -553,6 +610,12 @@ func TestBackupPodVolumes(t *testing.T) {
 			}
 
 			assert.Len(t, pvbs, test.pvbs)
+			if test.pvbUploaderType != "" {
+				for _, pvb := range pvbs {
+					assert.Equal(t, test.pvbUploaderType, pvb.Spec.UploaderType)
+					assert.Equal(t, test.pvbSettings, pvb.Spec.UploaderSettings)
+				}
+			}
 		})
 	}
 }
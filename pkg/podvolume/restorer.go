@@ -27,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -126,20 +127,38 @@ func (r *restorer) RestorePodVolumes(data RestoreData, tracker *volume.RestoreVo
 		return []error{errors.Wrapf(err, "error to check node agent status")}
 	}
 
-	repositoryType, err := getVolumesRepositoryType(volumesToRestore)
+	repositoryTypes, err := getVolumesRepositoryTypes(volumesToRestore)
 	if err != nil {
 		return []error{err}
 	}
 
-	repo, err := r.repoEnsurer.EnsureRepo(r.ctx, data.Restore.Namespace, data.SourceNamespace, data.BackupLocation, repositoryType)
-	if err != nil {
-		return []error{err}
-	}
+	// volumes of one pod may be backed up by different uploaders as chosen by the volume policies,
+	// so there may be more than one repository to restore from
+	var lockedRepos []string
+	defer func() {
+		for _, name := range lockedRepos {
+			r.repoLocker.Unlock(name)
+		}
+	}()
+
+	repoIdentifiers := make(map[string]string)
+	for _, repositoryType := range repositoryTypes {
+		repo, err := r.repoEnsurer.EnsureRepo(r.ctx, data.Restore.Namespace, data.SourceNamespace, data.BackupLocation, repositoryType)
+		if err != nil {
+			return []error{err}
+		}
 
-	// get a single non-exclusive lock since we'll wait for all individual
-	// restores to be complete before releasing it.
-	r.repoLocker.Lock(repo.Name)
-	defer r.repoLocker.Unlock(repo.Name)
+		// get a single non-exclusive lock since we'll wait for all individual
+		// restores to be complete before releasing it.
+		r.repoLocker.Lock(repo.Name)
+		lockedRepos = append(lockedRepos, repo.Name)
+
+		if repositoryType == velerov1api.BackupRepositoryTypeRestic {
+			repoIdentifiers[repositoryType] = repo.Spec.ResticIdentifier
+		} else {
+			repoIdentifiers[repositoryType] = ""
+		}
+	}
 
 	resultsChan := make(chan *velerov1api.PodVolumeRestore)
 
@@ -160,11 +179,6 @@ func (r *restorer) RestorePodVolumes(data RestoreData, tracker *volume.RestoreVo
 		podVolumes[podVolume.Name] = podVolume
 	}
 
-	repoIdentifier := ""
-	if repositoryType == velerov1api.BackupRepositoryTypeRestic {
-		repoIdentifier = repo.Spec.ResticIdentifier
-	}
-
 	for volume, backupInfo := range volumesToRestore {
 		volumeObj, ok := podVolumes[volume]
 		var pvc *corev1api.PersistentVolumeClaim
@@ -179,7 +193,7 @@ func (r *restorer) RestorePodVolumes(data RestoreData, tracker *volume.RestoreVo
 			}
 		}
 
-		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, backupInfo.snapshotID, repoIdentifier, backupInfo.uploaderType, data.SourceNamespace, pvc)
+		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, backupInfo.snapshotID, repoIdentifiers[backupInfo.repositoryType], backupInfo.uploaderType, data.SourceNamespace, pvc)
 		if err := veleroclient.CreateRetryGenerateName(r.crClient, r.ctx, volumeRestore); err != nil {
 			errs = append(errs, errors.WithStack(err))
 			continue
@@ -297,28 +311,19 @@ func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backu
 	return pvr
 }
 
-func getVolumesRepositoryType(volumes map[string]volumeBackupInfo) (string, error) {
+func getVolumesRepositoryTypes(volumes map[string]volumeBackupInfo) ([]string, error) {
 	if len(volumes) == 0 {
-		return "", errors.New("empty volume list")
+		return nil, errors.New("empty volume list")
 	}
 
-	// the podVolumeBackups list come from one backup. In one backup, it is impossible that volumes are
-	// backed up by different uploaders or to different repositories. Asserting this ensures one repo only,
-	// which will simplify the following logics
-	repositoryType := ""
+	repositoryTypes := sets.New[string]()
 	for _, backupInfo := range volumes {
 		if backupInfo.repositoryType == "" {
-			return "", errors.Errorf("empty repository type found among volume snapshots, snapshot ID %s, uploader %s",
+			return nil, errors.Errorf("empty repository type found among volume snapshots, snapshot ID %s, uploader %s",
 				backupInfo.snapshotID, backupInfo.uploaderType)
 		}
-
-		if repositoryType == "" {
-			repositoryType = backupInfo.repositoryType
-		} else if repositoryType != backupInfo.repositoryType {
-			return "", errors.Errorf("multiple repository type in one backup, current type %s, differential one [type %s, snapshot ID %s, uploader %s]",
-				repositoryType, backupInfo.repositoryType, backupInfo.snapshotID, backupInfo.uploaderType)
-		}
+		repositoryTypes.Insert(backupInfo.repositoryType)
 	}
 
-	return repositoryType, nil
+	return sets.List(repositoryTypes), nil
 }
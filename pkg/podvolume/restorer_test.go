@@ -43,11 +43,11 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
-func TestGetVolumesRepositoryType(t *testing.T) {
+func TestGetVolumesRepositoryTypes(t *testing.T) {
 	testCases := []struct {
 		name        string
 		volumes     map[string]volumeBackupInfo
-		expected    string
+		expected    []string
 		expectedErr string
 		prefixOnly  bool
 	}{
@@ -82,13 +82,13 @@ func TestGetVolumesRepositoryType(t *testing.T) {
 			expectedErr: "empty repository type found among volume snapshots, snapshot ID fake-snapshot-id-2, uploader fake-uploader-2",
 		},
 		{
-			name: "mismatch repository type",
+			name: "multiple repository types",
 			volumes: map[string]volumeBackupInfo{
-				"volume1": {"", "", "fake-type1"},
-				"volume2": {"fake-snapshot-id-2", "fake-uploader-2", "fake-type2"},
+				"volume1": {"", "", "fake-type2"},
+				"volume2": {"fake-snapshot-id-2", "fake-uploader-2", "fake-type1"},
+				"volume3": {"", "", "fake-type2"},
 			},
-			prefixOnly:  true,
-			expectedErr: "multiple repository type in one backup",
+			expected: []string{"fake-type1", "fake-type2"},
 		},
 		{
 			name: "success",
@@ -97,13 +97,13 @@ func TestGetVolumesRepositoryType(t *testing.T) {
 				"volume2": {"", "", "fake-type"},
 				"volume3": {"", "", "fake-type"},
 			},
-			expected: "fake-type",
+			expected: []string{"fake-type"},
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual, err := getVolumesRepositoryType(tc.volumes)
+			actual, err := getVolumesRepositoryTypes(tc.volumes)
 			assert.Equal(t, tc.expected, actual)
 
 			if err != nil {
@@ -208,8 +208,8 @@ func TestRestorePodVolumes(t *testing.T) {
 		{
 			name: "get repository type fail",
 			pvbs: []*velerov1api.PodVolumeBackup{
-				createPVBObj(true, true, 1, "restic"),
-				createPVBObj(true, true, 2, "kopia"),
+				createPVBObj(true, true, 1, "kopia"),
+				createPVBObj(true, true, 2, "fake-uploader"),
 			},
 			kubeClientObj: []runtime.Object{
 				createNodeAgentDaemonset(),
@@ -218,7 +218,7 @@ func TestRestorePodVolumes(t *testing.T) {
 			sourceNamespace: "fake-ns",
 			errs: []expectError{
 				{
-					err:        "multiple repository type in one backup",
+					err:        "empty repository type found among volume snapshots",
 					prefixOnly: true,
 				},
 			},
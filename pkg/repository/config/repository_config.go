@@ -0,0 +1,168 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo/kopialib/backend"
+)
+
+// RepositoryOptions are the configurable options of a backup repository
+type RepositoryOptions struct {
+	// CacheLimitMB is the limit of the local data and metadata cache of the repository in megabytes
+	CacheLimitMB *int64 `json:"cacheLimitMB,omitempty"`
+
+	// HashAlgo is the hashing algorithm, it only takes effect when the repository is created
+	HashAlgo string `json:"hashAlgo,omitempty"`
+
+	// EncryptAlgo is the encryption algorithm, it only takes effect when the repository is created
+	EncryptAlgo string `json:"encryptAlgo,omitempty"`
+
+	// SplitAlgo is the splitter algorithm, it only takes effect when the repository is created
+	SplitAlgo string `json:"splitAlgo,omitempty"`
+
+	// CompressionAlgo is the compression algorithm for the data written to the repository
+	CompressionAlgo string `json:"compressionAlgo,omitempty"`
+}
+
+// RepositoryTypeConfig is the configuration for one repository type, the options
+// in BackupStorageLocations override the common ones for the repositories of the
+// given BackupStorageLocation
+type RepositoryTypeConfig struct {
+	RepositoryOptions `json:",inline"`
+
+	BackupStorageLocations map[string]RepositoryOptions `json:"backupStorageLocations,omitempty"`
+}
+
+// GetRepositoryConfig reads the configuration of the repositories with the given type
+// in the given BackupStorageLocation from the repository config map. The data of the
+// config map is keyed by repository type and each value is a RepositoryTypeConfig in
+// JSON. Nil is returned if the config map name is empty or no configuration is found.
+func GetRepositoryConfig(ctx context.Context, cli client.Client, namespace string, configName string, repoType string, location string) (map[string]string, error) {
+	if configName == "" {
+		return nil, nil
+	}
+
+	cm := &corev1api.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configName}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "error to get repository config %s", configName)
+	}
+
+	jsonString, found := cm.Data[repoType]
+	if !found {
+		return nil, nil
+	}
+
+	typeConfig := RepositoryTypeConfig{}
+	if err := json.Unmarshal([]byte(jsonString), &typeConfig); err != nil {
+		return nil, errors.Wrapf(err, "error to unmarshall %s config from %s", repoType, configName)
+	}
+
+	result := map[string]string{}
+	typeConfig.RepositoryOptions.mergeTo(result)
+	if locationOptions, found := typeConfig.BackupStorageLocations[location]; found {
+		locationOptions.mergeTo(result)
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// formatOptions are the options which only take effect when a repository is created
+var formatOptions = []string{
+	udmrepo.StoreOptionGenHashAlgo,
+	udmrepo.StoreOptionGenEncryptAlgo,
+	udmrepo.StoreOptionGenSplitAlgo,
+}
+
+// GetEffectiveRepositoryConfig returns the configuration applied to a repository of the
+// given type, including the defaults of the options that are not configured. The options
+// fixed when the repository was created are taken from format, which is read from the
+// repository, and omitted if they are not in it, since the configured values may not apply.
+func GetEffectiveRepositoryConfig(repoType string, config map[string]string, format map[string]string) map[string]string {
+	if repoType != velerov1api.BackupRepositoryTypeKopia {
+		return config
+	}
+
+	effective := backend.GetEffectiveRepositoryConfig(config)
+	for _, option := range formatOptions {
+		if value, found := format[option]; found {
+			effective[option] = value
+		} else {
+			delete(effective, option)
+		}
+	}
+
+	return effective
+}
+
+// GetRepositoryConfigConflicts returns the warnings about the options configured with values
+// different from the ones in the format of the repository, which don't take effect.
+func GetRepositoryConfigConflicts(config map[string]string, format map[string]string) []string {
+	var conflicts []string
+	for _, option := range formatOptions {
+		configured, found := config[option]
+		if !found || format[option] == "" || configured == format[option] {
+			continue
+		}
+
+		conflicts = append(conflicts, fmt.Sprintf("%s is configured as %s, but the repository was created with %s, the configured value doesn't take effect",
+			option, configured, format[option]))
+	}
+
+	return conflicts
+}
+
+func (o RepositoryOptions) mergeTo(result map[string]string) {
+	if o.CacheLimitMB != nil {
+		result[udmrepo.StoreOptionCacheLimit] = strconv.FormatInt(*o.CacheLimitMB, 10)
+	}
+
+	if o.HashAlgo != "" {
+		result[udmrepo.StoreOptionGenHashAlgo] = o.HashAlgo
+	}
+
+	if o.EncryptAlgo != "" {
+		result[udmrepo.StoreOptionGenEncryptAlgo] = o.EncryptAlgo
+	}
+
+	if o.SplitAlgo != "" {
+		result[udmrepo.StoreOptionGenSplitAlgo] = o.SplitAlgo
+	}
+
+	if o.CompressionAlgo != "" {
+		result[udmrepo.StoreOptionGenCompressAlgo] = o.CompressionAlgo
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetRepositoryConfig(t *testing.T) {
+	configWithData := func(data map[string]string) *corev1api.ConfigMap {
+		return &corev1api.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      "repo-config",
+			},
+			Data: data,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		configName  string
+		configMap   *corev1api.ConfigMap
+		repoType    string
+		location    string
+		expected    map[string]string
+		expectedErr string
+	}{
+		{
+			name:     "config map name is empty",
+			repoType: "kopia",
+		},
+		{
+			name:       "config map doesn't exist",
+			configName: "repo-config",
+			repoType:   "kopia",
+		},
+		{
+			name:       "no config for the repository type",
+			configName: "repo-config",
+			configMap:  configWithData(map[string]string{"restic": `{"cacheLimitMB": 100}`}),
+			repoType:   "kopia",
+		},
+		{
+			name:        "invalid config",
+			configName:  "repo-config",
+			configMap:   configWithData(map[string]string{"kopia": `{"cacheLimitMB": "fake"}`}),
+			repoType:    "kopia",
+			expectedErr: "error to unmarshall kopia config from repo-config: json: cannot unmarshal string into Go struct field RepositoryTypeConfig.cacheLimitMB of type int64",
+		},
+		{
+			name:       "config for the repository type",
+			configName: "repo-config",
+			configMap: configWithData(map[string]string{
+				"kopia": `{"cacheLimitMB": 4096, "compressionAlgo": "zstd", "backupStorageLocations": {"bsl-2": {"cacheLimitMB": 8192}}}`,
+			}),
+			repoType: "kopia",
+			location: "bsl-1",
+			expected: map[string]string{
+				"cacheLimitMB":    "4096",
+				"compressionAlgo": "zstd",
+			},
+		},
+		{
+			name:       "config for the backup storage location overrides",
+			configName: "repo-config",
+			configMap: configWithData(map[string]string{
+				"kopia": `{"cacheLimitMB": 4096, "compressionAlgo": "zstd", "backupStorageLocations": {"bsl-2": {"cacheLimitMB": 8192, "splitAlgo": "FIXED-4M"}}}`,
+			}),
+			repoType: "kopia",
+			location: "bsl-2",
+			expected: map[string]string{
+				"cacheLimitMB":    "8192",
+				"compressionAlgo": "zstd",
+				"splitAlgo":       "FIXED-4M",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objects := []runtime.Object{}
+			if test.configMap != nil {
+				objects = append(objects, test.configMap)
+			}
+			cli := velerotest.NewFakeControllerRuntimeClient(t, objects...)
+
+			result, err := GetRepositoryConfig(context.Background(), cli, "velero", test.configName, test.repoType, test.location)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestGetEffectiveRepositoryConfig(t *testing.T) {
+	config := map[string]string{"cacheLimitMB": "4096", "hashAlgo": "BLAKE3-256"}
+
+	assert.Equal(t, config, GetEffectiveRepositoryConfig("restic", config, nil))
+
+	// the options fixed at the creation are only reported once read from the repository
+	effective := GetEffectiveRepositoryConfig("kopia", config, nil)
+	assert.Equal(t, map[string]string{"cacheLimitMB": "4096", "compressionAlgo": "none"}, effective)
+
+	format := map[string]string{"hashAlgo": "BLAKE2B-256-128", "encryptAlgo": "AES256-GCM-HMAC-SHA256", "splitAlgo": "DYNAMIC-4M-BUZHASH"}
+	effective = GetEffectiveRepositoryConfig("kopia", config, format)
+	assert.Equal(t, map[string]string{
+		"cacheLimitMB":    "4096",
+		"compressionAlgo": "none",
+		"hashAlgo":        "BLAKE2B-256-128",
+		"encryptAlgo":     "AES256-GCM-HMAC-SHA256",
+		"splitAlgo":       "DYNAMIC-4M-BUZHASH",
+	}, effective)
+}
+
+func TestGetRepositoryConfigConflicts(t *testing.T) {
+	format := map[string]string{"hashAlgo": "BLAKE2B-256-128", "encryptAlgo": "AES256-GCM-HMAC-SHA256", "splitAlgo": "DYNAMIC-4M-BUZHASH"}
+
+	assert.Empty(t, GetRepositoryConfigConflicts(map[string]string{"cacheLimitMB": "4096", "splitAlgo": "DYNAMIC-4M-BUZHASH"}, format))
+	assert.Empty(t, GetRepositoryConfigConflicts(map[string]string{"hashAlgo": "BLAKE3-256"}, nil))
+	assert.Equal(t, []string{
+		"hashAlgo is configured as BLAKE3-256, but the repository was created with BLAKE2B-256-128, the configured value doesn't take effect",
+	}, GetRepositoryConfigConflicts(map[string]string{"hashAlgo": "BLAKE3-256", "compressionAlgo": "zstd"}, format))
+}
@@ -18,6 +18,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"time"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/repository/provider"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -46,6 +48,9 @@ const DefaultMaintenanceJobCPULimit = "0"
 const DefaultMaintenanceJobMemRequest = "0"
 const DefaultMaintenanceJobMemLimit = "0"
 
+// GlobalKeyForRepoMaintenanceJobCM is the key of the maintenance job configs applied to all repositories
+const GlobalKeyForRepoMaintenanceJobCM = "global"
+
 // MaintenanceConfig is the configuration for the repo maintenance job
 type MaintenanceConfig struct {
 	KeepLatestMaitenanceJobs int
@@ -55,6 +60,81 @@ type MaintenanceConfig struct {
 	MemLimit                 string
 	LogLevelFlag             *logging.LevelFlag
 	FormatFlag               *logging.FormatFlag
+	JobConfigName            string
+}
+
+// JobConfigs is the configuration of the maintenance jobs of one or all repositories
+type JobConfigs struct {
+	// LoadAffinities is the node affinity of the maintenance job pod, only the first element is used at present
+	LoadAffinities []*kube.LoadAffinity `json:"loadAffinity,omitempty"`
+
+	// PodResources is the resource requests and limits of the maintenance job pod
+	PodResources *kube.PodResources `json:"podResources,omitempty"`
+
+	// PriorityClassName is the priority class of the maintenance job pod
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// Tolerations are the tolerations of the maintenance job pod
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+}
+
+// GetRepositoryKey returns the key identifying the repository in the maintenance job config map
+func GetRepositoryKey(repo *velerov1api.BackupRepository) string {
+	return fmt.Sprintf("%s-%s-%s", repo.Spec.VolumeNamespace, repo.Spec.BackupStorageLocation, repo.Spec.RepositoryType)
+}
+
+// getMaintenanceJobConfig reads the maintenance job configs of the repository from the config map.
+// The configs under the repository key override the ones under the global key.
+// Nil is returned if the config map is not specified or not found.
+func getMaintenanceJobConfig(ctx context.Context, cli client.Client, namespace string, configName string, repo *velerov1api.BackupRepository) (*JobConfigs, error) {
+	if configName == "" {
+		return nil, nil
+	}
+
+	cm := &v1.ConfigMap{}
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "error to get maintenance job configs %s", configName)
+	}
+
+	var result *JobConfigs
+	for _, key := range []string{GlobalKeyForRepoMaintenanceJobCM, GetRepositoryKey(repo)} {
+		jsonString, found := cm.Data[key]
+		if !found {
+			continue
+		}
+
+		configs := &JobConfigs{}
+		if err := json.Unmarshal([]byte(jsonString), configs); err != nil {
+			return nil, errors.Wrapf(err, "error to unmarshall maintenance job configs %s from %s", key, configName)
+		}
+
+		if result == nil {
+			result = configs
+			continue
+		}
+
+		if len(configs.LoadAffinities) > 0 {
+			result.LoadAffinities = configs.LoadAffinities
+		}
+
+		if configs.PodResources != nil {
+			result.PodResources = configs.PodResources
+		}
+
+		if configs.PriorityClassName != "" {
+			result.PriorityClassName = configs.PriorityClassName
+		}
+
+		if len(configs.Tolerations) > 0 {
+			result.Tolerations = configs.Tolerations
+		}
+	}
+
+	return result, nil
 }
 
 func generateJobName(repo string) string {
@@ -91,7 +171,26 @@ func buildMaintenanceJob(m MaintenanceConfig, param provider.RepoParam, cli clie
 	// Get image
 	image := veleroutil.GetVeleroServerImage(deployment)
 
+	jobConfigs, err := getMaintenanceJobConfig(context.TODO(), cli, namespace, m.JobConfigName, param.BackupRepo)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set resource limits and requests
+	if jobConfigs != nil && jobConfigs.PodResources != nil {
+		if jobConfigs.PodResources.CPURequest != "" {
+			m.CPURequest = jobConfigs.PodResources.CPURequest
+		}
+		if jobConfigs.PodResources.MemoryRequest != "" {
+			m.MemRequest = jobConfigs.PodResources.MemoryRequest
+		}
+		if jobConfigs.PodResources.CPULimit != "" {
+			m.CPULimit = jobConfigs.PodResources.CPULimit
+		}
+		if jobConfigs.PodResources.MemoryLimit != "" {
+			m.MemLimit = jobConfigs.PodResources.MemoryLimit
+		}
+	}
 	if m.CPURequest == "" {
 		m.CPURequest = DefaultMaintenanceJobCPURequest
 	}
@@ -176,6 +275,20 @@ func buildMaintenanceJob(m MaintenanceConfig, param provider.RepoParam, cli clie
 		job.Spec.Template.Annotations = annotations
 	}
 
+	if jobConfigs != nil {
+		if len(jobConfigs.LoadAffinities) > 0 {
+			if affinity := kube.ToSystemAffinity(jobConfigs.LoadAffinities[0]); affinity != nil {
+				job.Spec.Template.Spec.Affinity = affinity
+			}
+		}
+
+		if len(jobConfigs.Tolerations) > 0 {
+			job.Spec.Template.Spec.Tolerations = jobConfigs.Tolerations
+		}
+
+		job.Spec.Template.Spec.PriorityClassName = jobConfigs.PriorityClassName
+	}
+
 	return job, nil
 }
 
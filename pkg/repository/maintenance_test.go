@@ -36,6 +36,7 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -406,3 +407,227 @@ func TestBuildMaintenanceJob(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMaintenanceJobConfig(t *testing.T) {
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "test-repo",
+		},
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+			RepositoryType:        "kopia",
+		},
+	}
+
+	configWithData := func(data map[string]string) *v1.ConfigMap {
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      "repo-maintenance-job-config",
+			},
+			Data: data,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		configName  string
+		config      *v1.ConfigMap
+		expected    *JobConfigs
+		expectedErr string
+	}{
+		{
+			name: "config map name is empty",
+		},
+		{
+			name:       "config map doesn't exist",
+			configName: "repo-maintenance-job-config",
+		},
+		{
+			name:       "invalid config",
+			configName: "repo-maintenance-job-config",
+			config: configWithData(map[string]string{
+				GlobalKeyForRepoMaintenanceJobCM: "{invalid",
+			}),
+			expectedErr: "error to unmarshall maintenance job configs global from repo-maintenance-job-config: invalid character 'i' looking for beginning of object key string",
+		},
+		{
+			name:       "no config for the repository",
+			configName: "repo-maintenance-job-config",
+			config: configWithData(map[string]string{
+				"ns-2-default-kopia": `{"priorityClassName": "high"}`,
+			}),
+		},
+		{
+			name:       "global config only",
+			configName: "repo-maintenance-job-config",
+			config: configWithData(map[string]string{
+				GlobalKeyForRepoMaintenanceJobCM: `{"podResources": {"cpuRequest": "100m"}, "priorityClassName": "low"}`,
+			}),
+			expected: &JobConfigs{
+				PodResources:      &kube.PodResources{CPURequest: "100m"},
+				PriorityClassName: "low",
+			},
+		},
+		{
+			name:       "repository config overrides global config",
+			configName: "repo-maintenance-job-config",
+			config: configWithData(map[string]string{
+				GlobalKeyForRepoMaintenanceJobCM: `{"podResources": {"cpuRequest": "100m"}, "priorityClassName": "low"}`,
+				"ns-1-default-kopia":             `{"priorityClassName": "high", "loadAffinity": [{"nodeSelector": {"matchLabels": {"cloud.google.com/machine-family": "e2"}}}]}`,
+			}),
+			expected: &JobConfigs{
+				LoadAffinities: []*kube.LoadAffinity{
+					{
+						NodeSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"cloud.google.com/machine-family": "e2"},
+						},
+					},
+				},
+				PodResources:      &kube.PodResources{CPURequest: "100m"},
+				PriorityClassName: "high",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := []runtime.Object{}
+			if tc.config != nil {
+				objs = append(objs, tc.config)
+			}
+			scheme := runtime.NewScheme()
+			_ = v1.AddToScheme(scheme)
+			cli := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+
+			configs, err := getMaintenanceJobConfig(context.TODO(), cli, "velero", tc.configName, repo)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, configs)
+		})
+	}
+}
+
+func TestBuildMaintenanceJobWithJobConfigs(t *testing.T) {
+	param := provider.RepoParam{
+		BackupRepo: &velerov1api.BackupRepository{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      "test-123",
+			},
+			Spec: velerov1api.BackupRepositorySpec{
+				VolumeNamespace:       "test-123",
+				BackupStorageLocation: "test-location",
+				RepositoryType:        "kopia",
+			},
+		},
+		BackupLocation: &velerov1api.BackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      "test-location",
+			},
+		},
+	}
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "velero",
+			Namespace: "velero",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "velero",
+							Image: "velero-image",
+						},
+					},
+					Tolerations: []v1.Toleration{
+						{
+							Key:      "server-key",
+							Operator: v1.TolerationOpExists,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	config := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "repo-maintenance-job-config",
+		},
+		Data: map[string]string{
+			"test-123-test-location-kopia": `{
+				"podResources": {"cpuRequest": "50m", "memoryLimit": "1Gi"},
+				"loadAffinity": [{"nodeSelector": {"matchLabels": {"node-type": "maintenance"}}}],
+				"priorityClassName": "low-priority",
+				"tolerations": [{"key": "maintenance", "operator": "Exists", "effect": "NoSchedule"}]
+			}`,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	_ = v1.AddToScheme(scheme)
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(deploy, config).Build()
+
+	m := MaintenanceConfig{
+		CPURequest:    "100m",
+		MemRequest:    "128Mi",
+		LogLevelFlag:  logging.LogLevelFlag(logrus.InfoLevel),
+		FormatFlag:    logging.NewFormatFlag(),
+		JobConfigName: "repo-maintenance-job-config",
+	}
+
+	job, err := buildMaintenanceJob(m, param, cli, "velero")
+	assert.NoError(t, err)
+
+	expectedResources := v1.ResourceRequirements{
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("50m"),
+			v1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+		Limits: v1.ResourceList{
+			v1.ResourceMemory: resource.MustParse("1Gi"),
+		},
+	}
+	assert.Equal(t, expectedResources, job.Spec.Template.Spec.Containers[0].Resources)
+
+	assert.Equal(t, "low-priority", job.Spec.Template.Spec.PriorityClassName)
+
+	assert.Equal(t, []v1.Toleration{
+		{
+			Key:      "maintenance",
+			Operator: v1.TolerationOpExists,
+			Effect:   v1.TaintEffectNoSchedule,
+		},
+	}, job.Spec.Template.Spec.Tolerations)
+
+	expectedAffinity := &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{
+						MatchExpressions: []v1.NodeSelectorRequirement{
+							{
+								Key:      "node-type",
+								Values:   []string{"maintenance"},
+								Operator: v1.NodeSelectorOpIn,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expectedAffinity, job.Spec.Template.Spec.Affinity)
+}
@@ -87,6 +87,13 @@ type Manager interface {
 	// available snapshots in a repo.
 	BatchForget(context.Context, *velerov1api.BackupRepository, []string) []error
 
+	// VerifySnapshot checks that a snapshot exists in a repo. If readContent
+	// is true, all the data of the snapshot is read as well.
+	VerifySnapshot(ctx context.Context, repo *velerov1api.BackupRepository, snapshotID string, readContent bool) error
+
+	// GetRepositoryFormat returns the options fixed when the repo was created, read from the repo
+	GetRepositoryFormat(repo *velerov1api.BackupRepository) (map[string]string, error)
+
 	// DefaultMaintenanceFrequency returns the default maintenance frequency from the specific repo
 	DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error)
 }
@@ -303,6 +310,46 @@ func (m *manager) BatchForget(ctx context.Context, repo *velerov1api.BackupRepos
 	return prd.BatchForget(context.Background(), snapshots, param)
 }
 
+func (m *manager) VerifySnapshot(ctx context.Context, repo *velerov1api.BackupRepository, snapshotID string, readContent bool) error {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := prd.BoostRepoConnect(ctx, param); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return prd.VerifySnapshot(ctx, snapshotID, readContent, param)
+}
+
+func (m *manager) GetRepositoryFormat(repo *velerov1api.BackupRepository) (map[string]string, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := prd.BoostRepoConnect(context.Background(), param); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return prd.GetRepositoryFormat(context.Background(), param)
+}
+
 func (m *manager) DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error) {
 	prd, err := m.getRepositoryProvider(repo)
 	if err != nil {
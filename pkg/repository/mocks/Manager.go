@@ -101,6 +101,36 @@ func (_m *Manager) Forget(_a0 context.Context, _a1 *v1.BackupRepository, _a2 str
 	return r0
 }
 
+// GetRepositoryFormat provides a mock function with given fields: repo
+func (_m *Manager) GetRepositoryFormat(repo *v1.BackupRepository) (map[string]string, error) {
+	ret := _m.Called(repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepositoryFormat")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository) (map[string]string, error)); ok {
+		return rf(repo)
+	}
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository) map[string]string); ok {
+		r0 = rf(repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository) error); ok {
+		r1 = rf(repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InitRepo provides a mock function with given fields: repo
 func (_m *Manager) InitRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
@@ -173,6 +203,24 @@ func (_m *Manager) UnlockRepo(repo *v1.BackupRepository) error {
 	return r0
 }
 
+// VerifySnapshot provides a mock function with given fields: ctx, repo, snapshotID, readContent
+func (_m *Manager) VerifySnapshot(ctx context.Context, repo *v1.BackupRepository, snapshotID string, readContent bool) error {
+	ret := _m.Called(ctx, repo, snapshotID, readContent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifySnapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.BackupRepository, string, bool) error); ok {
+		r0 = rf(ctx, repo, snapshotID, readContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewManager creates a new instance of Manager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewManager(t interface {
@@ -27,6 +27,9 @@ import (
 type RepoParam struct {
 	BackupLocation *velerov1api.BackupStorageLocation
 	BackupRepo     *velerov1api.BackupRepository
+	// BandwidthLimits are the limits applying to the repository connection
+	// on top of the ones of the BackupLocation, e.g. those of the node
+	BandwidthLimits *velerov1api.BandwidthLimits
 }
 
 // Provider defines the methods to manipulate a backup repository
@@ -60,6 +63,14 @@ type Provider interface {
 	// BatchForget is to delete a list of snapshots from the repository
 	BatchForget(ctx context.Context, snapshotIDs []string, param RepoParam) []error
 
+	// VerifySnapshot checks that a snapshot exists in the repository and its metadata
+	// could be loaded, if readContent is true, it also reads all the data of the snapshot
+	VerifySnapshot(ctx context.Context, snapshotID string, readContent bool, param RepoParam) error
+
+	// GetRepositoryFormat returns the options fixed when the repository was created, read
+	// from the repository, or nil if the repository has no such options
+	GetRepositoryFormat(ctx context.Context, param RepoParam) (map[string]string, error)
+
 	// DefaultMaintenanceFrequency returns the default frequency to run maintenance
 	DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration
 }
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
@@ -90,6 +91,21 @@ func (r *resticRepositoryProvider) BatchForget(ctx context.Context, snapshotIDs
 	return errs
 }
 
+// VerifySnapshot only checks the existence of the snapshot, reading the content
+// of a single snapshot is not supported for restic repositories.
+func (r *resticRepositoryProvider) VerifySnapshot(ctx context.Context, snapshotID string, readContent bool, param RepoParam) error {
+	if readContent {
+		return errors.New("reading the content of a snapshot is not supported for restic repositories")
+	}
+
+	return r.svc.CatSnapshot(param.BackupLocation, param.BackupRepo, snapshotID)
+}
+
+// GetRepositoryFormat returns nil, none of the repository options applies to restic repositories.
+func (r *resticRepositoryProvider) GetRepositoryFormat(ctx context.Context, param RepoParam) (map[string]string, error) {
+	return nil, nil
+}
+
 func (r *resticRepositoryProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return r.svc.DefaultMaintenanceFrequency()
 }
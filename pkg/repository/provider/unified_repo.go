@@ -19,11 +19,13 @@ package provider
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/kopia/kopia/repo"
@@ -37,6 +39,8 @@ import (
 	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
 	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 	reposervice "github.com/vmware-tanzu/velero/pkg/repository/udmrepo/service"
+	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
+	"github.com/vmware-tanzu/velero/pkg/util/bandwidth"
 )
 
 type unifiedRepoProvider struct {
@@ -53,6 +57,7 @@ type unifiedRepoProvider struct {
 var getS3Credentials = repoconfig.GetS3Credentials
 var getGCPCredentials = repoconfig.GetGCPCredentials
 var getS3BucketRegion = repoconfig.GetAWSBucketRegion
+var verifySnapshot = kopia.VerifySnapshot
 
 type localFuncTable struct {
 	getStorageVariables   func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error)
@@ -64,11 +69,23 @@ var funcTable = localFuncTable{
 	getStorageCredentials: getStorageCredentials,
 }
 
+// connectedCredentials records, per repo config file, the rotation generation
+// of the BSL credentials the repo was last connected with. The connection is
+// refreshed by BoostRepoConnect once the credentials are rotated.
+var connectedCredentials = struct {
+	sync.Mutex
+	generations map[string]uint64
+}{generations: map[string]uint64{}}
+
 const (
 	repoOpDescMaintain = "repo maintenance"
 	repoOpDescForget   = "forget"
+	repoOpDescVerify   = "verify"
+	repoOpDescFormat   = "format"
 
 	repoConnectDesc = "unified repo"
+
+	defaultRepositoryRetentionPeriod = 30 * 24 * time.Hour
 )
 
 // NewUnifiedRepoProvider creates the service provider for Unified Repo
@@ -108,6 +125,8 @@ func (urp *unifiedRepoProvider) InitRepo(ctx context.Context, param RepoParam) e
 				udmrepo.GenOptionOwnerDomain: udmrepo.GetRepoDomain(),
 			},
 		),
+		udmrepo.WithGenOptions(param.BackupRepo.Spec.RepositoryConfig),
+		udmrepo.WithGenOptions(getRetentionOptions(param.BackupLocation)),
 		udmrepo.WithStoreOptions(urp, param),
 		udmrepo.WithDescription(repoConnectDesc),
 	)
@@ -135,6 +154,11 @@ func (urp *unifiedRepoProvider) ConnectToRepo(ctx context.Context, param RepoPar
 
 	log.Debug("Start to connect repo")
 
+	throttlingOptions, err := GetThrottlingOptions(param, time.Now())
+	if err != nil {
+		return err
+	}
+
 	repoOption, err := udmrepo.NewRepoOptions(
 		udmrepo.WithPassword(urp, param),
 		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
@@ -144,6 +168,8 @@ func (urp *unifiedRepoProvider) ConnectToRepo(ctx context.Context, param RepoPar
 				udmrepo.GenOptionOwnerDomain: udmrepo.GetRepoDomain(),
 			},
 		),
+		udmrepo.WithGenOptions(param.BackupRepo.Spec.RepositoryConfig),
+		udmrepo.WithGenOptions(throttlingOptions),
 		udmrepo.WithStoreOptions(urp, param),
 		udmrepo.WithDescription(repoConnectDesc),
 	)
@@ -180,6 +206,8 @@ func (urp *unifiedRepoProvider) PrepareRepo(ctx context.Context, param RepoParam
 				udmrepo.GenOptionOwnerDomain: udmrepo.GetRepoDomain(),
 			},
 		),
+		udmrepo.WithGenOptions(param.BackupRepo.Spec.RepositoryConfig),
+		udmrepo.WithGenOptions(getRetentionOptions(param.BackupLocation)),
 		udmrepo.WithStoreOptions(urp, param),
 		udmrepo.WithDescription(repoConnectDesc),
 	)
@@ -216,9 +244,16 @@ func (urp *unifiedRepoProvider) BoostRepoConnect(ctx context.Context, param Repo
 
 	log.Debug("Start to boost repo connect")
 
+	throttlingOptions, err := GetThrottlingOptions(param, time.Now())
+	if err != nil {
+		return err
+	}
+
 	repoOption, err := udmrepo.NewRepoOptions(
 		udmrepo.WithPassword(urp, param),
 		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithGenOptions(param.BackupRepo.Spec.RepositoryConfig),
+		udmrepo.WithGenOptions(throttlingOptions),
 		udmrepo.WithDescription(repoConnectDesc),
 	)
 
@@ -226,16 +261,56 @@ func (urp *unifiedRepoProvider) BoostRepoConnect(ctx context.Context, param Repo
 		return errors.Wrap(err, "error to get repo options")
 	}
 
-	bkRepo, err := urp.repoService.Open(ctx, *repoOption)
-	if err == nil {
-		if c := bkRepo.Close(ctx); c != nil {
-			log.WithError(c).Error("Failed to close repo")
+	generation, err := urp.credentialsGeneration(param)
+	if err != nil {
+		return err
+	}
+
+	connectedCredentials.Lock()
+	connected, found := connectedCredentials.generations[repoOption.ConfigFilePath]
+	connectedCredentials.Unlock()
+
+	if !found || connected == generation {
+		bkRepo, err := urp.repoService.Open(ctx, *repoOption)
+		if err == nil {
+			if c := bkRepo.Close(ctx); c != nil {
+				log.WithError(c).Error("Failed to close repo")
+			}
+
+			connectedCredentials.Lock()
+			connectedCredentials.generations[repoOption.ConfigFilePath] = generation
+			connectedCredentials.Unlock()
+
+			return nil
 		}
+	} else {
+		log.Info("BSL credentials were rotated, reconnecting repo")
+	}
 
-		return nil
+	if err := urp.ConnectToRepo(ctx, param); err != nil {
+		return err
 	}
 
-	return urp.ConnectToRepo(ctx, param)
+	connectedCredentials.Lock()
+	connectedCredentials.generations[repoOption.ConfigFilePath] = generation
+	connectedCredentials.Unlock()
+
+	return nil
+}
+
+// credentialsGeneration refreshes the serialized credentials of the BSL and
+// returns their rotation generation.
+func (urp *unifiedRepoProvider) credentialsGeneration(param RepoParam) (uint64, error) {
+	if param.BackupLocation.Spec.Credential == nil || urp.credentialGetter.FromFile == nil {
+		return 0, nil
+	}
+
+	credsFile, err := urp.credentialGetter.FromFile.Path(param.BackupLocation.Spec.Credential)
+	if err != nil {
+		return 0, errors.Wrap(err, "error get credential file in bsl")
+	}
+
+	return credentials.Generation(credsFile), nil
 }
 
 func (urp *unifiedRepoProvider) PruneRepo(ctx context.Context, param RepoParam) error {
@@ -368,6 +443,80 @@ func (urp *unifiedRepoProvider) BatchForget(ctx context.Context, snapshotIDs []s
 	return errs
 }
 
+func (urp *unifiedRepoProvider) VerifySnapshot(ctx context.Context, snapshotID string, readContent bool, param RepoParam) error {
+	log := urp.log.WithFields(logrus.Fields{
+		"BSL name":    param.BackupLocation.Name,
+		"repo name":   param.BackupRepo.Name,
+		"repo UID":    param.BackupRepo.UID,
+		"snapshotID":  snapshotID,
+		"readContent": readContent,
+	})
+
+	log.Debug("Start to verify snapshot")
+
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescVerify),
+	)
+
+	if err != nil {
+		return errors.Wrap(err, "error to get repo options")
+	}
+
+	bkRepo, err := urp.repoService.Open(ctx, *repoOption)
+	if err != nil {
+		return errors.Wrap(err, "error to open backup repo")
+	}
+
+	defer func() {
+		c := bkRepo.Close(ctx)
+		if c != nil {
+			log.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	if err := verifySnapshot(ctx, kopia.NewShimRepo(bkRepo), snapshotID, readContent, log); err != nil {
+		return errors.Wrapf(err, "error to verify snapshot %s", snapshotID)
+	}
+
+	log.Debug("Verify snapshot complete")
+
+	return nil
+}
+
+func (urp *unifiedRepoProvider) GetRepositoryFormat(ctx context.Context, param RepoParam) (map[string]string, error) {
+	log := urp.log.WithFields(logrus.Fields{
+		"BSL name":  param.BackupLocation.Name,
+		"repo name": param.BackupRepo.Name,
+		"repo UID":  param.BackupRepo.UID,
+	})
+
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescFormat),
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get repo options")
+	}
+
+	bkRepo, err := urp.repoService.Open(ctx, *repoOption)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to open backup repo")
+	}
+
+	defer func() {
+		c := bkRepo.Close(ctx)
+		if c != nil {
+			log.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	return bkRepo.GetFormatOptions(), nil
+}
+
 func (urp *unifiedRepoProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return urp.repoService.DefaultMaintenanceFrequency()
 }
@@ -502,6 +651,53 @@ func getStorageCredentials(backupLocation *velerov1api.BackupStorageLocation, cr
 	return result, nil
 }
 
+// GetThrottlingOptions returns the throttling options of the repo connection,
+// i.e. the most restrictive of the bandwidth limits of the BSL and of the
+// param applying at now. All the options are set, even when unlimited, so
+// that the limits saved in the repo config by a previous connection are
+// overwritten. If any of the limits has scheduled windows, the limits are
+// passed along too, so the open repository reevaluates them as the day goes.
+func GetThrottlingOptions(param RepoParam, now time.Time) (map[string]string, error) {
+	bslLimit, err := bandwidth.Current(param.BackupLocation.Spec.BandwidthLimits, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid bandwidth limits of the BSL")
+	}
+
+	limit, err := bandwidth.Current(param.BandwidthLimits, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid bandwidth limits")
+	}
+
+	limit = bandwidth.Min(bslLimit, limit)
+	ops := strconv.FormatInt(limit.OperationsPerSecond, 10)
+
+	options := map[string]string{
+		udmrepo.ThrottleOptionReadOps:       ops,
+		udmrepo.ThrottleOptionWriteOps:      ops,
+		udmrepo.ThrottleOptionListOps:       ops,
+		udmrepo.ThrottleOptionUploadBytes:   strconv.FormatInt(limit.UploadBytesPerSecond, 10),
+		udmrepo.ThrottleOptionDownloadBytes: strconv.FormatInt(limit.DownloadBytesPerSecond, 10),
+	}
+
+	scheduled := false
+	var limits []*velerov1api.BandwidthLimits
+	for _, l := range []*velerov1api.BandwidthLimits{param.BackupLocation.Spec.BandwidthLimits, param.BandwidthLimits} {
+		if l != nil {
+			limits = append(limits, l)
+			scheduled = scheduled || len(l.Schedule) > 0
+		}
+	}
+	if scheduled {
+		schedule, err := json.Marshal(limits)
+		if err != nil {
+			return nil, errors.Wrap(err, "error encoding the bandwidth limits")
+		}
+		options[udmrepo.ThrottleOptionSchedule] = string(schedule)
+	}
+
+	return options, nil
+}
+
 func getStorageVariables(backupLocation *velerov1api.BackupStorageLocation, repoBackend string, repoName string) (map[string]string, error) {
 	result := make(map[string]string)
 
@@ -575,6 +771,30 @@ func getStorageVariables(backupLocation *velerov1api.BackupStorageLocation, repo
 	return result, nil
 }
 
+// getRetentionOptions returns the options making Kopia lock the blobs of a new
+// repository when the backup storage location is configured with immutability.
+func getRetentionOptions(backupLocation *velerov1api.BackupStorageLocation) map[string]string {
+	immutability := backupLocation.Spec.Immutability
+	if immutability == nil {
+		return nil
+	}
+
+	mode := "COMPLIANCE"
+	if immutability.Mode == velerov1api.ObjectLockModeGovernance {
+		mode = "GOVERNANCE"
+	}
+
+	period := defaultRepositoryRetentionPeriod
+	if immutability.RepositoryRetentionPeriod != nil && immutability.RepositoryRetentionPeriod.Duration > 0 {
+		period = immutability.RepositoryRetentionPeriod.Duration
+	}
+
+	return map[string]string{
+		udmrepo.StoreOptionGenRetentionMode:   mode,
+		udmrepo.StoreOptionGenRetentionPeriod: period.String(),
+	}
+}
+
 func createRepoService(log logrus.FieldLogger) udmrepo.BackupRepoService {
 	return reposervice.Create(log)
 }
@@ -21,21 +21,26 @@ import (
 	"encoding/base64"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/kopia/kopia/repo"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerocredentials "github.com/vmware-tanzu/velero/internal/credentials"
 	credmock "github.com/vmware-tanzu/velero/internal/credentials/mocks"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 	reposervicenmocks "github.com/vmware-tanzu/velero/pkg/repository/udmrepo/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
 )
 
 func TestGetStorageCredentials(t *testing.T) {
@@ -454,6 +459,41 @@ func TestGetStorageVariables(t *testing.T) {
 	}
 }
 
+func TestGetRetentionOptions(t *testing.T) {
+	testCases := []struct {
+		name           string
+		backupLocation *velerov1api.BackupStorageLocation
+		expected       map[string]string
+	}{
+		{
+			name:           "no immutability",
+			backupLocation: builder.ForBackupStorageLocation("velero", "default").Result(),
+		},
+		{
+			name:           "compliance mode with the default retention period",
+			backupLocation: builder.ForBackupStorageLocation("velero", "default").Immutability("", 0).Result(),
+			expected: map[string]string{
+				udmrepo.StoreOptionGenRetentionMode:   "COMPLIANCE",
+				udmrepo.StoreOptionGenRetentionPeriod: "720h0m0s",
+			},
+		},
+		{
+			name:           "governance mode with a custom retention period",
+			backupLocation: builder.ForBackupStorageLocation("velero", "default").Immutability(velerov1api.ObjectLockModeGovernance, 72*time.Hour).Result(),
+			expected: map[string]string{
+				udmrepo.StoreOptionGenRetentionMode:   "GOVERNANCE",
+				udmrepo.StoreOptionGenRetentionPeriod: "72h0m0s",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getRetentionOptions(tc.backupLocation))
+		})
+	}
+}
+
 func TestGetRepoPassword(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -857,6 +897,81 @@ func TestForget(t *testing.T) {
 	}
 }
 
+func TestVerifySnapshot(t *testing.T) {
+	defer func() {
+		verifySnapshot = kopia.VerifySnapshot
+	}()
+
+	funcTable = localFuncTable{
+		getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+		getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		openErr     error
+		verifyErr   error
+		expectedErr string
+	}{
+		{
+			name:        "repo open fail",
+			openErr:     errors.New("fake-error-1"),
+			expectedErr: "error to open backup repo: fake-error-1",
+		},
+		{
+			name:        "verify fail",
+			verifyErr:   errors.New("fake-error-2"),
+			expectedErr: "error to verify snapshot fake-snapshot: fake-error-2",
+		},
+		{
+			name: "succeed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := new(credmock.SecretStore)
+			getter.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+
+			backupRepo := new(reposervicenmocks.BackupRepo)
+			backupRepo.On("Close", mock.Anything).Return(nil)
+
+			repoService := new(reposervicenmocks.BackupRepoService)
+			repoService.On("Open", mock.Anything, mock.Anything).Return(backupRepo, tc.openErr)
+
+			readContent := false
+			verifySnapshot = func(ctx context.Context, rep repo.Repository, snapshotID string, read bool, log logrus.FieldLogger) error {
+				readContent = read
+				return tc.verifyErr
+			}
+
+			urp := unifiedRepoProvider{
+				credentialGetter: velerocredentials.CredentialGetter{
+					FromSecret: getter,
+				},
+				repoService: repoService,
+				log:         velerotest.NewLogger(),
+			}
+
+			err := urp.VerifySnapshot(context.Background(), "fake-snapshot", true, RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{},
+				BackupRepo:     &velerov1api.BackupRepository{},
+			})
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.True(t, readContent)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestBatchForget(t *testing.T) {
 	var backupRepo *reposervicenmocks.BackupRepo
 
@@ -1332,6 +1447,145 @@ func TestBoostRepoConnect(t *testing.T) {
 	}
 }
 
+func TestBoostRepoConnectCredentialsRotated(t *testing.T) {
+	funcTable = localFuncTable{
+		getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+		getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+	}
+
+	credsFile := t.TempDir() + "/credentials"
+
+	secretStore := new(credmock.SecretStore)
+	secretStore.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+	fileStore := credmock.NewFileStore(t)
+	fileStore.On("Path", mock.Anything).Return(credsFile, nil)
+
+	backupRepo := new(reposervicenmocks.BackupRepo)
+	backupRepo.On("Close", mock.Anything).Return(nil)
+	repoService := reposervicenmocks.NewBackupRepoService(t)
+	repoService.On("Open", mock.Anything, mock.Anything).Return(backupRepo, nil)
+	repoService.On("Init", mock.Anything, mock.Anything, false).Return(nil).Once()
+
+	urp := unifiedRepoProvider{
+		credentialGetter: velerocredentials.CredentialGetter{
+			FromSecret: secretStore,
+			FromFile:   fileStore,
+		},
+		repoService: repoService,
+		log:         velerotest.NewLogger(),
+	}
+
+	param := RepoParam{
+		BackupLocation: &velerov1api.BackupStorageLocation{
+			Spec: velerov1api.BackupStorageLocationSpec{
+				Credential: &corev1api.SecretKeySelector{},
+			},
+		},
+		BackupRepo: &velerov1api.BackupRepository{
+			ObjectMeta: metav1.ObjectMeta{UID: "rotated-repo"},
+		},
+	}
+
+	// the repo is opened with the existing connection as long as the credentials are unchanged
+	require.NoError(t, urp.BoostRepoConnect(context.Background(), param))
+	require.NoError(t, urp.BoostRepoConnect(context.Background(), param))
+	repoService.AssertNotCalled(t, "Init", mock.Anything, mock.Anything, false)
+
+	// the repo is reconnected once the credentials are rotated
+	velerocredentials.NotifyRotated(credsFile)
+	require.NoError(t, urp.BoostRepoConnect(context.Background(), param))
+	repoService.AssertNumberOfCalls(t, "Init", 1)
+
+	require.NoError(t, urp.BoostRepoConnect(context.Background(), param))
+	repoService.AssertNumberOfCalls(t, "Init", 1)
+}
+
+func TestGetThrottlingOptions(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		bslLimits       *velerov1api.BandwidthLimits
+		bandwidthLimits *velerov1api.BandwidthLimits
+		expected        map[string]string
+		expectedErr     string
+	}{
+		{
+			name: "no limits",
+			expected: map[string]string{
+				udmrepo.ThrottleOptionReadOps:       "0",
+				udmrepo.ThrottleOptionWriteOps:      "0",
+				udmrepo.ThrottleOptionListOps:       "0",
+				udmrepo.ThrottleOptionUploadBytes:   "0",
+				udmrepo.ThrottleOptionDownloadBytes: "0",
+			},
+		},
+		{
+			name: "the most restrictive limits apply",
+			bslLimits: &velerov1api.BandwidthLimits{
+				BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 1000, OperationsPerSecond: 50},
+				Schedule: []velerov1api.ScheduledBandwidthLimit{
+					{Start: "09:00", End: "17:00", BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 100}},
+				},
+			},
+			bandwidthLimits: &velerov1api.BandwidthLimits{
+				BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 500, DownloadBytesPerSecond: 2000},
+			},
+			expected: map[string]string{
+				udmrepo.ThrottleOptionReadOps:       "0",
+				udmrepo.ThrottleOptionWriteOps:      "0",
+				udmrepo.ThrottleOptionListOps:       "0",
+				udmrepo.ThrottleOptionUploadBytes:   "100",
+				udmrepo.ThrottleOptionDownloadBytes: "2000",
+				udmrepo.ThrottleOptionSchedule: `[{"uploadBytesPerSecond":1000,"operationsPerSecond":50,` +
+					`"schedule":[{"start":"09:00","end":"17:00","uploadBytesPerSecond":100}]},` +
+					`{"uploadBytesPerSecond":500,"downloadBytesPerSecond":2000}]`,
+			},
+		},
+		{
+			name: "operations limit",
+			bslLimits: &velerov1api.BandwidthLimits{
+				BandwidthLimit: velerov1api.BandwidthLimit{OperationsPerSecond: 50},
+			},
+			expected: map[string]string{
+				udmrepo.ThrottleOptionReadOps:       "50",
+				udmrepo.ThrottleOptionWriteOps:      "50",
+				udmrepo.ThrottleOptionListOps:       "50",
+				udmrepo.ThrottleOptionUploadBytes:   "0",
+				udmrepo.ThrottleOptionDownloadBytes: "0",
+			},
+		},
+		{
+			name:        "invalid limits",
+			bslLimits:   &velerov1api.BandwidthLimits{TimeZone: "Nowhere/Town"},
+			expectedErr: `invalid bandwidth limits of the BSL: invalid time zone "Nowhere/Town"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options, err := GetThrottlingOptions(RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{
+					Spec: velerov1api.BackupStorageLocationSpec{BandwidthLimits: tc.bslLimits},
+				},
+				BandwidthLimits: tc.bandwidthLimits,
+			}, now)
+
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, options)
+		})
+	}
+}
+
 func TestPruneRepo(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -72,6 +72,10 @@ func (r *RepositoryService) Forget(bsl *velerov1api.BackupStorageLocation, repo
 	return r.exec(restic.ForgetCommand(repo.Spec.ResticIdentifier, snapshotID), bsl)
 }
 
+func (r *RepositoryService) CatSnapshot(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository, snapshotID string) error {
+	return r.exec(restic.CatSnapshotCommand(repo.Spec.ResticIdentifier, snapshotID), bsl)
+}
+
 func (r *RepositoryService) DefaultMaintenanceFrequency() time.Duration {
 	return restic.DefaultMaintenanceFrequency
 }
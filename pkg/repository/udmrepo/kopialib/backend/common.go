@@ -18,6 +18,7 @@ package backend
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/kopia/kopia/repo"
@@ -33,6 +34,9 @@ import (
 )
 
 const (
+	// DefaultCompressionAlgorithm is the compression used when none is configured, data is not compressed
+	DefaultCompressionAlgorithm = "none"
+
 	maxDataCacheMB         = 2000
 	maxMetadataCacheMB     = 2000
 	maxCacheDurationSecond = 30
@@ -48,6 +52,25 @@ func setupLimits(ctx context.Context, flags map[string]string) throttling.Limits
 	}
 }
 
+// SetupThrottlingLimits returns the throttling limits set in flags, or nil if
+// flags don't set any
+func SetupThrottlingLimits(ctx context.Context, flags map[string]string) *throttling.Limits {
+	for _, key := range []string{
+		udmrepo.ThrottleOptionReadOps,
+		udmrepo.ThrottleOptionWriteOps,
+		udmrepo.ThrottleOptionListOps,
+		udmrepo.ThrottleOptionUploadBytes,
+		udmrepo.ThrottleOptionDownloadBytes,
+	} {
+		if _, exist := flags[key]; exist {
+			limits := setupLimits(ctx, flags)
+			return &limits
+		}
+	}
+
+	return nil
+}
+
 // SetupNewRepositoryOptions setups the options when creating a new Kopia repository
 func SetupNewRepositoryOptions(ctx context.Context, flags map[string]string) repo.NewRepositoryOptions {
 	return repo.NewRepositoryOptions{
@@ -65,19 +88,37 @@ func SetupNewRepositoryOptions(ctx context.Context, flags map[string]string) rep
 	}
 }
 
+// SetupCachingOptions setups the cache options of a Kopia repository
+func SetupCachingOptions(ctx context.Context, flags map[string]string) content.CachingOptions {
+	return content.CachingOptions{
+		ContentCacheSizeBytes:  optionalHaveInt64WithDefault(ctx, udmrepo.StoreOptionCacheLimit, flags, maxDataCacheMB) << 20,
+		MetadataCacheSizeBytes: optionalHaveInt64WithDefault(ctx, udmrepo.StoreOptionCacheLimit, flags, maxMetadataCacheMB) << 20,
+		MaxListCacheDuration:   content.DurationSeconds(time.Duration(maxCacheDurationSecond) * time.Second),
+	}
+}
+
+// GetEffectiveRepositoryConfig returns the values of the configurable options of a Kopia
+// repository, the defaults are returned for the options that are not set in flags
+func GetEffectiveRepositoryConfig(flags map[string]string) map[string]string {
+	return map[string]string{
+		udmrepo.StoreOptionCacheLimit:      optionalHaveStringWithDefault(udmrepo.StoreOptionCacheLimit, flags, strconv.Itoa(maxDataCacheMB)),
+		udmrepo.StoreOptionGenHashAlgo:     optionalHaveStringWithDefault(udmrepo.StoreOptionGenHashAlgo, flags, hashing.DefaultAlgorithm),
+		udmrepo.StoreOptionGenEncryptAlgo:  optionalHaveStringWithDefault(udmrepo.StoreOptionGenEncryptAlgo, flags, encryption.DefaultAlgorithm),
+		udmrepo.StoreOptionGenSplitAlgo:    optionalHaveStringWithDefault(udmrepo.StoreOptionGenSplitAlgo, flags, splitter.DefaultAlgorithm),
+		udmrepo.StoreOptionGenCompressAlgo: optionalHaveStringWithDefault(udmrepo.StoreOptionGenCompressAlgo, flags, DefaultCompressionAlgorithm),
+	}
+}
+
 // SetupConnectOptions setups the options when connecting to an existing Kopia repository
 func SetupConnectOptions(ctx context.Context, repoOptions udmrepo.RepoOptions) repo.ConnectOptions {
 	return repo.ConnectOptions{
-		CachingOptions: content.CachingOptions{
-			ContentCacheSizeBytes:  maxDataCacheMB << 20,
-			MetadataCacheSizeBytes: maxMetadataCacheMB << 20,
-			MaxListCacheDuration:   content.DurationSeconds(time.Duration(maxCacheDurationSecond) * time.Second),
-		},
+		CachingOptions: SetupCachingOptions(ctx, repoOptions.GeneralOptions),
 		ClientOptions: repo.ClientOptions{
 			Hostname:    optionalHaveString(udmrepo.GenOptionOwnerDomain, repoOptions.GeneralOptions),
 			Username:    optionalHaveString(udmrepo.GenOptionOwnerName, repoOptions.GeneralOptions),
 			ReadOnly:    optionalHaveBool(ctx, udmrepo.StoreOptionGenReadOnly, repoOptions.GeneralOptions),
 			Description: repoOptions.Description,
+			Throttling:  SetupThrottlingLimits(ctx, repoOptions.GeneralOptions),
 		},
 	}
 }
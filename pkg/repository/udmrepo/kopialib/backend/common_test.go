@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob/throttling"
 	"github.com/kopia/kopia/repo/content"
 	"github.com/kopia/kopia/repo/encryption"
 	"github.com/kopia/kopia/repo/format"
@@ -187,6 +188,52 @@ func TestSetupConnectOptions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with wrong cache limit",
+			repoOptions: udmrepo.RepoOptions{
+				GeneralOptions: map[string]string{
+					udmrepo.StoreOptionCacheLimit: "fake-limit",
+				},
+			},
+			expected: repo.ConnectOptions{
+				CachingOptions: defaultCacheOption,
+				ClientOptions:  repo.ClientOptions{},
+			},
+		},
+		{
+			name: "with correct cache limit",
+			repoOptions: udmrepo.RepoOptions{
+				GeneralOptions: map[string]string{
+					udmrepo.StoreOptionCacheLimit: "4096",
+				},
+			},
+			expected: repo.ConnectOptions{
+				CachingOptions: content.CachingOptions{
+					ContentCacheSizeBytes:  4096 << 20,
+					MetadataCacheSizeBytes: 4096 << 20,
+					MaxListCacheDuration:   content.DurationSeconds(time.Duration(30) * time.Second),
+				},
+				ClientOptions: repo.ClientOptions{},
+			},
+		},
+		{
+			name: "with throttling limits",
+			repoOptions: udmrepo.RepoOptions{
+				GeneralOptions: map[string]string{
+					udmrepo.ThrottleOptionUploadBytes: "1000",
+					udmrepo.ThrottleOptionReadOps:     "10",
+				},
+			},
+			expected: repo.ConnectOptions{
+				CachingOptions: defaultCacheOption,
+				ClientOptions: repo.ClientOptions{
+					Throttling: &throttling.Limits{
+						UploadBytesPerSecond: 1000,
+						ReadsPerSecond:       10,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -196,3 +243,44 @@ func TestSetupConnectOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEffectiveRepositoryConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		flags    map[string]string
+		expected map[string]string
+	}{
+		{
+			name: "all defaults",
+			expected: map[string]string{
+				udmrepo.StoreOptionCacheLimit:      "2000",
+				udmrepo.StoreOptionGenHashAlgo:     hashing.DefaultAlgorithm,
+				udmrepo.StoreOptionGenEncryptAlgo:  encryption.DefaultAlgorithm,
+				udmrepo.StoreOptionGenSplitAlgo:    splitter.DefaultAlgorithm,
+				udmrepo.StoreOptionGenCompressAlgo: "none",
+			},
+		},
+		{
+			name: "with configured values",
+			flags: map[string]string{
+				udmrepo.StoreOptionCacheLimit:      "4096",
+				udmrepo.StoreOptionGenSplitAlgo:    "FIXED-4M",
+				udmrepo.StoreOptionGenCompressAlgo: "zstd",
+				udmrepo.GenOptionOwnerName:         "fake-user",
+			},
+			expected: map[string]string{
+				udmrepo.StoreOptionCacheLimit:      "4096",
+				udmrepo.StoreOptionGenHashAlgo:     hashing.DefaultAlgorithm,
+				udmrepo.StoreOptionGenEncryptAlgo:  encryption.DefaultAlgorithm,
+				udmrepo.StoreOptionGenSplitAlgo:    "FIXED-4M",
+				udmrepo.StoreOptionGenCompressAlgo: "zstd",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GetEffectiveRepositoryConfig(tc.flags))
+		})
+	}
+}
@@ -26,15 +26,18 @@ import (
 	"github.com/kopia/kopia/repo"
 	"github.com/kopia/kopia/repo/compression"
 	"github.com/kopia/kopia/repo/content/index"
+	"github.com/kopia/kopia/repo/format"
 	"github.com/kopia/kopia/repo/maintenance"
 	"github.com/kopia/kopia/repo/manifest"
 	"github.com/kopia/kopia/repo/object"
 	"github.com/kopia/kopia/snapshot/snapshotmaintenance"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/utils/ptr"
 
 	"github.com/vmware-tanzu/velero/pkg/kopia"
 	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo/kopialib/backend"
 )
 
 type kopiaRepoService struct {
@@ -49,6 +52,9 @@ type kopiaRepository struct {
 	openTime    time.Time
 	throttle    logThrottle
 	logger      logrus.FieldLogger
+	compressor  compression.Name
+	// stopThrottling stops the updates of the throttling limits by the scheduled bandwidth limits
+	stopThrottling chan struct{}
 }
 
 type kopiaMaintenance struct {
@@ -117,13 +123,44 @@ func (ks *kopiaRepoService) Open(ctx context.Context, repoOption udmrepo.RepoOpt
 		return nil, errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
 	}
 
+	compressor, err := getCompressor(repoOption.GeneralOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledLimits, err := getScheduledBandwidthLimits(repoOption.GeneralOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	repoCtx := kopia.SetupKopiaLog(ctx, ks.logger)
 
+	if _, exist := repoOption.GeneralOptions[udmrepo.StoreOptionCacheLimit]; exist {
+		if err := repo.SetCachingOptions(repoCtx, repoConfig, ptr.To(backend.SetupCachingOptions(repoCtx, repoOption.GeneralOptions))); err != nil {
+			return nil, errors.Wrap(err, "error to set caching options")
+		}
+	}
+
 	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if limits := backend.SetupThrottlingLimits(repoCtx, repoOption.GeneralOptions); limits != nil {
+		// the limits apply to the throttler of this open repository, kopia also writes them
+		// into the repo config by the update handler it registers on the throttler when the
+		// repository is opened, so the later opens which don't set the limits start with them
+		if dr, ok := r.(repo.DirectRepository); ok {
+			if err := dr.Throttler().SetLimits(*limits); err != nil {
+				if e := r.Close(repoCtx); e != nil {
+					ks.logger.WithError(e).Error("Failed to close raw repository on error")
+				}
+
+				return nil, errors.Wrap(err, "error to set throttling limits")
+			}
+		}
+	}
+
 	kr := kopiaRepository{
 		rawRepo:     r,
 		openTime:    time.Now(),
@@ -131,7 +168,8 @@ func (ks *kopiaRepoService) Open(ctx context.Context, repoOption udmrepo.RepoOpt
 		throttle: logThrottle{
 			interval: defaultLogInterval,
 		},
-		logger: ks.logger,
+		logger:     ks.logger,
+		compressor: compressor,
 	}
 
 	_, kr.rawWriter, err = r.NewWriter(repoCtx, repo.WriteSessionOptions{
@@ -147,6 +185,13 @@ func (ks *kopiaRepoService) Open(ctx context.Context, repoOption udmrepo.RepoOpt
 		return nil, errors.Wrap(err, "error to create repo writer")
 	}
 
+	// the limits set above are those of the windows scheduled at open, the windows are
+	// reevaluated while the repository is open so the limits apply as the day goes
+	if dr, ok := r.(repo.DirectRepository); ok && len(scheduledLimits) > 0 {
+		kr.stopThrottling = make(chan struct{})
+		go updateThrottlingLimits(dr.Throttler(), scheduledLimits, kr.stopThrottling, ks.logger)
+	}
+
 	return &kr, nil
 }
 
@@ -293,6 +338,11 @@ func (kr *kopiaRepository) Time() time.Time {
 }
 
 func (kr *kopiaRepository) Close(ctx context.Context) error {
+	if kr.stopThrottling != nil {
+		close(kr.stopThrottling)
+		kr.stopThrottling = nil
+	}
+
 	if kr.rawWriter != nil {
 		err := kr.rawWriter.Close(kopia.SetupKopiaLog(ctx, kr.logger))
 		if err != nil {
@@ -323,7 +373,7 @@ func (kr *kopiaRepository) NewObjectWriter(ctx context.Context, opt udmrepo.Obje
 		Description: opt.Description,
 		Prefix:      index.IDPrefix(opt.Prefix),
 		AsyncWrites: opt.AsyncWrites,
-		Compressor:  getCompressorForObject(opt),
+		Compressor:  kr.getCompressorForObject(opt),
 	})
 
 	if writer == nil {
@@ -380,6 +430,21 @@ func (kr *kopiaRepository) GetAdvancedFeatures() udmrepo.AdvancedFeatureInfo {
 	}
 }
 
+func (kr *kopiaRepository) GetFormatOptions() map[string]string {
+	dr, ok := kr.rawRepo.(repo.DirectRepository)
+	if !ok {
+		return nil
+	}
+
+	contentFormat := dr.ContentReader().ContentFormat()
+
+	return map[string]string{
+		udmrepo.StoreOptionGenHashAlgo:    contentFormat.GetHashFunction(),
+		udmrepo.StoreOptionGenEncryptAlgo: contentFormat.GetEncryptionAlgorithm(),
+		udmrepo.StoreOptionGenSplitAlgo:   dr.ObjectFormat().Splitter,
+	}
+}
+
 func (kr *kopiaRepository) ConcatenateObjects(ctx context.Context, objectIDs []udmrepo.ID) (udmrepo.ID, error) {
 	if kr.rawWriter == nil {
 		return "", errors.New("repo writer is closed or not open")
@@ -514,9 +579,29 @@ func (kow *kopiaObjectWriter) Close() error {
 	return nil
 }
 
-// getCompressorForObject returns the compressor for an object, at present, we don't support compression
-func getCompressorForObject(opt udmrepo.ObjectWriteOptions) compression.Name {
-	return ""
+// getCompressorForObject returns the compressor for an object, only data objects are compressed
+// with the compressor configured for the repository
+func (kr *kopiaRepository) getCompressorForObject(opt udmrepo.ObjectWriteOptions) compression.Name {
+	if opt.DataType != udmrepo.ObjectDataTypeData {
+		return ""
+	}
+
+	return kr.compressor
+}
+
+// getCompressor returns the compressor configured by the general options, empty is returned
+// if compression is not configured or is disabled
+func getCompressor(flags map[string]string) (compression.Name, error) {
+	name := flags[udmrepo.StoreOptionGenCompressAlgo]
+	if name == "" || name == backend.DefaultCompressionAlgorithm {
+		return "", nil
+	}
+
+	if _, exist := compression.ByName[compression.Name(name)]; !exist {
+		return "", errors.Errorf("invalid compression algorithm %s", name)
+	}
+
+	return compression.Name(name), nil
 }
 
 func getManifestEntryFromKopia(mani *manifest.EntryMetadata) *udmrepo.ManifestEntryMetadata {
@@ -602,6 +687,20 @@ func writeInitParameters(ctx context.Context, repoOption udmrepo.RepoOptions, lo
 
 		p.Owner = r.ClientOptions().UsernameAtHost()
 
+		// keep the object locks of the blobs in use from expiring, so that
+		// the maintenance never deletes a blob which is still locked
+		if options := backend.SetupNewRepositoryOptions(ctx, repoOption.GeneralOptions); options.RetentionMode != "" {
+			p.ExtendObjectLocks = true
+
+			blobCfg := format.BlobStorageConfiguration{
+				RetentionMode:   options.RetentionMode,
+				RetentionPeriod: options.RetentionPeriod,
+			}
+			if err := maintenance.CheckExtendRetention(ctx, blobCfg, &p); err != nil {
+				return errors.Wrap(err, "error to check object lock extension")
+			}
+		}
+
 		if err := maintenance.SetParams(ctx, w, &p); err != nil {
 			return errors.Wrap(err, "error to set maintenance params")
 		}
@@ -24,6 +24,9 @@ import (
 	"time"
 
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/compression"
+	"github.com/kopia/kopia/repo/content"
+	"github.com/kopia/kopia/repo/format"
 	"github.com/kopia/kopia/repo/manifest"
 	"github.com/kopia/kopia/repo/object"
 	"github.com/pkg/errors"
@@ -788,6 +791,41 @@ func TestConcatenateObjects(t *testing.T) {
 	}
 }
 
+// fakeContentReader only implements the content format of content.Reader
+type fakeContentReader struct {
+	content.Reader
+	format format.Provider
+}
+
+func (r *fakeContentReader) ContentFormat() format.Provider {
+	return r.format
+}
+
+func TestGetFormatOptions(t *testing.T) {
+	kr := &kopiaRepository{}
+	assert.Nil(t, kr.GetFormatOptions())
+
+	provider, err := format.NewFormattingOptionsProvider(&format.ContentFormat{
+		Hash:              "BLAKE2B-256-128",
+		Encryption:        "AES256-GCM-HMAC-SHA256",
+		HMACSecret:        make([]byte, 32),
+		MasterKey:         make([]byte, 32),
+		MutableParameters: format.MutableParameters{Version: format.FormatVersion3},
+	}, nil)
+	require.NoError(t, err)
+
+	rawRepo := repomocks.NewDirectRepository(t)
+	rawRepo.On("ContentReader").Return(&fakeContentReader{format: provider})
+	rawRepo.On("ObjectFormat").Return(format.ObjectFormat{Splitter: "DYNAMIC-4M-BUZHASH"})
+
+	kr = &kopiaRepository{rawRepo: rawRepo}
+	assert.Equal(t, map[string]string{
+		udmrepo.StoreOptionGenHashAlgo:    "BLAKE2B-256-128",
+		udmrepo.StoreOptionGenEncryptAlgo: "AES256-GCM-HMAC-SHA256",
+		udmrepo.StoreOptionGenSplitAlgo:   "DYNAMIC-4M-BUZHASH",
+	}, kr.GetFormatOptions())
+}
+
 func TestNewObjectWriter(t *testing.T) {
 	rawObjWriter := repomocks.NewWriter(t)
 	testCases := []struct {
@@ -827,6 +865,58 @@ func TestNewObjectWriter(t *testing.T) {
 	}
 }
 
+func TestGetCompressorForObject(t *testing.T) {
+	kr := &kopiaRepository{compressor: "zstd"}
+
+	assert.Equal(t, compression.Name("zstd"), kr.getCompressorForObject(udmrepo.ObjectWriteOptions{DataType: udmrepo.ObjectDataTypeData}))
+	assert.Equal(t, compression.Name(""), kr.getCompressorForObject(udmrepo.ObjectWriteOptions{DataType: udmrepo.ObjectDataTypeMetadata}))
+}
+
+func TestGetCompressor(t *testing.T) {
+	testCases := []struct {
+		name        string
+		flags       map[string]string
+		expected    compression.Name
+		expectedErr string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name: "disabled",
+			flags: map[string]string{
+				udmrepo.StoreOptionGenCompressAlgo: "none",
+			},
+		},
+		{
+			name: "invalid",
+			flags: map[string]string{
+				udmrepo.StoreOptionGenCompressAlgo: "fake-compressor",
+			},
+			expectedErr: "invalid compression algorithm fake-compressor",
+		},
+		{
+			name: "valid",
+			flags: map[string]string{
+				udmrepo.StoreOptionGenCompressAlgo: "zstd",
+			},
+			expected: "zstd",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ret, err := getCompressor(tc.flags)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, ret)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestUpdateProgress(t *testing.T) {
 	testCases := []struct {
 		name       string
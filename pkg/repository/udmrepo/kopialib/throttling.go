@@ -0,0 +1,102 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kopia/kopia/repo/blob/throttling"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/util/bandwidth"
+)
+
+// throttlingUpdateInterval is how often the scheduled bandwidth limits of an open
+// repository are reevaluated
+var throttlingUpdateInterval = time.Minute
+
+// getScheduledBandwidthLimits returns the bandwidth limits with scheduled windows the
+// throttling options are evaluated from, or nil if there are none.
+func getScheduledBandwidthLimits(flags map[string]string) ([]*velerov1api.BandwidthLimits, error) {
+	value := flags[udmrepo.ThrottleOptionSchedule]
+	if value == "" {
+		return nil, nil
+	}
+
+	var scheduled []*velerov1api.BandwidthLimits
+	if err := json.Unmarshal([]byte(value), &scheduled); err != nil {
+		return nil, errors.Wrapf(err, "invalid throttle schedule %s", value)
+	}
+
+	return scheduled, nil
+}
+
+// throttlingLimitsAt returns the throttling limits applying at now, i.e. the most restrictive
+// of the scheduled bandwidth limits, the limits which aren't scheduled are kept from current.
+func throttlingLimitsAt(scheduled []*velerov1api.BandwidthLimits, current throttling.Limits, now time.Time) (throttling.Limits, error) {
+	var limit velerov1api.BandwidthLimit
+	for _, limits := range scheduled {
+		l, err := bandwidth.Current(limits, now)
+		if err != nil {
+			return current, err
+		}
+		limit = bandwidth.Min(limit, l)
+	}
+
+	current.ReadsPerSecond = float64(limit.OperationsPerSecond)
+	current.WritesPerSecond = float64(limit.OperationsPerSecond)
+	current.ListsPerSecond = float64(limit.OperationsPerSecond)
+	current.UploadBytesPerSecond = float64(limit.UploadBytesPerSecond)
+	current.DownloadBytesPerSecond = float64(limit.DownloadBytesPerSecond)
+
+	return current, nil
+}
+
+// updateThrottlingLimits reevaluates the scheduled bandwidth limits every throttlingUpdateInterval
+// and updates the limits of the throttler when they change, until stop is closed.
+func updateThrottlingLimits(throttler throttling.SettableThrottler, scheduled []*velerov1api.BandwidthLimits, stop <-chan struct{}, logger logrus.FieldLogger) {
+	ticker := time.NewTicker(throttlingUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			current := throttler.Limits()
+			limits, err := throttlingLimitsAt(scheduled, current, now)
+			if err != nil {
+				// the limits were valid when the repository was opened, so this can't happen
+				logger.WithError(err).Warn("Error evaluating the bandwidth limits, keeping the current ones")
+				continue
+			}
+			if limits == current {
+				continue
+			}
+
+			if err := throttler.SetLimits(limits); err != nil {
+				logger.WithError(err).Warn("Error updating the throttling limits of the repository")
+				continue
+			}
+			logger.Infof("Updated the throttling limits of the repository to %+v", limits)
+		}
+	}
+}
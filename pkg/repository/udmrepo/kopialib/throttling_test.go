@@ -0,0 +1,155 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kopia/kopia/repo/blob/throttling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetScheduledBandwidthLimits(t *testing.T) {
+	scheduled, err := getScheduledBandwidthLimits(map[string]string{})
+	require.NoError(t, err)
+	assert.Nil(t, scheduled)
+
+	scheduled, err = getScheduledBandwidthLimits(map[string]string{
+		udmrepo.ThrottleOptionSchedule: `[{"uploadBytesPerSecond":1000,"schedule":[{"start":"09:00","end":"17:00","uploadBytesPerSecond":100}]}]`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*velerov1api.BandwidthLimits{
+		{
+			BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 1000},
+			Schedule: []velerov1api.ScheduledBandwidthLimit{
+				{Start: "09:00", End: "17:00", BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 100}},
+			},
+		},
+	}, scheduled)
+
+	_, err = getScheduledBandwidthLimits(map[string]string{udmrepo.ThrottleOptionSchedule: "invalid"})
+	assert.ErrorContains(t, err, "invalid throttle schedule")
+}
+
+func TestThrottlingLimitsAt(t *testing.T) {
+	scheduled := []*velerov1api.BandwidthLimits{
+		{
+			BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 1000, OperationsPerSecond: 50},
+			Schedule: []velerov1api.ScheduledBandwidthLimit{
+				{Start: "09:00", End: "17:00", BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 100}},
+			},
+		},
+		{
+			BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 500, DownloadBytesPerSecond: 2000},
+		},
+	}
+	current := throttling.Limits{ConcurrentReads: 10, ConcurrentWrites: 20}
+
+	tests := []struct {
+		name      string
+		scheduled []*velerov1api.BandwidthLimits
+		now       time.Time
+		expected  throttling.Limits
+		wantErr   string
+	}{
+		{
+			name:      "within the window",
+			scheduled: scheduled,
+			now:       time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			expected: throttling.Limits{
+				UploadBytesPerSecond:   100,
+				DownloadBytesPerSecond: 2000,
+				ConcurrentReads:        10,
+				ConcurrentWrites:       20,
+			},
+		},
+		{
+			name:      "out of the window",
+			scheduled: scheduled,
+			now:       time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC),
+			expected: throttling.Limits{
+				ReadsPerSecond:         50,
+				WritesPerSecond:        50,
+				ListsPerSecond:         50,
+				UploadBytesPerSecond:   500,
+				DownloadBytesPerSecond: 2000,
+				ConcurrentReads:        10,
+				ConcurrentWrites:       20,
+			},
+		},
+		{
+			name: "invalid schedule",
+			scheduled: []*velerov1api.BandwidthLimits{
+				{Schedule: []velerov1api.ScheduledBandwidthLimit{{Start: "9", End: "17:00"}}},
+			},
+			now:      time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			expected: current,
+			wantErr:  "9",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limits, err := throttlingLimitsAt(test.scheduled, current, test.now)
+			if test.wantErr != "" {
+				assert.ErrorContains(t, err, test.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, limits)
+		})
+	}
+}
+
+func TestUpdateThrottlingLimits(t *testing.T) {
+	interval := throttlingUpdateInterval
+	throttlingUpdateInterval = 10 * time.Millisecond
+	defer func() {
+		throttlingUpdateInterval = interval
+	}()
+
+	throttler, err := throttling.NewThrottler(throttling.Limits{}, time.Second, 0)
+	require.NoError(t, err)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		updateThrottlingLimits(throttler, []*velerov1api.BandwidthLimits{
+			{BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 100, OperationsPerSecond: 5}},
+		}, stop, velerotest.NewLogger())
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return throttler.Limits().UploadBytesPerSecond == 100
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, throttling.Limits{
+		ReadsPerSecond:       5,
+		WritesPerSecond:      5,
+		ListsPerSecond:       5,
+		UploadBytesPerSecond: 100,
+	}, throttler.Limits())
+
+	close(stop)
+	<-done
+}
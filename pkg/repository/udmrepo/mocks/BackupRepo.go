@@ -146,6 +146,26 @@ func (_m *BackupRepo) GetAdvancedFeatures() udmrepo.AdvancedFeatureInfo {
 	return r0
 }
 
+// GetFormatOptions provides a mock function with given fields:
+func (_m *BackupRepo) GetFormatOptions() map[string]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFormatOptions")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
 // GetManifest provides a mock function with given fields: ctx, id, mani
 func (_m *BackupRepo) GetManifest(ctx context.Context, id udmrepo.ID, mani *udmrepo.RepoManifest) error {
 	ret := _m.Called(ctx, id, mani)
@@ -123,6 +123,10 @@ type BackupRepo interface {
 	// GetAdvancedFeatures returns the support for advanced features
 	GetAdvancedFeatures() AdvancedFeatureInfo
 
+	// GetFormatOptions returns the options fixed when the backup repository was created,
+	// i.e. the hash, encryption and splitter algorithms, keyed by their store options
+	GetFormatOptions() map[string]string
+
 	// ConcatenateObjects is for multiple-part backup, it concatenates multiple objects into one object
 	ConcatenateObjects(ctx context.Context, objectIDs []ID) (ID, error)
 
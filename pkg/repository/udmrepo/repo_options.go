@@ -55,9 +55,12 @@ const (
 	StoreOptionPrefix         = "prefix"
 	StoreOptionPrefixName     = "unified-repo"
 
-	StoreOptionGenHashAlgo    = "hashAlgo"
-	StoreOptionGenEncryptAlgo = "encryptAlgo"
-	StoreOptionGenSplitAlgo   = "splitAlgo"
+	StoreOptionGenHashAlgo     = "hashAlgo"
+	StoreOptionGenEncryptAlgo  = "encryptAlgo"
+	StoreOptionGenSplitAlgo    = "splitAlgo"
+	StoreOptionGenCompressAlgo = "compressionAlgo"
+
+	StoreOptionCacheLimit = "cacheLimitMB"
 
 	StoreOptionGenRetentionMode   = "retentionMode"
 	StoreOptionGenRetentionPeriod = "retentionPeriod"
@@ -68,6 +71,9 @@ const (
 	ThrottleOptionListOps       = "listOPS"
 	ThrottleOptionUploadBytes   = "uploadBytes"
 	ThrottleOptionDownloadBytes = "downloadBytes"
+	// ThrottleOptionSchedule is the JSON encoded list of the bandwidth limits with scheduled windows
+	// the other throttle options are evaluated from, they're reevaluated while the repository is open
+	ThrottleOptionSchedule = "throttleSchedule"
 )
 
 const (
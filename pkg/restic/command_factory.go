@@ -107,6 +107,14 @@ func ForgetCommand(repoIdentifier, snapshotID string) *Command {
 	}
 }
 
+func CatSnapshotCommand(repoIdentifier, snapshotID string) *Command {
+	return &Command{
+		Command:        "cat",
+		RepoIdentifier: repoIdentifier,
+		Args:           []string{"snapshot", snapshotID},
+	}
+}
+
 func UnlockCommand(repoIdentifier string) *Command {
 	return &Command{
 		Command:        "unlock",
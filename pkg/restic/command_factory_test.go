@@ -120,6 +120,14 @@ func TestForgetCommand(t *testing.T) {
 	assert.Equal(t, []string{"snapshot-id"}, c.Args)
 }
 
+func TestCatSnapshotCommand(t *testing.T) {
+	c := CatSnapshotCommand("repo-id", "snapshot-id")
+
+	assert.Equal(t, "cat", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"snapshot", "snapshot-id"}, c.Args)
+}
+
 func TestStatsCommand(t *testing.T) {
 	c := StatsCommand("repo-id", "password-file", "snapshot-id")
 
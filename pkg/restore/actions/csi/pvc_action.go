@@ -207,6 +207,13 @@ func (p *pvcRestoreItemAction) Execute(
 				}, nil
 			}
 
+			if boolptr.IsSetToTrue(input.Restore.Spec.DryRun) {
+				logger.Info("Skip creating DataDownload in dry-run restore.")
+				return &velero.RestoreItemActionExecuteOutput{
+					UpdatedItem: input.Item,
+				}, nil
+			}
+
 			operationID = label.GetValidName(
 				string(velerov1api.AsyncOperationIDPrefixDataDownload) +
 					string(input.Restore.UID) + "." + string(pvcFromBackup.UID))
@@ -229,7 +236,11 @@ func (p *pvcRestoreItemAction) Execute(
 					UpdatedItem: input.Item,
 				}, nil
 			}
-			if err := restoreFromVolumeSnapshot(
+			if boolptr.IsSetToTrue(input.Restore.Spec.DryRun) {
+				// The VolumeSnapshot isn't restored in dry-run restore,
+				// so only reset the PVC to use it as the data source.
+				resetPVCSpec(&pvc, volumeSnapshotName)
+			} else if err := restoreFromVolumeSnapshot(
 				&pvc, newNamespace, p.crClient, volumeSnapshotName, logger,
 			); err != nil {
 				logger.Errorf("Failed to restore PVC from VolumeSnapshot.")
@@ -149,6 +149,11 @@ func (p *volumeSnapshotRestoreItemAction) Execute(
 		// and VolumeSnapshot objects have to be setup. Further, it is disallowed
 		// to convert a dynamically created VolumeSnapshotContent for static binding.
 		// See: https://github.com/kubernetes-csi/external-snapshotter/issues/274
+		if boolptr.IsSetToTrue(input.Restore.Spec.DryRun) {
+			p.log.Infof("Skip creating VolumeSnapshotContent for VolumeSnapshot %s/%s in dry-run restore",
+				newNamespace, vs.Name)
+			return &velero.RestoreItemActionExecuteOutput{UpdatedItem: input.Item}, nil
+		}
 		if err := p.crClient.Create(context.TODO(), &vsc); err != nil {
 			return nil, errors.Wrapf(err,
 				"failed to create VolumeSnapshotContents %s",
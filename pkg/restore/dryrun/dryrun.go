@@ -0,0 +1,73 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun defines the report of a dry-run restore, which describes
+// what a restore would do to each item without changing the cluster.
+package dryrun
+
+const (
+	// ActionCreate means the item doesn't exist in the cluster and would be created.
+	ActionCreate = "create"
+
+	// ActionUpdate means the item exists in the cluster and would be updated.
+	ActionUpdate = "update"
+
+	// ActionRecreate means the item exists in the cluster and would be deleted
+	// and created again.
+	ActionRecreate = "recreate"
+
+	// ActionSkip means the item wouldn't be created or updated, e.g. because it
+	// already exists in the cluster.
+	ActionSkip = "skip"
+
+	// ActionExclude means the item wouldn't be restored because of the restore's
+	// filters or a RestoreItemAction.
+	ActionExclude = "exclude"
+
+	// ActionFail means an error happened when processing the item.
+	ActionFail = "fail"
+)
+
+// ItemResult is the outcome of an item in a dry-run restore.
+type ItemResult struct {
+	// Resource is the API version and kind of the item, e.g. "apps/v1/Deployment".
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the item would be restored into.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Action is what the restore would do to the item.
+	Action string `json:"action"`
+
+	// Reason explains why the item would be skipped or excluded.
+	Reason string `json:"reason,omitempty"`
+
+	// Diff is the JSON merge patch which would be applied to the in-cluster
+	// item when it would be updated.
+	Diff string `json:"diff,omitempty"`
+}
+
+// Summarize returns the number of the items by action.
+func Summarize(items []ItemResult) map[string]int {
+	summary := map[string]int{}
+	for _, item := range items {
+		summary[item.Action]++
+	}
+	return summary
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/restore/dryrun"
 )
 
 const (
@@ -36,6 +37,15 @@ const (
 	ItemRestoreResultUpdated = "updated"
 	ItemRestoreResultFailed  = "failed"
 	ItemRestoreResultSkipped = "skipped"
+
+	// ItemRestoreResultExcluded is only recorded by dry-run restores, for the items
+	// which are excluded by the restore's filters or by a RestoreItemAction.
+	ItemRestoreResultExcluded = "excluded"
+
+	// ItemRestoreResultRecreated is only recorded by dry-run restores, for the items
+	// which would be deleted and re-created by the recreate existing resource policy.
+	// The re-created items of other restores are recorded as created.
+	ItemRestoreResultRecreated = "recreated"
 )
 
 type itemKey struct {
@@ -69,6 +79,9 @@ type Request struct {
 type restoredItemStatus struct {
 	action     string
 	itemExists bool
+	// reason and diff are only recorded by dry-run restores
+	reason string
+	diff   string
 }
 
 // GetItemOperationsList returns ItemOperationsList, initializing it if necessary
@@ -100,3 +113,40 @@ func (r *Request) RestoredResourceList() map[string][]string {
 
 	return resources
 }
+
+// DryRunResults returns the outcome of every item processed by a dry-run
+// restore, sorted by resource, namespace and name
+func (r *Request) DryRunResults() []dryrun.ItemResult {
+	actions := map[string]string{
+		ItemRestoreResultCreated:   dryrun.ActionCreate,
+		ItemRestoreResultUpdated:   dryrun.ActionUpdate,
+		ItemRestoreResultSkipped:   dryrun.ActionSkip,
+		ItemRestoreResultExcluded:  dryrun.ActionExclude,
+		ItemRestoreResultRecreated: dryrun.ActionRecreate,
+		ItemRestoreResultFailed:    dryrun.ActionFail,
+	}
+
+	items := []dryrun.ItemResult{}
+	for i, item := range r.RestoredItems {
+		items = append(items, dryrun.ItemResult{
+			Resource:  i.resource,
+			Namespace: i.namespace,
+			Name:      i.name,
+			Action:    actions[item.action],
+			Reason:    item.reason,
+			Diff:      item.diff,
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Resource != items[j].Resource {
+			return items[i].Resource < items[j].Resource
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	return items
+}
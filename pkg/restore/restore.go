@@ -79,6 +79,17 @@ var resourceMustHave = []string{
 	"datauploads.velero.io",
 }
 
+// nonRecreatableResources are never deleted and re-created by the recreate existing
+// resource policy, they're handled like with the update policy instead. ServiceAccounts
+// are merged, deleting a PVC or a PV may delete the volume data depending on the reclaim
+// policy, and deleting a CRD deletes all the custom resources of the CRD in the cluster.
+var nonRecreatableResources = sets.New(
+	kuberesource.ServiceAccounts,
+	kuberesource.PersistentVolumeClaims,
+	kuberesource.PersistentVolumes,
+	kuberesource.CustomResourceDefinitions,
+)
+
 type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (vsv1.VolumeSnapshotter, error)
 }
@@ -221,6 +232,8 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		)
 	}
 
+	existingResourcePolicies := resolveExistingResourcePolicies(kr.discoveryHelper, req.Restore.Spec.ExistingResourcePolicyOverrides)
+
 	// Get namespace includes-excludes.
 	namespaceIncludesExcludes := collections.NewIncludesExcludes().
 		Includes(req.Restore.Spec.IncludedNamespaces...).
@@ -280,6 +293,14 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 
 	req.RestoredItems = make(map[itemKey]restoredItemStatus)
 
+	includedItems := sets.New[string]()
+	for _, item := range req.Restore.Spec.IncludedItems {
+		// the items are validated by the restore controller
+		if ref, err := archive.ParseItemReference(item); err == nil {
+			includedItems.Insert(ref.String())
+		}
+	}
+
 	restoreCtx := &restoreContext{
 		backup:                         req.Backup,
 		backupReader:                   req.BackupReader,
@@ -288,6 +309,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		resourceStatusIncludesExcludes: restoreStatusIncludesExcludes,
 		namespaceIncludesExcludes:      namespaceIncludesExcludes,
 		resourceMustHave:               sets.New[string](resourceMustHave...),
+		includedItems:                  includedItems,
 		chosenGrpVersToRestore:         make(map[string]ChosenGroupVersion),
 		selector:                       selector,
 		OrSelectors:                    OrSelectors,
@@ -320,6 +342,8 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		backupVolumeInfoMap:            req.BackupVolumeInfoMap,
 		restoreVolumeInfoTracker:       req.RestoreVolumeInfoTracker,
 		hooksWaitExecutor:              hooksWaitExecutor,
+		dryRun:                         boolptr.IsSetToTrue(req.Restore.Spec.DryRun),
+		existingResourcePolicies:       existingResourcePolicies,
 	}
 
 	return restoreCtx.execute()
@@ -334,6 +358,7 @@ type restoreContext struct {
 	resourceStatusIncludesExcludes *collections.IncludesExcludes
 	namespaceIncludesExcludes      *collections.IncludesExcludes
 	resourceMustHave               sets.Set[string]
+	includedItems                  sets.Set[string]
 	chosenGrpVersToRestore         map[string]ChosenGroupVersion
 	selector                       labels.Selector
 	OrSelectors                    []labels.Selector
@@ -368,6 +393,8 @@ type restoreContext struct {
 	backupVolumeInfoMap            map[string]volume.BackupVolumeInfo
 	restoreVolumeInfoTracker       *volume.RestoreVolumeInfoTracker
 	hooksWaitExecutor              *hooksWaitExecutor
+	dryRun                         bool
+	existingResourcePolicies       map[schema.GroupResource]velerov1api.PolicyType
 }
 
 type resourceClientKey struct {
@@ -418,6 +445,10 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	warnings, errs := results.Result{}, results.Result{}
 
 	ctx.log.Infof("Starting restore of backup %s", kube.NamespaceAndName(ctx.backup))
+	if modifiers := ctx.backup.Status.ResourceModifiers; modifiers != nil && len(modifiers.AppliedRules) > 0 {
+		ctx.log.Warnf("Items of backup %s were modified by the resource modifiers in configmap %s at backup time, they may differ from the original resources",
+			kube.NamespaceAndName(ctx.backup), modifiers.ConfigMap)
+	}
 
 	dir, err := archive.NewExtractor(ctx.log, ctx.fileSystem).UnzipAndExtractBackup(ctx.backupReader)
 	if err != nil {
@@ -711,11 +742,7 @@ func (ctx *restoreContext) processSelectedResource(
 					archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace),
 					targetNS,
 				)
-				_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(
-					ns,
-					ctx.namespaceClient,
-					ctx.resourceTerminatingTimeout,
-				)
+				nsCreated, err := ctx.ensureNamespaceExists(ns)
 				if err != nil {
 					errs.AddVeleroError(err)
 					continue
@@ -729,6 +756,16 @@ func (ctx *restoreContext) processSelectedResource(
 						name:      ns.Name,
 					}
 					ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
+				} else if ctx.dryRun && groupResource == kuberesource.Namespaces {
+					itemKey := itemKey{
+						resource: resourceKey(ns),
+						name:     ns.Name,
+					}
+					ctx.restoredItems[itemKey] = restoredItemStatus{
+						action:     ItemRestoreResultSkipped,
+						itemExists: true,
+						reason:     "it already exists in the cluster",
+					}
 				}
 
 				// Keep track of namespaces that we know exist so we don't
@@ -1097,6 +1134,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// that's excluded.
 	if !ctx.resourceIncludesExcludes.ShouldInclude(groupResource.String()) && !ctx.resourceMustHave.Has(groupResource.String()) {
 		restoreLogger.Info("Not restoring item because resource is excluded")
+		ctx.excludeItemForDryRun(obj, namespace, "the resource is excluded")
 		return warnings, errs, itemExists
 	}
 
@@ -1109,6 +1147,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	if namespace != "" {
 		if !ctx.namespaceIncludesExcludes.ShouldInclude(obj.GetNamespace()) && !ctx.resourceMustHave.Has(groupResource.String()) {
 			restoreLogger.Info("Not restoring item because namespace is excluded")
+			ctx.excludeItemForDryRun(obj, namespace, "the namespace is excluded")
 			return warnings, errs, itemExists
 		}
 
@@ -1116,7 +1155,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// namespace into which the resource is being restored into exists.
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(ctx.log, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
-		_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+		nsCreated, err := ctx.ensureNamespaceExists(nsToEnsure)
 		if err != nil {
 			errs.AddVeleroError(err)
 			return warnings, errs, itemExists
@@ -1133,6 +1172,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	} else {
 		if boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
 			restoreLogger.Info("Not restoring item because it's cluster-scoped")
+			ctx.excludeItemForDryRun(obj, namespace, "the cluster-scoped resources are excluded")
 			return warnings, errs, itemExists
 		}
 	}
@@ -1148,6 +1188,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 	if complete {
 		ctx.log.Infof("%s is complete - skipping", kube.NamespaceAndName(obj))
+		ctx.excludeItemForDryRun(obj, namespace, "it is complete")
 		return warnings, errs, itemExists
 	}
 
@@ -1186,6 +1227,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// to the interface.
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
 		ctx.log.Infof("Not restoring pod because it's a mirror pod")
+		ctx.setDryRunResult(itemKey, ItemRestoreResultExcluded, "it is a mirror pod", "")
 		return warnings, errs, itemExists
 	}
 
@@ -1212,6 +1254,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			case volume.PodVolumeBackup:
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a pod volume backup to be restored.")
 				ctx.pvsToProvision.Insert(name)
+				ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it would be dynamically re-provisioned", "")
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1220,6 +1263,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			case volume.CSISnapshot:
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a CSI VolumeSnapshot or a related snapshot DataUpload.")
 				ctx.pvsToProvision.Insert(name)
+				ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it would be dynamically re-provisioned", "")
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1231,6 +1275,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if hasDeleteReclaimPolicy(obj.Object) {
 					restoreLogger.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
 					ctx.pvsToProvision.Insert(name)
+					ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it would be dynamically re-provisioned", "")
 
 					// Return early because we don't want to restore the PV itself, we
 					// want to dynamically re-provision it.
@@ -1261,6 +1306,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			case hasPodVolumeBackup(obj, ctx):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a pod volume backup to be restored.")
 				ctx.pvsToProvision.Insert(name)
+				ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it would be dynamically re-provisioned", "")
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1271,6 +1317,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			case hasSnapshotDataUpload(ctx, obj):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a CSI VolumeSnapshot or a related snapshot DataUpload.")
 				ctx.pvsToProvision.Insert(name)
+				ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it would be dynamically re-provisioned", "")
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1279,6 +1326,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			case hasDeleteReclaimPolicy(obj.Object):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
 				ctx.pvsToProvision.Insert(name)
+				ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it would be dynamically re-provisioned", "")
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1352,6 +1400,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
+			ctx.setDryRunResult(itemKey, ItemRestoreResultExcluded, fmt.Sprintf("RestoreItemAction %s discarded it", action.Name()), "")
 			return warnings, errs, itemExists
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
@@ -1399,6 +1448,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			errs.Merge(&e)
 		}
 		executeOutput.AdditionalItems = filteredAdditionalItems
+		// the additional items are never created by a dry-run restore
+		if ctx.dryRun {
+			continue
+		}
 		available, err := ctx.itemsAvailable(action, executeOutput)
 		if err != nil {
 			errs.Add(namespace, errors.Wrapf(err, "error verifying additional items are ready to use"))
@@ -1444,7 +1497,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 
 	if ctx.resourceModifiers != nil {
-		if errList := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.kbClient.Scheme(), ctx.log); errList != nil {
+		modCtx := &resourcemodifiers.Context{
+			BackupName:      ctx.backup.Name,
+			RestoreName:     ctx.restore.Name,
+			TargetNamespace: namespace,
+		}
+		if errList := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.kbClient.Scheme(), modCtx, ctx.log); errList != nil {
 			for _, err := range errList {
 				errs.Add(namespace, err)
 			}
@@ -1497,6 +1555,20 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		ctx.log.Debugf("Checking for existence %s: %v", obj.GroupVersionKind().Kind, name)
 		fromCluster, err = ctx.getResource(groupResource, obj, namespace, name)
 	}
+	if ctx.dryRun && (err != nil || fromCluster == nil) {
+		// the informer cache may be disabled, so check the existence against the API server
+		fromCluster, err = resourceClient.Get(name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			ctx.log.Infof("Dry run: %s %s would be created", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+			itemExists = true
+			ctx.setDryRunResult(itemKey, ItemRestoreResultCreated, "", "")
+			return warnings, errs, itemExists
+		}
+		if err != nil {
+			errs.Add(namespace, fmt.Errorf("error getting %s from the cluster: %v", resourceID, err))
+			return warnings, errs, itemExists
+		}
+	}
 	if err != nil || fromCluster == nil {
 		// couldn't find the resource, attempt to create
 		ctx.log.Debugf("Creating %s: %v", obj.GroupVersionKind().Kind, name)
@@ -1529,6 +1601,23 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	if fromCluster != nil && !nonRecreatableResources.Has(groupResource) &&
+		ctx.getExistingResourcePolicy(groupResource) == velerov1api.PolicyTypeRecreate {
+		recreated, done, warningsFromRecreate, errsFromRecreate := ctx.processRecreateResourcePolicy(itemKey, fromCluster, obj, namespace, resourceClient)
+		warnings.Merge(&warningsFromRecreate)
+		errs.Merge(&errsFromRecreate)
+		if done {
+			itemExists = true
+			return warnings, errs, itemExists
+		}
+		if recreated != nil {
+			// continue with the workflow of the newly created object
+			itemExists = true
+			ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: itemExists}
+			fromCluster, createdObj, restoreErr = nil, recreated, nil
+		}
+	}
+
 	if fromCluster != nil {
 		itemExists = true
 		itemStatus := ctx.restoredItems[itemKey]
@@ -1568,6 +1657,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if patchBytes == nil {
 					// In-cluster and desired state are the same, so move on to
 					// the next item.
+					ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it already exists in the cluster and is the same as the backed-up version", "")
+					return warnings, errs, itemExists
+				}
+
+				if ctx.dryRun {
+					ctx.setDryRunResult(itemKey, ItemRestoreResultUpdated, "", string(patchBytes))
 					return warnings, errs, itemExists
 				}
 
@@ -1575,7 +1670,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if err != nil {
 					warnings.Add(namespace, err)
 					// check if there is existingResourcePolicy and if it is set to update policy
-					if len(ctx.restore.Spec.ExistingResourcePolicy) > 0 && ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
+					if ctx.getExistingResourcePolicy(groupResource) == velerov1api.PolicyTypeUpdate {
 						// remove restore labels so that we apply the latest backup/restore names on the object via patch
 						removeRestoreLabels(fromCluster)
 						//try patching just the backup/restore labels
@@ -1590,8 +1685,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				}
 			default:
 				// check for the presence of existingResourcePolicy
-				if len(ctx.restore.Spec.ExistingResourcePolicy) > 0 {
-					resourcePolicy := ctx.restore.Spec.ExistingResourcePolicy
+				if resourcePolicy := ctx.getExistingResourcePolicy(groupResource); len(resourcePolicy) > 0 {
+					if resourcePolicy == velerov1api.PolicyTypeRecreate {
+						// the changed resources which aren't re-created, see nonRecreatableResources, are updated
+						resourcePolicy = velerov1api.PolicyTypeUpdate
+					}
 					ctx.log.Infof("restore API has resource policy defined %s , executing restore workflow accordingly for changed resource %s %s", resourcePolicy, fromCluster.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
 
 					// existingResourcePolicy is set as none, add warning
@@ -1599,7 +1697,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 						e := errors.Errorf("could not restore, %s %q already exists. Warning: the in-cluster version is different than the backed-up version",
 							obj.GetKind(), obj.GetName())
 						warnings.Add(namespace, e)
+						ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it already exists in the cluster and is different from the backed-up version", "")
 						// existingResourcePolicy is set as update, attempt patch on the resource and add warning if it fails
+					} else if resourcePolicy == velerov1api.PolicyTypeUpdate && ctx.dryRun {
+						errsFromDryRun := ctx.recordDryRunUpdate(itemKey, fromCluster, obj, namespace)
+						errs.Merge(&errsFromDryRun)
 					} else if resourcePolicy == velerov1api.PolicyTypeUpdate {
 						// processing update as existingResourcePolicy
 						warningsFromUpdateRP, errsFromUpdateRP := ctx.processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj, namespace, resourceClient)
@@ -1615,14 +1717,19 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					e := errors.Errorf("could not restore, %s %q already exists. Warning: the in-cluster version is different than the backed-up version",
 						obj.GetKind(), obj.GetName())
 					warnings.Add(namespace, e)
+					ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it already exists in the cluster and is different from the backed-up version", "")
 				}
 			}
 			return warnings, errs, itemExists
 		}
 
-		//update backup/restore labels on the unchanged resources if existingResourcePolicy is set as update
-		if ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
-			resourcePolicy := ctx.restore.Spec.ExistingResourcePolicy
+		if ctx.dryRun {
+			ctx.setDryRunResult(itemKey, ItemRestoreResultSkipped, "it already exists in the cluster and is the same as the backed-up version", "")
+			return warnings, errs, itemExists
+		}
+
+		//update backup/restore labels on the unchanged resources if existingResourcePolicy is set as update or recreate
+		if resourcePolicy := ctx.getExistingResourcePolicy(groupResource); resourcePolicy == velerov1api.PolicyTypeUpdate || resourcePolicy == velerov1api.PolicyTypeRecreate {
 			ctx.log.Infof("restore API has resource policy defined %s , executing restore workflow accordingly for unchanged resource %s %s ", resourcePolicy, obj.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
 			// remove restore labels so that we apply the latest backup/restore names on the object via patch
 			removeRestoreLabels(fromCluster)
@@ -2287,6 +2394,11 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource string, original
 	}
 
 	for _, item := range items {
+		if ctx.includedItems.Len() > 0 && !ctx.resourceMustHave.Has(resource) &&
+			!ctx.includedItems.Has(archive.ItemReference{GroupResource: resource, Namespace: originalNamespace, Name: item}.String()) {
+			continue
+		}
+
 		itemPath := archive.GetItemFilePath(ctx.restoreDir, resourceForPath, originalNamespace, item)
 
 		obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
@@ -2420,6 +2532,175 @@ func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterW
 	return warnings, errs
 }
 
+// recordDryRunUpdate records the patch which the update existing resource policy
+// would apply to the in-cluster item in a dry-run restore.
+func (ctx *restoreContext) recordDryRunUpdate(key itemKey, fromCluster, obj *unstructured.Unstructured, namespace string) (errs results.Result) {
+	// the restore labels are applied by the patch as well
+	removeRestoreLabels(fromCluster)
+	patchBytes, err := generatePatch(fromCluster, obj)
+	if err != nil {
+		errs.Add(namespace, errors.Wrapf(err, "error generating patch for %s %s", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj)))
+		return errs
+	}
+
+	if patchBytes == nil {
+		ctx.setDryRunResult(key, ItemRestoreResultSkipped, "it already exists in the cluster and is the same as the backed-up version", "")
+		return errs
+	}
+
+	ctx.log.Infof("Dry run: %s %s would be updated", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+	ctx.setDryRunResult(key, ItemRestoreResultUpdated, "", string(patchBytes))
+	return errs
+}
+
+// processRecreateResourcePolicy deletes the in-cluster version of the item and
+// creates it again from the backup if the two versions are different. The
+// returned object is the re-created one, done is true if there's nothing
+// more to do with the item.
+func (ctx *restoreContext) processRecreateResourcePolicy(key itemKey, fromCluster, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (recreated *unstructured.Unstructured, done bool, warnings, errs results.Result) {
+	inCluster, err := resetMetadataAndStatus(fromCluster.DeepCopy())
+	if err != nil {
+		// let the workflow for the existing items handle it
+		return nil, false, warnings, errs
+	}
+	labels := obj.GetLabels()
+	addRestoreLabels(inCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+	if equality.Semantic.DeepEqual(inCluster, obj) {
+		// unchanged items only get the restore labels updated
+		return nil, false, warnings, errs
+	}
+
+	if ctx.dryRun {
+		ctx.log.Infof("Dry run: %s %s would be deleted and re-created", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+		ctx.setDryRunResult(key, ItemRestoreResultRecreated, "it already exists in the cluster and is different from the backed-up version", "")
+		return nil, true, warnings, errs
+	}
+
+	ctx.log.Infof("restore API has existingResourcePolicy defined as recreate, deleting changed resource %s %s", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+	propagation := metav1.DeletePropagationBackground
+	if err := resourceClient.Delete(obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+		warnings.Add(namespace, errors.Wrapf(err, "error deleting %s %s to re-create it", obj.GetKind(), kube.NamespaceAndName(obj)))
+		return nil, true, warnings, errs
+	}
+
+	if err := waitForDeletion(obj.GetName(), resourceClient, ctx.restore.Spec.RecreateWaitTimeout.Duration); err != nil {
+		warnings.Add(namespace, errors.Wrapf(err, "%s %s was deleted but not removed from the cluster, it isn't re-created", obj.GetKind(), kube.NamespaceAndName(obj)))
+		return nil, true, warnings, errs
+	}
+
+	recreated, err = resourceClient.Create(obj)
+	if err != nil {
+		errs.Add(namespace, errors.Wrapf(err, "error re-creating %s %s", obj.GetKind(), kube.NamespaceAndName(obj)))
+		return nil, true, warnings, errs
+	}
+
+	ctx.log.Infof("%s %s successfully re-created", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+	return recreated, false, warnings, errs
+}
+
+// waitForDeletion waits for the deleted object to be removed from the cluster
+// until the timeout reaches. With zero timeout, it only checks once.
+func waitForDeletion(name string, resourceClient client.Dynamic, timeout time.Duration) error {
+	removed := func(go_context.Context) (bool, error) {
+		_, err := resourceClient.Get(name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if timeout <= 0 {
+		done, err := removed(go_context.Background())
+		if err != nil {
+			return err
+		}
+		if !done {
+			return errors.New("it's still terminating")
+		}
+		return nil
+	}
+
+	err := wait.PollUntilContextTimeout(go_context.Background(), time.Second, timeout, true, removed)
+	if wait.Interrupted(err) {
+		return errors.Errorf("it's still terminating after %s", timeout)
+	}
+	return err
+}
+
+// getExistingResourcePolicy returns the existing resource policy which applies
+// to the resource, the overrides take precedence over the restore's policy.
+func (ctx *restoreContext) getExistingResourcePolicy(groupResource schema.GroupResource) velerov1api.PolicyType {
+	if policy, ok := ctx.existingResourcePolicies[groupResource]; ok {
+		return policy
+	}
+	return ctx.restore.Spec.ExistingResourcePolicy
+}
+
+// resolveExistingResourcePolicies resolves the resource names of the existing
+// resource policy overrides to their GroupResources.
+func resolveExistingResourcePolicies(helper discovery.Helper, overrides map[string]velerov1api.PolicyType) map[schema.GroupResource]velerov1api.PolicyType {
+	policies := make(map[schema.GroupResource]velerov1api.PolicyType, len(overrides))
+	for resource, policy := range overrides {
+		gr := schema.ParseGroupResource(resource)
+		if gvr, _, err := helper.ResourceFor(gr.WithVersion("")); err == nil {
+			gr = gvr.GroupResource()
+		}
+		policies[gr] = policy
+	}
+	return policies
+}
+
+// setDryRunResult records the outcome of an item in a dry-run restore. It's
+// a no-op for other restores.
+func (ctx *restoreContext) setDryRunResult(key itemKey, action, reason, diff string) {
+	if !ctx.dryRun {
+		return
+	}
+
+	itemStatus := ctx.restoredItems[key]
+	itemStatus.action = action
+	itemStatus.reason = reason
+	itemStatus.diff = diff
+	ctx.restoredItems[key] = itemStatus
+}
+
+// excludeItemForDryRun records an item which is excluded before being processed
+// in a dry-run restore. It's a no-op for other restores.
+func (ctx *restoreContext) excludeItemForDryRun(obj *unstructured.Unstructured, namespace, reason string) {
+	if !ctx.dryRun {
+		return
+	}
+
+	key := itemKey{
+		resource:  resourceKey(obj),
+		namespace: namespace,
+		name:      obj.GetName(),
+	}
+	if _, exists := ctx.restoredItems[key]; exists {
+		return
+	}
+	ctx.restoredItems[key] = restoredItemStatus{action: ItemRestoreResultExcluded, reason: reason}
+}
+
+// ensureNamespaceExists creates the namespace if it doesn't exist in the cluster,
+// and returns whether the namespace is created. A dry-run restore only checks
+// whether the namespace exists.
+func (ctx *restoreContext) ensureNamespaceExists(ns *v1.Namespace) (bool, error) {
+	if !ctx.dryRun {
+		_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+		return nsCreated, err
+	}
+
+	if _, err := ctx.namespaceClient.Get(go_context.TODO(), ns.Name, metav1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "error getting namespace %s", ns.Name)
+	}
+
+	return false, nil
+}
+
 func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructured, resourceClient client.Dynamic) (*unstructured.Unstructured, error) {
 	retObj := obj.DeepCopy()
 	oldName := obj.GetName()
@@ -2457,10 +2738,14 @@ func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructu
 
 		// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
 		// uses the original name to look up metadata about the snapshot.
-		ctx.log.Infof("Restoring persistent volume from snapshot.")
-		retObj, err = ctx.pvRestorer.executePVAction(retObj)
-		if err != nil {
-			return nil, fmt.Errorf("error executing PVAction for %s: %v", getResourceID(kuberesource.PersistentVolumes, "", oldName), err)
+		if ctx.dryRun {
+			ctx.log.Infof("Skipping restoring persistent volume from snapshot for dry-run restore.")
+		} else {
+			ctx.log.Infof("Restoring persistent volume from snapshot.")
+			retObj, err = ctx.pvRestorer.executePVAction(retObj)
+			if err != nil {
+				return nil, fmt.Errorf("error executing PVAction for %s: %v", getResourceID(kuberesource.PersistentVolumes, "", oldName), err)
+			}
 		}
 
 		// VolumeSnapshotter has modified the PV name, we should rename the PV.
@@ -56,6 +56,7 @@ import (
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	uploadermocks "github.com/vmware-tanzu/velero/pkg/podvolume/mocks"
+	"github.com/vmware-tanzu/velero/pkg/restore/dryrun"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	. "github.com/vmware-tanzu/velero/pkg/util/results"
@@ -310,6 +311,29 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				test.Pods(): {"ns-1/pod-1", "ns-2/pod-2"},
 			},
 		},
+		{
+			name:    "included items filter only restores those items",
+			restore: defaultRestore().IncludedItems("pods/ns-2/pod-2", "persistentvolumes/pv-1").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-2/pod-2"},
+				test.PVs():  {"/pv-1"},
+			},
+		},
 		{
 			name:    "excluded resources filter only restores resources not of those types",
 			restore: defaultRestore().ExcludedResources("pvs").Result(),
@@ -1238,6 +1262,95 @@ func TestRestoreItems(t *testing.T) {
 				{resource: "v1/Secret", namespace: "ns-1", name: "sa-1"}: {action: "updated", itemExists: true},
 			},
 		},
+		{
+			name:    "re-create secret when secret exists in cluster and is not identical to the backed up one, existing resource policy is recreate",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").ObjectMeta(builder.WithLabels("foo", "bar")).Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			disableInformer: true,
+			want: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data(map[string][]byte{"key-1": []byte("value-1")}).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:  {action: "created", itemExists: true},
+				{resource: "v1/Secret", namespace: "ns-1", name: "sa-1"}: {action: "created", itemExists: true},
+			},
+		},
+		{
+			name:    "re-create secret when secret exists in cluster and is not identical to the backed up one, existing resource policy is overridden as recreate, using informer cache",
+			restore: defaultRestore().ExistingResourcePolicy("none").ExistingResourcePolicyOverride("secrets", "recreate").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").ObjectMeta(builder.WithLabels("foo", "bar")).Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			want: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data(map[string][]byte{"key-1": []byte("value-1")}).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:  {action: "created", itemExists: true},
+				{resource: "v1/Secret", namespace: "ns-1", name: "sa-1"}: {action: "created", itemExists: true},
+			},
+		},
+		{
+			name:    "update secret when secret exists in cluster and is not identical to the backed up one, existing resource policy is overridden as update",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").ExistingResourcePolicyOverride("secrets", "update").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			disableInformer: true,
+			want: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data(map[string][]byte{"key-1": []byte("value-1")}).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:  {action: "created", itemExists: true},
+				{resource: "v1/Secret", namespace: "ns-1", name: "sa-1"}: {action: "updated", itemExists: true},
+			},
+		},
+		{
+			name:    "update PVC when PVC exists in cluster and is not identical to the backed up one, existing resource policy is recreate",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumeclaims", builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVCs(builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels("foo", "bar")).Result()),
+			},
+			disableInformer: true,
+			want: []*test.APIResource{
+				test.PVCs(builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:                  {action: "created", itemExists: true},
+				{resource: "v1/PersistentVolumeClaim", namespace: "ns-1", name: "pvc-1"}: {action: "updated", itemExists: true},
+			},
+		},
+		{
+			name:    "update pod labels when pod exists in cluster and is identical to the backed up one, existing resource policy is recreate",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "sa-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "foo", "velero.io/restore-name", "bar")).Result()),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
+			},
+		},
 		{
 			name:    "update service account labels when service account exists in cluster and is identical to the backed up one, existing resource policy is update",
 			restore: defaultRestore().ExistingResourcePolicy("update").Result(),
@@ -1412,6 +1525,169 @@ func TestRestoreItems(t *testing.T) {
 	}
 }
 
+// TestRestoreDryRun runs dry-run restores and verifies that the cluster is not
+// changed and the outcome of every item is recorded.
+func TestRestoreDryRun(t *testing.T) {
+	tests := []struct {
+		name            string
+		restore         *velerov1api.Restore
+		tarball         io.Reader
+		apiResources    []*test.APIResource
+		disableInformer bool
+		want            []dryrun.ItemResult
+	}{
+		{
+			name:    "new items would be created and the excluded ones are reported",
+			restore: defaultRestore().DryRun(true).ExcludedNamespaces("ns-2").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			want: []dryrun.ItemResult{
+				{Resource: "v1/Namespace", Name: "ns-1", Action: dryrun.ActionCreate},
+				{Resource: "v1/Pod", Namespace: "ns-1", Name: "pod-1", Action: dryrun.ActionCreate},
+			},
+		},
+		{
+			name:    "existing items would be skipped when existing resource policy is none",
+			restore: defaultRestore().DryRun(true).ExistingResourcePolicy("none").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			disableInformer: true,
+			want: []dryrun.ItemResult{
+				{Resource: "v1/Namespace", Name: "ns-1", Action: dryrun.ActionCreate},
+				{Resource: "v1/Secret", Namespace: "ns-1", Name: "secret-1", Action: dryrun.ActionSkip, Reason: "it already exists in the cluster and is different from the backed-up version"},
+			},
+		},
+		{
+			name:    "existing items would be updated with the diff when existing resource policy is update",
+			restore: defaultRestore().DryRun(true).ExistingResourcePolicy("update").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			want: []dryrun.ItemResult{
+				{Resource: "v1/Namespace", Name: "ns-1", Action: dryrun.ActionCreate},
+				{
+					Resource:  "v1/Secret",
+					Namespace: "ns-1",
+					Name:      "secret-1",
+					Action:    dryrun.ActionUpdate,
+					Diff:      `{"data":{"foo":null,"key-1":"dmFsdWUtMQ=="},"metadata":{"labels":{"velero.io/backup-name":"backup-1","velero.io/restore-name":"restore-1"}}}`,
+				},
+			},
+		},
+		{
+			name:    "existing items would be re-created when existing resource policy is recreate",
+			restore: defaultRestore().DryRun(true).ExistingResourcePolicy("recreate").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			want: []dryrun.ItemResult{
+				{Resource: "v1/Namespace", Name: "ns-1", Action: dryrun.ActionCreate},
+				{Resource: "v1/Secret", Namespace: "ns-1", Name: "secret-1", Action: dryrun.ActionRecreate, Reason: "it already exists in the cluster and is different from the backed-up version"},
+			},
+		},
+		{
+			name:    "existing PVCs would be updated instead of re-created when existing resource policy is recreate",
+			restore: defaultRestore().DryRun(true).ExistingResourcePolicy("recreate").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumeclaims", builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVCs(builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels("foo", "bar")).Result()),
+			},
+			want: []dryrun.ItemResult{
+				{Resource: "v1/Namespace", Name: "ns-1", Action: dryrun.ActionCreate},
+				{Resource: "v1/PersistentVolumeClaim", Namespace: "ns-1", Name: "pvc-1", Action: dryrun.ActionUpdate, Diff: `{"metadata":{"labels":{"foo":null,"velero.io/backup-name":"backup-1","velero.io/restore-name":"restore-1"}}}`},
+			},
+		},
+		{
+			name:    "existing PVs would be updated instead of re-created when existing resource policy is recreate",
+			restore: defaultRestore().DryRun(true).ExistingResourcePolicy("recreate").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVs(builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("foo", "bar")).ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result()),
+			},
+			want: []dryrun.ItemResult{
+				{Resource: "v1/PersistentVolume", Name: "pv-1", Action: dryrun.ActionUpdate, Diff: `{"metadata":{"labels":{"foo":null,"velero.io/backup-name":"backup-1","velero.io/restore-name":"restore-1"}}}`},
+			},
+		},
+		{
+			name:    "existing CRDs would be updated instead of re-created when existing resource policy is overridden as recreate",
+			restore: defaultRestore().DryRun(true).ExistingResourcePolicyOverride("customresourcedefinitions.apiextensions.k8s.io", "recreate").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("customresourcedefinitions.apiextensions.k8s.io", builder.ForCustomResourceDefinitionV1Beta1("crd-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.CRDs(builder.ForCustomResourceDefinitionV1Beta1("crd-1").ObjectMeta(builder.WithLabels("foo", "bar")).Result()),
+			},
+			want: []dryrun.ItemResult{
+				{Resource: "apiextensions.k8s.io/v1beta1/CustomResourceDefinition", Name: "crd-1", Action: dryrun.ActionUpdate, Diff: `{"metadata":{"labels":{"foo":null,"velero.io/backup-name":"backup-1","velero.io/restore-name":"restore-1"}}}`},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			data := &Request{
+				Log:                  h.log,
+				Restore:              tc.restore,
+				Backup:               defaultBackup().Result(),
+				BackupReader:         tc.tarball,
+				RestoredItems:        map[itemKey]restoredItemStatus{},
+				DisableInformerCache: tc.disableInformer,
+			}
+			_, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+
+			assert.Empty(t, errs.Velero)
+			assert.Empty(t, errs.Cluster)
+			assert.Empty(t, errs.Namespaces)
+			// nothing is changed in the cluster
+			wantAPIContents := map[*test.APIResource][]string{}
+			for _, r := range tc.apiResources {
+				wantAPIContents[r] = []string{}
+				for _, item := range r.Items {
+					wantAPIContents[r] = append(wantAPIContents[r], fmt.Sprintf("%s/%s", item.GetNamespace(), item.GetName()))
+				}
+			}
+			assertAPIContents(t, h, wantAPIContents)
+			assertRestoredItems(t, h, tc.apiResources)
+			assert.Equal(t, tc.want, data.DryRunResults())
+
+			namespaces, err := h.KubeClient.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, namespaces.Items)
+		})
+	}
+}
+
 // recordResourcesAction is a restore item action that can be configured
 // to run for specific resources/namespaces and simply records the items
 // that it is executed for.
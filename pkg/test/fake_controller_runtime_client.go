@@ -19,6 +19,7 @@ package test
 import (
 	"testing"
 
+	groupsnapshotv1alpha1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	"github.com/stretchr/testify/require"
 	appsv1api "k8s.io/api/apps/v1"
@@ -40,6 +41,7 @@ func NewFakeControllerRuntimeClientBuilder(t *testing.T) *k8sfake.ClientBuilder
 	require.NoError(t, corev1api.AddToScheme(scheme))
 	require.NoError(t, appsv1api.AddToScheme(scheme))
 	require.NoError(t, snapshotv1api.AddToScheme(scheme))
+	require.NoError(t, groupsnapshotv1alpha1.AddToScheme(scheme))
 	require.NoError(t, storagev1api.AddToScheme(scheme))
 
 	return k8sfake.NewClientBuilder().WithScheme(scheme)
@@ -53,6 +55,7 @@ func NewFakeControllerRuntimeClient(t *testing.T, initObjs ...runtime.Object) cl
 	require.NoError(t, corev1api.AddToScheme(scheme))
 	require.NoError(t, appsv1api.AddToScheme(scheme))
 	require.NoError(t, snapshotv1api.AddToScheme(scheme))
+	require.NoError(t, groupsnapshotv1alpha1.AddToScheme(scheme))
 	require.NoError(t, storagev1api.AddToScheme(scheme))
 
 	return k8sfake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(initObjs...).Build()
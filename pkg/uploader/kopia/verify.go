@@ -0,0 +1,76 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"io"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/kopia"
+)
+
+// VerifySnapshot checks that the snapshot with the given ID exists in the repository and that
+// its directory tree can be walked. If readContent is true, the content of every file in the
+// snapshot is also read, so that missing or corrupted content blobs are detected.
+func VerifySnapshot(ctx context.Context, rep repo.Repository, snapshotID string, readContent bool, log logrus.FieldLogger) error {
+	kopiaCtx := kopia.SetupKopiaLog(ctx, log)
+
+	snap, err := loadSnapshotFunc(kopiaCtx, rep, manifest.ID(snapshotID))
+	if err != nil {
+		return errors.Wrapf(err, "unable to load snapshot %v", snapshotID)
+	}
+
+	log.Debugf("Verifying snapshot %s, created time %v", snapshotID, snap.EndTime.ToTime())
+
+	rootEntry, err := filesystemEntryFunc(kopiaCtx, rep, snapshotID, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get filesystem entry for snapshot %v", snapshotID)
+	}
+
+	return verifyEntry(kopiaCtx, rootEntry, readContent)
+}
+
+func verifyEntry(ctx context.Context, entry fs.Entry, readContent bool) error {
+	switch e := entry.(type) {
+	case fs.Directory:
+		return fs.IterateEntries(ctx, e, func(ctx context.Context, child fs.Entry) error {
+			return verifyEntry(ctx, child, readContent)
+		})
+	case fs.File:
+		if !readContent {
+			return nil
+		}
+
+		reader, err := e.Open(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error to open file %s", e.Name())
+		}
+		defer reader.Close()
+
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return errors.Wrapf(err, "error to read file %s", e.Name())
+		}
+	}
+
+	return nil
+}
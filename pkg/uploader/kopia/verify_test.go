@@ -0,0 +1,146 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/fs/virtualfs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFile struct {
+	fs.Entry
+	name    string
+	content string
+	openErr error
+	opened  *int
+}
+
+func (f *fakeFile) Name() string {
+	return f.name
+}
+
+func (f *fakeFile) IsDir() bool {
+	return false
+}
+
+func (f *fakeFile) Open(ctx context.Context) (fs.Reader, error) {
+	if f.openErr != nil {
+		return nil, f.openErr
+	}
+	*f.opened++
+	return &fakeReader{Reader: strings.NewReader(f.content)}, nil
+}
+
+type fakeReader struct {
+	*strings.Reader
+}
+
+func (r *fakeReader) Close() error {
+	return nil
+}
+
+func (r *fakeReader) Entry() (fs.Entry, error) {
+	return nil, nil
+}
+
+func TestVerifySnapshot(t *testing.T) {
+	defer func() {
+		loadSnapshotFunc = snapshot.LoadSnapshot
+		filesystemEntryFunc = snapshotfs.FilesystemEntryFromIDWithPath
+	}()
+
+	tests := []struct {
+		name          string
+		loadErr       error
+		entryErr      error
+		openErr       error
+		readContent   bool
+		expectedErr   string
+		expectedReads int
+	}{
+		{
+			name:        "snapshot doesn't exist",
+			loadErr:     errors.New("fake-load-error"),
+			expectedErr: "unable to load snapshot fake-snapshot: fake-load-error",
+		},
+		{
+			name:        "fail to get root entry",
+			entryErr:    errors.New("fake-entry-error"),
+			expectedErr: "unable to get filesystem entry for snapshot fake-snapshot: fake-entry-error",
+		},
+		{
+			name: "walk tree without reading content",
+		},
+		{
+			name:          "read content",
+			readContent:   true,
+			expectedReads: 2,
+		},
+		{
+			name:        "fail to read content",
+			readContent: true,
+			openErr:     errors.New("fake-open-error"),
+			expectedErr: "error to open file file-1: fake-open-error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reads := 0
+			root := virtualfs.NewStaticDirectory("root", []fs.Entry{
+				&fakeFile{name: "file-1", content: "fake-content", openErr: test.openErr, opened: &reads},
+				virtualfs.NewStaticDirectory("dir-1", []fs.Entry{
+					&fakeFile{name: "file-2", content: "fake-content", openErr: test.openErr, opened: &reads},
+				}),
+			})
+
+			loadSnapshotFunc = func(ctx context.Context, rep repo.Repository, manifestID manifest.ID) (*snapshot.Manifest, error) {
+				if test.loadErr != nil {
+					return nil, test.loadErr
+				}
+				return &snapshot.Manifest{}, nil
+			}
+			filesystemEntryFunc = func(ctx context.Context, rep repo.Repository, rootID string, consistentAttributes bool) (fs.Entry, error) {
+				if test.entryErr != nil {
+					return nil, test.entryErr
+				}
+				return root, nil
+			}
+
+			err := VerifySnapshot(context.Background(), nil, "fake-snapshot", test.readContent, logrus.New())
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedReads, reads)
+		})
+	}
+}
@@ -56,6 +56,7 @@ func NewKopiaUploaderProvider(
 	ctx context.Context,
 	credGetter *credentials.CredentialGetter,
 	backupRepo *velerov1api.BackupRepository,
+	throttlingOptions map[string]string,
 	log logrus.FieldLogger,
 ) (Provider, error) {
 	kp := &kopiaProvider{
@@ -68,6 +69,8 @@ func NewKopiaUploaderProvider(
 	repoOpt, err := udmrepo.NewRepoOptions(
 		udmrepo.WithPassword(kp, ""),
 		udmrepo.WithConfigFile("", repoUID),
+		udmrepo.WithGenOptions(backupRepo.Spec.RepositoryConfig),
+		udmrepo.WithGenOptions(throttlingOptions),
 		udmrepo.WithDescription("Initial kopia uploader provider"),
 	)
 	if err != nil {
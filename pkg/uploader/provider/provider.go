@@ -75,6 +75,7 @@ func NewUploaderProvider(
 	backupRepo *velerov1api.BackupRepository,
 	credGetter *credentials.CredentialGetter,
 	repoKeySelector *v1.SecretKeySelector,
+	throttlingOptions map[string]string,
 	log logrus.FieldLogger,
 ) (Provider, error) {
 	if requesterType == "" {
@@ -85,7 +86,7 @@ func NewUploaderProvider(
 		return nil, errors.New("uninitialized FileStore credential is not supported")
 	}
 	if uploaderType == uploader.KopiaType {
-		return NewKopiaUploaderProvider(requesterType, ctx, credGetter, backupRepo, log)
+		return NewKopiaUploaderProvider(requesterType, ctx, credGetter, backupRepo, throttlingOptions, log)
 	} else {
 		return NewResticUploaderProvider(repoIdentifier, bsl, credGetter, repoKeySelector, log)
 	}
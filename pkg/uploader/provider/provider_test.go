@@ -86,7 +86,7 @@ func TestNewUploaderProvider(t *testing.T) {
 				mockFileGetter.On("Path", &v1.SecretKeySelector{}).Return("", nil)
 				credGetter.FromFile = mockFileGetter
 			}
-			_, err := NewUploaderProvider(ctx, client, testCase.UploaderType, testCase.RequestorType, repoIdentifier, bsl, backupRepo, credGetter, repoKeySelector, log)
+			_, err := NewUploaderProvider(ctx, client, testCase.UploaderType, testCase.RequestorType, repoIdentifier, bsl, backupRepo, credGetter, repoKeySelector, nil, log)
 			if testCase.ExpectedError == "" {
 				assert.Nil(t, err)
 			} else {
@@ -0,0 +1,169 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bandwidth selects the rate limits configured for the data
+// transferred to and from the object storage, and applies them to streams.
+package bandwidth
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// Current returns the limit of limits applying at now, i.e. the one of the
+// first scheduled window containing now or, if there is none, the default one.
+// It returns the zero, unlimited, limit if limits is nil.
+func Current(limits *velerov1api.BandwidthLimits, now time.Time) (velerov1api.BandwidthLimit, error) {
+	if limits == nil {
+		return velerov1api.BandwidthLimit{}, nil
+	}
+
+	location := time.UTC
+	if limits.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(limits.TimeZone); err != nil {
+			return velerov1api.BandwidthLimit{}, errors.Wrapf(err, "invalid time zone %q", limits.TimeZone)
+		}
+	}
+
+	now = now.In(location)
+	minute := now.Hour()*60 + now.Minute()
+
+	for _, window := range limits.Schedule {
+		start, err := minuteOfDay(window.Start)
+		if err != nil {
+			return velerov1api.BandwidthLimit{}, err
+		}
+		end, err := minuteOfDay(window.End)
+		if err != nil {
+			return velerov1api.BandwidthLimit{}, err
+		}
+
+		if start <= end && minute >= start && minute < end ||
+			start > end && (minute >= start || minute < end) {
+			return window.BandwidthLimit, nil
+		}
+	}
+
+	return limits.BandwidthLimit, nil
+}
+
+func minuteOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, errors.Errorf("invalid time of the day %q, the format is HH:MM", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Min returns the most restrictive of the two limits for each rate, a zero
+// rate being unlimited.
+func Min(a, b velerov1api.BandwidthLimit) velerov1api.BandwidthLimit {
+	return velerov1api.BandwidthLimit{
+		UploadBytesPerSecond:   minRate(a.UploadBytesPerSecond, b.UploadBytesPerSecond),
+		DownloadBytesPerSecond: minRate(a.DownloadBytesPerSecond, b.DownloadBytesPerSecond),
+		OperationsPerSecond:    minRate(a.OperationsPerSecond, b.OperationsPerSecond),
+	}
+}
+
+func minRate(a, b int64) int64 {
+	if a == 0 || b != 0 && b < a {
+		return b
+	}
+
+	return a
+}
+
+// NewLimiter returns a limiter allowing perSecond events per second, with a
+// burst of one second worth of events, or an unlimited one if perSecond is 0.
+func NewLimiter(perSecond int64) *rate.Limiter {
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	SetLimit(limiter, perSecond)
+
+	return limiter
+}
+
+// SetLimit changes limiter to allow perSecond events per second, or to be
+// unlimited if perSecond is 0.
+func SetLimit(limiter *rate.Limiter, perSecond int64) {
+	if perSecond <= 0 {
+		limiter.SetLimit(rate.Inf)
+		return
+	}
+
+	limiter.SetBurst(int(perSecond))
+	limiter.SetLimit(rate.Limit(perSecond))
+}
+
+type reader struct {
+	ctx     context.Context
+	src     io.Reader
+	limiter *rate.Limiter
+}
+
+// NewReader returns a reader reading from src no faster than limiter allows,
+// one byte being one event.
+func NewReader(ctx context.Context, src io.Reader, limiter *rate.Limiter) io.Reader {
+	return &reader{ctx: ctx, src: src, limiter: limiter}
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if r.limiter.Limit() == rate.Inf {
+		return r.src.Read(p)
+	}
+
+	if burst := r.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+
+	n, err := r.src.Read(p)
+
+	// the burst can be lowered by SetLimit while src is read, so the bytes
+	// read are waited for in chunks no larger than the current burst
+	for waited := 0; waited < n; {
+		chunk := n - waited
+		if burst := r.limiter.Burst(); burst > 0 && chunk > burst {
+			chunk = burst
+		}
+		if werr := r.limiter.WaitN(r.ctx, chunk); werr != nil {
+			if err == nil {
+				err = werr
+			}
+			break
+		}
+		waited += chunk
+	}
+
+	return n, err
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// NewReadCloser returns a ReadCloser reading from src no faster than limiter
+// allows and closing src.
+func NewReadCloser(ctx context.Context, src io.ReadCloser, limiter *rate.Limiter) io.ReadCloser {
+	return readCloser{Reader: NewReader(ctx, src, limiter), Closer: src}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bandwidth
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestCurrent(t *testing.T) {
+	limits := &velerov1api.BandwidthLimits{
+		BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 1000},
+		Schedule: []velerov1api.ScheduledBandwidthLimit{
+			{Start: "09:00", End: "17:00", BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 10}},
+			{Start: "22:00", End: "02:00", BandwidthLimit: velerov1api.BandwidthLimit{UploadBytesPerSecond: 100}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		limits   *velerov1api.BandwidthLimits
+		now      time.Time
+		expected velerov1api.BandwidthLimit
+		wantErr  string
+	}{
+		{
+			name:     "no limits",
+			now:      time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			expected: velerov1api.BandwidthLimit{},
+		},
+		{
+			name:     "within a window",
+			limits:   limits,
+			now:      time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+			expected: velerov1api.BandwidthLimit{UploadBytesPerSecond: 10},
+		},
+		{
+			name:     "window end is excluded",
+			limits:   limits,
+			now:      time.Date(2024, 5, 1, 17, 0, 0, 0, time.UTC),
+			expected: velerov1api.BandwidthLimit{UploadBytesPerSecond: 1000},
+		},
+		{
+			name:     "within a window spanning midnight",
+			limits:   limits,
+			now:      time.Date(2024, 5, 1, 1, 30, 0, 0, time.UTC),
+			expected: velerov1api.BandwidthLimit{UploadBytesPerSecond: 100},
+		},
+		{
+			name: "window in a time zone",
+			limits: &velerov1api.BandwidthLimits{
+				TimeZone: "America/New_York",
+				Schedule: limits.Schedule,
+			},
+			now:      time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC),
+			expected: velerov1api.BandwidthLimit{UploadBytesPerSecond: 10},
+		},
+		{
+			name:    "invalid time zone",
+			limits:  &velerov1api.BandwidthLimits{TimeZone: "Nowhere/Town"},
+			now:     time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC),
+			wantErr: `invalid time zone "Nowhere/Town"`,
+		},
+		{
+			name: "invalid window",
+			limits: &velerov1api.BandwidthLimits{
+				Schedule: []velerov1api.ScheduledBandwidthLimit{{Start: "9am", End: "17:00"}},
+			},
+			now:     time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC),
+			wantErr: `invalid time of the day "9am", the format is HH:MM`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limit, err := Current(tc.limits, tc.now)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, limit)
+		})
+	}
+}
+
+func TestMin(t *testing.T) {
+	assert.Equal(t,
+		velerov1api.BandwidthLimit{UploadBytesPerSecond: 10, DownloadBytesPerSecond: 20, OperationsPerSecond: 5},
+		Min(
+			velerov1api.BandwidthLimit{UploadBytesPerSecond: 10, DownloadBytesPerSecond: 30},
+			velerov1api.BandwidthLimit{UploadBytesPerSecond: 100, DownloadBytesPerSecond: 20, OperationsPerSecond: 5},
+		),
+	)
+}
+
+func TestReader(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 300)
+
+	// unlimited
+	content, err := io.ReadAll(NewReader(context.Background(), bytes.NewReader(data), NewLimiter(0)))
+	require.NoError(t, err)
+	assert.Equal(t, data, content)
+
+	// the first second worth of data is read at once, the rest is throttled
+	limiter := NewLimiter(1000)
+	start := time.Now()
+	content, err = io.ReadAll(NewReader(context.Background(), bytes.NewReader(bytes.Repeat(data, 5)), limiter))
+	require.NoError(t, err)
+	assert.Len(t, content, 1500)
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	// the limit lowered while reading doesn't fail the read
+	limiter = NewLimiter(1000)
+	src := &limitChangingReader{Reader: bytes.NewReader(data), change: func() { SetLimit(limiter, 100) }}
+	content, err = io.ReadAll(NewReader(context.Background(), src, limiter))
+	require.NoError(t, err)
+	assert.Equal(t, data, content)
+
+	// the wait is interrupted by the cancellation of the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = io.ReadAll(NewReader(ctx, bytes.NewReader(data), NewLimiter(10)))
+	require.Error(t, err)
+}
+
+// limitChangingReader calls change before its first read.
+type limitChangingReader struct {
+	io.Reader
+	change func()
+}
+
+func (r *limitChangingReader) Read(p []byte) (int, error) {
+	if r.change != nil {
+		r.change()
+		r.change = nil
+	}
+	return r.Reader.Read(p)
+}
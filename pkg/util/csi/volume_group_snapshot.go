@@ -0,0 +1,272 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	groupsnapshotv1alpha1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/stringptr"
+)
+
+// GetVolumeGroupSnapshotClass returns the VolumeGroupSnapshotClass to use for the
+// supplied CSI driver. A class named by the backup's
+// "velero.io/csi-volumegroupsnapshot-class_<driver>" annotation takes precedence,
+// followed by the class for the driver that has the
+// "velero.io/csi-volumegroupsnapshot-class" label, followed by the only class
+// for the driver.
+func GetVolumeGroupSnapshotClass(
+	driver string,
+	backup *velerov1api.Backup,
+	crClient crclient.Client,
+) (*groupsnapshotv1alpha1.VolumeGroupSnapshotClass, error) {
+	classes := new(groupsnapshotv1alpha1.VolumeGroupSnapshotClassList)
+	if err := crClient.List(context.TODO(), classes); err != nil {
+		return nil, errors.Wrap(err, "error listing VolumeGroupSnapshotClass")
+	}
+
+	annotationKey := fmt.Sprintf(
+		"%s_%s",
+		velerov1api.VolumeGroupSnapshotClassDriverBackupAnnotationPrefix,
+		strings.ToLower(driver),
+	)
+	if className, ok := backup.Annotations[annotationKey]; ok {
+		for i := range classes.Items {
+			if !strings.EqualFold(className, classes.Items[i].Name) {
+				continue
+			}
+			if !strings.EqualFold(classes.Items[i].Driver, driver) {
+				return nil, errors.Errorf(
+					"Incorrect VolumeGroupSnapshotClass %s is not for driver %s for backup %s",
+					classes.Items[i].Name, driver, backup.Name,
+				)
+			}
+			return &classes.Items[i], nil
+		}
+		return nil, errors.Errorf(
+			"No CSI VolumeGroupSnapshotClass found with name %s for driver %s for backup %s",
+			className, driver, backup.Name,
+		)
+	}
+
+	n := 0
+	var groupClass *groupsnapshotv1alpha1.VolumeGroupSnapshotClass
+	for i := range classes.Items {
+		if classes.Items[i].Driver != driver {
+			continue
+		}
+		if _, hasLabelSelector := classes.Items[i].Labels[velerov1api.VolumeGroupSnapshotClassSelectorLabel]; hasLabelSelector {
+			return &classes.Items[i], nil
+		}
+		n++
+		groupClass = &classes.Items[i]
+	}
+	// If there's only one VolumeGroupSnapshotClass for the driver, return it.
+	if n == 1 {
+		return groupClass, nil
+	}
+
+	return nil, errors.Errorf(
+		"failed to get VolumeGroupSnapshotClass for driver %s, ensure that the desired VolumeGroupSnapshotClass has the %s label",
+		driver, velerov1api.VolumeGroupSnapshotClassSelectorLabel)
+}
+
+// WaitVolumeGroupSnapshotReady waits a VolumeGroupSnapshot to become ready to use
+// until the timeout reaches.
+func WaitVolumeGroupSnapshotReady(
+	ctx context.Context,
+	crClient crclient.Client,
+	name string,
+	namespace string,
+	timeout time.Duration,
+	log logrus.FieldLogger,
+) (*groupsnapshotv1alpha1.VolumeGroupSnapshot, error) {
+	var updated *groupsnapshotv1alpha1.VolumeGroupSnapshot
+	errMessage := sets.NewString()
+
+	err := wait.PollUntilContextTimeout(
+		ctx,
+		waitInternal,
+		timeout,
+		true,
+		func(ctx context.Context) (bool, error) {
+			tmpVGS := new(groupsnapshotv1alpha1.VolumeGroupSnapshot)
+			if err := crClient.Get(
+				ctx,
+				crclient.ObjectKey{Namespace: namespace, Name: name},
+				tmpVGS,
+			); err != nil {
+				return false, errors.Wrapf(
+					err, "error to get VolumeGroupSnapshot %s/%s", namespace, name)
+			}
+
+			if tmpVGS.Status == nil {
+				return false, nil
+			}
+
+			if tmpVGS.Status.Error != nil {
+				errMessage.Insert(stringptr.GetString(tmpVGS.Status.Error.Message))
+			}
+
+			if !boolptr.IsSetToTrue(tmpVGS.Status.ReadyToUse) {
+				return false, nil
+			}
+
+			updated = tmpVGS
+			return true, nil
+		},
+	)
+
+	if wait.Interrupted(err) {
+		err = errors.Errorf(
+			"volume group snapshot is not ready until timeout, errors: %v",
+			errMessage.List(),
+		)
+	}
+
+	if errMessage.Len() > 0 {
+		log.Warnf("Some errors happened during waiting for ready group snapshot, errors: %v",
+			errMessage.List())
+	}
+
+	return updated, err
+}
+
+// ReleaseVolumeGroupSnapshot detaches the member VolumeSnapshots and
+// VolumeSnapshotContents of a ready VolumeGroupSnapshot from the group objects,
+// so that each member snapshot can be handled the same way as an individually
+// created one, and then removes the VolumeGroupSnapshot and its
+// VolumeGroupSnapshotContent without deleting the snapshots in the storage.
+// The supplied labels are added to every member VolumeSnapshot.
+func ReleaseVolumeGroupSnapshot(
+	vgs *groupsnapshotv1alpha1.VolumeGroupSnapshot,
+	memberLabels map[string]string,
+	crClient crclient.Client,
+	log logrus.FieldLogger,
+) error {
+	if vgs.Status != nil {
+		for _, ref := range vgs.Status.VolumeSnapshotRefList {
+			vs := new(snapshotv1api.VolumeSnapshot)
+			if err := crClient.Get(
+				context.TODO(),
+				crclient.ObjectKey{Namespace: vgs.Namespace, Name: ref.Name},
+				vs,
+			); err != nil {
+				return errors.Wrapf(err, "error getting VolumeSnapshot %s/%s", vgs.Namespace, ref.Name)
+			}
+
+			originVS := vs.DeepCopy()
+			vs.OwnerReferences = removeOwnerReferencesOfKind(vs.OwnerReferences, "VolumeGroupSnapshot")
+			if vs.Labels == nil {
+				vs.Labels = make(map[string]string)
+			}
+			for k, v := range memberLabels {
+				vs.Labels[k] = v
+			}
+			if err := crClient.Patch(context.TODO(), vs, crclient.MergeFrom(originVS)); err != nil {
+				return errors.Wrapf(err, "error patching VolumeSnapshot %s/%s", vs.Namespace, vs.Name)
+			}
+
+			if vs.Status == nil || vs.Status.BoundVolumeSnapshotContentName == nil {
+				continue
+			}
+
+			vsc := new(snapshotv1api.VolumeSnapshotContent)
+			if err := crClient.Get(
+				context.TODO(),
+				crclient.ObjectKey{Name: *vs.Status.BoundVolumeSnapshotContentName},
+				vsc,
+			); err != nil {
+				return errors.Wrapf(err, "error getting VolumeSnapshotContent %s",
+					*vs.Status.BoundVolumeSnapshotContentName)
+			}
+
+			originVSC := vsc.DeepCopy()
+			vsc.OwnerReferences = removeOwnerReferencesOfKind(vsc.OwnerReferences, "VolumeGroupSnapshotContent")
+			if err := crClient.Patch(context.TODO(), vsc, crclient.MergeFrom(originVSC)); err != nil {
+				return errors.Wrapf(err, "error patching VolumeSnapshotContent %s", vsc.Name)
+			}
+		}
+	}
+
+	if vgs.Status != nil && vgs.Status.BoundVolumeGroupSnapshotContentName != nil {
+		vgsc := new(groupsnapshotv1alpha1.VolumeGroupSnapshotContent)
+		err := crClient.Get(
+			context.TODO(),
+			crclient.ObjectKey{Name: *vgs.Status.BoundVolumeGroupSnapshotContentName},
+			vgsc,
+		)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error getting VolumeGroupSnapshotContent %s",
+				*vgs.Status.BoundVolumeGroupSnapshotContentName)
+		}
+
+		if err == nil {
+			// Retain the group snapshot content, so deleting the group objects
+			// never removes the member snapshots from the storage.
+			originVGSC := vgsc.DeepCopy()
+			vgsc.Spec.DeletionPolicy = snapshotv1api.VolumeSnapshotContentRetain
+			if err := crClient.Patch(context.TODO(), vgsc, crclient.MergeFrom(originVGSC)); err != nil {
+				return errors.Wrapf(err, "error patching VolumeGroupSnapshotContent %s", vgsc.Name)
+			}
+
+			if err := crClient.Delete(context.TODO(), vgs); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "error deleting VolumeGroupSnapshot %s/%s", vgs.Namespace, vgs.Name)
+			}
+
+			if err := crClient.Delete(context.TODO(), vgsc); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "error deleting VolumeGroupSnapshotContent %s", vgsc.Name)
+			}
+
+			log.Infof("Released VolumeGroupSnapshot %s/%s and VolumeGroupSnapshotContent %s",
+				vgs.Namespace, vgs.Name, vgsc.Name)
+			return nil
+		}
+	}
+
+	if err := crClient.Delete(context.TODO(), vgs); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting VolumeGroupSnapshot %s/%s", vgs.Namespace, vgs.Name)
+	}
+	log.Infof("Released VolumeGroupSnapshot %s/%s", vgs.Namespace, vgs.Name)
+
+	return nil
+}
+
+func removeOwnerReferencesOfKind(refs []metav1.OwnerReference, kind string) []metav1.OwnerReference {
+	var kept []metav1.OwnerReference
+	for _, ref := range refs {
+		if ref.Kind == kind {
+			continue
+		}
+		kept = append(kept, ref)
+	}
+	return kept
+}
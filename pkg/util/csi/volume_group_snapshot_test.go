@@ -0,0 +1,110 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"testing"
+
+	groupsnapshotv1alpha1 "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumegroupsnapshot/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetVolumeGroupSnapshotClass(t *testing.T) {
+	newClass := func(name, driver string, labels map[string]string) *groupsnapshotv1alpha1.VolumeGroupSnapshotClass {
+		return &groupsnapshotv1alpha1.VolumeGroupSnapshotClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Driver:     driver,
+		}
+	}
+	selected := map[string]string{velerov1api.VolumeGroupSnapshotClassSelectorLabel: ""}
+
+	tests := []struct {
+		name          string
+		backup        *velerov1api.Backup
+		classes       []runtime.Object
+		expectedClass string
+		expectedErr   string
+	}{
+		{
+			name:   "class from backup annotation is used",
+			backup: builder.ForBackup("velero", "backup").ObjectMeta(builder.WithAnnotations("velero.io/csi-volumegroupsnapshot-class_hostpath", "class-2")).Result(),
+			classes: []runtime.Object{
+				newClass("class-1", "hostpath", selected),
+				newClass("class-2", "hostpath", nil),
+			},
+			expectedClass: "class-2",
+		},
+		{
+			name:   "class from backup annotation is for another driver",
+			backup: builder.ForBackup("velero", "backup").ObjectMeta(builder.WithAnnotations("velero.io/csi-volumegroupsnapshot-class_hostpath", "class-1")).Result(),
+			classes: []runtime.Object{
+				newClass("class-1", "other", nil),
+			},
+			expectedErr: "Incorrect VolumeGroupSnapshotClass class-1 is not for driver hostpath for backup backup",
+		},
+		{
+			name:   "labelled class is used",
+			backup: builder.ForBackup("velero", "backup").Result(),
+			classes: []runtime.Object{
+				newClass("class-1", "hostpath", nil),
+				newClass("class-2", "hostpath", selected),
+				newClass("class-3", "other", selected),
+			},
+			expectedClass: "class-2",
+		},
+		{
+			name:   "the only class of the driver is used",
+			backup: builder.ForBackup("velero", "backup").Result(),
+			classes: []runtime.Object{
+				newClass("class-1", "hostpath", nil),
+				newClass("class-2", "other", selected),
+			},
+			expectedClass: "class-1",
+		},
+		{
+			name:   "multiple classes without label",
+			backup: builder.ForBackup("velero", "backup").Result(),
+			classes: []runtime.Object{
+				newClass("class-1", "hostpath", nil),
+				newClass("class-2", "hostpath", nil),
+			},
+			expectedErr: "failed to get VolumeGroupSnapshotClass for driver hostpath, ensure that the desired VolumeGroupSnapshotClass has the velero.io/csi-volumegroupsnapshot-class label",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crClient := velerotest.NewFakeControllerRuntimeClient(t, tc.classes...)
+
+			class, err := GetVolumeGroupSnapshotClass("hostpath", tc.backup, crClient)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedClass, class.Name)
+		})
+	}
+}
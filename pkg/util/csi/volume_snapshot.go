@@ -305,8 +305,19 @@ func GetVolumeSnapshotClass(
 	if err != nil {
 		return nil, errors.Wrap(err, "error listing VolumeSnapshotClass")
 	}
+	// If a snapshot class is chosen by the matched volume policy, use that
+	snapshotClass, err := getVolumeSnapshotClassFromPVCAnnotationForDriver(
+		pvc, velerov1api.VolumePolicySnapshotClassAnnotation, provisioner, snapshotClasses,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting VolumeSnapshotClass of the volume policy")
+	}
+	if snapshotClass != nil {
+		return snapshotClass, nil
+	}
+
 	// If a snapshot class is set for provider in PVC annotations, use that
-	snapshotClass, err := GetVolumeSnapshotClassFromPVCAnnotationsForDriver(
+	snapshotClass, err = GetVolumeSnapshotClassFromPVCAnnotationsForDriver(
 		pvc, provisioner, snapshotClasses,
 	)
 	if err != nil {
@@ -341,7 +352,17 @@ func GetVolumeSnapshotClassFromPVCAnnotationsForDriver(
 	provisioner string,
 	snapshotClasses *snapshotv1api.VolumeSnapshotClassList,
 ) (*snapshotv1api.VolumeSnapshotClass, error) {
-	annotationKey := velerov1api.VolumeSnapshotClassDriverPVCAnnotation
+	return getVolumeSnapshotClassFromPVCAnnotationForDriver(
+		pvc, velerov1api.VolumeSnapshotClassDriverPVCAnnotation, provisioner, snapshotClasses,
+	)
+}
+
+func getVolumeSnapshotClassFromPVCAnnotationForDriver(
+	pvc *corev1api.PersistentVolumeClaim,
+	annotationKey string,
+	provisioner string,
+	snapshotClasses *snapshotv1api.VolumeSnapshotClassList,
+) (*snapshotv1api.VolumeSnapshotClass, error) {
 	snapshotClassName, ok := pvc.ObjectMeta.Annotations[annotationKey]
 	if !ok {
 		return nil, nil
@@ -826,6 +826,16 @@ func TestGetVolumeSnapshotClass(t *testing.T) {
 		},
 		Spec: v1.PersistentVolumeClaimSpec{},
 	}
+	pvcPolicy := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "policy",
+			Annotations: map[string]string{
+				"velero.io/csi-volumesnapshot-class":            "foo",
+				velerov1api.VolumePolicySnapshotClassAnnotation: "foowithoutlabel",
+			},
+		},
+		Spec: v1.PersistentVolumeClaimSpec{},
+	}
 
 	// vsclasses
 	hostpathClass := &snapshotv1api.VolumeSnapshotClass{
@@ -949,6 +959,22 @@ func TestGetVolumeSnapshotClass(t *testing.T) {
 			expectedVSC: fooClass,
 			expectError: false,
 		},
+		{
+			name:        "VSC of the volume policy takes precedence over the VSC annotation on pvc",
+			driverName:  "foo.csi.k8s.io",
+			pvc:         pvcPolicy,
+			backup:      backupNone,
+			expectedVSC: fooClassWithoutLabel,
+			expectError: false,
+		},
+		{
+			name:        "VSC of the volume policy doesn't match csi driver",
+			driverName:  "bar.csi.k8s.io",
+			pvc:         pvcPolicy,
+			backup:      backupBar2,
+			expectedVSC: nil,
+			expectError: true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -29,6 +29,11 @@ import (
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
+type LoadAffinity struct {
+	// NodeSelector specifies the label selector to match nodes
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+}
+
 // IsPodRunning does a well-rounded check to make sure the specified pod is running stably.
 // If not, return the error found
 func IsPodRunning(pod *corev1api.Pod) error {
@@ -140,3 +145,43 @@ func IsPodUnrecoverable(pod *corev1api.Pod, log logrus.FieldLogger) (bool, strin
 	}
 	return false, ""
 }
+
+// ToSystemAffinity converts a LoadAffinity to the node affinity of a pod
+func ToSystemAffinity(loadAffinity *LoadAffinity) *corev1api.Affinity {
+	if loadAffinity == nil {
+		return nil
+	}
+
+	requirements := []corev1api.NodeSelectorRequirement{}
+	for k, v := range loadAffinity.NodeSelector.MatchLabels {
+		requirements = append(requirements, corev1api.NodeSelectorRequirement{
+			Key:      k,
+			Values:   []string{v},
+			Operator: corev1api.NodeSelectorOpIn,
+		})
+	}
+
+	for _, exp := range loadAffinity.NodeSelector.MatchExpressions {
+		requirements = append(requirements, corev1api.NodeSelectorRequirement{
+			Key:      exp.Key,
+			Values:   exp.Values,
+			Operator: corev1api.NodeSelectorOperator(exp.Operator),
+		})
+	}
+
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	return &corev1api.Affinity{
+		NodeAffinity: &corev1api.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
+				NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+					{
+						MatchExpressions: requirements,
+					},
+				},
+			},
+		},
+	}
+}
@@ -340,7 +340,7 @@ func IsPVCBound(pvc *corev1api.PersistentVolumeClaim) bool {
 }
 
 // MakePodPVCAttachment returns the volume mounts and devices for a pod needed to attach a PVC
-func MakePodPVCAttachment(volumeName string, volumeMode *corev1api.PersistentVolumeMode) ([]corev1api.VolumeMount, []corev1api.VolumeDevice) {
+func MakePodPVCAttachment(volumeName string, volumeMode *corev1api.PersistentVolumeMode, readOnly bool) ([]corev1api.VolumeMount, []corev1api.VolumeDevice) {
 	var volumeMounts []corev1api.VolumeMount = nil
 	var volumeDevices []corev1api.VolumeDevice = nil
 
@@ -353,6 +353,7 @@ func MakePodPVCAttachment(volumeName string, volumeMode *corev1api.PersistentVol
 		volumeMounts = []corev1api.VolumeMount{{
 			Name:      volumeName,
 			MountPath: "/" + volumeName,
+			ReadOnly:  readOnly,
 		}}
 	}
 
@@ -387,6 +388,32 @@ func GetPVForPVC(
 	return pv, nil
 }
 
+// GetPVCForPV returns the PVC claiming the PV, or nil if the PV is not claimed
+// or the PVC doesn't exist anymore.
+func GetPVCForPV(
+	pv *corev1api.PersistentVolume,
+	crClient crclient.Client,
+) (*corev1api.PersistentVolumeClaim, error) {
+	if pv.Spec.ClaimRef == nil {
+		return nil, nil
+	}
+
+	pvc := &corev1api.PersistentVolumeClaim{}
+	err := crClient.Get(
+		context.TODO(),
+		crclient.ObjectKey{Name: pv.Spec.ClaimRef.Name, Namespace: pv.Spec.ClaimRef.Namespace},
+		pvc,
+	)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get PVC %s/%s for PV %s",
+			pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name, pv.Name)
+	}
+	return pvc, nil
+}
+
 func GetPVCForPodVolume(vol *corev1api.Volume, pod *corev1api.Pod, crClient crclient.Client) (*corev1api.PersistentVolumeClaim, error) {
 	if vol.PersistentVolumeClaim == nil {
 		return nil, errors.Errorf("volume %s/%s has no PVC associated with it", pod.Namespace, vol.Name)
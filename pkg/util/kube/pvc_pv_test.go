@@ -23,6 +23,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
@@ -34,6 +35,7 @@ import (
 
 	clientTesting "k8s.io/client-go/testing"
 
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -1378,3 +1380,41 @@ func TestGetPVCForPodVolume(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPVCForPV(t *testing.T) {
+	pvc := builder.ForPersistentVolumeClaim("sample-ns", "sample-pvc").Result()
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, pvc)
+
+	testCases := []struct {
+		name        string
+		pv          *corev1api.PersistentVolume
+		expectedPVC string
+	}{
+		{
+			name:        "claimed PV",
+			pv:          builder.ForPersistentVolume("pv-1").ClaimRef("sample-ns", "sample-pvc").Result(),
+			expectedPVC: "sample-pvc",
+		},
+		{
+			name: "unclaimed PV",
+			pv:   builder.ForPersistentVolume("pv-1").Result(),
+		},
+		{
+			name: "PVC not found",
+			pv:   builder.ForPersistentVolume("pv-1").ClaimRef("sample-ns", "deleted-pvc").Result(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actualPVC, err := GetPVCForPV(tc.pv, fakeClient)
+			require.NoError(t, err)
+			if tc.expectedPVC == "" {
+				assert.Nil(t, actualPVC)
+				return
+			}
+			require.NotNil(t, actualPVC)
+			assert.Equal(t, tc.expectedPVC, actualPVC.Name)
+		})
+	}
+}
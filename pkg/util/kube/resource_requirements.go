@@ -22,6 +22,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+// PodResources is the resource requests and limits of a pod in strings
+type PodResources struct {
+	CPURequest    string `json:"cpuRequest,omitempty"`
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	CPULimit      string `json:"cpuLimit,omitempty"`
+	MemoryLimit   string `json:"memoryLimit,omitempty"`
+}
+
 // ParseResourceRequirements takes a set of CPU and memory requests and limit string
 // values and returns a ResourceRequirements struct to be used in a Container.
 // An error is returned if we cannot parse the request/limit.
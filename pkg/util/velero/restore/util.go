@@ -5,7 +5,8 @@ import (
 )
 
 func IsResourcePolicyValid(resourcePolicy string) bool {
-	if resourcePolicy == string(api.PolicyTypeNone) || resourcePolicy == string(api.PolicyTypeUpdate) {
+	switch api.PolicyType(resourcePolicy) {
+	case api.PolicyTypeNone, api.PolicyTypeUpdate, api.PolicyTypeRecreate:
 		return true
 	}
 	return false
@@ -11,5 +11,7 @@ import (
 func TestIsResourcePolicyValid(t *testing.T) {
 	require.True(t, IsResourcePolicyValid(string(velerov1api.PolicyTypeNone)))
 	require.True(t, IsResourcePolicyValid(string(velerov1api.PolicyTypeUpdate)))
+	require.True(t, IsResourcePolicyValid(string(velerov1api.PolicyTypeRecreate)))
+	require.False(t, IsResourcePolicyValid("replace"))
 	require.False(t, IsResourcePolicyValid(""))
 }